@@ -26,8 +26,32 @@ func NewPostgreSQLDatabase() (*gorm.DB, error) {
 	return gorm.Open(postgres.Open(dsn), &gorm.Config{})
 }
 
+// searchIndexes are the full-text indexes of the search repository. Their expressions must stay the same as the
+// documents searched there, otherwise PostgreSQL cannot use them.
+var searchIndexes = []string{
+	`CREATE INDEX IF NOT EXISTS idx_groups_search ON groups USING GIN (to_tsvector('simple', name || ' ' || leader))`,
+	`CREATE INDEX IF NOT EXISTS idx_addresses_search ON addresses USING GIN (to_tsvector('simple', address || ' ' || village_name || ' ' || district_name))`,
+	`CREATE INDEX IF NOT EXISTS idx_properties_search ON properties USING GIN (to_tsvector('simple', name || ' ' || description))`,
+	`CREATE INDEX IF NOT EXISTS idx_show_schedules_search ON show_schedules USING GIN (to_tsvector('simple', place))`,
+}
+
 func MigratePostgreSQLDatabase(db *gorm.DB) error {
-	return db.AutoMigrate(&entity.Admin{}, &entity.Group{}, &entity.Address{}, &entity.Property{}, &entity.ShowSchedule{})
+	if err := db.AutoMigrate(&entity.Admin{}, &entity.Group{}, &entity.Address{}, &entity.Property{}, &entity.ShowSchedule{}, &entity.Member{}, &entity.Attachment{}, &entity.RegistrationCounter{}, &entity.GroupStatusTransition{}, &entity.Achievement{}, &entity.LeadershipChange{}, &entity.GroupSubmission{}, &entity.Loan{}, &entity.Maintenance{}); err != nil {
+		return err
+	}
+
+	// AutoMigrate never drops a NOT NULL constraint, the group of a show schedule became optional to let it be
+	// detached from a deleted group.
+	if err := db.Exec("ALTER TABLE show_schedules ALTER COLUMN group_id DROP NOT NULL").Error; err != nil {
+		return err
+	}
+
+	for _, index := range searchIndexes {
+		if err := db.Exec(index).Error; err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 func SetInitialDataPostgreSQLDatabase(db *gorm.DB) error {
@@ -0,0 +1,85 @@
+package config
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/erikrios/reog-apps-apis/utils/storage"
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+// LocalStoragePath is the URL path the local storage directory is served from.
+const LocalStoragePath = "/uploads"
+
+// publicReadPolicy lets anyone download the objects of a bucket, so attachment URLs work without signing.
+const publicReadPolicy = `{
+	"Version": "2012-10-17",
+	"Statement": [{
+		"Effect": "Allow",
+		"Principal": {"AWS": ["*"]},
+		"Action": ["s3:GetObject"],
+		"Resource": ["arn:aws:s3:::%s/*"]
+	}]
+}`
+
+func NewStorage() (storage.Storage, error) {
+	if os.Getenv("STORAGE_DRIVER") == "s3" {
+		return newS3Storage()
+	}
+
+	baseURL := os.Getenv("STORAGE_BASE_URL")
+	if baseURL == "" {
+		baseURL = LocalStoragePath
+	}
+	return storage.NewLocalStorage(LocalStorageDir(), baseURL), nil
+}
+
+func LocalStorageDir() string {
+	if dir := os.Getenv("STORAGE_LOCAL_DIR"); dir != "" {
+		return dir
+	}
+	return "uploads"
+}
+
+func newS3Storage() (storage.Storage, error) {
+	endpoint := os.Getenv("S3_ENDPOINT")
+	bucket := os.Getenv("S3_BUCKET")
+	useSSL := os.Getenv("S3_USE_SSL") == "true"
+
+	client, err := minio.New(endpoint, &minio.Options{
+		Creds:  credentials.NewStaticV4(os.Getenv("S3_ACCESS_KEY"), os.Getenv("S3_SECRET_KEY"), ""),
+		Secure: useSSL,
+		Region: os.Getenv("S3_REGION"),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := context.Background()
+	exists, err := client.BucketExists(ctx, bucket)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		if err := client.MakeBucket(ctx, bucket, minio.MakeBucketOptions{Region: os.Getenv("S3_REGION")}); err != nil {
+			return nil, err
+		}
+
+		policy := fmt.Sprintf(publicReadPolicy, bucket)
+		if err := client.SetBucketPolicy(ctx, bucket, policy); err != nil {
+			return nil, err
+		}
+	}
+
+	baseURL := os.Getenv("STORAGE_BASE_URL")
+	if baseURL == "" {
+		scheme := "http"
+		if useSSL {
+			scheme = "https"
+		}
+		baseURL = fmt.Sprintf("%s://%s/%s", scheme, endpoint, bucket)
+	}
+	return storage.NewS3Storage(client, bucket, baseURL), nil
+}
@@ -0,0 +1,241 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/erikrios/reog-apps-apis/middleware"
+	"github.com/erikrios/reog-apps-apis/model"
+	"github.com/erikrios/reog-apps-apis/model/payload"
+	"github.com/erikrios/reog-apps-apis/model/response"
+	"github.com/erikrios/reog-apps-apis/service"
+	"github.com/erikrios/reog-apps-apis/service/achievement"
+	"github.com/labstack/echo/v4"
+)
+
+type achievementsController struct {
+	service achievement.AchievementService
+}
+
+func NewAchievementsController(service achievement.AchievementService) *achievementsController {
+	return &achievementsController{service: service}
+}
+
+func (a *achievementsController) Route(e *echo.Group) {
+	group := e.Group("/groups/:id/achievements", middleware.JWTMiddleware())
+	group.POST("", a.postCreateAchievement)
+	group.GET("", a.getAchievements)
+	group.GET("/:achievementID", a.getAchievementByID)
+	group.PUT("/:achievementID", a.putUpdateAchievement)
+	group.DELETE("/:achievementID", a.deleteAchievement)
+
+	ranking := e.Group("/achievements/ranking", middleware.JWTMiddleware())
+	ranking.GET("", a.getAchievementRanking)
+}
+
+// postCreateAchievement godoc
+// @Summary      Add an Achievement
+// @Description  Add an achievement won by a group
+// @Tags         achievements
+// @Accept       json
+// @Produce      json
+// @Param        default  body  payload.CreateAchievement  true  "request body"
+// @Param        id       path  string                     true  "group ID"
+// @Security     ApiKeyAuth
+// @Success      201  {object}  createAchievementResponse
+// @Failure      400  {object}  echo.HTTPError
+// @Failure      401  {object}  echo.HTTPError
+// @Failure      404  {object}  echo.HTTPError
+// @Failure      500  {object}  echo.HTTPError
+// @Router       /groups/{id}/achievements [post]
+func (a *achievementsController) postCreateAchievement(c echo.Context) error {
+	groupID := c.Param("id")
+
+	payload := new(payload.CreateAchievement)
+	if err := c.Bind(payload); err != nil {
+		return newErrorResponse(service.ErrInvalidPayload)
+	}
+
+	id, err := a.service.Create(c.Request().Context(), groupID, *payload)
+	if err != nil {
+		return newErrorResponse(err)
+	}
+
+	idResponse := map[string]any{"id": id}
+	response := model.NewResponse("success", "achievement successfully created", idResponse)
+	return c.JSON(http.StatusCreated, response)
+}
+
+// getAchievements godoc
+// @Summary      Get Achievements
+// @Description  Get the achievements of a group, from the most recent one
+// @Tags         achievements
+// @Produce      json
+// @Param        id  path  string  true  "group ID"
+// @Security     ApiKeyAuth
+// @Success      200  {object}  achievementsResponse
+// @Failure      401  {object}  echo.HTTPError
+// @Failure      404  {object}  echo.HTTPError
+// @Failure      500  {object}  echo.HTTPError
+// @Router       /groups/{id}/achievements [get]
+func (a *achievementsController) getAchievements(c echo.Context) error {
+	groupID := c.Param("id")
+
+	achievements, err := a.service.GetByGroupID(c.Request().Context(), groupID)
+	if err != nil {
+		return newErrorResponse(err)
+	}
+
+	achievementsResponses := map[string]any{"achievements": achievements}
+	responses := model.NewResponse("success", "successfully get achievements", achievementsResponses)
+	return c.JSON(http.StatusOK, responses)
+}
+
+// getAchievementByID godoc
+// @Summary      Get Achievement by ID
+// @Description  Get achievement by ID
+// @Tags         achievements
+// @Produce      json
+// @Param        id             path  string  true  "group ID"
+// @Param        achievementID  path  string  true  "achievement ID"
+// @Security     ApiKeyAuth
+// @Success      200  {object}  achievementResponse
+// @Failure      401  {object}  echo.HTTPError
+// @Failure      404  {object}  echo.HTTPError
+// @Failure      500  {object}  echo.HTTPError
+// @Router       /groups/{id}/achievements/{achievementID} [get]
+func (a *achievementsController) getAchievementByID(c echo.Context) error {
+	groupID := c.Param("id")
+	id := c.Param("achievementID")
+
+	achievement, err := a.service.GetByID(c.Request().Context(), groupID, id)
+	if err != nil {
+		return newErrorResponse(err)
+	}
+
+	achievementResponse := map[string]any{"achievement": achievement}
+	response := model.NewResponse("success", "successfully get achievement with id "+id, achievementResponse)
+	return c.JSON(http.StatusOK, response)
+}
+
+// putUpdateAchievement godoc
+// @Summary      Update an Achievement
+// @Description  Update an achievement
+// @Tags         achievements
+// @Accept       json
+// @Produce      json
+// @Param        default        body  payload.UpdateAchievement  true  "request body"
+// @Param        id             path  string                     true  "group ID"
+// @Param        achievementID  path  string                     true  "achievement ID"
+// @Security     ApiKeyAuth
+// @Success      204
+// @Failure      400  {object}  echo.HTTPError
+// @Failure      401  {object}  echo.HTTPError
+// @Failure      404  {object}  echo.HTTPError
+// @Failure      500  {object}  echo.HTTPError
+// @Router       /groups/{id}/achievements/{achievementID} [put]
+func (a *achievementsController) putUpdateAchievement(c echo.Context) error {
+	groupID := c.Param("id")
+	id := c.Param("achievementID")
+
+	payload := new(payload.UpdateAchievement)
+	if err := c.Bind(payload); err != nil {
+		return newErrorResponse(service.ErrInvalidPayload)
+	}
+
+	if err := a.service.Update(c.Request().Context(), groupID, id, *payload); err != nil {
+		return newErrorResponse(err)
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+// deleteAchievement godoc
+// @Summary      Delete an Achievement
+// @Description  Delete an achievement
+// @Tags         achievements
+// @Produce      json
+// @Param        id             path  string  true  "group ID"
+// @Param        achievementID  path  string  true  "achievement ID"
+// @Security     ApiKeyAuth
+// @Success      204
+// @Failure      401  {object}  echo.HTTPError
+// @Failure      404  {object}  echo.HTTPError
+// @Failure      500  {object}  echo.HTTPError
+// @Router       /groups/{id}/achievements/{achievementID} [delete]
+func (a *achievementsController) deleteAchievement(c echo.Context) error {
+	groupID := c.Param("id")
+	id := c.Param("achievementID")
+
+	if err := a.service.Delete(c.Request().Context(), groupID, id); err != nil {
+		return newErrorResponse(err)
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+// getAchievementRanking godoc
+// @Summary      Get Achievement Ranking
+// @Description  Get the medal table of the districts, counting first, second and third places as gold, silver and bronze medals
+// @Tags         achievements
+// @Produce      json
+// @Param        level  query  string  false  "count only achievements of the level: village, regency or national"
+// @Param        year   query  int     false  "count only achievements of the year"
+// @Security     ApiKeyAuth
+// @Success      200  {object}  achievementRankingResponse
+// @Failure      400  {object}  echo.HTTPError
+// @Failure      401  {object}  echo.HTTPError
+// @Failure      500  {object}  echo.HTTPError
+// @Router       /achievements/ranking [get]
+func (a *achievementsController) getAchievementRanking(c echo.Context) error {
+	payload := new(payload.GetAchievementRanking)
+	if err := c.Bind(payload); err != nil {
+		return newErrorResponse(service.ErrInvalidPayload)
+	}
+
+	ranking, err := a.service.GetRanking(c.Request().Context(), *payload)
+	if err != nil {
+		return newErrorResponse(err)
+	}
+
+	rankingResponse := map[string]any{"ranking": ranking}
+	response := model.NewResponse("success", "successfully get achievement ranking", rankingResponse)
+	return c.JSON(http.StatusOK, response)
+}
+
+// createAchievementResponse struct is used for swaggo to generate the API documentation, as it doesn't support generic yet.
+type createAchievementResponse struct {
+	Status  string `json:"status" extensions:"x-order=0"`
+	Message string `json:"message" extensions:"x-order=1"`
+	Data    idData `json:"data" extensions:"x-order=2"`
+}
+
+// achievementsResponse struct is used for swaggo to generate the API documentation, as it doesn't support generic yet.
+type achievementsResponse struct {
+	Status  string           `json:"status" extensions:"x-order=0"`
+	Message string           `json:"message" extensions:"x-order=1"`
+	Data    achievementsData `json:"data" extensions:"x-order=2"`
+}
+
+type achievementsData struct {
+	Achievements []response.Achievement `json:"achievements"`
+}
+
+// achievementResponse struct is used for swaggo to generate the API documentation, as it doesn't support generic yet.
+type achievementResponse struct {
+	Status  string          `json:"status" extensions:"x-order=0"`
+	Message string          `json:"message" extensions:"x-order=1"`
+	Data    achievementData `json:"data" extensions:"x-order=2"`
+}
+
+type achievementData struct {
+	Achievement response.Achievement `json:"achievement"`
+}
+
+// achievementRankingResponse struct is used for swaggo to generate the API documentation, as it doesn't support generic yet.
+type achievementRankingResponse struct {
+	Status  string                 `json:"status" extensions:"x-order=0"`
+	Message string                 `json:"message" extensions:"x-order=1"`
+	Data    achievementRankingData `json:"data" extensions:"x-order=2"`
+}
+
+type achievementRankingData struct {
+	Ranking []response.DistrictRanking `json:"ranking"`
+}
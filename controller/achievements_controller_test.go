@@ -0,0 +1,493 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/erikrios/reog-apps-apis/model"
+	"github.com/erikrios/reog-apps-apis/model/payload"
+	"github.com/erikrios/reog-apps-apis/model/response"
+	"github.com/erikrios/reog-apps-apis/service"
+	"github.com/erikrios/reog-apps-apis/service/achievement/mocks"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestRouteAchievements(t *testing.T) {
+	mockAchievementService := &mocks.AchievementService{}
+	controller := NewAchievementsController(mockAchievementService)
+	g := echo.New().Group("/api/v1")
+	controller.Route(g)
+	assert.NotNil(t, controller)
+}
+
+func TestPostCreateAchievement(t *testing.T) {
+	mockAchievementService := &mocks.AchievementService{}
+
+	dummyReq := payload.CreateAchievement{
+		EventName: "Festival Reog Nasional",
+		Year:      2021,
+		Level:     "national",
+		Rank:      1,
+	}
+
+	testCases := []struct {
+		name                 string
+		inputError           error
+		expectedStatusCode   int
+		expectedErrorMessage string
+	}{
+		{
+			name:               "it should return 201 status code, when there is no error",
+			inputError:         nil,
+			expectedStatusCode: http.StatusCreated,
+		},
+		{
+			name:                 "it should return 400 status code, when payload is invalid",
+			inputError:           service.ErrInvalidPayload,
+			expectedStatusCode:   http.StatusBadRequest,
+			expectedErrorMessage: "Invalid payload. Please check the payload schema in the API Documentation.",
+		},
+		{
+			name:                 "it should return 404 status code, when group ID not found",
+			inputError:           service.ErrDataNotFound,
+			expectedStatusCode:   http.StatusNotFound,
+			expectedErrorMessage: "Resource with given ID not found.",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockAchievementService.On(
+				"Create",
+				mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+				"g-xyz",
+				dummyReq,
+			).Return(
+				func(ctx context.Context, groupID string, p payload.CreateAchievement) string {
+					return "c-aBcdEfG"
+				},
+				func(ctx context.Context, groupID string, p payload.CreateAchievement) error {
+					return testCase.inputError
+				},
+			).Once()
+
+			controller := NewAchievementsController(mockAchievementService)
+			requestBody, err := json.Marshal(dummyReq)
+			assert.NoError(t, err)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(string(requestBody)))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/api/v1/groups/:id/achievements")
+			c.SetParamNames("id")
+			c.SetParamValues("g-xyz")
+
+			gotError := controller.postCreateAchievement(c)
+			if testCase.inputError == nil {
+				if assert.NoError(t, gotError) {
+					assert.Equal(t, testCase.expectedStatusCode, rec.Code)
+
+					gotResponse := make(map[string]any)
+					if err := json.Unmarshal(rec.Body.Bytes(), &gotResponse); assert.NoError(t, err) {
+						assert.Equal(t, "c-aBcdEfG", gotResponse["data"].(map[string]any)["id"])
+					}
+				}
+				return
+			}
+
+			if assert.Error(t, gotError) {
+				if echoHTTPError, ok := gotError.(*echo.HTTPError); assert.Equal(t, true, ok) {
+					assert.Equal(t, testCase.expectedStatusCode, echoHTTPError.Code)
+					assert.Equal(t, testCase.expectedErrorMessage, echoHTTPError.Message)
+				}
+			}
+		})
+	}
+}
+
+func TestGetAchievements(t *testing.T) {
+	mockAchievementService := &mocks.AchievementService{}
+
+	dummyAchievements := []response.Achievement{
+		{
+			ID:        "c-aBcdEfG",
+			GroupID:   "g-xyz",
+			EventName: "Festival Reog Nasional",
+			Year:      2021,
+			Level:     "national",
+			Rank:      1,
+			Certificate: &response.Attachment{
+				ID:          "f-hIjkLmN",
+				FileName:    "piagam.pdf",
+				ContentType: "application/pdf",
+				Size:        51200,
+				URL:         "/uploads/achievements/c-aBcdEfG/f-hIjkLmN.pdf",
+			},
+		},
+	}
+
+	testCases := []struct {
+		name                 string
+		inputError           error
+		expectedStatusCode   int
+		expectedErrorMessage string
+	}{
+		{
+			name:               "it should return 200 status code with valid response, when there is no error",
+			inputError:         nil,
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name:                 "it should return 404 status code, when group ID not found",
+			inputError:           service.ErrDataNotFound,
+			expectedStatusCode:   http.StatusNotFound,
+			expectedErrorMessage: "Resource with given ID not found.",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockAchievementService.On(
+				"GetByGroupID",
+				mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+				"g-xyz",
+			).Return(
+				func(ctx context.Context, groupID string) []response.Achievement {
+					return dummyAchievements
+				},
+				func(ctx context.Context, groupID string) error {
+					return testCase.inputError
+				},
+			).Once()
+
+			controller := NewAchievementsController(mockAchievementService)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/api/v1/groups/:id/achievements")
+			c.SetParamNames("id")
+			c.SetParamValues("g-xyz")
+
+			gotError := controller.getAchievements(c)
+			if testCase.inputError == nil {
+				if assert.NoError(t, gotError) {
+					assert.Equal(t, testCase.expectedStatusCode, rec.Code)
+
+					gotResponse := &model.Response[achievementsData]{}
+					if err := json.Unmarshal(rec.Body.Bytes(), gotResponse); assert.NoError(t, err) {
+						assert.Equal(t, dummyAchievements, gotResponse.Data.Achievements)
+					}
+				}
+				return
+			}
+
+			if assert.Error(t, gotError) {
+				if echoHTTPError, ok := gotError.(*echo.HTTPError); assert.Equal(t, true, ok) {
+					assert.Equal(t, testCase.expectedStatusCode, echoHTTPError.Code)
+					assert.Equal(t, testCase.expectedErrorMessage, echoHTTPError.Message)
+				}
+			}
+		})
+	}
+}
+
+func TestGetAchievementByID(t *testing.T) {
+	mockAchievementService := &mocks.AchievementService{}
+
+	dummyAchievement := response.Achievement{
+		ID:        "c-aBcdEfG",
+		GroupID:   "g-xyz",
+		EventName: "Parade Reog Kabupaten",
+		Year:      2020,
+		Level:     "regency",
+		Rank:      2,
+	}
+
+	testCases := []struct {
+		name                 string
+		inputError           error
+		expectedStatusCode   int
+		expectedErrorMessage string
+	}{
+		{
+			name:               "it should return 200 status code with valid response, when there is no error",
+			inputError:         nil,
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name:                 "it should return 404 status code, when achievement ID not found",
+			inputError:           service.ErrDataNotFound,
+			expectedStatusCode:   http.StatusNotFound,
+			expectedErrorMessage: "Resource with given ID not found.",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockAchievementService.On(
+				"GetByID",
+				mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+				"g-xyz",
+				"c-aBcdEfG",
+			).Return(
+				func(ctx context.Context, groupID string, id string) response.Achievement {
+					return dummyAchievement
+				},
+				func(ctx context.Context, groupID string, id string) error {
+					return testCase.inputError
+				},
+			).Once()
+
+			controller := NewAchievementsController(mockAchievementService)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/api/v1/groups/:id/achievements/:achievementID")
+			c.SetParamNames("id", "achievementID")
+			c.SetParamValues("g-xyz", "c-aBcdEfG")
+
+			gotError := controller.getAchievementByID(c)
+			if testCase.inputError == nil {
+				if assert.NoError(t, gotError) {
+					assert.Equal(t, testCase.expectedStatusCode, rec.Code)
+
+					gotResponse := &model.Response[achievementData]{}
+					if err := json.Unmarshal(rec.Body.Bytes(), gotResponse); assert.NoError(t, err) {
+						assert.Equal(t, dummyAchievement, gotResponse.Data.Achievement)
+					}
+				}
+				return
+			}
+
+			if assert.Error(t, gotError) {
+				if echoHTTPError, ok := gotError.(*echo.HTTPError); assert.Equal(t, true, ok) {
+					assert.Equal(t, testCase.expectedStatusCode, echoHTTPError.Code)
+					assert.Equal(t, testCase.expectedErrorMessage, echoHTTPError.Message)
+				}
+			}
+		})
+	}
+}
+
+func TestPutUpdateAchievement(t *testing.T) {
+	mockAchievementService := &mocks.AchievementService{}
+
+	dummyReq := payload.UpdateAchievement{
+		EventName: "Parade Reog Kabupaten",
+		Year:      2020,
+		Level:     "regency",
+		Rank:      2,
+	}
+
+	testCases := []struct {
+		name                 string
+		inputError           error
+		expectedStatusCode   int
+		expectedErrorMessage string
+	}{
+		{
+			name:               "it should return 204 status code, when there is no error",
+			inputError:         nil,
+			expectedStatusCode: http.StatusNoContent,
+		},
+		{
+			name:                 "it should return 404 status code, when achievement ID not found",
+			inputError:           service.ErrDataNotFound,
+			expectedStatusCode:   http.StatusNotFound,
+			expectedErrorMessage: "Resource with given ID not found.",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockAchievementService.On(
+				"Update",
+				mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+				"g-xyz",
+				"c-aBcdEfG",
+				dummyReq,
+			).Return(
+				func(ctx context.Context, groupID string, id string, p payload.UpdateAchievement) error {
+					return testCase.inputError
+				},
+			).Once()
+
+			controller := NewAchievementsController(mockAchievementService)
+			requestBody, err := json.Marshal(dummyReq)
+			assert.NoError(t, err)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPut, "/", strings.NewReader(string(requestBody)))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/api/v1/groups/:id/achievements/:achievementID")
+			c.SetParamNames("id", "achievementID")
+			c.SetParamValues("g-xyz", "c-aBcdEfG")
+
+			gotError := controller.putUpdateAchievement(c)
+			if testCase.inputError == nil {
+				if assert.NoError(t, gotError) {
+					assert.Equal(t, testCase.expectedStatusCode, rec.Code)
+				}
+				return
+			}
+
+			if assert.Error(t, gotError) {
+				if echoHTTPError, ok := gotError.(*echo.HTTPError); assert.Equal(t, true, ok) {
+					assert.Equal(t, testCase.expectedStatusCode, echoHTTPError.Code)
+					assert.Equal(t, testCase.expectedErrorMessage, echoHTTPError.Message)
+				}
+			}
+		})
+	}
+}
+
+func TestDeleteAchievement(t *testing.T) {
+	mockAchievementService := &mocks.AchievementService{}
+
+	testCases := []struct {
+		name                 string
+		inputError           error
+		expectedStatusCode   int
+		expectedErrorMessage string
+	}{
+		{
+			name:               "it should return 204 status code, when there is no error",
+			inputError:         nil,
+			expectedStatusCode: http.StatusNoContent,
+		},
+		{
+			name:                 "it should return 404 status code, when achievement ID not found",
+			inputError:           service.ErrDataNotFound,
+			expectedStatusCode:   http.StatusNotFound,
+			expectedErrorMessage: "Resource with given ID not found.",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockAchievementService.On(
+				"Delete",
+				mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+				"g-xyz",
+				"c-aBcdEfG",
+			).Return(
+				func(ctx context.Context, groupID string, id string) error {
+					return testCase.inputError
+				},
+			).Once()
+
+			controller := NewAchievementsController(mockAchievementService)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodDelete, "/", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/api/v1/groups/:id/achievements/:achievementID")
+			c.SetParamNames("id", "achievementID")
+			c.SetParamValues("g-xyz", "c-aBcdEfG")
+
+			gotError := controller.deleteAchievement(c)
+			if testCase.inputError == nil {
+				if assert.NoError(t, gotError) {
+					assert.Equal(t, testCase.expectedStatusCode, rec.Code)
+				}
+				return
+			}
+
+			if assert.Error(t, gotError) {
+				if echoHTTPError, ok := gotError.(*echo.HTTPError); assert.Equal(t, true, ok) {
+					assert.Equal(t, testCase.expectedStatusCode, echoHTTPError.Code)
+					assert.Equal(t, testCase.expectedErrorMessage, echoHTTPError.Message)
+				}
+			}
+		})
+	}
+}
+
+func TestGetAchievementRanking(t *testing.T) {
+	mockAchievementService := &mocks.AchievementService{}
+
+	dummyRanking := []response.DistrictRanking{
+		{Position: 1, DistrictID: "3502030", DistrictName: "Bungkal", Gold: 2, Silver: 0, Bronze: 1, Total: 3},
+		{Position: 2, DistrictID: "3502010", DistrictName: "Ngrayun", Gold: 1, Silver: 1, Bronze: 0, Total: 2},
+	}
+
+	testCases := []struct {
+		name                 string
+		inputError           error
+		expectedStatusCode   int
+		expectedErrorMessage string
+	}{
+		{
+			name:               "it should return 200 status code with valid response, when there is no error",
+			inputError:         nil,
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name:                 "it should return 400 status code, when level is invalid",
+			inputError:           service.ErrInvalidPayload,
+			expectedStatusCode:   http.StatusBadRequest,
+			expectedErrorMessage: "Invalid payload. Please check the payload schema in the API Documentation.",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockAchievementService.On(
+				"GetRanking",
+				mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+				payload.GetAchievementRanking{Level: "regency", Year: 2021},
+			).Return(
+				func(ctx context.Context, p payload.GetAchievementRanking) []response.DistrictRanking {
+					return dummyRanking
+				},
+				func(ctx context.Context, p payload.GetAchievementRanking) error {
+					return testCase.inputError
+				},
+			).Once()
+
+			controller := NewAchievementsController(mockAchievementService)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/?level=regency&year=2021", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/api/v1/achievements/ranking")
+
+			gotError := controller.getAchievementRanking(c)
+			if testCase.inputError == nil {
+				if assert.NoError(t, gotError) {
+					assert.Equal(t, testCase.expectedStatusCode, rec.Code)
+
+					gotResponse := &model.Response[achievementRankingData]{}
+					if err := json.Unmarshal(rec.Body.Bytes(), gotResponse); assert.NoError(t, err) {
+						assert.Equal(t, dummyRanking, gotResponse.Data.Ranking)
+					}
+				}
+				return
+			}
+
+			if assert.Error(t, gotError) {
+				if echoHTTPError, ok := gotError.(*echo.HTTPError); assert.Equal(t, true, ok) {
+					assert.Equal(t, testCase.expectedStatusCode, echoHTTPError.Code)
+					assert.Equal(t, testCase.expectedErrorMessage, echoHTTPError.Message)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,318 @@
+package controller
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/erikrios/reog-apps-apis/middleware"
+	"github.com/erikrios/reog-apps-apis/model"
+	"github.com/erikrios/reog-apps-apis/model/payload"
+	"github.com/erikrios/reog-apps-apis/service"
+	"github.com/erikrios/reog-apps-apis/service/attachment"
+	"github.com/labstack/echo/v4"
+)
+
+type attachmentsController struct {
+	service attachment.AttachmentService
+}
+
+func NewAttachmentsController(service attachment.AttachmentService) *attachmentsController {
+	return &attachmentsController{service: service}
+}
+
+func (a *attachmentsController) Route(e *echo.Group) {
+	groupAttachments := e.Group("/groups/:id/attachments", middleware.JWTMiddleware())
+	middleware.UploadRoute(groupAttachments, "", a.postCreateGroupAttachment)
+	groupAttachments.DELETE("/:attachmentID", a.deleteGroupAttachment)
+
+	propertyAttachments := e.Group("/groups/:id/properties/:propertyID/attachments", middleware.JWTMiddleware())
+	middleware.UploadRoute(propertyAttachments, "", a.postCreatePropertyAttachment)
+	propertyAttachments.DELETE("/:attachmentID", a.deletePropertyAttachment)
+
+	certificate := e.Group("/groups/:id/achievements/:achievementID/certificate", middleware.JWTMiddleware())
+	middleware.UploadRoute(certificate, "", a.postCreateAchievementCertificate)
+	certificate.DELETE("", a.deleteAchievementCertificate)
+
+	maintenancePhotos := e.Group("/groups/:id/properties/:propertyID/maintenance/:maintenanceID/photos", middleware.JWTMiddleware())
+	middleware.UploadRoute(maintenancePhotos, "", a.postCreateMaintenancePhoto)
+	maintenancePhotos.DELETE("/:attachmentID", a.deleteMaintenancePhoto)
+}
+
+// postCreateGroupAttachment godoc
+// @Summary      Upload a Group Attachment
+// @Description  Upload a photo (JPEG, PNG, GIF or WebP) or a PDF document of at most 10 MB for a group
+// @Tags         attachments
+// @Accept       multipart/form-data
+// @Produce      json
+// @Param        id    path      string  true  "group ID"
+// @Param        file  formData  file    true  "attachment file"
+// @Security     ApiKeyAuth
+// @Success      201  {object}  createAttachmentResponse
+// @Failure      400  {object}  echo.HTTPError
+// @Failure      401  {object}  echo.HTTPError
+// @Failure      404  {object}  echo.HTTPError
+// @Failure      413  {object}  echo.HTTPError
+// @Failure      415  {object}  echo.HTTPError
+// @Failure      500  {object}  echo.HTTPError
+// @Router       /groups/{id}/attachments [post]
+func (a *attachmentsController) postCreateGroupAttachment(c echo.Context) error {
+	groupID := c.Param("id")
+
+	payload, err := readAttachment(c)
+	if err != nil {
+		return newErrorResponse(err)
+	}
+
+	id, err := a.service.CreateForGroup(c.Request().Context(), groupID, payload)
+	if err != nil {
+		return newErrorResponse(err)
+	}
+
+	idResponse := map[string]any{"id": id}
+	response := model.NewResponse("success", "attachment successfully uploaded", idResponse)
+	return c.JSON(http.StatusCreated, response)
+}
+
+// deleteGroupAttachment godoc
+// @Summary      Delete a Group Attachment
+// @Description  Delete an attachment of a group, together with its files
+// @Tags         attachments
+// @Produce      json
+// @Param        id            path  string  true  "group ID"
+// @Param        attachmentID  path  string  true  "attachment ID"
+// @Security     ApiKeyAuth
+// @Success      204
+// @Failure      401  {object}  echo.HTTPError
+// @Failure      404  {object}  echo.HTTPError
+// @Failure      500  {object}  echo.HTTPError
+// @Router       /groups/{id}/attachments/{attachmentID} [delete]
+func (a *attachmentsController) deleteGroupAttachment(c echo.Context) error {
+	groupID := c.Param("id")
+	id := c.Param("attachmentID")
+
+	if err := a.service.DeleteFromGroup(c.Request().Context(), groupID, id); err != nil {
+		return newErrorResponse(err)
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+// postCreatePropertyAttachment godoc
+// @Summary      Upload a Property Attachment
+// @Description  Upload a photo (JPEG, PNG, GIF or WebP) or a PDF document of at most 10 MB for a property
+// @Tags         attachments
+// @Accept       multipart/form-data
+// @Produce      json
+// @Param        id          path      string  true  "group ID"
+// @Param        propertyID  path      string  true  "property ID"
+// @Param        file        formData  file    true  "attachment file"
+// @Security     ApiKeyAuth
+// @Success      201  {object}  createAttachmentResponse
+// @Failure      400  {object}  echo.HTTPError
+// @Failure      401  {object}  echo.HTTPError
+// @Failure      404  {object}  echo.HTTPError
+// @Failure      413  {object}  echo.HTTPError
+// @Failure      415  {object}  echo.HTTPError
+// @Failure      500  {object}  echo.HTTPError
+// @Router       /groups/{id}/properties/{propertyID}/attachments [post]
+func (a *attachmentsController) postCreatePropertyAttachment(c echo.Context) error {
+	groupID := c.Param("id")
+	propertyID := c.Param("propertyID")
+
+	payload, err := readAttachment(c)
+	if err != nil {
+		return newErrorResponse(err)
+	}
+
+	id, err := a.service.CreateForProperty(c.Request().Context(), groupID, propertyID, payload)
+	if err != nil {
+		return newErrorResponse(err)
+	}
+
+	idResponse := map[string]any{"id": id}
+	response := model.NewResponse("success", "attachment successfully uploaded", idResponse)
+	return c.JSON(http.StatusCreated, response)
+}
+
+// deletePropertyAttachment godoc
+// @Summary      Delete a Property Attachment
+// @Description  Delete an attachment of a property, together with its files
+// @Tags         attachments
+// @Produce      json
+// @Param        id            path  string  true  "group ID"
+// @Param        propertyID    path  string  true  "property ID"
+// @Param        attachmentID  path  string  true  "attachment ID"
+// @Security     ApiKeyAuth
+// @Success      204
+// @Failure      401  {object}  echo.HTTPError
+// @Failure      404  {object}  echo.HTTPError
+// @Failure      500  {object}  echo.HTTPError
+// @Router       /groups/{id}/properties/{propertyID}/attachments/{attachmentID} [delete]
+func (a *attachmentsController) deletePropertyAttachment(c echo.Context) error {
+	groupID := c.Param("id")
+	propertyID := c.Param("propertyID")
+	id := c.Param("attachmentID")
+
+	if err := a.service.DeleteFromProperty(c.Request().Context(), groupID, propertyID, id); err != nil {
+		return newErrorResponse(err)
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+// postCreateAchievementCertificate godoc
+// @Summary      Upload an Achievement Certificate
+// @Description  Upload the certificate scan of an achievement, as a photo (JPEG, PNG, GIF or WebP) or a PDF document of at most 10 MB. An achievement holds one certificate, delete the current one to replace it.
+// @Tags         attachments
+// @Accept       multipart/form-data
+// @Produce      json
+// @Param        id             path      string  true  "group ID"
+// @Param        achievementID  path      string  true  "achievement ID"
+// @Param        file           formData  file    true  "certificate file"
+// @Security     ApiKeyAuth
+// @Success      201  {object}  createAttachmentResponse
+// @Failure      400  {object}  echo.HTTPError
+// @Failure      401  {object}  echo.HTTPError
+// @Failure      404  {object}  echo.HTTPError
+// @Failure      413  {object}  echo.HTTPError
+// @Failure      415  {object}  echo.HTTPError
+// @Failure      500  {object}  echo.HTTPError
+// @Router       /groups/{id}/achievements/{achievementID}/certificate [post]
+func (a *attachmentsController) postCreateAchievementCertificate(c echo.Context) error {
+	groupID := c.Param("id")
+	achievementID := c.Param("achievementID")
+
+	payload, err := readAttachment(c)
+	if err != nil {
+		return newErrorResponse(err)
+	}
+
+	id, err := a.service.CreateForAchievement(c.Request().Context(), groupID, achievementID, payload)
+	if err != nil {
+		return newErrorResponse(err)
+	}
+
+	idResponse := map[string]any{"id": id}
+	response := model.NewResponse("success", "certificate successfully uploaded", idResponse)
+	return c.JSON(http.StatusCreated, response)
+}
+
+// deleteAchievementCertificate godoc
+// @Summary      Delete an Achievement Certificate
+// @Description  Delete the certificate scan of an achievement, together with its files
+// @Tags         attachments
+// @Produce      json
+// @Param        id             path  string  true  "group ID"
+// @Param        achievementID  path  string  true  "achievement ID"
+// @Security     ApiKeyAuth
+// @Success      204
+// @Failure      401  {object}  echo.HTTPError
+// @Failure      404  {object}  echo.HTTPError
+// @Failure      500  {object}  echo.HTTPError
+// @Router       /groups/{id}/achievements/{achievementID}/certificate [delete]
+func (a *attachmentsController) deleteAchievementCertificate(c echo.Context) error {
+	groupID := c.Param("id")
+	achievementID := c.Param("achievementID")
+
+	if err := a.service.DeleteFromAchievement(c.Request().Context(), groupID, achievementID); err != nil {
+		return newErrorResponse(err)
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+// postCreateMaintenancePhoto godoc
+// @Summary      Upload a Maintenance Photo
+// @Description  Upload a photo (JPEG, PNG, GIF or WebP) of at most 10 MB showing the condition of a property on a maintenance record
+// @Tags         attachments
+// @Accept       multipart/form-data
+// @Produce      json
+// @Param        id             path      string  true  "group ID"
+// @Param        propertyID     path      string  true  "property ID"
+// @Param        maintenanceID  path      string  true  "maintenance ID"
+// @Param        file           formData  file    true  "photo file"
+// @Security     ApiKeyAuth
+// @Success      201  {object}  createAttachmentResponse
+// @Failure      400  {object}  echo.HTTPError
+// @Failure      401  {object}  echo.HTTPError
+// @Failure      404  {object}  echo.HTTPError
+// @Failure      413  {object}  echo.HTTPError
+// @Failure      415  {object}  echo.HTTPError
+// @Failure      500  {object}  echo.HTTPError
+// @Router       /groups/{id}/properties/{propertyID}/maintenance/{maintenanceID}/photos [post]
+func (a *attachmentsController) postCreateMaintenancePhoto(c echo.Context) error {
+	groupID := c.Param("id")
+	propertyID := c.Param("propertyID")
+	maintenanceID := c.Param("maintenanceID")
+
+	payload, err := readAttachment(c)
+	if err != nil {
+		return newErrorResponse(err)
+	}
+
+	id, err := a.service.CreateForMaintenance(c.Request().Context(), groupID, propertyID, maintenanceID, payload)
+	if err != nil {
+		return newErrorResponse(err)
+	}
+
+	idResponse := map[string]any{"id": id}
+	response := model.NewResponse("success", "photo successfully uploaded", idResponse)
+	return c.JSON(http.StatusCreated, response)
+}
+
+// deleteMaintenancePhoto godoc
+// @Summary      Delete a Maintenance Photo
+// @Description  Delete a photo of a maintenance record, together with its files
+// @Tags         attachments
+// @Produce      json
+// @Param        id             path  string  true  "group ID"
+// @Param        propertyID     path  string  true  "property ID"
+// @Param        maintenanceID  path  string  true  "maintenance ID"
+// @Param        attachmentID   path  string  true  "attachment ID"
+// @Security     ApiKeyAuth
+// @Success      204
+// @Failure      401  {object}  echo.HTTPError
+// @Failure      404  {object}  echo.HTTPError
+// @Failure      500  {object}  echo.HTTPError
+// @Router       /groups/{id}/properties/{propertyID}/maintenance/{maintenanceID}/photos/{attachmentID} [delete]
+func (a *attachmentsController) deleteMaintenancePhoto(c echo.Context) error {
+	groupID := c.Param("id")
+	propertyID := c.Param("propertyID")
+	maintenanceID := c.Param("maintenanceID")
+	id := c.Param("attachmentID")
+
+	if err := a.service.DeleteFromMaintenance(c.Request().Context(), groupID, propertyID, maintenanceID, id); err != nil {
+		return newErrorResponse(err)
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+// readAttachment reads the uploaded file from the "file" form field. At most one byte more than
+// attachment.MaxFileSize is read, which is enough for the service to reject oversized files.
+func readAttachment(c echo.Context) (p payload.CreateAttachment, err error) {
+	fileHeader, formErr := c.FormFile("file")
+	if formErr != nil {
+		err = service.ErrInvalidPayload
+		return
+	}
+
+	file, openErr := fileHeader.Open()
+	if openErr != nil {
+		err = service.ErrInvalidPayload
+		return
+	}
+	defer file.Close()
+
+	content, readErr := io.ReadAll(io.LimitReader(file, attachment.MaxFileSize+1))
+	if readErr != nil {
+		err = service.ErrInvalidPayload
+		return
+	}
+
+	p = payload.CreateAttachment{FileName: fileHeader.Filename, Content: content}
+	return
+}
+
+// createAttachmentResponse struct is used for swaggo to generate the API documentation, as it doesn't support generic yet.
+type createAttachmentResponse struct {
+	Status  string `json:"status" extensions:"x-order=0"`
+	Message string `json:"message" extensions:"x-order=1"`
+	Data    idData `json:"data" extensions:"x-order=2"`
+}
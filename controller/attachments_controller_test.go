@@ -0,0 +1,662 @@
+package controller
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/erikrios/reog-apps-apis/middleware"
+	"github.com/erikrios/reog-apps-apis/model/payload"
+	"github.com/erikrios/reog-apps-apis/service"
+	"github.com/erikrios/reog-apps-apis/service/attachment/mocks"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func newMultipartRequest(t *testing.T, fileName string, content []byte) *http.Request {
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	part, err := writer.CreateFormFile("file", fileName)
+	if assert.NoError(t, err) {
+		_, err = part.Write(content)
+		assert.NoError(t, err)
+	}
+	assert.NoError(t, writer.Close())
+
+	req := httptest.NewRequest(http.MethodPost, "/", body)
+	req.Header.Set(echo.HeaderContentType, writer.FormDataContentType())
+	return req
+}
+
+func TestRouteAttachments(t *testing.T) {
+	mockAttachmentService := &mocks.AttachmentService{}
+	controller := NewAttachmentsController(mockAttachmentService)
+	e := echo.New()
+	middleware.BodyLimit(e)
+	controller.Route(e.Group("/api/v1"))
+	assert.NotNil(t, controller)
+
+	uploadPaths := []string{
+		"/api/v1/groups/g-xyz/attachments",
+		"/api/v1/groups/g-xyz/properties/p-YIhpPgp/attachments",
+		"/api/v1/groups/g-xyz/achievements/c-aBcdEfG/certificate",
+		"/api/v1/groups/g-xyz/properties/p-YIhpPgp/maintenance/i-oPqrStU/photos",
+	}
+	for _, path := range uploadPaths {
+		t.Run("it should not apply the global body limit to "+path, func(t *testing.T) {
+			req := newMultipartRequest(t, "barongan.png", make([]byte, 1<<20))
+			req.URL.Path = path
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, req)
+
+			assert.NotEqual(t, http.StatusRequestEntityTooLarge, rec.Code)
+		})
+	}
+}
+
+func TestPostCreateGroupAttachment(t *testing.T) {
+	mockAttachmentService := &mocks.AttachmentService{}
+	dummyContent := []byte("%PDF-1.4\n%%EOF\n")
+
+	t.Run("success scenario", func(t *testing.T) {
+		dummyID := "f-aBcdEfG"
+
+		mockAttachmentService.On(
+			"CreateForGroup",
+			mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+			"g-xyz",
+			payload.CreateAttachment{FileName: "surat.pdf", Content: dummyContent},
+		).Return(
+			func(ctx context.Context, groupID string, p payload.CreateAttachment) string {
+				return dummyID
+			},
+			func(ctx context.Context, groupID string, p payload.CreateAttachment) error {
+				return nil
+			},
+		).Once()
+
+		t.Run("it should return 201 status code with valid response, when there is no error", func(t *testing.T) {
+			controller := NewAttachmentsController(mockAttachmentService)
+
+			e := echo.New()
+			rec := httptest.NewRecorder()
+			c := e.NewContext(newMultipartRequest(t, "surat.pdf", dummyContent), rec)
+			c.SetPath("/api/v1/groups/:id/attachments")
+			c.SetParamNames("id")
+			c.SetParamValues("g-xyz")
+
+			if assert.NoError(t, controller.postCreateGroupAttachment(c)) {
+				assert.Equal(t, http.StatusCreated, rec.Code)
+
+				gotResponse := make(map[string]any)
+				if err := json.Unmarshal(rec.Body.Bytes(), &gotResponse); assert.NoError(t, err) {
+					gotID := gotResponse["data"].(map[string]any)["id"].(string)
+					assert.Equal(t, dummyID, gotID)
+				}
+			}
+		})
+	})
+
+	t.Run("failed scenario", func(t *testing.T) {
+		t.Run("it should return 400 status code, when file is missing", func(t *testing.T) {
+			controller := NewAttachmentsController(mockAttachmentService)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPost, "/", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/api/v1/groups/:id/attachments")
+			c.SetParamNames("id")
+			c.SetParamValues("g-xyz")
+
+			gotError := controller.postCreateGroupAttachment(c)
+			if assert.Error(t, gotError) {
+				if echoHTTPError, ok := gotError.(*echo.HTTPError); assert.Equal(t, true, ok) {
+					assert.Equal(t, http.StatusBadRequest, echoHTTPError.Code)
+				}
+			}
+		})
+
+		testCases := []struct {
+			name                 string
+			inputError           error
+			expectedStatusCode   int
+			expectedErrorMessage string
+		}{
+			{
+				name:                 "it should return 404 status code, when group ID not found",
+				inputError:           service.ErrDataNotFound,
+				expectedStatusCode:   http.StatusNotFound,
+				expectedErrorMessage: "Resource with given ID not found.",
+			},
+			{
+				name:                 "it should return 413 status code, when file is too large",
+				inputError:           service.ErrFileTooLarge,
+				expectedStatusCode:   http.StatusRequestEntityTooLarge,
+				expectedErrorMessage: "File too large. Please upload a file of at most 10 MB, or an image of at most 40 megapixels.",
+			},
+			{
+				name:                 "it should return 415 status code, when file type is not supported",
+				inputError:           service.ErrUnsupportedFile,
+				expectedStatusCode:   http.StatusUnsupportedMediaType,
+				expectedErrorMessage: "Unsupported file type. Please upload a JPEG, PNG, GIF or WebP image, or a PDF document.",
+			},
+			{
+				name:                 "it should return 500 status code, when error happened",
+				inputError:           service.ErrRepository,
+				expectedStatusCode:   http.StatusInternalServerError,
+				expectedErrorMessage: "Something went wrong.",
+			},
+		}
+
+		for _, testCase := range testCases {
+			t.Run(testCase.name, func(t *testing.T) {
+				mockAttachmentService.On(
+					"CreateForGroup",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", payload.CreateAttachment{})),
+				).Return(
+					func(ctx context.Context, groupID string, p payload.CreateAttachment) string {
+						return ""
+					},
+					func(ctx context.Context, groupID string, p payload.CreateAttachment) error {
+						return testCase.inputError
+					},
+				).Once()
+
+				controller := NewAttachmentsController(mockAttachmentService)
+
+				e := echo.New()
+				rec := httptest.NewRecorder()
+				c := e.NewContext(newMultipartRequest(t, "surat.pdf", dummyContent), rec)
+				c.SetPath("/api/v1/groups/:id/attachments")
+				c.SetParamNames("id")
+				c.SetParamValues("g-xyz")
+
+				gotError := controller.postCreateGroupAttachment(c)
+				if assert.Error(t, gotError) {
+					if echoHTTPError, ok := gotError.(*echo.HTTPError); assert.Equal(t, true, ok) {
+						assert.Equal(t, testCase.expectedStatusCode, echoHTTPError.Code)
+						assert.Equal(t, testCase.expectedErrorMessage, echoHTTPError.Message)
+					}
+				}
+			})
+		}
+	})
+}
+
+func TestDeleteGroupAttachment(t *testing.T) {
+	mockAttachmentService := &mocks.AttachmentService{}
+
+	testCases := []struct {
+		name                 string
+		inputError           error
+		expectedStatusCode   int
+		expectedErrorMessage string
+	}{
+		{
+			name:               "it should return 204 status code, when there is no error",
+			inputError:         nil,
+			expectedStatusCode: http.StatusNoContent,
+		},
+		{
+			name:                 "it should return 404 status code, when attachment ID not found",
+			inputError:           service.ErrDataNotFound,
+			expectedStatusCode:   http.StatusNotFound,
+			expectedErrorMessage: "Resource with given ID not found.",
+		},
+		{
+			name:                 "it should return 500 status code, when error happened",
+			inputError:           service.ErrRepository,
+			expectedStatusCode:   http.StatusInternalServerError,
+			expectedErrorMessage: "Something went wrong.",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockAttachmentService.On(
+				"DeleteFromGroup",
+				mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+				"g-xyz",
+				"f-aBcdEfG",
+			).Return(
+				func(ctx context.Context, groupID string, id string) error {
+					return testCase.inputError
+				},
+			).Once()
+
+			controller := NewAttachmentsController(mockAttachmentService)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodDelete, "/", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/api/v1/groups/:id/attachments/:attachmentID")
+			c.SetParamNames("id", "attachmentID")
+			c.SetParamValues("g-xyz", "f-aBcdEfG")
+
+			gotError := controller.deleteGroupAttachment(c)
+			if testCase.inputError == nil {
+				if assert.NoError(t, gotError) {
+					assert.Equal(t, testCase.expectedStatusCode, rec.Code)
+				}
+				return
+			}
+
+			if assert.Error(t, gotError) {
+				if echoHTTPError, ok := gotError.(*echo.HTTPError); assert.Equal(t, true, ok) {
+					assert.Equal(t, testCase.expectedStatusCode, echoHTTPError.Code)
+					assert.Equal(t, testCase.expectedErrorMessage, echoHTTPError.Message)
+				}
+			}
+		})
+	}
+}
+
+func TestPostCreatePropertyAttachment(t *testing.T) {
+	mockAttachmentService := &mocks.AttachmentService{}
+	dummyContent := []byte("%PDF-1.4\n%%EOF\n")
+
+	testCases := []struct {
+		name                 string
+		inputError           error
+		expectedStatusCode   int
+		expectedErrorMessage string
+	}{
+		{
+			name:               "it should return 201 status code, when there is no error",
+			inputError:         nil,
+			expectedStatusCode: http.StatusCreated,
+		},
+		{
+			name:                 "it should return 404 status code, when property ID not found",
+			inputError:           service.ErrDataNotFound,
+			expectedStatusCode:   http.StatusNotFound,
+			expectedErrorMessage: "Resource with given ID not found.",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockAttachmentService.On(
+				"CreateForProperty",
+				mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+				"g-xyz",
+				"p-YIhpPgp",
+				payload.CreateAttachment{FileName: "nota.pdf", Content: dummyContent},
+			).Return(
+				func(ctx context.Context, groupID string, propertyID string, p payload.CreateAttachment) string {
+					return "f-aBcdEfG"
+				},
+				func(ctx context.Context, groupID string, propertyID string, p payload.CreateAttachment) error {
+					return testCase.inputError
+				},
+			).Once()
+
+			controller := NewAttachmentsController(mockAttachmentService)
+
+			e := echo.New()
+			rec := httptest.NewRecorder()
+			c := e.NewContext(newMultipartRequest(t, "nota.pdf", dummyContent), rec)
+			c.SetPath("/api/v1/groups/:id/properties/:propertyID/attachments")
+			c.SetParamNames("id", "propertyID")
+			c.SetParamValues("g-xyz", "p-YIhpPgp")
+
+			gotError := controller.postCreatePropertyAttachment(c)
+			if testCase.inputError == nil {
+				if assert.NoError(t, gotError) {
+					assert.Equal(t, testCase.expectedStatusCode, rec.Code)
+				}
+				return
+			}
+
+			if assert.Error(t, gotError) {
+				if echoHTTPError, ok := gotError.(*echo.HTTPError); assert.Equal(t, true, ok) {
+					assert.Equal(t, testCase.expectedStatusCode, echoHTTPError.Code)
+					assert.Equal(t, testCase.expectedErrorMessage, echoHTTPError.Message)
+				}
+			}
+		})
+	}
+}
+
+func TestDeletePropertyAttachment(t *testing.T) {
+	mockAttachmentService := &mocks.AttachmentService{}
+
+	testCases := []struct {
+		name                 string
+		inputError           error
+		expectedStatusCode   int
+		expectedErrorMessage string
+	}{
+		{
+			name:               "it should return 204 status code, when there is no error",
+			inputError:         nil,
+			expectedStatusCode: http.StatusNoContent,
+		},
+		{
+			name:                 "it should return 404 status code, when attachment ID not found",
+			inputError:           service.ErrDataNotFound,
+			expectedStatusCode:   http.StatusNotFound,
+			expectedErrorMessage: "Resource with given ID not found.",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockAttachmentService.On(
+				"DeleteFromProperty",
+				mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+				"g-xyz",
+				"p-YIhpPgp",
+				"f-aBcdEfG",
+			).Return(
+				func(ctx context.Context, groupID string, propertyID string, id string) error {
+					return testCase.inputError
+				},
+			).Once()
+
+			controller := NewAttachmentsController(mockAttachmentService)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodDelete, "/", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/api/v1/groups/:id/properties/:propertyID/attachments/:attachmentID")
+			c.SetParamNames("id", "propertyID", "attachmentID")
+			c.SetParamValues("g-xyz", "p-YIhpPgp", "f-aBcdEfG")
+
+			gotError := controller.deletePropertyAttachment(c)
+			if testCase.inputError == nil {
+				if assert.NoError(t, gotError) {
+					assert.Equal(t, testCase.expectedStatusCode, rec.Code)
+				}
+				return
+			}
+
+			if assert.Error(t, gotError) {
+				if echoHTTPError, ok := gotError.(*echo.HTTPError); assert.Equal(t, true, ok) {
+					assert.Equal(t, testCase.expectedStatusCode, echoHTTPError.Code)
+					assert.Equal(t, testCase.expectedErrorMessage, echoHTTPError.Message)
+				}
+			}
+		})
+	}
+}
+
+func TestPostCreateAchievementCertificate(t *testing.T) {
+	mockAttachmentService := &mocks.AttachmentService{}
+	dummyContent := []byte("%PDF-1.4\n%%EOF\n")
+
+	testCases := []struct {
+		name                 string
+		inputError           error
+		expectedStatusCode   int
+		expectedErrorMessage string
+	}{
+		{
+			name:               "it should return 201 status code, when there is no error",
+			inputError:         nil,
+			expectedStatusCode: http.StatusCreated,
+		},
+		{
+			name:                 "it should return 400 status code, when achievement already has a certificate",
+			inputError:           service.ErrDataAlreadyExists,
+			expectedStatusCode:   http.StatusBadRequest,
+			expectedErrorMessage: "Data already exists.",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockAttachmentService.On(
+				"CreateForAchievement",
+				mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+				"g-xyz",
+				"c-oPqrStU",
+				payload.CreateAttachment{FileName: "piagam.pdf", Content: dummyContent},
+			).Return(
+				func(ctx context.Context, groupID string, achievementID string, p payload.CreateAttachment) string {
+					return "f-aBcdEfG"
+				},
+				func(ctx context.Context, groupID string, achievementID string, p payload.CreateAttachment) error {
+					return testCase.inputError
+				},
+			).Once()
+
+			controller := NewAttachmentsController(mockAttachmentService)
+
+			e := echo.New()
+			rec := httptest.NewRecorder()
+			c := e.NewContext(newMultipartRequest(t, "piagam.pdf", dummyContent), rec)
+			c.SetPath("/api/v1/groups/:id/achievements/:achievementID/certificate")
+			c.SetParamNames("id", "achievementID")
+			c.SetParamValues("g-xyz", "c-oPqrStU")
+
+			gotError := controller.postCreateAchievementCertificate(c)
+			if testCase.inputError == nil {
+				if assert.NoError(t, gotError) {
+					assert.Equal(t, testCase.expectedStatusCode, rec.Code)
+				}
+				return
+			}
+
+			if assert.Error(t, gotError) {
+				if echoHTTPError, ok := gotError.(*echo.HTTPError); assert.Equal(t, true, ok) {
+					assert.Equal(t, testCase.expectedStatusCode, echoHTTPError.Code)
+					assert.Equal(t, testCase.expectedErrorMessage, echoHTTPError.Message)
+				}
+			}
+		})
+	}
+}
+
+func TestDeleteAchievementCertificate(t *testing.T) {
+	mockAttachmentService := &mocks.AttachmentService{}
+
+	testCases := []struct {
+		name                 string
+		inputError           error
+		expectedStatusCode   int
+		expectedErrorMessage string
+	}{
+		{
+			name:               "it should return 204 status code, when there is no error",
+			inputError:         nil,
+			expectedStatusCode: http.StatusNoContent,
+		},
+		{
+			name:                 "it should return 404 status code, when achievement has no certificate",
+			inputError:           service.ErrDataNotFound,
+			expectedStatusCode:   http.StatusNotFound,
+			expectedErrorMessage: "Resource with given ID not found.",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockAttachmentService.On(
+				"DeleteFromAchievement",
+				mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+				"g-xyz",
+				"c-oPqrStU",
+			).Return(
+				func(ctx context.Context, groupID string, achievementID string) error {
+					return testCase.inputError
+				},
+			).Once()
+
+			controller := NewAttachmentsController(mockAttachmentService)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodDelete, "/", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/api/v1/groups/:id/achievements/:achievementID/certificate")
+			c.SetParamNames("id", "achievementID")
+			c.SetParamValues("g-xyz", "c-oPqrStU")
+
+			gotError := controller.deleteAchievementCertificate(c)
+			if testCase.inputError == nil {
+				if assert.NoError(t, gotError) {
+					assert.Equal(t, testCase.expectedStatusCode, rec.Code)
+				}
+				return
+			}
+
+			if assert.Error(t, gotError) {
+				if echoHTTPError, ok := gotError.(*echo.HTTPError); assert.Equal(t, true, ok) {
+					assert.Equal(t, testCase.expectedStatusCode, echoHTTPError.Code)
+					assert.Equal(t, testCase.expectedErrorMessage, echoHTTPError.Message)
+				}
+			}
+		})
+	}
+}
+
+func TestPostCreateMaintenancePhoto(t *testing.T) {
+	mockAttachmentService := &mocks.AttachmentService{}
+	dummyContent := []byte("\x89PNG\r\n\x1a\n")
+
+	testCases := []struct {
+		name                 string
+		inputError           error
+		expectedStatusCode   int
+		expectedErrorMessage string
+	}{
+		{
+			name:               "it should return 201 status code, when there is no error",
+			inputError:         nil,
+			expectedStatusCode: http.StatusCreated,
+		},
+		{
+			name:                 "it should return 404 status code, when maintenance ID not found",
+			inputError:           service.ErrDataNotFound,
+			expectedStatusCode:   http.StatusNotFound,
+			expectedErrorMessage: "Resource with given ID not found.",
+		},
+		{
+			name:                 "it should return 415 status code, when the photo is not an image",
+			inputError:           service.ErrUnsupportedFile,
+			expectedStatusCode:   http.StatusUnsupportedMediaType,
+			expectedErrorMessage: "Unsupported file type. Please upload a JPEG, PNG, GIF or WebP image, or a PDF document.",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockAttachmentService.On(
+				"CreateForMaintenance",
+				mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+				"g-xyz",
+				"p-YIhpPgp",
+				"i-oPqrStU",
+				payload.CreateAttachment{FileName: "barongan.png", Content: dummyContent},
+			).Return(
+				func(ctx context.Context, groupID string, propertyID string, maintenanceID string, p payload.CreateAttachment) string {
+					return "f-aBcdEfG"
+				},
+				func(ctx context.Context, groupID string, propertyID string, maintenanceID string, p payload.CreateAttachment) error {
+					return testCase.inputError
+				},
+			).Once()
+
+			controller := NewAttachmentsController(mockAttachmentService)
+
+			e := echo.New()
+			rec := httptest.NewRecorder()
+			c := e.NewContext(newMultipartRequest(t, "barongan.png", dummyContent), rec)
+			c.SetPath("/api/v1/groups/:id/properties/:propertyID/maintenance/:maintenanceID/photos")
+			c.SetParamNames("id", "propertyID", "maintenanceID")
+			c.SetParamValues("g-xyz", "p-YIhpPgp", "i-oPqrStU")
+
+			gotError := controller.postCreateMaintenancePhoto(c)
+			if testCase.inputError == nil {
+				if assert.NoError(t, gotError) {
+					assert.Equal(t, testCase.expectedStatusCode, rec.Code)
+				}
+				return
+			}
+
+			if assert.Error(t, gotError) {
+				if echoHTTPError, ok := gotError.(*echo.HTTPError); assert.Equal(t, true, ok) {
+					assert.Equal(t, testCase.expectedStatusCode, echoHTTPError.Code)
+					assert.Equal(t, testCase.expectedErrorMessage, echoHTTPError.Message)
+				}
+			}
+		})
+	}
+}
+
+func TestDeleteMaintenancePhoto(t *testing.T) {
+	mockAttachmentService := &mocks.AttachmentService{}
+
+	testCases := []struct {
+		name                 string
+		inputError           error
+		expectedStatusCode   int
+		expectedErrorMessage string
+	}{
+		{
+			name:               "it should return 204 status code, when there is no error",
+			inputError:         nil,
+			expectedStatusCode: http.StatusNoContent,
+		},
+		{
+			name:                 "it should return 404 status code, when attachment ID not found",
+			inputError:           service.ErrDataNotFound,
+			expectedStatusCode:   http.StatusNotFound,
+			expectedErrorMessage: "Resource with given ID not found.",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockAttachmentService.On(
+				"DeleteFromMaintenance",
+				mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+				"g-xyz",
+				"p-YIhpPgp",
+				"i-oPqrStU",
+				"f-aBcdEfG",
+			).Return(
+				func(ctx context.Context, groupID string, propertyID string, maintenanceID string, id string) error {
+					return testCase.inputError
+				},
+			).Once()
+
+			controller := NewAttachmentsController(mockAttachmentService)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodDelete, "/", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/api/v1/groups/:id/properties/:propertyID/maintenance/:maintenanceID/photos/:attachmentID")
+			c.SetParamNames("id", "propertyID", "maintenanceID", "attachmentID")
+			c.SetParamValues("g-xyz", "p-YIhpPgp", "i-oPqrStU", "f-aBcdEfG")
+
+			gotError := controller.deleteMaintenancePhoto(c)
+			if testCase.inputError == nil {
+				if assert.NoError(t, gotError) {
+					assert.Equal(t, testCase.expectedStatusCode, rec.Code)
+				}
+				return
+			}
+
+			if assert.Error(t, gotError) {
+				if echoHTTPError, ok := gotError.(*echo.HTTPError); assert.Equal(t, true, ok) {
+					assert.Equal(t, testCase.expectedStatusCode, echoHTTPError.Code)
+					assert.Equal(t, testCase.expectedErrorMessage, echoHTTPError.Message)
+				}
+			}
+		})
+	}
+}
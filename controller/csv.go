@@ -0,0 +1,59 @@
+package controller
+
+import (
+	"encoding/csv"
+	"errors"
+	"io"
+	"strings"
+
+	"github.com/erikrios/reog-apps-apis/model/payload"
+)
+
+var errInvalidCSV = errors.New("controller: invalid csv")
+
+// readGroupsCSV reads groups from a CSV file whose first row is a header containing
+// the name, leader, address and villageID columns, in any order.
+func readGroupsCSV(r io.Reader) (groups []payload.CreateGroup, err error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+
+	header, readErr := reader.Read()
+	if readErr != nil {
+		err = errInvalidCSV
+		return
+	}
+
+	columns := map[string]int{"name": -1, "leader": -1, "address": -1, "villageid": -1}
+	for i, column := range header {
+		column = strings.ToLower(strings.TrimSpace(strings.TrimPrefix(column, "\ufeff")))
+		if _, ok := columns[column]; ok {
+			columns[column] = i
+		}
+	}
+	for _, i := range columns {
+		if i < 0 {
+			err = errInvalidCSV
+			return
+		}
+	}
+
+	groups = make([]payload.CreateGroup, 0)
+	for {
+		record, readErr := reader.Read()
+		if errors.Is(readErr, io.EOF) {
+			break
+		}
+		if readErr != nil {
+			err = errInvalidCSV
+			return
+		}
+
+		groups = append(groups, payload.CreateGroup{
+			Name:      strings.TrimSpace(record[columns["name"]]),
+			Leader:    strings.TrimSpace(record[columns["leader"]]),
+			Address:   strings.TrimSpace(record[columns["address"]]),
+			VillageID: strings.TrimSpace(record[columns["villageid"]]),
+		})
+	}
+	return
+}
@@ -22,6 +22,54 @@ func newErrorResponse(err error) *echo.HTTPError {
 	} else if errors.Is(err, service.ErrTimeParsing) {
 		statusCode = http.StatusBadRequest
 		message = "Invalid time format. Please use RFC822 time format (02 Jan 06 15:04 MST)"
+	} else if errors.Is(err, service.ErrDateParsing) {
+		statusCode = http.StatusBadRequest
+		message = "Invalid date format. Please use ISO 8601 date format (2006-01-02)"
+	} else if errors.Is(err, service.ErrFileTooLarge) {
+		statusCode = http.StatusRequestEntityTooLarge
+		message = "File too large. Please upload a file of at most 10 MB, or an image of at most 40 megapixels."
+	} else if errors.Is(err, service.ErrUnsupportedFile) {
+		statusCode = http.StatusUnsupportedMediaType
+		message = "Unsupported file type. Please upload a JPEG, PNG, GIF or WebP image, or a PDF document."
+	} else if errors.Is(err, service.ErrStatusUnchanged) {
+		statusCode = http.StatusConflict
+		message = "Group already has the given status."
+	} else if errors.Is(err, service.ErrGroupInactive) {
+		statusCode = http.StatusConflict
+		message = "Group is suspended or dissolved."
+	} else if errors.Is(err, service.ErrParentDeleted) {
+		statusCode = http.StatusConflict
+		message = "The group it belongs to is deleted. Please restore the group first."
+	} else if errors.Is(err, service.ErrAlreadyReviewed) {
+		statusCode = http.StatusConflict
+		message = "Submission has already been approved or rejected."
+	} else if errors.Is(err, service.ErrGroupHasShows) {
+		statusCode = http.StatusConflict
+		message = "Group still has show schedules. Please cascade or detach them to delete the group."
+	} else if errors.Is(err, service.ErrNotEnoughAvailable) {
+		statusCode = http.StatusConflict
+		message = "Not enough items available. Please lend at most the available quantity, and keep at least the quantity on loan."
+	} else if errors.Is(err, service.ErrPropertyOnLoan) {
+		statusCode = http.StatusConflict
+		message = "Property still has items out on loan. Please return them first."
+	} else if errors.Is(err, service.ErrLoanReturned) {
+		statusCode = http.StatusConflict
+		message = "Loan has already been returned."
+	} else if errors.Is(err, service.ErrNotRegistered) {
+		statusCode = http.StatusConflict
+		message = "Group has no registration number yet. It is given when the server starts."
+	} else if errors.Is(err, service.ErrCodeNotGenuine) {
+		statusCode = http.StatusBadRequest
+		message = "Code is not genuine. It is malformed, tampered with or signed with an unknown key."
+	} else if errors.Is(err, service.ErrVersionRequired) {
+		statusCode = http.StatusPreconditionRequired
+		message = "If-Match header is required. Please send the ETag of the resource the change is based on."
+	} else if errors.Is(err, service.ErrVersionMismatch) {
+		statusCode = http.StatusPreconditionFailed
+		message = "Resource has been modified since it was read. Please get it again and retry."
+	} else if errors.Is(err, service.ErrTooManyRequests) {
+		statusCode = http.StatusTooManyRequests
+		message = "Too many submissions. Please try again tomorrow."
 	} else if errors.Is(err, service.ErrInvalidPayload) {
 		statusCode = http.StatusBadRequest
 		message = "Invalid payload. Please check the payload schema in the API Documentation."
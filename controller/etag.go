@@ -0,0 +1,70 @@
+package controller
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/erikrios/reog-apps-apis/service"
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	headerETag        = "ETag"
+	headerIfMatch     = "If-Match"
+	headerIfNoneMatch = "If-None-Match"
+)
+
+// newETag tags a representation with the version of the resource, followed by a digest of the representation. The
+// version is what If-Match is checked against, while the digest changes along with the records embedded in the
+// representation, such as the properties of a group, so that If-None-Match never skips them.
+func newETag(version int, body any) string {
+	// The bodies are response structs, which always marshal
+	data, _ := json.Marshal(body)
+	sum := sha256.Sum256(data)
+	return fmt.Sprintf(`"%d-%x"`, version, sum[:8])
+}
+
+// notModified sets the ETag header of the response, and tells whether the client already has the representation
+// according to the If-None-Match header of the request.
+func notModified(c echo.Context, etag string) bool {
+	c.Response().Header().Set(headerETag, etag)
+
+	for _, tag := range strings.Split(c.Request().Header.Get(headerIfNoneMatch), ",") {
+		tag = strings.TrimPrefix(strings.TrimSpace(tag), "W/")
+		if tag == etag || tag == "*" {
+			return true
+		}
+	}
+	return false
+}
+
+// ifMatchVersion reads the version the client based its change on from the If-Match header, holding either the
+// ETag of the resource or its quoted version.
+func ifMatchVersion(c echo.Context) (version int, err error) {
+	return tagVersion(c.Request().Header.Get(headerIfMatch))
+}
+
+// tagVersion reads the version out of an ETag or a quoted version. A missing tag is service.ErrVersionRequired, and
+// a tag without a version cannot match any version.
+func tagVersion(tag string) (version int, err error) {
+	tag = strings.TrimSpace(tag)
+	if tag == "" {
+		err = service.ErrVersionRequired
+		return
+	}
+
+	if len(tag) < 2 || !strings.HasPrefix(tag, `"`) || !strings.HasSuffix(tag, `"`) {
+		err = service.ErrVersionMismatch
+		return
+	}
+
+	versionPart, _, _ := strings.Cut(tag[1:len(tag)-1], "-")
+	version, parseErr := strconv.Atoi(versionPart)
+	if parseErr != nil || version < 1 {
+		err = service.ErrVersionMismatch
+	}
+	return
+}
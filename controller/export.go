@@ -0,0 +1,201 @@
+package controller
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/erikrios/reog-apps-apis/model/response"
+	"github.com/xuri/excelize/v2"
+)
+
+const (
+	exportSheetGroups     = "groups"
+	exportSheetProperties = "properties"
+)
+
+var groupColumns = []any{
+	"id",
+	"name",
+	"leader",
+	"status",
+	"address",
+	"villageID",
+	"villageName",
+	"districtID",
+	"districtName",
+	"regencyID",
+	"regencyName",
+	"provinceID",
+	"provinceName",
+	"propertyCount",
+}
+
+var propertyColumns = []any{
+	"groupID",
+	"groupName",
+	"id",
+	"name",
+	"description",
+	"amount",
+}
+
+func groupRows(groups []response.Group) [][]any {
+	rows := make([][]any, len(groups))
+	for i, group := range groups {
+		rows[i] = []any{
+			group.ID,
+			group.Name,
+			group.Leader,
+			group.Status,
+			group.Address.Address,
+			group.Address.VillageID,
+			group.Address.VillageName,
+			group.Address.DistrictID,
+			group.Address.DistrictName,
+			group.Address.RegencyID,
+			group.Address.RegencyName,
+			group.Address.ProvinceID,
+			group.Address.ProvinceName,
+			len(group.Properties),
+		}
+	}
+	return rows
+}
+
+func propertyRows(groups []response.Group) [][]any {
+	rows := make([][]any, 0)
+	for _, group := range groups {
+		for _, property := range group.Properties {
+			rows = append(rows, []any{
+				group.ID,
+				group.Name,
+				property.ID,
+				property.Name,
+				property.Description,
+				property.Amount,
+			})
+		}
+	}
+	return rows
+}
+
+// writeCSV writes the rows to the CSV writer and flushes them, so that every exported page reaches the client as soon
+// as it is written.
+func writeCSV(writer *csv.Writer, rows [][]any) error {
+	for _, row := range rows {
+		record := make([]string, len(row))
+		for i, value := range row {
+			record[i] = fmt.Sprint(escapeFormula(value))
+		}
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+// groupsWorkbook is an XLSX workbook with a groups sheet and a properties sheet, filled page by page through stream
+// writers which keep the rows on disk once they grow large. A workbook is a zip archive, so it can only be written out
+// once every page is in.
+type groupsWorkbook struct {
+	file       *excelize.File
+	groups     *sheetWriter
+	properties *sheetWriter
+}
+
+func newGroupsWorkbook() (*groupsWorkbook, error) {
+	file := excelize.NewFile()
+	file.SetSheetName(file.GetSheetName(0), exportSheetGroups)
+	file.NewSheet(exportSheetProperties)
+
+	groups, err := newSheetWriter(file, exportSheetGroups, groupColumns)
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+
+	properties, err := newSheetWriter(file, exportSheetProperties, propertyColumns)
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+
+	return &groupsWorkbook{file: file, groups: groups, properties: properties}, nil
+}
+
+// writePage appends the groups and their properties to the sheets.
+func (w *groupsWorkbook) writePage(groups []response.Group) error {
+	if err := w.groups.write(groupRows(groups)); err != nil {
+		return err
+	}
+	return w.properties.write(propertyRows(groups))
+}
+
+// writeTo writes the complete workbook to out.
+func (w *groupsWorkbook) writeTo(out io.Writer) error {
+	if err := w.groups.Flush(); err != nil {
+		return err
+	}
+	if err := w.properties.Flush(); err != nil {
+		return err
+	}
+
+	_, err := w.file.WriteTo(out)
+	return err
+}
+
+func (w *groupsWorkbook) Close() error {
+	return w.file.Close()
+}
+
+// sheetWriter streams rows to a sheet, each below the last written one.
+type sheetWriter struct {
+	*excelize.StreamWriter
+	written int
+}
+
+func newSheetWriter(file *excelize.File, sheet string, header []any) (*sheetWriter, error) {
+	streamWriter, err := file.NewStreamWriter(sheet)
+	if err != nil {
+		return nil, err
+	}
+
+	writer := &sheetWriter{StreamWriter: streamWriter}
+	if err := writer.write([][]any{header}); err != nil {
+		return nil, err
+	}
+	return writer, nil
+}
+
+func (s *sheetWriter) write(rows [][]any) error {
+	for _, row := range rows {
+		cell, err := excelize.CoordinatesToCellName(1, s.written+1)
+		if err != nil {
+			return err
+		}
+
+		values := make([]any, len(row))
+		for i, value := range row {
+			values[i] = escapeFormula(value)
+		}
+		if err := s.SetRow(cell, values); err != nil {
+			return err
+		}
+		s.written++
+	}
+	return nil
+}
+
+// escapeFormula prefixes the text starting like a formula with an apostrophe, so that a spreadsheet opening the export
+// shows the text instead of evaluating it. Numbers are left as they are.
+func escapeFormula(value any) any {
+	text, ok := value.(string)
+	if ok && text != "" && strings.ContainsRune("=+-@\t\r", rune(text[0])) {
+		return "'" + text
+	}
+	return value
+}
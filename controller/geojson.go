@@ -0,0 +1,77 @@
+package controller
+
+import (
+	"github.com/erikrios/reog-apps-apis/model/response"
+)
+
+// mimeGeoJSON is the media type of GeoJSON documents, registered by RFC 7946.
+const mimeGeoJSON = "application/geo+json"
+
+type featureCollection[T any] struct {
+	Type     string       `json:"type"`
+	Features []feature[T] `json:"features"`
+}
+
+// feature is a GeoJSON feature. Its geometry is null when the location is unknown, which RFC 7946 allows.
+type feature[T any] struct {
+	Type       string    `json:"type"`
+	ID         string    `json:"id"`
+	Geometry   *geometry `json:"geometry"`
+	Properties T         `json:"properties"`
+}
+
+type geometry struct {
+	Type string `json:"type"`
+	// Coordinates are in longitude, latitude order
+	Coordinates []float64 `json:"coordinates"`
+}
+
+func newFeature[T any](id string, latitude, longitude *float64, properties T) feature[T] {
+	f := feature[T]{Type: "Feature", ID: id, Properties: properties}
+	if latitude != nil && longitude != nil {
+		f.Geometry = &geometry{Type: "Point", Coordinates: []float64{*longitude, *latitude}}
+	}
+	return f
+}
+
+func groupsFeatureCollection(groups []response.Group) featureCollection[response.Group] {
+	features := make([]feature[response.Group], len(groups))
+	for i, group := range groups {
+		features[i] = newFeature(group.ID, group.Address.Latitude, group.Address.Longitude, group)
+	}
+	return featureCollection[response.Group]{Type: "FeatureCollection", Features: features}
+}
+
+func showsFeatureCollection(shows []response.ShowSchedule) featureCollection[response.ShowSchedule] {
+	features := make([]feature[response.ShowSchedule], len(shows))
+	for i, show := range shows {
+		features[i] = newFeature(show.ID, show.Latitude, show.Longitude, show)
+	}
+	return featureCollection[response.ShowSchedule]{Type: "FeatureCollection", Features: features}
+}
+
+// groupsGeoJSON struct is used for swaggo to generate the API documentation, as it doesn't support generic yet.
+type groupsGeoJSON struct {
+	Type     string         `json:"type" example:"FeatureCollection" extensions:"x-order=0"`
+	Features []groupFeature `json:"features" extensions:"x-order=1"`
+}
+
+type groupFeature struct {
+	Type       string         `json:"type" example:"Feature" extensions:"x-order=0"`
+	ID         string         `json:"id" extensions:"x-order=1"`
+	Geometry   *geometry      `json:"geometry" extensions:"x-order=2"`
+	Properties response.Group `json:"properties" extensions:"x-order=3"`
+}
+
+// showsGeoJSON struct is used for swaggo to generate the API documentation, as it doesn't support generic yet.
+type showsGeoJSON struct {
+	Type     string        `json:"type" example:"FeatureCollection" extensions:"x-order=0"`
+	Features []showFeature `json:"features" extensions:"x-order=1"`
+}
+
+type showFeature struct {
+	Type       string                `json:"type" example:"Feature" extensions:"x-order=0"`
+	ID         string                `json:"id" extensions:"x-order=1"`
+	Geometry   *geometry             `json:"geometry" extensions:"x-order=2"`
+	Properties response.ShowSchedule `json:"properties" extensions:"x-order=3"`
+}
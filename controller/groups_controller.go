@@ -1,7 +1,10 @@
 package controller
 
 import (
+	"encoding/csv"
+	"io"
 	"net/http"
+	"strings"
 
 	"github.com/erikrios/reog-apps-apis/middleware"
 	"github.com/erikrios/reog-apps-apis/model"
@@ -11,6 +14,7 @@ import (
 	"github.com/erikrios/reog-apps-apis/service/address"
 	"github.com/erikrios/reog-apps-apis/service/group"
 	"github.com/erikrios/reog-apps-apis/service/property"
+	"github.com/erikrios/reog-apps-apis/utils/generator"
 	"github.com/labstack/echo/v4"
 )
 
@@ -18,33 +22,52 @@ type groupsController struct {
 	groupService    group.GroupService
 	propertyService property.PropertyService
 	addressService  address.AddressService
+	tokenGenerator  generator.TokenGenerator
 }
 
 func NewGroupsController(
 	groupService group.GroupService,
 	propertyService property.PropertyService,
 	addressService address.AddressService,
+	tokenGenerator generator.TokenGenerator,
 ) *groupsController {
 	return &groupsController{
 		groupService:    groupService,
 		propertyService: propertyService,
 		addressService:  addressService,
+		tokenGenerator:  tokenGenerator,
 	}
 }
 
 func (g *groupsController) Route(e *echo.Group) {
 	group := e.Group("/groups", middleware.JWTMiddleware())
 	group.POST("", g.postCreateGroup)
+	middleware.UploadRoute(group, "/import", g.postImportGroups)
 	group.GET("", g.getGroups)
+	group.GET("/export", g.getExportGroups)
+	group.GET("/nearby", g.getNearbyGroups)
+	group.GET("/duplicates", g.getDuplicateGroups)
 	group.GET("/:id", g.getGroupByID)
 	group.PUT("/:id", g.putUpdateGroupByID)
+	group.PATCH("/:id", g.patchGroupByID)
+	group.PUT("/:id/contacts", g.putUpdateGroupContacts)
 	group.DELETE("/:id", g.deleteGroupByID)
+	group.POST("/:id/merge", g.postMergeGroup)
 	group.GET("/:id/generate", g.getGenerateQRCode)
+	group.GET("/:id/certificate", g.getGenerateCertificate)
+	group.GET("/:id/vcard", g.getGenerateVCard)
+	group.PUT("/:id/status", g.putUpdateGroupStatus)
+	group.GET("/:id/status/history", g.getGroupStatusHistory)
+	group.GET("/:id/leaders", g.getGroupLeadershipHistory)
 	group.PUT("/addresses/:id", g.putUpdateAddress)
+	group.PATCH("/addresses/:id", g.patchAddress)
 	group.POST("/:id/properties", g.postCreateProperty)
 	group.PUT("/:id/properties/:propertyID", g.putUpdateProperty)
+	group.PATCH("/:id/properties/:propertyID", g.patchProperty)
 	group.DELETE("/:id/properties/:propertyID", g.deleteProperty)
 	group.GET("/:id/properties/:propertyID/generate", g.getGeneratePropertyQRCode)
+
+	e.GET("/groups.geojson", g.getGroupsGeoJSON, middleware.JWTMiddleware())
 }
 
 // postCreateGroup godoc
@@ -77,35 +100,293 @@ func (g *groupsController) postCreateGroup(c echo.Context) error {
 	return c.JSON(http.StatusCreated, response)
 }
 
+// postImportGroups godoc
+// @Summary      Import Groups
+// @Description  Create groups from a CSV file with name, leader, address and villageID columns. Without atomic, the rows are created one by one and a row that cannot be saved is reported as failed.
+// @Tags         groups
+// @Accept       multipart/form-data
+// @Accept       text/csv
+// @Produce      json
+// @Param        file     formData  file  false  "CSV file"
+// @Param        dry_run  query     bool  false  "validate the rows without creating any group"
+// @Param        atomic   query     bool  false  "create no group at all when one of the rows is invalid or cannot be saved"
+// @Security     ApiKeyAuth
+// @Success      200  {object}  importGroupsResponse
+// @Failure      400  {object}  echo.HTTPError
+// @Failure      401  {object}  echo.HTTPError
+// @Failure      413  {object}  echo.HTTPError
+// @Failure      500  {object}  echo.HTTPError
+// @Router       /groups/import [post]
+func (g *groupsController) postImportGroups(c echo.Context) error {
+	payload := new(payload.ImportGroups)
+	if err := (&echo.DefaultBinder{}).BindQueryParams(c, payload); err != nil {
+		return newErrorResponse(service.ErrInvalidPayload)
+	}
+
+	var file io.Reader = c.Request().Body
+	if strings.HasPrefix(c.Request().Header.Get(echo.HeaderContentType), echo.MIMEMultipartForm) {
+		fileHeader, err := c.FormFile("file")
+		if err != nil {
+			return newErrorResponse(service.ErrInvalidPayload)
+		}
+
+		multipartFile, err := fileHeader.Open()
+		if err != nil {
+			return newErrorResponse(service.ErrInvalidPayload)
+		}
+		defer multipartFile.Close()
+
+		file = multipartFile
+	}
+
+	rows, err := readGroupsCSV(file)
+	if err != nil {
+		return newErrorResponse(service.ErrInvalidPayload)
+	}
+
+	results, err := g.groupService.Import(c.Request().Context(), rows, *payload)
+	if err != nil {
+		return newErrorResponse(err)
+	}
+
+	message := "groups successfully imported"
+	if payload.DryRun {
+		message = "groups successfully validated"
+	}
+
+	resultsResponse := map[string]any{"groups": results}
+	response := model.NewResponse("success", message, resultsResponse)
+	return c.JSON(http.StatusOK, response)
+}
+
 // getGroups     godoc
 // @Summary      Get Groups
-// @Description  Get Groups
+// @Description  Get Groups. The attachments and achievements are left empty, get a group by ID for them.
 // @Tags         groups
 // @Produce      json
+// @Param        page         query  int     false  "page number, starting from 1, maximum 10000"
+// @Param        limit        query  int     false  "number of groups per page, maximum 100"
+// @Param        district_id  query  string  false  "filter groups by district ID"
+// @Param        village_id   query  string  false  "filter groups by village ID"
+// @Param        name         query  string  false  "filter groups by name substring"
+// @Param        status       query  string  false  "filter groups by status: active, dormant, suspended or dissolved"
+// @Param        sort         query  string  false  "sort by name, created_at or property_count, prefix with - for descending order"
 // @Security     ApiKeyAuth
 // @Success      200  {object}  groupsResponse
+// @Failure      400  {object}  echo.HTTPError
 // @Failure      401  {object}  echo.HTTPError
 // @Failure      500  {object}  echo.HTTPError
 // @Router       /groups [get]
 func (g *groupsController) getGroups(c echo.Context) error {
-	groups, err := g.groupService.GetAll(c.Request().Context())
+	payload := new(payload.GetGroups)
+	if err := c.Bind(payload); err != nil {
+		return newErrorResponse(service.ErrInvalidPayload)
+	}
+
+	groups, pagination, err := g.groupService.GetAll(c.Request().Context(), *payload)
 	if err != nil {
 		return newErrorResponse(err)
 	}
 
-	groupsResposes := map[string]any{"groups": groups}
+	if pagination.Page < pagination.TotalPages {
+		pagination.Next = pageLink(c, pagination.Page+1)
+	}
+	if pagination.Page > 1 {
+		pagination.Previous = pageLink(c, pagination.Page-1)
+	}
+
+	groupsResposes := map[string]any{"groups": groups, "pagination": pagination}
 	responses := model.NewResponse("success", "successfully get groups", groupsResposes)
 	return c.JSON(http.StatusOK, responses)
 }
 
+// getExportGroups godoc
+// @Summary      Export Groups
+// @Description  Export groups and their properties as CSV or XLSX. The XLSX workbook holds a groups sheet and a properties sheet.
+// @Tags         groups
+// @Produce      text/csv
+// @Produce      application/vnd.openxmlformats-officedocument.spreadsheetml.sheet
+// @Param        format       query  string  false  "csv or xlsx, default to csv"
+// @Param        sheet        query  string  false  "groups or properties for the csv format, default to groups"
+// @Param        district_id  query  string  false  "filter groups by district ID"
+// @Param        village_id   query  string  false  "filter groups by village ID"
+// @Param        name         query  string  false  "filter groups by name substring"
+// @Param        status       query  string  false  "filter groups by status: active, dormant, suspended or dissolved"
+// @Param        sort         query  string  false  "sort by name, created_at or property_count, prefix with - for descending order"
+// @Security     ApiKeyAuth
+// @Success      200  {file}    binary
+// @Failure      400  {object}  echo.HTTPError
+// @Failure      401  {object}  echo.HTTPError
+// @Failure      500  {object}  echo.HTTPError
+// @Router       /groups/export [get]
+func (g *groupsController) getExportGroups(c echo.Context) error {
+	format := c.QueryParam("format")
+	sheet := c.QueryParam("sheet")
+	if format == "" {
+		format = "csv"
+	}
+	if sheet == "" {
+		sheet = exportSheetGroups
+	}
+
+	if (format != "csv" && format != "xlsx") || (sheet != exportSheetGroups && sheet != exportSheetProperties) {
+		return newErrorResponse(service.ErrInvalidPayload)
+	}
+
+	payload := new(payload.GetGroups)
+	if err := c.Bind(payload); err != nil {
+		return newErrorResponse(service.ErrInvalidPayload)
+	}
+
+	res := c.Response()
+	if format == "xlsx" {
+		workbook, err := newGroupsWorkbook()
+		if err != nil {
+			return newErrorResponse(err)
+		}
+		defer workbook.Close()
+
+		if err := g.groupService.Export(c.Request().Context(), *payload, workbook.writePage); err != nil {
+			return newErrorResponse(err)
+		}
+
+		res.Header().Set(echo.HeaderContentType, "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet")
+		res.Header().Set(echo.HeaderContentDisposition, `attachment; filename="groups.xlsx"`)
+		res.WriteHeader(http.StatusOK)
+		return workbook.writeTo(res)
+	}
+
+	header, rows := groupColumns, groupRows
+	if sheet == exportSheetProperties {
+		header, rows = propertyColumns, propertyRows
+	}
+
+	// The response is only committed along with the first page, so that an error before it is still answered with
+	// an error response.
+	writer := csv.NewWriter(res)
+	err := g.groupService.Export(c.Request().Context(), *payload, func(groups []response.Group) error {
+		if !res.Committed {
+			res.Header().Set(echo.HeaderContentType, "text/csv; charset=utf-8")
+			res.Header().Set(echo.HeaderContentDisposition, `attachment; filename="`+sheet+`.csv"`)
+			res.WriteHeader(http.StatusOK)
+			if err := writeCSV(writer, [][]any{header}); err != nil {
+				return err
+			}
+		}
+		return writeCSV(writer, rows(groups))
+	})
+	if err != nil && !res.Committed {
+		return newErrorResponse(err)
+	}
+	return err
+}
+
+// getGroupsGeoJSON godoc
+// @Summary      Get Groups as GeoJSON
+// @Description  Get every group matching the filters as a GeoJSON feature collection, located by its address. Groups without a known location have a null geometry.
+// @Tags         groups
+// @Produce      application/geo+json
+// @Param        district_id  query  string  false  "filter groups by district ID"
+// @Param        village_id   query  string  false  "filter groups by village ID"
+// @Param        name         query  string  false  "filter groups by name substring"
+// @Param        status       query  string  false  "filter groups by status: active, dormant, suspended or dissolved"
+// @Param        sort         query  string  false  "sort by name, created_at or property_count, prefix with - for descending order"
+// @Security     ApiKeyAuth
+// @Success      200  {object}  groupsGeoJSON
+// @Failure      400  {object}  echo.HTTPError
+// @Failure      401  {object}  echo.HTTPError
+// @Failure      500  {object}  echo.HTTPError
+// @Router       /groups.geojson [get]
+func (g *groupsController) getGroupsGeoJSON(c echo.Context) error {
+	payload := new(payload.GetGroups)
+	if err := c.Bind(payload); err != nil {
+		return newErrorResponse(service.ErrInvalidPayload)
+	}
+
+	groups := make([]response.Group, 0)
+	err := g.groupService.Export(c.Request().Context(), *payload, func(page []response.Group) error {
+		groups = append(groups, page...)
+		return nil
+	})
+	if err != nil {
+		return newErrorResponse(err)
+	}
+
+	c.Response().Header().Set(echo.HeaderContentType, mimeGeoJSON)
+	return c.JSON(http.StatusOK, groupsFeatureCollection(groups))
+}
+
+// getNearbyGroups godoc
+// @Summary      Get Nearby Groups
+// @Description  Get the groups located within the radius of a point, from the closest one
+// @Tags         groups
+// @Produce      json
+// @Param        lat     query  number  true   "latitude of the point"
+// @Param        lng     query  number  true   "longitude of the point"
+// @Param        radius  query  number  false  "radius in meters, maximum 50000, default to 5000"
+// @Param        limit   query  int     false  "maximum number of groups, maximum 100, default to 20"
+// @Security     ApiKeyAuth
+// @Success      200  {object}  nearbyGroupsResponse
+// @Failure      400  {object}  echo.HTTPError
+// @Failure      401  {object}  echo.HTTPError
+// @Failure      500  {object}  echo.HTTPError
+// @Router       /groups/nearby [get]
+func (g *groupsController) getNearbyGroups(c echo.Context) error {
+	payload := new(payload.GetNearbyGroups)
+	if err := c.Bind(payload); err != nil {
+		return newErrorResponse(service.ErrInvalidPayload)
+	}
+
+	groups, err := g.groupService.GetNearby(c.Request().Context(), *payload)
+	if err != nil {
+		return newErrorResponse(err)
+	}
+
+	groupsResponses := map[string]any{"groups": groups}
+	responses := model.NewResponse("success", "successfully get nearby groups", groupsResponses)
+	return c.JSON(http.StatusOK, responses)
+}
+
+// getDuplicateGroups godoc
+// @Summary      Get Duplicate Groups
+// @Description  Get the pairs of groups of the same village whose names and leaders are spelled alike, from the most similar one
+// @Tags         groups
+// @Produce      json
+// @Param        district_id     query  string  false  "compare only the groups of the district"
+// @Param        village_id      query  string  false  "compare only the groups of the village"
+// @Param        min_similarity  query  number  false  "lowest similarity of the pairs, between 0 and 1, default to 0.8"
+// @Security     ApiKeyAuth
+// @Success      200  {object}  duplicateGroupsResponse
+// @Failure      400  {object}  echo.HTTPError
+// @Failure      401  {object}  echo.HTTPError
+// @Failure      500  {object}  echo.HTTPError
+// @Router       /groups/duplicates [get]
+func (g *groupsController) getDuplicateGroups(c echo.Context) error {
+	payload := new(payload.GetDuplicateGroups)
+	if err := c.Bind(payload); err != nil {
+		return newErrorResponse(service.ErrInvalidPayload)
+	}
+
+	duplicates, err := g.groupService.GetDuplicates(c.Request().Context(), *payload)
+	if err != nil {
+		return newErrorResponse(err)
+	}
+
+	duplicatesResponse := map[string]any{"duplicates": duplicates}
+	response := model.NewResponse("success", "successfully get duplicate groups", duplicatesResponse)
+	return c.JSON(http.StatusOK, response)
+}
+
 //  getGroupByID godoc
 // @Summary      Get Group by ID
-// @Description  Get group by ID
+// @Description  Get group by ID. The ETag header is sent back in the If-Match header to update or delete the group, and in the If-None-Match header to skip downloading it again while it is unchanged.
 // @Tags         groups
 // @Produce      json
-// @Param        id          path  string  true  "group ID"
+// @Param        id             path    string  true   "group ID"
+// @Param        If-None-Match  header  string  false  "ETag of the group the client already has"
 // @Security     ApiKeyAuth
 // @Success      200  {object}  groupResponse
+// @Success      304
 // @Failure      401  {object}  echo.HTTPError
 // @Failure      404  {object}  echo.HTTPError
 // @Failure      500  {object}  echo.HTTPError
@@ -119,6 +400,10 @@ func (g *groupsController) getGroupByID(c echo.Context) error {
 		return newErrorResponse(err)
 	}
 
+	if notModified(c, newETag(group.Version, group)) {
+		return c.NoContent(http.StatusNotModified)
+	}
+
 	groupResponse := map[string]any{"group": group}
 	response := model.NewResponse("success", "successfully get group with id "+id, groupResponse)
 	return c.JSON(http.StatusOK, response)
@@ -126,56 +411,215 @@ func (g *groupsController) getGroupByID(c echo.Context) error {
 
 // putUpdateGroupByID godoc
 // @Summary      Update a Group
-// @Description  Update a group
+// @Description  Update a group. Replacing the leader records a leadership change, dated by leaderSince.
 // @Tags         groups
 // @Accept       json
 // @Produce      json
-// @Param        default  body  payload.UpdateGroup  true  "request body"
-// @Param        id       path  string               true  "group ID"
+// @Param        default   body    payload.UpdateGroup  true  "request body"
+// @Param        id        path    string               true  "group ID"
+// @Param        If-Match  header  string               true  "ETag of the group the update is based on"
 // @Security     ApiKeyAuth
 // @Success      204
 // @Failure      400  {object}  echo.HTTPError
 // @Failure      401  {object}  echo.HTTPError
 // @Failure      404  {object}  echo.HTTPError
+// @Failure      412  {object}  echo.HTTPError
+// @Failure      428  {object}  echo.HTTPError
 // @Failure      500  {object}  echo.HTTPError
 // @Router       /groups/{id} [put]
 func (g *groupsController) putUpdateGroupByID(c echo.Context) error {
 	id := c.Param("id")
 
+	version, err := ifMatchVersion(c)
+	if err != nil {
+		return newErrorResponse(err)
+	}
+
 	payload := new(payload.UpdateGroup)
 	if err := c.Bind(payload); err != nil {
 		return newErrorResponse(service.ErrInvalidPayload)
 	}
 
-	err := g.groupService.Update(c.Request().Context(), id, *payload)
+	adminID, adminUsername := g.tokenGenerator.ExtractToken(c)
+
+	if err := g.groupService.Update(c.Request().Context(), id, version, adminID, adminUsername, *payload); err != nil {
+		return newErrorResponse(err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// patchGroupByID godoc
+// @Summary      Patch a Group
+// @Description  Change some fields of a group with a JSON merge patch (RFC 7396). Absent fields are kept, null is rejected. Replacing the leader records a leadership change, as with the update.
+// @Tags         groups
+// @Accept       application/merge-patch+json,json
+// @Produce      json
+// @Param        default   body    payload.PatchGroup  true  "merge patch"
+// @Param        id        path    string              true  "group ID"
+// @Param        If-Match  header  string              true  "ETag of the group the patch is based on"
+// @Security     ApiKeyAuth
+// @Success      204
+// @Failure      400  {object}  echo.HTTPError
+// @Failure      401  {object}  echo.HTTPError
+// @Failure      404  {object}  echo.HTTPError
+// @Failure      412  {object}  echo.HTTPError
+// @Failure      415  {object}  echo.HTTPError
+// @Failure      428  {object}  echo.HTTPError
+// @Failure      500  {object}  echo.HTTPError
+// @Router       /groups/{id} [patch]
+func (g *groupsController) patchGroupByID(c echo.Context) error {
+	id := c.Param("id")
+
+	version, err := ifMatchVersion(c)
+	if err != nil {
+		return newErrorResponse(err)
+	}
+
+	payload := new(payload.PatchGroup)
+	if err := bindMergePatch(c, payload); err != nil {
+		return err
+	}
+
+	adminID, adminUsername := g.tokenGenerator.ExtractToken(c)
+
+	if err := g.groupService.Patch(c.Request().Context(), id, version, adminID, adminUsername, *payload); err != nil {
+		return newErrorResponse(err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// putUpdateGroupContacts godoc
+// @Summary      Update Group Contacts
+// @Description  Replace the contacts of a group. Phone numbers are normalized to the +62 format and social media profile URLs to handles.
+// @Tags         groups
+// @Accept       json
+// @Produce      json
+// @Param        default   body    payload.UpdateGroupContacts  true  "request body"
+// @Param        id        path    string                       true  "group ID"
+// @Param        If-Match  header  string                       true  "ETag of the group the update is based on"
+// @Security     ApiKeyAuth
+// @Success      204
+// @Failure      400  {object}  echo.HTTPError
+// @Failure      401  {object}  echo.HTTPError
+// @Failure      404  {object}  echo.HTTPError
+// @Failure      412  {object}  echo.HTTPError
+// @Failure      428  {object}  echo.HTTPError
+// @Failure      500  {object}  echo.HTTPError
+// @Router       /groups/{id}/contacts [put]
+func (g *groupsController) putUpdateGroupContacts(c echo.Context) error {
+	id := c.Param("id")
+
+	version, err := ifMatchVersion(c)
 	if err != nil {
 		return newErrorResponse(err)
 	}
 
+	payload := new(payload.UpdateGroupContacts)
+	if err := c.Bind(payload); err != nil {
+		return newErrorResponse(service.ErrInvalidPayload)
+	}
+
+	if err := g.groupService.UpdateContacts(c.Request().Context(), id, version, *payload); err != nil {
+		return newErrorResponse(err)
+	}
+
 	return c.NoContent(http.StatusNoContent)
 }
 
 // deleteGroupByID godoc
 // @Summary      Delete Group by ID
-// @Description  Delete group by ID
+// @Description  Delete group by ID, with its address, properties, members and achievements. The show schedules not finished yet and the finished ones are each deleted along (cascade), prevent the deletion (block) or are kept without a group (detach). With dry_run, the records that would be affected are reported and nothing is deleted.
 // @Tags         groups
 // @Produce      json
-// @Param        id  path  string  true  "group ID"
+// @Param        id            path    string  true   "group ID"
+// @Param        future_shows  query   string  false  "cascade, block or detach the show schedules not finished yet, default to cascade"
+// @Param        past_shows    query   string  false  "cascade, block or detach the finished show schedules, default to cascade"
+// @Param        dry_run       query   bool    false  "report the affected records without deleting anything"
+// @Param        If-Match      header  string  false  "ETag of the group the deletion is based on, required unless dry_run"
 // @Security     ApiKeyAuth
+// @Success      200  {object}  groupDeletionResponse
 // @Success      204
+// @Failure      400  {object}  echo.HTTPError
 // @Failure      404  {object}  echo.HTTPError
 // @Failure      401  {object}  echo.HTTPError
+// @Failure      409  {object}  echo.HTTPError
+// @Failure      412  {object}  echo.HTTPError
+// @Failure      428  {object}  echo.HTTPError
 // @Failure      500  {object}  echo.HTTPError
 // @Router       /groups/{id} [delete]
 func (g *groupsController) deleteGroupByID(c echo.Context) error {
 	id := c.Param("id")
 
-	err := g.groupService.Delete(c.Request().Context(), id)
+	payload := new(payload.DeleteGroup)
+	if err := (&echo.DefaultBinder{}).BindQueryParams(c, payload); err != nil {
+		return newErrorResponse(service.ErrInvalidPayload)
+	}
+
+	if payload.DryRun {
+		deletion, err := g.groupService.PreviewDelete(c.Request().Context(), id, *payload)
+		if err != nil {
+			return newErrorResponse(err)
+		}
+
+		deletionResponse := map[string]any{"deletion": deletion}
+		response := model.NewResponse("success", "successfully preview group deletion", deletionResponse)
+		return c.JSON(http.StatusOK, response)
+	}
+
+	version, err := ifMatchVersion(c)
+	if err != nil {
+		return newErrorResponse(err)
+	}
+
+	if err := g.groupService.Delete(c.Request().Context(), id, version, *payload); err != nil {
+		return newErrorResponse(err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// postMergeGroup godoc
+// @Summary      Merge a Group
+// @Description  Move the properties, show schedules, members, achievements and attachments of a group to the target group in one transaction. The group is left soft-deleted, pointing to the target. The address is not moved: the target keeps its own and only takes the location of the merged one when it has none, while the merged address stays with the soft-deleted group. Both groups must be unchanged since their ETags were read, the If-Match header for the merged group and targetETag for the target.
+// @Tags         groups
+// @Accept       json
+// @Produce      json
+// @Param        default   body    payload.MergeGroup  true  "request body"
+// @Param        id        path    string              true  "ID of the group to merge"
+// @Param        If-Match  header  string              true  "ETag of the group to merge"
+// @Security     ApiKeyAuth
+// @Success      204
+// @Failure      400  {object}  echo.HTTPError
+// @Failure      401  {object}  echo.HTTPError
+// @Failure      404  {object}  echo.HTTPError
+// @Failure      412  {object}  echo.HTTPError
+// @Failure      428  {object}  echo.HTTPError
+// @Failure      500  {object}  echo.HTTPError
+// @Router       /groups/{id}/merge [post]
+func (g *groupsController) postMergeGroup(c echo.Context) error {
+	id := c.Param("id")
+
+	version, err := ifMatchVersion(c)
+	if err != nil {
+		return newErrorResponse(err)
+	}
 
+	payload := new(payload.MergeGroup)
+	if err := c.Bind(payload); err != nil {
+		return newErrorResponse(service.ErrInvalidPayload)
+	}
+
+	targetVersion, err := tagVersion(payload.TargetETag)
 	if err != nil {
 		return newErrorResponse(err)
 	}
 
+	if err := g.groupService.Merge(c.Request().Context(), id, version, targetVersion, *payload); err != nil {
+		return newErrorResponse(err)
+	}
+
 	return c.NoContent(http.StatusNoContent)
 }
 
@@ -203,6 +647,146 @@ func (g *groupsController) getGenerateQRCode(c echo.Context) error {
 	return c.Blob(http.StatusOK, "image/png", file)
 }
 
+// getGenerateCertificate godoc
+// @Summary      Generate Registration Certificate
+// @Description  Generate a printable PDF registration certificate with the group data, address and QR code
+// @Tags         groups
+// @Produce      application/pdf
+// @Param        id  path  string  true  "group ID"
+// @Security     ApiKeyAuth
+// @Success      200  {file}    binary
+// @Failure      401  {object}  echo.HTTPError
+// @Failure      404  {object}  echo.HTTPError
+// @Failure      409  {object}  echo.HTTPError
+// @Failure      500  {object}  echo.HTTPError
+// @Router       /groups/{id}/certificate [get]
+func (g *groupsController) getGenerateCertificate(c echo.Context) error {
+	id := c.Param("id")
+
+	file, err := g.groupService.GenerateCertificate(c.Request().Context(), id)
+	if err != nil {
+		return newErrorResponse(err)
+	}
+
+	c.Response().Header().Set(echo.HeaderContentDisposition, `inline; filename="certificate-`+id+`.pdf"`)
+	return c.Blob(http.StatusOK, "application/pdf", file)
+}
+
+// getGenerateVCard godoc
+// @Summary      Generate a Leader vCard
+// @Description  Generate a vCard of the group leader with the group contacts, to import in an address book
+// @Tags         groups
+// @Produce      text/vcard
+// @Param        id  path  string  true  "group ID"
+// @Security     ApiKeyAuth
+// @Success      200  {file}    binary
+// @Failure      401  {object}  echo.HTTPError
+// @Failure      404  {object}  echo.HTTPError
+// @Failure      500  {object}  echo.HTTPError
+// @Router       /groups/{id}/vcard [get]
+func (g *groupsController) getGenerateVCard(c echo.Context) error {
+	id := c.Param("id")
+
+	file, err := g.groupService.GenerateVCard(c.Request().Context(), id)
+	if err != nil {
+		return newErrorResponse(err)
+	}
+
+	c.Response().Header().Set(echo.HeaderContentDisposition, `attachment; filename="leader-`+id+`.vcf"`)
+	return c.Blob(http.StatusOK, "text/vcard; charset=utf-8", file)
+}
+
+// putUpdateGroupStatus godoc
+// @Summary      Update a Group Status
+// @Description  Change the lifecycle status of a group and record the transition with its reason and the acting admin
+// @Tags         groups
+// @Accept       json
+// @Produce      json
+// @Param        default   body    payload.UpdateGroupStatus  true  "request body"
+// @Param        id        path    string                     true  "group ID"
+// @Param        If-Match  header  string                     true  "ETag of the group the status change is based on"
+// @Security     ApiKeyAuth
+// @Success      204
+// @Failure      400  {object}  echo.HTTPError
+// @Failure      401  {object}  echo.HTTPError
+// @Failure      404  {object}  echo.HTTPError
+// @Failure      409  {object}  echo.HTTPError
+// @Failure      412  {object}  echo.HTTPError
+// @Failure      428  {object}  echo.HTTPError
+// @Failure      500  {object}  echo.HTTPError
+// @Router       /groups/{id}/status [put]
+func (g *groupsController) putUpdateGroupStatus(c echo.Context) error {
+	id := c.Param("id")
+
+	version, err := ifMatchVersion(c)
+	if err != nil {
+		return newErrorResponse(err)
+	}
+
+	payload := new(payload.UpdateGroupStatus)
+	if err := c.Bind(payload); err != nil {
+		return newErrorResponse(service.ErrInvalidPayload)
+	}
+
+	adminID, adminUsername := g.tokenGenerator.ExtractToken(c)
+
+	if err := g.groupService.UpdateStatus(c.Request().Context(), id, version, adminID, adminUsername, *payload); err != nil {
+		return newErrorResponse(err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// getGroupStatusHistory godoc
+// @Summary      Get Group Status History
+// @Description  Get the status transitions of a group, oldest first
+// @Tags         groups
+// @Produce      json
+// @Param        id  path  string  true  "group ID"
+// @Security     ApiKeyAuth
+// @Success      200  {object}  groupStatusHistoryResponse
+// @Failure      401  {object}  echo.HTTPError
+// @Failure      404  {object}  echo.HTTPError
+// @Failure      500  {object}  echo.HTTPError
+// @Router       /groups/{id}/status/history [get]
+func (g *groupsController) getGroupStatusHistory(c echo.Context) error {
+	id := c.Param("id")
+
+	transitions, err := g.groupService.GetStatusHistory(c.Request().Context(), id)
+	if err != nil {
+		return newErrorResponse(err)
+	}
+
+	transitionsResponse := map[string]any{"transitions": transitions}
+	response := model.NewResponse("success", "successfully get status history of group with id "+id, transitionsResponse)
+	return c.JSON(http.StatusOK, response)
+}
+
+// getGroupLeadershipHistory godoc
+// @Summary      Get Group Leadership History
+// @Description  Get the leadership changes of a group, ordered by the date they took effect
+// @Tags         groups
+// @Produce      json
+// @Param        id  path  string  true  "group ID"
+// @Security     ApiKeyAuth
+// @Success      200  {object}  groupLeadershipHistoryResponse
+// @Failure      401  {object}  echo.HTTPError
+// @Failure      404  {object}  echo.HTTPError
+// @Failure      500  {object}  echo.HTTPError
+// @Router       /groups/{id}/leaders [get]
+func (g *groupsController) getGroupLeadershipHistory(c echo.Context) error {
+	id := c.Param("id")
+
+	changes, err := g.groupService.GetLeadershipHistory(c.Request().Context(), id)
+	if err != nil {
+		return newErrorResponse(err)
+	}
+
+	changesResponse := map[string]any{"leaders": changes}
+	response := model.NewResponse("success", "successfully get leadership history of group with id "+id, changesResponse)
+	return c.JSON(http.StatusOK, response)
+}
+
 // putUpdateAddress godoc
 // @Summary      Update an Address
 // @Description  Update an address
@@ -233,6 +817,37 @@ func (g *groupsController) putUpdateAddress(c echo.Context) error {
 	return c.NoContent(http.StatusNoContent)
 }
 
+// patchAddress godoc
+// @Summary      Patch an Address
+// @Description  Change some fields of an address with a JSON merge patch (RFC 7396). Absent fields are kept, null is rejected.
+// @Tags         groups
+// @Accept       application/merge-patch+json,json
+// @Produce      json
+// @Param        default  body  payload.PatchAddress  true  "merge patch"
+// @Param        id       path  string                true  "address ID"
+// @Security     ApiKeyAuth
+// @Success      204
+// @Failure      400  {object}  echo.HTTPError
+// @Failure      401  {object}  echo.HTTPError
+// @Failure      404  {object}  echo.HTTPError
+// @Failure      415  {object}  echo.HTTPError
+// @Failure      500  {object}  echo.HTTPError
+// @Router       /groups/addresses/{id} [patch]
+func (g *groupsController) patchAddress(c echo.Context) error {
+	id := c.Param("id")
+
+	payload := new(payload.PatchAddress)
+	if err := bindMergePatch(c, payload); err != nil {
+		return err
+	}
+
+	if err := g.addressService.Patch(c.Request().Context(), id, *payload); err != nil {
+		return newErrorResponse(err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
 // postCreateProperty godoc
 // @Summary      Add a Property
 // @Description  Add a Property
@@ -268,29 +883,78 @@ func (g *groupsController) postCreateProperty(c echo.Context) error {
 
 // putUpdateProperty godoc
 // @Summary      Update a Property
-// @Description  Update a Property
+// @Description  Update a Property. The amount cannot be lowered below the quantity still out on loan.
 // @Tags         groups
 // @Accept       json
 // @Produce      json
-// @Param        default     body  payload.UpdateProperty  true  "request body"
-// @Param        id          path  string                  true  "group ID"
-// @Param        propertyID  path  string  true  "property ID"
+// @Param        default     body    payload.UpdateProperty  true  "request body"
+// @Param        id          path    string                  true  "group ID"
+// @Param        propertyID  path    string  true  "property ID"
+// @Param        If-Match    header  string  true  "quoted version of the property the update is based on"
 // @Security     ApiKeyAuth
 // @Success      204
 // @Failure      400  {object}  echo.HTTPError
 // @Failure      401  {object}  echo.HTTPError
 // @Failure      404  {object}  echo.HTTPError
+// @Failure      409  {object}  echo.HTTPError
+// @Failure      412  {object}  echo.HTTPError
+// @Failure      428  {object}  echo.HTTPError
 // @Failure      500  {object}  echo.HTTPError
 // @Router       /groups/{id}/properties/{propertyID} [put]
 func (g *groupsController) putUpdateProperty(c echo.Context) error {
 	propertyID := c.Param("propertyID")
 
+	version, err := ifMatchVersion(c)
+	if err != nil {
+		return newErrorResponse(err)
+	}
+
 	payload := new(payload.UpdateProperty)
 	if err := c.Bind(payload); err != nil {
 		return newErrorResponse(service.ErrInvalidPayload)
 	}
 
-	if err := g.propertyService.Update(c.Request().Context(), propertyID, *payload); err != nil {
+	if err := g.propertyService.Update(c.Request().Context(), propertyID, version, *payload); err != nil {
+		return newErrorResponse(err)
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+// patchProperty godoc
+// @Summary      Patch a Property
+// @Description  Change some fields of a property with a JSON merge patch (RFC 7396). Absent fields are kept, null is rejected. The amount cannot be lowered below the quantity still out on loan.
+// @Tags         groups
+// @Accept       application/merge-patch+json,json
+// @Produce      json
+// @Param        default     body    payload.PatchProperty  true  "merge patch"
+// @Param        id          path    string                 true  "group ID"
+// @Param        propertyID  path    string                 true  "property ID"
+// @Param        If-Match    header  string                 true  "quoted version of the property the patch is based on"
+// @Security     ApiKeyAuth
+// @Success      204
+// @Failure      400  {object}  echo.HTTPError
+// @Failure      401  {object}  echo.HTTPError
+// @Failure      404  {object}  echo.HTTPError
+// @Failure      409  {object}  echo.HTTPError
+// @Failure      412  {object}  echo.HTTPError
+// @Failure      415  {object}  echo.HTTPError
+// @Failure      428  {object}  echo.HTTPError
+// @Failure      500  {object}  echo.HTTPError
+// @Router       /groups/{id}/properties/{propertyID} [patch]
+func (g *groupsController) patchProperty(c echo.Context) error {
+	propertyID := c.Param("propertyID")
+
+	version, err := ifMatchVersion(c)
+	if err != nil {
+		return newErrorResponse(err)
+	}
+
+	payload := new(payload.PatchProperty)
+	if err := bindMergePatch(c, payload); err != nil {
+		return err
+	}
+
+	if err := g.propertyService.Patch(c.Request().Context(), propertyID, version, *payload); err != nil {
 		return newErrorResponse(err)
 	}
 	return c.NoContent(http.StatusNoContent)
@@ -298,21 +962,30 @@ func (g *groupsController) putUpdateProperty(c echo.Context) error {
 
 // deleteProperty godoc
 // @Summary      Delete a Property
-// @Description  Delete a Property
+// @Description  Delete a Property. A property with items still out on loan cannot be deleted.
 // @Tags         groups
 // @Produce      json
-// @Param        id          path  string  true  "group ID"
-// @Param        propertyID  path  string  true  "property ID"
+// @Param        id          path    string  true  "group ID"
+// @Param        propertyID  path    string  true  "property ID"
+// @Param        If-Match    header  string  true  "quoted version of the property the deletion is based on"
 // @Security     ApiKeyAuth
 // @Success      204
 // @Failure      401  {object}  echo.HTTPError
 // @Failure      404  {object}  echo.HTTPError
+// @Failure      409  {object}  echo.HTTPError
+// @Failure      412  {object}  echo.HTTPError
+// @Failure      428  {object}  echo.HTTPError
 // @Failure      500  {object}  echo.HTTPError
 // @Router       /groups/{id}/properties/{propertyID} [delete]
 func (g *groupsController) deleteProperty(c echo.Context) error {
 	propertyID := c.Param("propertyID")
 
-	if err := g.propertyService.Delete(c.Request().Context(), propertyID); err != nil {
+	version, err := ifMatchVersion(c)
+	if err != nil {
+		return newErrorResponse(err)
+	}
+
+	if err := g.propertyService.Delete(c.Request().Context(), propertyID, version); err != nil {
 		return newErrorResponse(err)
 	}
 	return c.NoContent(http.StatusNoContent)
@@ -354,6 +1027,28 @@ type idData struct {
 	ID string `json:"id"`
 }
 
+// importGroupsResponse struct is used for swaggo to generate the API documentation, as it doesn't support generic yet.
+type importGroupsResponse struct {
+	Status  string           `json:"status" extensions:"x-order=0"`
+	Message string           `json:"message" extensions:"x-order=1"`
+	Data    importGroupsData `json:"data" extensions:"x-order=2"`
+}
+
+type importGroupsData struct {
+	Groups []response.ImportGroup `json:"groups"`
+}
+
+// groupDeletionResponse struct is used for swaggo to generate the API documentation, as it doesn't support generic yet.
+type groupDeletionResponse struct {
+	Status  string            `json:"status" extensions:"x-order=0"`
+	Message string            `json:"message" extensions:"x-order=1"`
+	Data    groupDeletionData `json:"data" extensions:"x-order=2"`
+}
+
+type groupDeletionData struct {
+	Deletion response.GroupDeletion `json:"deletion"`
+}
+
 // groupsResponse struct is used for swaggo to generate the API documentation, as it doesn't support generic yet.
 type groupsResponse struct {
 	Status  string     `json:"status" extensions:"x-order=0"`
@@ -362,7 +1057,30 @@ type groupsResponse struct {
 }
 
 type groupsData struct {
-	Groups []response.Group `json:"groups"`
+	Groups     []response.Group    `json:"groups"`
+	Pagination response.Pagination `json:"pagination"`
+}
+
+// nearbyGroupsResponse struct is used for swaggo to generate the API documentation, as it doesn't support generic yet.
+type nearbyGroupsResponse struct {
+	Status  string           `json:"status" extensions:"x-order=0"`
+	Message string           `json:"message" extensions:"x-order=1"`
+	Data    nearbyGroupsData `json:"data" extensions:"x-order=2"`
+}
+
+type nearbyGroupsData struct {
+	Groups []response.NearbyGroup `json:"groups"`
+}
+
+// duplicateGroupsResponse struct is used for swaggo to generate the API documentation, as it doesn't support generic yet.
+type duplicateGroupsResponse struct {
+	Status  string              `json:"status" extensions:"x-order=0"`
+	Message string              `json:"message" extensions:"x-order=1"`
+	Data    duplicateGroupsData `json:"data" extensions:"x-order=2"`
+}
+
+type duplicateGroupsData struct {
+	Duplicates []response.DuplicateGroups `json:"duplicates"`
 }
 
 // groupResponse struct is used for swaggo to generate the API documentation, as it doesn't support generic yet.
@@ -376,6 +1094,28 @@ type groupData struct {
 	Group response.Group `json:"group"`
 }
 
+// groupStatusHistoryResponse struct is used for swaggo to generate the API documentation, as it doesn't support generic yet.
+type groupStatusHistoryResponse struct {
+	Status  string                 `json:"status" extensions:"x-order=0"`
+	Message string                 `json:"message" extensions:"x-order=1"`
+	Data    groupStatusHistoryData `json:"data" extensions:"x-order=2"`
+}
+
+type groupStatusHistoryData struct {
+	Transitions []response.GroupStatusTransition `json:"transitions"`
+}
+
+// groupLeadershipHistoryResponse struct is used for swaggo to generate the API documentation, as it doesn't support generic yet.
+type groupLeadershipHistoryResponse struct {
+	Status  string                     `json:"status" extensions:"x-order=0"`
+	Message string                     `json:"message" extensions:"x-order=1"`
+	Data    groupLeadershipHistoryData `json:"data" extensions:"x-order=2"`
+}
+
+type groupLeadershipHistoryData struct {
+	Leaders []response.LeadershipChange `json:"leaders"`
+}
+
 // createPropertyResponse struct is used for swaggo to generate the API documentation, as it doesn't support generic yet.
 type createPropertyResponse struct {
 	Status  string `json:"status" extensions:"x-order=0"`
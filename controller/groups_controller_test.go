@@ -1,6 +1,7 @@
 package controller
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
@@ -17,19 +18,23 @@ import (
 	mas "github.com/erikrios/reog-apps-apis/service/address/mocks"
 	mgs "github.com/erikrios/reog-apps-apis/service/group/mocks"
 	mps "github.com/erikrios/reog-apps-apis/service/property/mocks"
+	mig "github.com/erikrios/reog-apps-apis/utils/generator/mocks"
 	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/xuri/excelize/v2"
 )
 
 func TestRouteGroups(t *testing.T) {
 	mockGroupService := &mgs.GroupService{}
 	mockPropertyService := &mps.PropertyService{}
 	mockAddressService := &mas.AddressService{}
+	mockTokenGen := &mig.TokenGenerator{}
 	controller := NewGroupsController(
 		mockGroupService,
 		mockPropertyService,
 		mockAddressService,
+		mockTokenGen,
 	)
 	g := echo.New().Group("/api/v1")
 	controller.Route(g)
@@ -40,6 +45,7 @@ func TestPostCreateGroup(t *testing.T) {
 	mockGroupService := &mgs.GroupService{}
 	mockPropertyService := &mps.PropertyService{}
 	mockAddressService := &mas.AddressService{}
+	mockTokenGen := &mig.TokenGenerator{}
 
 	t.Run("success scenario", func(t *testing.T) {
 		dummyReq := payload.CreateGroup{
@@ -67,7 +73,7 @@ func TestPostCreateGroup(t *testing.T) {
 		).Once()
 
 		t.Run("it should return 201 status code with valid response, when there is no error", func(t *testing.T) {
-			controller := NewGroupsController(mockGroupService, mockPropertyService, mockAddressService)
+			controller := NewGroupsController(mockGroupService, mockPropertyService, mockAddressService, mockTokenGen)
 			requestBody, err := json.Marshal(dummyReq)
 			assert.NoError(t, err)
 
@@ -173,7 +179,7 @@ func TestPostCreateGroup(t *testing.T) {
 			t.Run(testCase.name, func(t *testing.T) {
 				testCase.mockBehaviour()
 
-				controller := NewGroupsController(mockGroupService, mockPropertyService, mockAddressService)
+				controller := NewGroupsController(mockGroupService, mockPropertyService, mockAddressService, mockTokenGen)
 				requestBody, err := json.Marshal(dummyReq)
 				assert.NoError(t, err)
 
@@ -195,10 +201,138 @@ func TestPostCreateGroup(t *testing.T) {
 	})
 }
 
+func TestPostImportGroups(t *testing.T) {
+	mockGroupService := &mgs.GroupService{}
+	mockPropertyService := &mps.PropertyService{}
+	mockAddressService := &mas.AddressService{}
+	mockTokenGen := &mig.TokenGenerator{}
+
+	t.Run("success scenario", func(t *testing.T) {
+		dummyCSV := "name,leader,address,villageID\n" +
+			"Paguyuban Reog,Erik Rio S,RT 01 RW 01 Dukuh Bibis,3502030007\n"
+
+		dummyResults := []response.ImportGroup{
+			{Row: 1, Status: "valid"},
+		}
+
+		mockGroupService.On(
+			"Import",
+			mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+			[]payload.CreateGroup{
+				{
+					Name:      "Paguyuban Reog",
+					Leader:    "Erik Rio S",
+					Address:   "RT 01 RW 01 Dukuh Bibis",
+					VillageID: "3502030007",
+				},
+			},
+			payload.ImportGroups{DryRun: true},
+		).Return(
+			func(ctx context.Context, rows []payload.CreateGroup, p payload.ImportGroups) []response.ImportGroup {
+				return dummyResults
+			},
+			func(ctx context.Context, rows []payload.CreateGroup, p payload.ImportGroups) error {
+				return nil
+			},
+		).Once()
+
+		t.Run("it should return 200 status code with valid response, when there is no error", func(t *testing.T) {
+			controller := NewGroupsController(mockGroupService, mockPropertyService, mockAddressService, mockTokenGen)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/groups/import?dry_run=true", strings.NewReader(dummyCSV))
+			req.Header.Set(echo.HeaderContentType, "text/csv")
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			if assert.NoError(t, controller.postImportGroups(c)) {
+				assert.Equal(t, http.StatusOK, rec.Code)
+
+				body := rec.Body.String()
+
+				gotResponse := &model.Response[importGroupsData]{}
+
+				if err := json.Unmarshal([]byte(body), &gotResponse); assert.NoError(t, err) {
+					assert.Equal(t, dummyResults, gotResponse.Data.Groups)
+				}
+			}
+		})
+	})
+
+	t.Run("failed scenario", func(t *testing.T) {
+		testCases := []struct {
+			name                 string
+			inputCSV             string
+			expectedStatusCode   int
+			expectedErrorMessage string
+			mockBehaviour        func()
+		}{
+			{
+				name:                 "it should return 400 status code, when csv header is missing a column",
+				inputCSV:             "name,leader,address\nPaguyuban Reog,Erik Rio S,RT 01 RW 01\n",
+				expectedStatusCode:   http.StatusBadRequest,
+				expectedErrorMessage: "Invalid payload. Please check the payload schema in the API Documentation.",
+				mockBehaviour:        func() {},
+			},
+			{
+				name:                 "it should return 400 status code, when csv row has a wrong number of fields",
+				inputCSV:             "name,leader,address,villageID\nPaguyuban Reog,Erik Rio S\n",
+				expectedStatusCode:   http.StatusBadRequest,
+				expectedErrorMessage: "Invalid payload. Please check the payload schema in the API Documentation.",
+				mockBehaviour:        func() {},
+			},
+			{
+				name:                 "it should return 500 status code, when error happened",
+				inputCSV:             "villageID,name,leader,address\n3502030007,Paguyuban Reog,Erik Rio S,RT 01 RW 01\n",
+				expectedStatusCode:   http.StatusInternalServerError,
+				expectedErrorMessage: "Something went wrong.",
+				mockBehaviour: func() {
+					mockGroupService.On(
+						"Import",
+						mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+						mock.AnythingOfType(fmt.Sprintf("%T", []payload.CreateGroup{})),
+						mock.AnythingOfType(fmt.Sprintf("%T", payload.ImportGroups{})),
+					).Return(
+						func(ctx context.Context, rows []payload.CreateGroup, p payload.ImportGroups) []response.ImportGroup {
+							return []response.ImportGroup{}
+						},
+						func(ctx context.Context, rows []payload.CreateGroup, p payload.ImportGroups) error {
+							return service.ErrRepository
+						},
+					).Once()
+				},
+			},
+		}
+
+		for _, testCase := range testCases {
+			t.Run(testCase.name, func(t *testing.T) {
+				testCase.mockBehaviour()
+
+				controller := NewGroupsController(mockGroupService, mockPropertyService, mockAddressService, mockTokenGen)
+
+				e := echo.New()
+				req := httptest.NewRequest(http.MethodPost, "/api/v1/groups/import", strings.NewReader(testCase.inputCSV))
+				req.Header.Set(echo.HeaderContentType, "text/csv")
+				rec := httptest.NewRecorder()
+				c := e.NewContext(req, rec)
+
+				gotError := controller.postImportGroups(c)
+				if assert.Error(t, gotError) {
+					if echoHTTPError, ok := gotError.(*echo.HTTPError); assert.Equal(t, true, ok) {
+						assert.Equal(t, testCase.expectedStatusCode, echoHTTPError.Code)
+						assert.Equal(t, testCase.expectedErrorMessage, echoHTTPError.Message)
+					}
+				}
+			})
+		}
+	})
+}
+
 func TestGetGroups(t *testing.T) {
 	mockGroupService := &mgs.GroupService{}
 	mockPropertyService := &mps.PropertyService{}
 	mockAddressService := &mas.AddressService{}
+	mockTokenGen := &mig.TokenGenerator{}
 
 	t.Run("success scenario", func(t *testing.T) {
 		dummyGroups := []response.Group{
@@ -229,26 +363,37 @@ func TestGetGroups(t *testing.T) {
 			},
 		}
 
+		dummyPagination := response.Pagination{
+			Page:       2,
+			Limit:      1,
+			TotalItems: 3,
+			TotalPages: 3,
+		}
+
 		dummyGroupsResponse := map[string]any{"groups": dummyGroups}
 		dummyResp := model.NewResponse("success", "successfully get groups", dummyGroupsResponse)
 
 		mockGroupService.On(
 			"GetAll",
 			mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+			payload.GetGroups{Page: 2, Limit: 1, DistrictID: "350211"},
 		).Return(
-			func(ctx context.Context) []response.Group {
+			func(ctx context.Context, p payload.GetGroups) []response.Group {
 				return dummyGroups
 			},
-			func(ctx context.Context) error {
+			func(ctx context.Context, p payload.GetGroups) response.Pagination {
+				return dummyPagination
+			},
+			func(ctx context.Context, p payload.GetGroups) error {
 				return nil
 			},
 		).Once()
 
 		t.Run("it should return 200 status code with valid response, when there is no error", func(t *testing.T) {
-			controller := NewGroupsController(mockGroupService, mockPropertyService, mockAddressService)
+			controller := NewGroupsController(mockGroupService, mockPropertyService, mockAddressService, mockTokenGen)
 
 			e := echo.New()
-			req := httptest.NewRequest(http.MethodGet, "/api/v1/groups", nil)
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/groups?page=2&limit=1&district_id=350211", nil)
 			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 			rec := httptest.NewRecorder()
 			c := e.NewContext(req, rec)
@@ -262,6 +407,10 @@ func TestGetGroups(t *testing.T) {
 
 				if err := json.Unmarshal([]byte(body), &gotResponse); assert.NoError(t, err) {
 					reflect.DeepEqual(dummyResp.Data["groups"], gotResponse.Data["groups"])
+
+					gotPagination := gotResponse.Data["pagination"].(map[string]any)
+					assert.Equal(t, "/api/v1/groups?district_id=350211&limit=1&page=3", gotPagination["next"])
+					assert.Equal(t, "/api/v1/groups?district_id=350211&limit=1&page=1", gotPagination["previous"])
 				}
 			}
 		})
@@ -270,10 +419,18 @@ func TestGetGroups(t *testing.T) {
 	t.Run("failed scenario", func(t *testing.T) {
 		testCases := []struct {
 			name                 string
+			inputQuery           string
 			expectedStatusCode   int
 			expectedErrorMessage string
 			mockBehaviour        func()
 		}{
+			{
+				name:                 "it should return 400 status code, when query param is invalid",
+				inputQuery:           "?page=abc",
+				expectedStatusCode:   http.StatusBadRequest,
+				expectedErrorMessage: "Invalid payload. Please check the payload schema in the API Documentation.",
+				mockBehaviour:        func() {},
+			},
 			{
 				name:                 "it should return 500 status code, when error happened",
 				expectedStatusCode:   http.StatusInternalServerError,
@@ -282,11 +439,15 @@ func TestGetGroups(t *testing.T) {
 					mockGroupService.On(
 						"GetAll",
 						mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+						mock.AnythingOfType(fmt.Sprintf("%T", payload.GetGroups{})),
 					).Return(
-						func(ctx context.Context) []response.Group {
+						func(ctx context.Context, p payload.GetGroups) []response.Group {
 							return []response.Group{}
 						},
-						func(ctx context.Context) error {
+						func(ctx context.Context, p payload.GetGroups) response.Pagination {
+							return response.Pagination{}
+						},
+						func(ctx context.Context, p payload.GetGroups) error {
 							return service.ErrRepository
 						},
 					).Once()
@@ -299,10 +460,10 @@ func TestGetGroups(t *testing.T) {
 			t.Run(testCase.name, func(t *testing.T) {
 				testCase.mockBehaviour()
 
-				controller := NewGroupsController(mockGroupService, mockPropertyService, mockAddressService)
+				controller := NewGroupsController(mockGroupService, mockPropertyService, mockAddressService, mockTokenGen)
 
 				e := echo.New()
-				req := httptest.NewRequest(http.MethodGet, "/api/v1/groups", nil)
+				req := httptest.NewRequest(http.MethodGet, "/api/v1/groups"+testCase.inputQuery, nil)
 				req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 				rec := httptest.NewRecorder()
 				c := e.NewContext(req, rec)
@@ -319,76 +480,1694 @@ func TestGetGroups(t *testing.T) {
 	})
 }
 
-func TestGetGroupByID(t *testing.T) {
+func TestGetGroupsGeoJSON(t *testing.T) {
 	mockGroupService := &mgs.GroupService{}
 	mockPropertyService := &mps.PropertyService{}
 	mockAddressService := &mas.AddressService{}
+	mockTokenGen := &mig.TokenGenerator{}
 
 	t.Run("success scenario", func(t *testing.T) {
-		dummyGroup := response.Group{
-			ID:     "g-xyz",
-			Name:   "Paguyuban Reog",
-			Leader: "Erik Rio S",
-			Address: response.Address{
-				ID:           "g-xyz",
-				Address:      "RT 01 RW 01 Dukuh Bibis",
-				VillageID:    "350211189",
-				VillageName:  "Pager",
-				DistrictID:   "350211",
-				DistrictName: "Bungkal",
-				RegencyID:    "3502",
-				RegencyName:  "Kabupaten Ponorogo",
-				ProvinceID:   "35",
-				ProvinceName: "Jawa Timur",
+		latitude, longitude := -7.87, 111.46
+		dummyGroups := []response.Group{
+			{
+				ID:     "g-xyz",
+				Name:   "Paguyuban Reog",
+				Leader: "Erik Rio S",
+				Address: response.Address{
+					ID:           "g-xyz",
+					Address:      "RT 01 RW 01 Dukuh Bibis",
+					VillageID:    "350211189",
+					VillageName:  "Pager",
+					DistrictID:   "350211",
+					DistrictName: "Bungkal",
+					Latitude:     &latitude,
+					Longitude:    &longitude,
+				},
+				Properties: []response.Property{},
 			},
-			Properties: []response.Property{
-				{
-					ID:          "p-Ay8LmNI",
-					Name:        "Dadak Merak",
-					Description: "Ini adalah deskripsi dadak merak",
-					Amount:      1,
+			{
+				ID:     "g-abc",
+				Name:   "Singo Barong",
+				Leader: "Rio",
+				Address: response.Address{
+					ID:         "g-abc",
+					DistrictID: "350211",
 				},
+				Properties: []response.Property{},
 			},
 		}
 
-		dummyGroupsResponse := map[string]any{"group": dummyGroup}
-		dummyResp := model.NewResponse("success", "successfully get group with id "+dummyGroup.ID, dummyGroupsResponse)
+		mockGroupService.On(
+			"Export",
+			mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+			payload.GetGroups{DistrictID: "350211", Status: "active"},
+			mock.AnythingOfType(fmt.Sprintf("%T", func([]response.Group) error { return nil })),
+		).Return(
+			func(ctx context.Context, p payload.GetGroups, write func(groups []response.Group) error) error {
+				if err := write(dummyGroups[:1]); err != nil {
+					return err
+				}
+				return write(dummyGroups[1:])
+			},
+		).Once()
+
+		t.Run("it should return a feature collection located by the addresses, when there is no error", func(t *testing.T) {
+			controller := NewGroupsController(mockGroupService, mockPropertyService, mockAddressService, mockTokenGen)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/groups.geojson?district_id=350211&status=active", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			if assert.NoError(t, controller.getGroupsGeoJSON(c)) {
+				assert.Equal(t, http.StatusOK, rec.Code)
+				assert.Equal(t, "application/geo+json", rec.Header().Get(echo.HeaderContentType))
+
+				gotCollection := featureCollection[response.Group]{}
+				if err := json.Unmarshal(rec.Body.Bytes(), &gotCollection); assert.NoError(t, err) {
+					assert.Equal(t, "FeatureCollection", gotCollection.Type)
+					if assert.Len(t, gotCollection.Features, 2) {
+						assert.Equal(t, "Feature", gotCollection.Features[0].Type)
+						assert.Equal(t, "g-xyz", gotCollection.Features[0].ID)
+						assert.Equal(t, &geometry{Type: "Point", Coordinates: []float64{111.46, -7.87}}, gotCollection.Features[0].Geometry)
+						assert.Equal(t, dummyGroups[0].Name, gotCollection.Features[0].Properties.Name)
+						assert.Equal(t, dummyGroups[0].Address, gotCollection.Features[0].Properties.Address)
+						assert.Nil(t, gotCollection.Features[1].Geometry)
+					}
+				}
+			}
+		})
+	})
+
+	t.Run("failed scenario", func(t *testing.T) {
+		testCases := []struct {
+			name                 string
+			inputQuery           string
+			expectedStatusCode   int
+			expectedErrorMessage string
+			mockBehaviour        func()
+		}{
+			{
+				name:                 "it should return 400 status code, when service return invalid payload error",
+				inputQuery:           "?sort=leader",
+				expectedStatusCode:   http.StatusBadRequest,
+				expectedErrorMessage: "Invalid payload. Please check the payload schema in the API Documentation.",
+				mockBehaviour: func() {
+					mockGroupService.On(
+						"Export",
+						mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+						mock.AnythingOfType(fmt.Sprintf("%T", payload.GetGroups{})),
+						mock.AnythingOfType(fmt.Sprintf("%T", func([]response.Group) error { return nil })),
+					).Return(
+						func(ctx context.Context, p payload.GetGroups, write func(groups []response.Group) error) error {
+							return service.ErrInvalidPayload
+						},
+					).Once()
+				},
+			},
+			{
+				name:                 "it should return 500 status code, when error happened",
+				expectedStatusCode:   http.StatusInternalServerError,
+				expectedErrorMessage: "Something went wrong.",
+				mockBehaviour: func() {
+					mockGroupService.On(
+						"Export",
+						mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+						mock.AnythingOfType(fmt.Sprintf("%T", payload.GetGroups{})),
+						mock.AnythingOfType(fmt.Sprintf("%T", func([]response.Group) error { return nil })),
+					).Return(
+						func(ctx context.Context, p payload.GetGroups, write func(groups []response.Group) error) error {
+							return service.ErrRepository
+						},
+					).Once()
+				},
+			},
+		}
+
+		for _, testCase := range testCases {
+			t.Run(testCase.name, func(t *testing.T) {
+				testCase.mockBehaviour()
+
+				controller := NewGroupsController(mockGroupService, mockPropertyService, mockAddressService, mockTokenGen)
+
+				e := echo.New()
+				req := httptest.NewRequest(http.MethodGet, "/api/v1/groups.geojson"+testCase.inputQuery, nil)
+				rec := httptest.NewRecorder()
+				c := e.NewContext(req, rec)
+
+				gotError := controller.getGroupsGeoJSON(c)
+				if assert.Error(t, gotError) {
+					if echoHTTPError, ok := gotError.(*echo.HTTPError); assert.Equal(t, true, ok) {
+						assert.Equal(t, testCase.expectedStatusCode, echoHTTPError.Code)
+						assert.Equal(t, testCase.expectedErrorMessage, echoHTTPError.Message)
+					}
+				}
+			})
+		}
+	})
+}
+
+func TestGetNearbyGroups(t *testing.T) {
+	mockGroupService := &mgs.GroupService{}
+	mockPropertyService := &mps.PropertyService{}
+	mockAddressService := &mas.AddressService{}
+	mockTokenGen := &mig.TokenGenerator{}
+
+	t.Run("success scenario", func(t *testing.T) {
+		latitude := -7.9
+		longitude := 111.5
+
+		dummyGroups := []response.NearbyGroup{
+			{
+				Group: response.Group{
+					ID:     "g-xyz",
+					Name:   "Paguyuban Reog",
+					Leader: "Erik Rio S",
+					Address: response.Address{
+						ID:        "g-xyz",
+						Address:   "RT 01 RW 01 Dukuh Bibis",
+						Latitude:  &latitude,
+						Longitude: &longitude,
+					},
+				},
+				Distance: 120,
+			},
+		}
 
 		mockGroupService.On(
-			"GetByID",
+			"GetNearby",
 			mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
-			mock.AnythingOfType(fmt.Sprintf("%T", "")),
+			payload.GetNearbyGroups{Latitude: &latitude, Longitude: &longitude, Radius: 1000},
 		).Return(
-			func(ctx context.Context, id string) response.Group {
-				return dummyGroup
+			func(ctx context.Context, p payload.GetNearbyGroups) []response.NearbyGroup {
+				return dummyGroups
 			},
-			func(ctx context.Context, id string) error {
+			func(ctx context.Context, p payload.GetNearbyGroups) error {
 				return nil
 			},
 		).Once()
 
 		t.Run("it should return 200 status code with valid response, when there is no error", func(t *testing.T) {
-			controller := NewGroupsController(mockGroupService, mockPropertyService, mockAddressService)
+			controller := NewGroupsController(mockGroupService, mockPropertyService, mockAddressService, mockTokenGen)
 
 			e := echo.New()
-			req := httptest.NewRequest(http.MethodGet, "/api/v1/groups", nil)
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/groups/nearby?lat=-7.9&lng=111.5&radius=1000", nil)
 			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 			rec := httptest.NewRecorder()
 			c := e.NewContext(req, rec)
-			c.SetPath("/:id")
-			c.SetParamNames("id")
-			c.SetParamValues(dummyGroup.ID)
 
-			if assert.NoError(t, controller.getGroupByID(c)) {
+			if assert.NoError(t, controller.getNearbyGroups(c)) {
 				assert.Equal(t, http.StatusOK, rec.Code)
 
 				body := rec.Body.String()
 
-				gotResponse := &model.Response[map[string]any]{}
+				gotResponse := &model.Response[map[string][]response.NearbyGroup]{}
+
+				if err := json.Unmarshal([]byte(body), &gotResponse); assert.NoError(t, err) {
+					assert.Equal(t, dummyGroups, gotResponse.Data["groups"])
+				}
+			}
+		})
+	})
+
+	t.Run("failed scenario", func(t *testing.T) {
+		testCases := []struct {
+			name                 string
+			inputQuery           string
+			expectedStatusCode   int
+			expectedErrorMessage string
+			mockBehaviour        func()
+		}{
+			{
+				name:                 "it should return 400 status code, when query param is invalid",
+				inputQuery:           "?lat=abc&lng=111.5",
+				expectedStatusCode:   http.StatusBadRequest,
+				expectedErrorMessage: "Invalid payload. Please check the payload schema in the API Documentation.",
+				mockBehaviour:        func() {},
+			},
+			{
+				name:                 "it should return 400 status code, when the point is missing",
+				inputQuery:           "?radius=1000",
+				expectedStatusCode:   http.StatusBadRequest,
+				expectedErrorMessage: "Invalid payload. Please check the payload schema in the API Documentation.",
+				mockBehaviour: func() {
+					mockGroupService.On(
+						"GetNearby",
+						mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+						mock.AnythingOfType(fmt.Sprintf("%T", payload.GetNearbyGroups{})),
+					).Return(
+						func(ctx context.Context, p payload.GetNearbyGroups) []response.NearbyGroup {
+							return nil
+						},
+						func(ctx context.Context, p payload.GetNearbyGroups) error {
+							return service.ErrInvalidPayload
+						},
+					).Once()
+				},
+			},
+			{
+				name:                 "it should return 500 status code, when error happened",
+				inputQuery:           "?lat=-7.9&lng=111.5",
+				expectedStatusCode:   http.StatusInternalServerError,
+				expectedErrorMessage: "Something went wrong.",
+				mockBehaviour: func() {
+					mockGroupService.On(
+						"GetNearby",
+						mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+						mock.AnythingOfType(fmt.Sprintf("%T", payload.GetNearbyGroups{})),
+					).Return(
+						func(ctx context.Context, p payload.GetNearbyGroups) []response.NearbyGroup {
+							return nil
+						},
+						func(ctx context.Context, p payload.GetNearbyGroups) error {
+							return service.ErrRepository
+						},
+					).Once()
+				},
+			},
+		}
+
+		for _, testCase := range testCases {
+			t.Run(testCase.name, func(t *testing.T) {
+				testCase.mockBehaviour()
+
+				controller := NewGroupsController(mockGroupService, mockPropertyService, mockAddressService, mockTokenGen)
+
+				e := echo.New()
+				req := httptest.NewRequest(http.MethodGet, "/api/v1/groups/nearby"+testCase.inputQuery, nil)
+				req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+				rec := httptest.NewRecorder()
+				c := e.NewContext(req, rec)
+
+				gotError := controller.getNearbyGroups(c)
+				if assert.Error(t, gotError) {
+					if echoHTTPError, ok := gotError.(*echo.HTTPError); assert.Equal(t, true, ok) {
+						assert.Equal(t, testCase.expectedStatusCode, echoHTTPError.Code)
+						assert.Equal(t, testCase.expectedErrorMessage, echoHTTPError.Message)
+					}
+				}
+			})
+		}
+	})
+}
+
+func TestGetExportGroups(t *testing.T) {
+	mockGroupService := &mgs.GroupService{}
+	mockPropertyService := &mps.PropertyService{}
+	mockAddressService := &mas.AddressService{}
+	mockTokenGen := &mig.TokenGenerator{}
+
+	t.Run("success scenario", func(t *testing.T) {
+		dummyGroups := []response.Group{
+			{
+				ID:     "g-xyz",
+				Name:   "Paguyuban Reog",
+				Leader: "Erik Rio S",
+				Status: "active",
+				Address: response.Address{
+					ID:           "g-xyz",
+					Address:      "RT 01 RW 01 Dukuh Bibis",
+					VillageID:    "350211189",
+					VillageName:  "Pager",
+					DistrictID:   "350211",
+					DistrictName: "Bungkal",
+					RegencyID:    "3502",
+					RegencyName:  "Kabupaten Ponorogo",
+					ProvinceID:   "35",
+					ProvinceName: "Jawa Timur",
+				},
+				Properties: []response.Property{
+					{
+						ID:          "p-Ay8LmNI",
+						Name:        "Dadak Merak",
+						Description: "Ini adalah deskripsi dadak merak",
+						Amount:      2,
+					},
+				},
+			},
+			{
+				ID:         "g-abc",
+				Name:       "Singo Barong",
+				Leader:     "=HYPERLINK(\"https://example.com\")",
+				Status:     "dormant",
+				Address:    response.Address{ID: "g-abc", DistrictID: "350211"},
+				Properties: []response.Property{},
+			},
+		}
+
+		testCases := []struct {
+			name                string
+			inputQuery          string
+			expectedContentType string
+			assertBody          func(t *testing.T, body []byte)
+		}{
+			{
+				name:                "it should return the groups as csv, when no format is given",
+				inputQuery:          "?district_id=350211",
+				expectedContentType: "text/csv; charset=utf-8",
+				assertBody: func(t *testing.T, body []byte) {
+					assert.Equal(
+						t,
+						"id,name,leader,status,address,villageID,villageName,districtID,districtName,regencyID,regencyName,provinceID,provinceName,propertyCount\n"+
+							"g-xyz,Paguyuban Reog,Erik Rio S,active,RT 01 RW 01 Dukuh Bibis,350211189,Pager,350211,Bungkal,3502,Kabupaten Ponorogo,35,Jawa Timur,1\n"+
+							"g-abc,Singo Barong,\"'=HYPERLINK(\"\"https://example.com\"\")\",dormant,,,,350211,,,,,,0\n",
+						string(body),
+					)
+				},
+			},
+			{
+				name:                "it should return the properties as csv, when properties sheet is given",
+				inputQuery:          "?format=csv&sheet=properties",
+				expectedContentType: "text/csv; charset=utf-8",
+				assertBody: func(t *testing.T, body []byte) {
+					assert.Equal(
+						t,
+						"groupID,groupName,id,name,description,amount\n"+
+							"g-xyz,Paguyuban Reog,p-Ay8LmNI,Dadak Merak,Ini adalah deskripsi dadak merak,2\n",
+						string(body),
+					)
+				},
+			},
+			{
+				name:                "it should return a workbook with groups and properties sheets, when xlsx format is given",
+				inputQuery:          "?format=xlsx",
+				expectedContentType: "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet",
+				assertBody: func(t *testing.T, body []byte) {
+					file, err := excelize.OpenReader(bytes.NewReader(body))
+					if assert.NoError(t, err) {
+						assert.Equal(t, []string{"groups", "properties"}, file.GetSheetList())
+
+						name, _ := file.GetCellValue("groups", "B2")
+						assert.Equal(t, "Paguyuban Reog", name)
+
+						name, _ = file.GetCellValue("groups", "B3")
+						assert.Equal(t, "Singo Barong", name)
+
+						leader, _ := file.GetCellValue("groups", "C3")
+						assert.Equal(t, `'=HYPERLINK("https://example.com")`, leader)
+
+						amount, _ := file.GetCellValue("properties", "F2")
+						assert.Equal(t, "2", amount)
+					}
+				},
+			},
+		}
+
+		for _, testCase := range testCases {
+			t.Run(testCase.name, func(t *testing.T) {
+				mockGroupService.On(
+					"Export",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", payload.GetGroups{})),
+					mock.AnythingOfType(fmt.Sprintf("%T", func([]response.Group) error { return nil })),
+				).Return(
+					func(ctx context.Context, p payload.GetGroups, write func(groups []response.Group) error) error {
+						for _, group := range dummyGroups {
+							if err := write([]response.Group{group}); err != nil {
+								return err
+							}
+						}
+						return write([]response.Group{})
+					},
+				).Once()
+
+				controller := NewGroupsController(mockGroupService, mockPropertyService, mockAddressService, mockTokenGen)
+
+				e := echo.New()
+				req := httptest.NewRequest(http.MethodGet, "/api/v1/groups/export"+testCase.inputQuery, nil)
+				rec := httptest.NewRecorder()
+				c := e.NewContext(req, rec)
+
+				if assert.NoError(t, controller.getExportGroups(c)) {
+					assert.Equal(t, http.StatusOK, rec.Code)
+					assert.Equal(t, testCase.expectedContentType, rec.Header().Get(echo.HeaderContentType))
+					testCase.assertBody(t, rec.Body.Bytes())
+				}
+			})
+		}
+	})
+
+	t.Run("failed scenario", func(t *testing.T) {
+		testCases := []struct {
+			name                 string
+			inputQuery           string
+			expectedStatusCode   int
+			expectedErrorMessage string
+			mockBehaviour        func()
+		}{
+			{
+				name:                 "it should return 400 status code, when format is invalid",
+				inputQuery:           "?format=pdf",
+				expectedStatusCode:   http.StatusBadRequest,
+				expectedErrorMessage: "Invalid payload. Please check the payload schema in the API Documentation.",
+				mockBehaviour:        func() {},
+			},
+			{
+				name:                 "it should return 500 status code, when error happened before the first csv page",
+				inputQuery:           "?format=csv",
+				expectedStatusCode:   http.StatusInternalServerError,
+				expectedErrorMessage: "Something went wrong.",
+				mockBehaviour: func() {
+					mockGroupService.On(
+						"Export",
+						mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+						mock.AnythingOfType(fmt.Sprintf("%T", payload.GetGroups{})),
+						mock.AnythingOfType(fmt.Sprintf("%T", func([]response.Group) error { return nil })),
+					).Return(
+						func(ctx context.Context, p payload.GetGroups, write func(groups []response.Group) error) error {
+							return service.ErrRepository
+						},
+					).Once()
+				},
+			},
+			{
+				name:                 "it should return 500 status code, when error happened",
+				inputQuery:           "?format=xlsx",
+				expectedStatusCode:   http.StatusInternalServerError,
+				expectedErrorMessage: "Something went wrong.",
+				mockBehaviour: func() {
+					mockGroupService.On(
+						"Export",
+						mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+						mock.AnythingOfType(fmt.Sprintf("%T", payload.GetGroups{})),
+						mock.AnythingOfType(fmt.Sprintf("%T", func([]response.Group) error { return nil })),
+					).Return(
+						func(ctx context.Context, p payload.GetGroups, write func(groups []response.Group) error) error {
+							return service.ErrRepository
+						},
+					).Once()
+				},
+			},
+		}
+
+		for _, testCase := range testCases {
+			t.Run(testCase.name, func(t *testing.T) {
+				testCase.mockBehaviour()
+
+				controller := NewGroupsController(mockGroupService, mockPropertyService, mockAddressService, mockTokenGen)
+
+				e := echo.New()
+				req := httptest.NewRequest(http.MethodGet, "/api/v1/groups/export"+testCase.inputQuery, nil)
+				rec := httptest.NewRecorder()
+				c := e.NewContext(req, rec)
+
+				gotError := controller.getExportGroups(c)
+				if assert.Error(t, gotError) {
+					if echoHTTPError, ok := gotError.(*echo.HTTPError); assert.Equal(t, true, ok) {
+						assert.Equal(t, testCase.expectedStatusCode, echoHTTPError.Code)
+						assert.Equal(t, testCase.expectedErrorMessage, echoHTTPError.Message)
+					}
+				}
+			})
+		}
+	})
+}
+
+func TestGetDuplicateGroups(t *testing.T) {
+	mockGroupService := &mgs.GroupService{}
+	mockPropertyService := &mps.PropertyService{}
+	mockAddressService := &mas.AddressService{}
+	mockTokenGen := &mig.TokenGenerator{}
+
+	t.Run("success scenario", func(t *testing.T) {
+		dummyDuplicates := []response.DuplicateGroups{
+			{
+				VillageID:   "3502030007",
+				VillageName: "Pager",
+				Groups: []response.DuplicateGroup{
+					{ID: "g-abc", Name: "Singo Barong", Leader: "Erik Rio", PropertyCount: 1, CreatedAt: "01 Jun 22 09:30 UTC"},
+					{ID: "g-def", Name: "Singo Baron", Leader: "Erik Rio S", CreatedAt: "01 Jun 22 09:30 UTC"},
+				},
+				Similarity:       0.86,
+				NameSimilarity:   0.92,
+				LeaderSimilarity: 0.8,
+			},
+		}
+
+		mockGroupService.On(
+			"GetDuplicates",
+			mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+			payload.GetDuplicateGroups{VillageID: "3502030007", MinSimilarity: 0.85},
+		).Return(
+			func(ctx context.Context, p payload.GetDuplicateGroups) []response.DuplicateGroups {
+				return dummyDuplicates
+			},
+			func(ctx context.Context, p payload.GetDuplicateGroups) error {
+				return nil
+			},
+		).Once()
+
+		t.Run("it should return 200 status code with valid response, when there is no error", func(t *testing.T) {
+			controller := NewGroupsController(mockGroupService, mockPropertyService, mockAddressService, mockTokenGen)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/groups/duplicates?village_id=3502030007&min_similarity=0.85", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			if assert.NoError(t, controller.getDuplicateGroups(c)) {
+				assert.Equal(t, http.StatusOK, rec.Code)
+
+				gotResponse := &model.Response[map[string][]response.DuplicateGroups]{}
+				if err := json.Unmarshal(rec.Body.Bytes(), &gotResponse); assert.NoError(t, err) {
+					assert.Equal(t, dummyDuplicates, gotResponse.Data["duplicates"])
+				}
+			}
+		})
+	})
+
+	t.Run("failed scenario", func(t *testing.T) {
+		testCases := []struct {
+			name                 string
+			inputQuery           string
+			expectedStatusCode   int
+			expectedErrorMessage string
+			mockBehaviour        func()
+		}{
+			{
+				name:                 "it should return 400 status code, when query param is invalid",
+				inputQuery:           "?min_similarity=abc",
+				expectedStatusCode:   http.StatusBadRequest,
+				expectedErrorMessage: "Invalid payload. Please check the payload schema in the API Documentation.",
+				mockBehaviour:        func() {},
+			},
+			{
+				name:                 "it should return 500 status code, when error happened",
+				expectedStatusCode:   http.StatusInternalServerError,
+				expectedErrorMessage: "Something went wrong.",
+				mockBehaviour: func() {
+					mockGroupService.On(
+						"GetDuplicates",
+						mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+						mock.AnythingOfType(fmt.Sprintf("%T", payload.GetDuplicateGroups{})),
+					).Return(
+						func(ctx context.Context, p payload.GetDuplicateGroups) []response.DuplicateGroups {
+							return nil
+						},
+						func(ctx context.Context, p payload.GetDuplicateGroups) error {
+							return service.ErrRepository
+						},
+					).Once()
+				},
+			},
+		}
+
+		for _, testCase := range testCases {
+			t.Run(testCase.name, func(t *testing.T) {
+				testCase.mockBehaviour()
+
+				controller := NewGroupsController(mockGroupService, mockPropertyService, mockAddressService, mockTokenGen)
+
+				e := echo.New()
+				req := httptest.NewRequest(http.MethodGet, "/api/v1/groups/duplicates"+testCase.inputQuery, nil)
+				rec := httptest.NewRecorder()
+				c := e.NewContext(req, rec)
+
+				gotError := controller.getDuplicateGroups(c)
+				if assert.Error(t, gotError) {
+					if echoHTTPError, ok := gotError.(*echo.HTTPError); assert.Equal(t, true, ok) {
+						assert.Equal(t, testCase.expectedStatusCode, echoHTTPError.Code)
+						assert.Equal(t, testCase.expectedErrorMessage, echoHTTPError.Message)
+					}
+				}
+			})
+		}
+	})
+}
+
+func TestGetGroupByID(t *testing.T) {
+	mockGroupService := &mgs.GroupService{}
+	mockPropertyService := &mps.PropertyService{}
+	mockAddressService := &mas.AddressService{}
+	mockTokenGen := &mig.TokenGenerator{}
+
+	t.Run("success scenario", func(t *testing.T) {
+		dummyGroup := response.Group{
+			ID:     "g-xyz",
+			Name:   "Paguyuban Reog",
+			Leader: "Erik Rio S",
+			Address: response.Address{
+				ID:           "g-xyz",
+				Address:      "RT 01 RW 01 Dukuh Bibis",
+				VillageID:    "350211189",
+				VillageName:  "Pager",
+				DistrictID:   "350211",
+				DistrictName: "Bungkal",
+				RegencyID:    "3502",
+				RegencyName:  "Kabupaten Ponorogo",
+				ProvinceID:   "35",
+				ProvinceName: "Jawa Timur",
+			},
+			Properties: []response.Property{
+				{
+					ID:          "p-Ay8LmNI",
+					Name:        "Dadak Merak",
+					Description: "Ini adalah deskripsi dadak merak",
+					Amount:      1,
+					Version:     1,
+				},
+			},
+			Version: 2,
+		}
+
+		dummyGroupsResponse := map[string]any{"group": dummyGroup}
+		dummyResp := model.NewResponse("success", "successfully get group with id "+dummyGroup.ID, dummyGroupsResponse)
+
+		mockGroupService.On(
+			"GetByID",
+			mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+			mock.AnythingOfType(fmt.Sprintf("%T", "")),
+		).Return(
+			func(ctx context.Context, id string) response.Group {
+				return dummyGroup
+			},
+			func(ctx context.Context, id string) error {
+				return nil
+			},
+		).Times(2)
+
+		t.Run("it should return 200 status code with valid response, when there is no error", func(t *testing.T) {
+			controller := NewGroupsController(mockGroupService, mockPropertyService, mockAddressService, mockTokenGen)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/groups", nil)
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/:id")
+			c.SetParamNames("id")
+			c.SetParamValues(dummyGroup.ID)
+
+			if assert.NoError(t, controller.getGroupByID(c)) {
+				assert.Equal(t, http.StatusOK, rec.Code)
+				assert.Equal(t, newETag(2, dummyGroup), rec.Header().Get(headerETag))
+
+				body := rec.Body.String()
+
+				gotResponse := &model.Response[map[string]any]{}
+
+				if err := json.Unmarshal([]byte(body), &gotResponse); assert.NoError(t, err) {
+					reflect.DeepEqual(dummyResp, gotResponse)
+				}
+			}
+		})
+
+		t.Run("it should return 304 status code without body, when If-None-Match has the current ETag", func(t *testing.T) {
+			controller := NewGroupsController(mockGroupService, mockPropertyService, mockAddressService, mockTokenGen)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/groups", nil)
+			req.Header.Set(headerIfNoneMatch, `W/"1-0000000000000000", `+newETag(2, dummyGroup))
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/:id")
+			c.SetParamNames("id")
+			c.SetParamValues(dummyGroup.ID)
+
+			if assert.NoError(t, controller.getGroupByID(c)) {
+				assert.Equal(t, http.StatusNotModified, rec.Code)
+				assert.Equal(t, newETag(2, dummyGroup), rec.Header().Get(headerETag))
+				assert.Empty(t, rec.Body.String())
+			}
+		})
+	})
+
+	t.Run("failed scenario", func(t *testing.T) {
+		testCases := []struct {
+			name                 string
+			expectedStatusCode   int
+			expectedErrorMessage string
+			mockBehaviour        func()
+		}{
+			{
+				name:                 "it should return 404 status code, when group ID not found",
+				expectedStatusCode:   http.StatusNotFound,
+				expectedErrorMessage: "Resource with given ID not found.",
+				mockBehaviour: func() {
+					mockGroupService.On(
+						"GetByID",
+						mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					).Return(
+						func(ctx context.Context, id string) response.Group {
+							return response.Group{}
+						},
+						func(ctx context.Context, id string) error {
+							return service.ErrDataNotFound
+						},
+					).Once()
+				},
+			},
+			{
+				name:                 "it should return 500 status code, when error happened",
+				expectedStatusCode:   http.StatusInternalServerError,
+				expectedErrorMessage: "Something went wrong.",
+				mockBehaviour: func() {
+					mockGroupService.On(
+						"GetByID",
+						mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					).Return(
+						func(ctx context.Context, id string) response.Group {
+							return response.Group{}
+						},
+						func(ctx context.Context, id string) error {
+							return service.ErrRepository
+						},
+					).Once()
+				},
+			},
+		}
+
+		for _, testCase := range testCases {
+			t.Run(testCase.name, func(t *testing.T) {
+				testCase.mockBehaviour()
+
+				controller := NewGroupsController(mockGroupService, mockPropertyService, mockAddressService, mockTokenGen)
+
+				e := echo.New()
+				req := httptest.NewRequest(http.MethodGet, "/api/v1/groups", nil)
+				req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+				rec := httptest.NewRecorder()
+				c := e.NewContext(req, rec)
+				c.SetPath("/:id")
+				c.SetParamNames("id")
+				c.SetParamValues("g-xyz")
+
+				gotError := controller.getGroupByID(c)
+				if assert.Error(t, gotError) {
+					if echoHTTPError, ok := gotError.(*echo.HTTPError); assert.Equal(t, true, ok) {
+						assert.Equal(t, testCase.expectedStatusCode, echoHTTPError.Code)
+						assert.Equal(t, testCase.expectedErrorMessage, echoHTTPError.Message)
+					}
+				}
+			})
+		}
+	})
+}
+
+func TestPutUpdateGroupByID(t *testing.T) {
+	mockGroupService := &mgs.GroupService{}
+	mockPropertyService := &mps.PropertyService{}
+	mockAddressService := &mas.AddressService{}
+	mockTokenGen := &mig.TokenGenerator{}
+
+	mockTokenGen.On("ExtractToken", mock.Anything).Return("a-XU", "erikrios")
+
+	t.Run("success scenario", func(t *testing.T) {
+		dummyReq := payload.UpdateGroup{
+			Name:        "Paguyuban Reog",
+			Leader:      "Erik Rio S",
+			LeaderSince: "2022-01-02",
+			LeaderNote:  "Elected at the annual meeting",
+		}
+
+		mockGroupService.On(
+			"Update",
+			mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+			"g-xyz",
+			2,
+			"a-XU",
+			"erikrios",
+			dummyReq,
+		).Return(
+			func(ctx context.Context, id string, version int, adminID, adminUsername string, p payload.UpdateGroup) error {
+				return nil
+			},
+		).Once()
+
+		t.Run("it should return 204 status code with valid response, when there is no error", func(t *testing.T) {
+			controller := NewGroupsController(mockGroupService, mockPropertyService, mockAddressService, mockTokenGen)
+			requestBody, err := json.Marshal(dummyReq)
+			assert.NoError(t, err)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPut, "/api/v1/groups", strings.NewReader(string(requestBody)))
+			req.Header.Set(headerIfMatch, `"2"`)
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/:id")
+			c.SetParamNames("id")
+			c.SetParamValues("g-xyz")
+
+			if assert.NoError(t, controller.putUpdateGroupByID(c)) {
+				assert.Equal(t, http.StatusNoContent, rec.Code)
+			}
+		})
+	})
+
+	t.Run("failed scenario", func(t *testing.T) {
+		dummyReq := payload.UpdateGroup{
+			Name:   "Paguyuban Reog",
+			Leader: "Erik Rio S",
+		}
+
+		testCases := []struct {
+			name                 string
+			inputPayload         payload.UpdateGroup
+			expectedStatusCode   int
+			expectedErrorMessage string
+			mockBehaviour        func()
+		}{
+			{
+				name:                 "it should return 400 status code, when payload is invalid",
+				inputPayload:         dummyReq,
+				expectedStatusCode:   http.StatusBadRequest,
+				expectedErrorMessage: "Invalid payload. Please check the payload schema in the API Documentation.",
+				mockBehaviour: func() {
+					mockGroupService.On(
+						"Update",
+						mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+						mock.AnythingOfType(fmt.Sprintf("%T", 0)),
+						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+						mock.AnythingOfType(fmt.Sprintf("%T", payload.UpdateGroup{})),
+					).Return(
+						func(ctx context.Context, id string, version int, adminID, adminUsername string, p payload.UpdateGroup) error {
+							return service.ErrInvalidPayload
+						},
+					).Once()
+				},
+			},
+			{
+				name:                 "it should return 404 status code, when village ID not found",
+				inputPayload:         dummyReq,
+				expectedStatusCode:   http.StatusNotFound,
+				expectedErrorMessage: "Resource with given ID not found.",
+				mockBehaviour: func() {
+					mockGroupService.On(
+						"Update",
+						mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+						mock.AnythingOfType(fmt.Sprintf("%T", 0)),
+						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+						mock.AnythingOfType(fmt.Sprintf("%T", payload.UpdateGroup{})),
+					).Return(
+						func(ctx context.Context, id string, version int, adminID, adminUsername string, p payload.UpdateGroup) error {
+							return service.ErrDataNotFound
+						},
+					).Once()
+				},
+			},
+			{
+				name:                 "it should return 412 status code, when the resource has been modified",
+				inputPayload:         dummyReq,
+				expectedStatusCode:   http.StatusPreconditionFailed,
+				expectedErrorMessage: "Resource has been modified since it was read. Please get it again and retry.",
+				mockBehaviour: func() {
+					mockGroupService.On(
+						"Update",
+						mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+						mock.AnythingOfType(fmt.Sprintf("%T", 0)),
+						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+						mock.AnythingOfType(fmt.Sprintf("%T", payload.UpdateGroup{})),
+					).Return(
+						func(ctx context.Context, id string, version int, adminID, adminUsername string, p payload.UpdateGroup) error {
+							return service.ErrVersionMismatch
+						},
+					).Once()
+				},
+			},
+			{
+				name:                 "it should return 500 status code, when error happened",
+				inputPayload:         dummyReq,
+				expectedStatusCode:   http.StatusInternalServerError,
+				expectedErrorMessage: "Something went wrong.",
+				mockBehaviour: func() {
+					mockGroupService.On(
+						"Update",
+						mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+						mock.AnythingOfType(fmt.Sprintf("%T", 0)),
+						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+						mock.AnythingOfType(fmt.Sprintf("%T", payload.UpdateGroup{})),
+					).Return(
+						func(ctx context.Context, id string, version int, adminID, adminUsername string, p payload.UpdateGroup) error {
+							return service.ErrRepository
+						},
+					).Once()
+				},
+			},
+		}
+
+		for _, testCase := range testCases {
+			t.Run(testCase.name, func(t *testing.T) {
+				testCase.mockBehaviour()
+
+				controller := NewGroupsController(mockGroupService, mockPropertyService, mockAddressService, mockTokenGen)
+				requestBody, err := json.Marshal(dummyReq)
+				assert.NoError(t, err)
+
+				e := echo.New()
+				req := httptest.NewRequest(http.MethodPut, "/api/v1/groups", strings.NewReader(string(requestBody)))
+				req.Header.Set(headerIfMatch, `"2"`)
+				req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+				rec := httptest.NewRecorder()
+				c := e.NewContext(req, rec)
+				c.SetPath("/:id")
+				c.SetParamNames("id")
+				c.SetParamValues("g-xyz")
+
+				gotError := controller.putUpdateGroupByID(c)
+				if assert.Error(t, gotError) {
+					if echoHTTPError, ok := gotError.(*echo.HTTPError); assert.Equal(t, true, ok) {
+						assert.Equal(t, testCase.expectedStatusCode, echoHTTPError.Code)
+						assert.Equal(t, testCase.expectedErrorMessage, echoHTTPError.Message)
+					}
+				}
+			})
+		}
+
+		t.Run("it should return 428 status code, when If-Match header is missing", func(t *testing.T) {
+			controller := NewGroupsController(mockGroupService, mockPropertyService, mockAddressService, mockTokenGen)
+			requestBody, err := json.Marshal(dummyReq)
+			assert.NoError(t, err)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPut, "/api/v1/groups", strings.NewReader(string(requestBody)))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/:id")
+			c.SetParamNames("id")
+			c.SetParamValues("g-xyz")
+
+			gotError := controller.putUpdateGroupByID(c)
+			if assert.Error(t, gotError) {
+				if echoHTTPError, ok := gotError.(*echo.HTTPError); assert.Equal(t, true, ok) {
+					assert.Equal(t, http.StatusPreconditionRequired, echoHTTPError.Code)
+					assert.Equal(t, "If-Match header is required. Please send the ETag of the resource the change is based on.", echoHTTPError.Message)
+				}
+			}
+		})
+	})
+}
+
+func TestPatchGroupByID(t *testing.T) {
+	mockGroupService := &mgs.GroupService{}
+	mockPropertyService := &mps.PropertyService{}
+	mockAddressService := &mas.AddressService{}
+	mockTokenGen := &mig.TokenGenerator{}
+
+	mockTokenGen.On("ExtractToken", mock.Anything).Return("a-XU", "erikrios")
+
+	t.Run("success scenario", func(t *testing.T) {
+		leader := "Rio S"
+
+		mockGroupService.On(
+			"Patch",
+			mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+			"g-xyz",
+			2,
+			"a-XU",
+			"erikrios",
+			payload.PatchGroup{Leader: &leader},
+		).Return(
+			func(ctx context.Context, id string, version int, adminID, adminUsername string, p payload.PatchGroup) error {
+				return nil
+			},
+		).Once()
+
+		t.Run("it should return 204 status code, when there is no error", func(t *testing.T) {
+			controller := NewGroupsController(mockGroupService, mockPropertyService, mockAddressService, mockTokenGen)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPatch, "/api/v1/groups", strings.NewReader(`{"leader":"Rio S"}`))
+			req.Header.Set(headerIfMatch, `"2-5f1c9a0e3b7d4c26"`)
+			req.Header.Set(echo.HeaderContentType, mimeApplicationMergePatchJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/:id")
+			c.SetParamNames("id")
+			c.SetParamValues("g-xyz")
+
+			if assert.NoError(t, controller.patchGroupByID(c)) {
+				assert.Equal(t, http.StatusNoContent, rec.Code)
+			}
+		})
+	})
+
+	t.Run("failed scenario", func(t *testing.T) {
+		testCases := []struct {
+			name                 string
+			inputIfMatch         string
+			inputContentType     string
+			inputBody            string
+			expectedStatusCode   int
+			expectedErrorMessage string
+			mockBehaviour        func()
+		}{
+			{
+				name:                 "it should return 428 status code, when If-Match header is missing",
+				inputContentType:     mimeApplicationMergePatchJSON,
+				inputBody:            `{"name":"Paguyuban Reog"}`,
+				expectedStatusCode:   http.StatusPreconditionRequired,
+				expectedErrorMessage: "If-Match header is required. Please send the ETag of the resource the change is based on.",
+				mockBehaviour:        func() {},
+			},
+			{
+				name:                 "it should return 415 status code, when the patch is not JSON",
+				inputIfMatch:         `"2"`,
+				inputContentType:     echo.MIMEApplicationForm,
+				inputBody:            "name=Paguyuban+Reog",
+				expectedStatusCode:   http.StatusUnsupportedMediaType,
+				expectedErrorMessage: "Unsupported media type. Please send the patch as application/merge-patch+json.",
+				mockBehaviour:        func() {},
+			},
+			{
+				name:                 "it should return 400 status code, when the patch is not an object",
+				inputIfMatch:         `"2"`,
+				inputContentType:     mimeApplicationMergePatchJSON,
+				inputBody:            `null`,
+				expectedStatusCode:   http.StatusBadRequest,
+				expectedErrorMessage: "Invalid payload. Please check the payload schema in the API Documentation.",
+				mockBehaviour:        func() {},
+			},
+			{
+				name:                 "it should return 400 status code, when a member is null",
+				inputIfMatch:         `"2"`,
+				inputContentType:     mimeApplicationMergePatchJSON,
+				inputBody:            `{"name":"Paguyuban Reog","leader":null}`,
+				expectedStatusCode:   http.StatusBadRequest,
+				expectedErrorMessage: "Invalid payload. Please check the payload schema in the API Documentation.",
+				mockBehaviour:        func() {},
+			},
+			{
+				name:                 "it should return 400 status code, when a member has the wrong type",
+				inputIfMatch:         `"2"`,
+				inputContentType:     echo.MIMEApplicationJSON,
+				inputBody:            `{"name":1}`,
+				expectedStatusCode:   http.StatusBadRequest,
+				expectedErrorMessage: "Invalid payload. Please check the payload schema in the API Documentation.",
+				mockBehaviour:        func() {},
+			},
+			{
+				name:                 "it should return 412 status code, when the group has been modified",
+				inputIfMatch:         `"2"`,
+				inputContentType:     mimeApplicationMergePatchJSON,
+				inputBody:            `{"name":"Paguyuban Reog"}`,
+				expectedStatusCode:   http.StatusPreconditionFailed,
+				expectedErrorMessage: "Resource has been modified since it was read. Please get it again and retry.",
+				mockBehaviour: func() {
+					mockGroupService.On(
+						"Patch",
+						mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+						mock.AnythingOfType(fmt.Sprintf("%T", 0)),
+						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+						mock.AnythingOfType(fmt.Sprintf("%T", payload.PatchGroup{})),
+					).Return(
+						func(ctx context.Context, id string, version int, adminID, adminUsername string, p payload.PatchGroup) error {
+							return service.ErrVersionMismatch
+						},
+					).Once()
+				},
+			},
+			{
+				name:                 "it should return 404 status code, when group ID not found",
+				inputIfMatch:         `"2"`,
+				inputContentType:     mimeApplicationMergePatchJSON,
+				inputBody:            `{"name":"Paguyuban Reog"}`,
+				expectedStatusCode:   http.StatusNotFound,
+				expectedErrorMessage: "Resource with given ID not found.",
+				mockBehaviour: func() {
+					mockGroupService.On(
+						"Patch",
+						mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+						mock.AnythingOfType(fmt.Sprintf("%T", 0)),
+						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+						mock.AnythingOfType(fmt.Sprintf("%T", payload.PatchGroup{})),
+					).Return(
+						func(ctx context.Context, id string, version int, adminID, adminUsername string, p payload.PatchGroup) error {
+							return service.ErrDataNotFound
+						},
+					).Once()
+				},
+			},
+		}
+
+		for _, testCase := range testCases {
+			t.Run(testCase.name, func(t *testing.T) {
+				testCase.mockBehaviour()
+
+				controller := NewGroupsController(mockGroupService, mockPropertyService, mockAddressService, mockTokenGen)
+
+				e := echo.New()
+				req := httptest.NewRequest(http.MethodPatch, "/api/v1/groups", strings.NewReader(testCase.inputBody))
+				if testCase.inputIfMatch != "" {
+					req.Header.Set(headerIfMatch, testCase.inputIfMatch)
+				}
+				req.Header.Set(echo.HeaderContentType, testCase.inputContentType)
+				rec := httptest.NewRecorder()
+				c := e.NewContext(req, rec)
+				c.SetPath("/:id")
+				c.SetParamNames("id")
+				c.SetParamValues("g-xyz")
+
+				gotError := controller.patchGroupByID(c)
+				if assert.Error(t, gotError) {
+					if echoHTTPError, ok := gotError.(*echo.HTTPError); assert.Equal(t, true, ok) {
+						assert.Equal(t, testCase.expectedStatusCode, echoHTTPError.Code)
+						assert.Equal(t, testCase.expectedErrorMessage, echoHTTPError.Message)
+					}
+				}
+			})
+		}
+	})
+}
+
+func TestDeleteGroupByID(t *testing.T) {
+	mockGroupService := &mgs.GroupService{}
+	mockPropertyService := &mps.PropertyService{}
+	mockAddressService := &mas.AddressService{}
+	mockTokenGen := &mig.TokenGenerator{}
+
+	t.Run("success scenario", func(t *testing.T) {
+		mockGroupService.On(
+			"Delete",
+			mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+			mock.AnythingOfType(fmt.Sprintf("%T", "")),
+			mock.AnythingOfType(fmt.Sprintf("%T", 0)),
+			payload.DeleteGroup{FutureShows: "block"},
+		).Return(
+			func(ctx context.Context, id string, version int, p payload.DeleteGroup) error {
+				return nil
+			},
+		).Once()
+
+		dummyDeletion := response.GroupDeletion{
+			GroupID:               "g-xyz",
+			Deletable:             false,
+			Properties:            []string{"p-Ay8LmNI"},
+			Members:               []string{},
+			Achievements:          []string{},
+			DeletedShowSchedules:  []string{"s-aBcdEfG"},
+			DetachedShowSchedules: []string{},
+			BlockingShowSchedules: []string{"s-EuKgD1O"},
+		}
+
+		mockGroupService.On(
+			"PreviewDelete",
+			mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+			"g-xyz",
+			payload.DeleteGroup{DryRun: true, FutureShows: "block"},
+		).Return(
+			func(ctx context.Context, id string, p payload.DeleteGroup) response.GroupDeletion {
+				return dummyDeletion
+			},
+			func(ctx context.Context, id string, p payload.DeleteGroup) error {
+				return nil
+			},
+		).Once()
+
+		t.Run("it should return 204 status code with valid response, when there is no error", func(t *testing.T) {
+			controller := NewGroupsController(mockGroupService, mockPropertyService, mockAddressService, mockTokenGen)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodDelete, "/api/v1/groups?future_shows=block", nil)
+			req.Header.Set(headerIfMatch, `"2"`)
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/:id")
+			c.SetParamNames("id")
+			c.SetParamValues("g-xyz")
+
+			if assert.NoError(t, controller.deleteGroupByID(c)) {
+				assert.Equal(t, http.StatusNoContent, rec.Code)
+			}
+		})
+
+		t.Run("it should return 200 status code with the affected records, when it is a dry run", func(t *testing.T) {
+			controller := NewGroupsController(mockGroupService, mockPropertyService, mockAddressService, mockTokenGen)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodDelete, "/api/v1/groups?dry_run=true&future_shows=block", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/:id")
+			c.SetParamNames("id")
+			c.SetParamValues("g-xyz")
+
+			if assert.NoError(t, controller.deleteGroupByID(c)) {
+				assert.Equal(t, http.StatusOK, rec.Code)
+
+				gotResponse := groupDeletionResponse{}
+				if err := json.Unmarshal(rec.Body.Bytes(), &gotResponse); assert.NoError(t, err) {
+					assert.Equal(t, dummyDeletion, gotResponse.Data.Deletion)
+				}
+			}
+		})
+	})
+
+	t.Run("failed scenario", func(t *testing.T) {
+		testCases := []struct {
+			name                 string
+			expectedStatusCode   int
+			expectedErrorMessage string
+			mockBehaviour        func()
+		}{
+			{
+				name:                 "it should return 404 status code, when village ID not found",
+				expectedStatusCode:   http.StatusNotFound,
+				expectedErrorMessage: "Resource with given ID not found.",
+				mockBehaviour: func() {
+					mockGroupService.On(
+						"Delete",
+						mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+						mock.AnythingOfType(fmt.Sprintf("%T", 0)),
+						mock.AnythingOfType(fmt.Sprintf("%T", payload.DeleteGroup{})),
+					).Return(
+						func(ctx context.Context, id string, version int, p payload.DeleteGroup) error {
+							return service.ErrDataNotFound
+						},
+					).Once()
+				},
+			},
+			{
+				name:                 "it should return 409 status code, when show schedules block the deletion",
+				expectedStatusCode:   http.StatusConflict,
+				expectedErrorMessage: "Group still has show schedules. Please cascade or detach them to delete the group.",
+				mockBehaviour: func() {
+					mockGroupService.On(
+						"Delete",
+						mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+						mock.AnythingOfType(fmt.Sprintf("%T", 0)),
+						mock.AnythingOfType(fmt.Sprintf("%T", payload.DeleteGroup{})),
+					).Return(
+						func(ctx context.Context, id string, version int, p payload.DeleteGroup) error {
+							return service.ErrGroupHasShows
+						},
+					).Once()
+				},
+			},
+			{
+				name:                 "it should return 412 status code, when the resource has been modified",
+				expectedStatusCode:   http.StatusPreconditionFailed,
+				expectedErrorMessage: "Resource has been modified since it was read. Please get it again and retry.",
+				mockBehaviour: func() {
+					mockGroupService.On(
+						"Delete",
+						mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+						mock.AnythingOfType(fmt.Sprintf("%T", 0)),
+						mock.AnythingOfType(fmt.Sprintf("%T", payload.DeleteGroup{})),
+					).Return(
+						func(ctx context.Context, id string, version int, p payload.DeleteGroup) error {
+							return service.ErrVersionMismatch
+						},
+					).Once()
+				},
+			},
+			{
+				name:                 "it should return 500 status code, when error happened",
+				expectedStatusCode:   http.StatusInternalServerError,
+				expectedErrorMessage: "Something went wrong.",
+				mockBehaviour: func() {
+					mockGroupService.On(
+						"Delete",
+						mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+						mock.AnythingOfType(fmt.Sprintf("%T", 0)),
+						mock.AnythingOfType(fmt.Sprintf("%T", payload.DeleteGroup{})),
+					).Return(
+						func(ctx context.Context, id string, version int, p payload.DeleteGroup) error {
+							return service.ErrRepository
+						},
+					).Once()
+				},
+			},
+		}
+
+		for _, testCase := range testCases {
+			t.Run(testCase.name, func(t *testing.T) {
+				testCase.mockBehaviour()
+
+				controller := NewGroupsController(mockGroupService, mockPropertyService, mockAddressService, mockTokenGen)
+
+				e := echo.New()
+				req := httptest.NewRequest(http.MethodDelete, "/api/v1/groups", nil)
+				req.Header.Set(headerIfMatch, `"2"`)
+				req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+				rec := httptest.NewRecorder()
+				c := e.NewContext(req, rec)
+				c.SetPath("/:id")
+				c.SetParamNames("id")
+				c.SetParamValues("g-xyz")
+
+				gotError := controller.deleteGroupByID(c)
+				if assert.Error(t, gotError) {
+					if echoHTTPError, ok := gotError.(*echo.HTTPError); assert.Equal(t, true, ok) {
+						assert.Equal(t, testCase.expectedStatusCode, echoHTTPError.Code)
+						assert.Equal(t, testCase.expectedErrorMessage, echoHTTPError.Message)
+					}
+				}
+			})
+		}
+
+		t.Run("it should return 428 status code, when If-Match header is missing", func(t *testing.T) {
+			controller := NewGroupsController(mockGroupService, mockPropertyService, mockAddressService, mockTokenGen)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodDelete, "/api/v1/groups", nil)
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/:id")
+			c.SetParamNames("id")
+			c.SetParamValues("g-xyz")
+
+			gotError := controller.deleteGroupByID(c)
+			if assert.Error(t, gotError) {
+				if echoHTTPError, ok := gotError.(*echo.HTTPError); assert.Equal(t, true, ok) {
+					assert.Equal(t, http.StatusPreconditionRequired, echoHTTPError.Code)
+					assert.Equal(t, "If-Match header is required. Please send the ETag of the resource the change is based on.", echoHTTPError.Message)
+				}
+			}
+		})
+	})
+}
+
+func TestPostMergeGroup(t *testing.T) {
+	mockGroupService := &mgs.GroupService{}
+	mockPropertyService := &mps.PropertyService{}
+	mockAddressService := &mas.AddressService{}
+	mockTokenGen := &mig.TokenGenerator{}
+
+	dummyReq := payload.MergeGroup{TargetID: "g-abc", TargetETag: `"5-1a2b3c4d5e6f7a8b"`}
+
+	t.Run("success scenario", func(t *testing.T) {
+		mockGroupService.On(
+			"Merge",
+			mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+			"g-def",
+			3,
+			5,
+			dummyReq,
+		).Return(
+			func(ctx context.Context, id string, version, targetVersion int, p payload.MergeGroup) error {
+				return nil
+			},
+		).Once()
+
+		t.Run("it should return 204 status code, when there is no error", func(t *testing.T) {
+			controller := NewGroupsController(mockGroupService, mockPropertyService, mockAddressService, mockTokenGen)
+			requestBody, err := json.Marshal(dummyReq)
+			assert.NoError(t, err)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/groups", strings.NewReader(string(requestBody)))
+			req.Header.Set(headerIfMatch, `"3"`)
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/:id/merge")
+			c.SetParamNames("id")
+			c.SetParamValues("g-def")
+
+			if assert.NoError(t, controller.postMergeGroup(c)) {
+				assert.Equal(t, http.StatusNoContent, rec.Code)
+			}
+		})
+	})
+
+	t.Run("failed scenario", func(t *testing.T) {
+		testCases := []struct {
+			name                 string
+			expectedStatusCode   int
+			expectedErrorMessage string
+			mockError            error
+		}{
+			{
+				name:                 "it should return 400 status code, when payload is invalid",
+				expectedStatusCode:   http.StatusBadRequest,
+				expectedErrorMessage: "Invalid payload. Please check the payload schema in the API Documentation.",
+				mockError:            service.ErrInvalidPayload,
+			},
+			{
+				name:                 "it should return 404 status code, when group ID not found",
+				expectedStatusCode:   http.StatusNotFound,
+				expectedErrorMessage: "Resource with given ID not found.",
+				mockError:            service.ErrDataNotFound,
+			},
+			{
+				name:                 "it should return 412 status code, when a group has been modified",
+				expectedStatusCode:   http.StatusPreconditionFailed,
+				expectedErrorMessage: "Resource has been modified since it was read. Please get it again and retry.",
+				mockError:            service.ErrVersionMismatch,
+			},
+			{
+				name:                 "it should return 500 status code, when error happened",
+				expectedStatusCode:   http.StatusInternalServerError,
+				expectedErrorMessage: "Something went wrong.",
+				mockError:            service.ErrRepository,
+			},
+		}
+
+		for _, testCase := range testCases {
+			t.Run(testCase.name, func(t *testing.T) {
+				mockGroupService.On(
+					"Merge",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", 0)),
+					mock.AnythingOfType(fmt.Sprintf("%T", 0)),
+					mock.AnythingOfType(fmt.Sprintf("%T", payload.MergeGroup{})),
+				).Return(
+					func(ctx context.Context, id string, version, targetVersion int, p payload.MergeGroup) error {
+						return testCase.mockError
+					},
+				).Once()
+
+				controller := NewGroupsController(mockGroupService, mockPropertyService, mockAddressService, mockTokenGen)
+				requestBody, err := json.Marshal(dummyReq)
+				assert.NoError(t, err)
+
+				e := echo.New()
+				req := httptest.NewRequest(http.MethodPost, "/api/v1/groups", strings.NewReader(string(requestBody)))
+				req.Header.Set(headerIfMatch, `"3"`)
+				req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+				rec := httptest.NewRecorder()
+				c := e.NewContext(req, rec)
+				c.SetPath("/:id/merge")
+				c.SetParamNames("id")
+				c.SetParamValues("g-def")
+
+				gotError := controller.postMergeGroup(c)
+				if assert.Error(t, gotError) {
+					if echoHTTPError, ok := gotError.(*echo.HTTPError); assert.Equal(t, true, ok) {
+						assert.Equal(t, testCase.expectedStatusCode, echoHTTPError.Code)
+						assert.Equal(t, testCase.expectedErrorMessage, echoHTTPError.Message)
+					}
+				}
+			})
+		}
+
+		preconditionCases := []struct {
+			name         string
+			inputIfMatch string
+			inputPayload payload.MergeGroup
+		}{
+			{
+				name:         "it should return 428 status code, when If-Match header is missing",
+				inputPayload: dummyReq,
+			},
+			{
+				name:         "it should return 428 status code, when the target ETag is missing",
+				inputIfMatch: `"3"`,
+				inputPayload: payload.MergeGroup{TargetID: "g-abc"},
+			},
+		}
+
+		for _, testCase := range preconditionCases {
+			t.Run(testCase.name, func(t *testing.T) {
+				controller := NewGroupsController(mockGroupService, mockPropertyService, mockAddressService, mockTokenGen)
+				requestBody, err := json.Marshal(testCase.inputPayload)
+				assert.NoError(t, err)
+
+				e := echo.New()
+				req := httptest.NewRequest(http.MethodPost, "/api/v1/groups", strings.NewReader(string(requestBody)))
+				if testCase.inputIfMatch != "" {
+					req.Header.Set(headerIfMatch, testCase.inputIfMatch)
+				}
+				req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+				rec := httptest.NewRecorder()
+				c := e.NewContext(req, rec)
+				c.SetPath("/:id/merge")
+				c.SetParamNames("id")
+				c.SetParamValues("g-def")
+
+				gotError := controller.postMergeGroup(c)
+				if assert.Error(t, gotError) {
+					if echoHTTPError, ok := gotError.(*echo.HTTPError); assert.Equal(t, true, ok) {
+						assert.Equal(t, http.StatusPreconditionRequired, echoHTTPError.Code)
+						assert.Equal(t, "If-Match header is required. Please send the ETag of the resource the change is based on.", echoHTTPError.Message)
+					}
+				}
+			})
+		}
+	})
+}
+
+func TestGetGenerateQRCode(t *testing.T) {
+	mockGroupService := &mgs.GroupService{}
+	mockPropertyService := &mps.PropertyService{}
+	mockAddressService := &mas.AddressService{}
+	mockTokenGen := &mig.TokenGenerator{}
+
+	t.Run("success scenario", func(t *testing.T) {
+		mockGroupService.On(
+			"GenerateQRCode",
+			mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+			mock.AnythingOfType(fmt.Sprintf("%T", "")),
+		).Return(
+			func(ctx context.Context, id string) []byte {
+				return []byte{1}
+			},
+			func(ctx context.Context, id string) error {
+				return nil
+			},
+		).Once()
+
+		t.Run("it should return 200 status code with valid response, when there is no error", func(t *testing.T) {
+			controller := NewGroupsController(mockGroupService, mockPropertyService, mockAddressService, mockTokenGen)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/groups", nil)
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/:id/generate")
+			c.SetParamNames("id")
+			c.SetParamValues("g-xyz")
+
+			if assert.NoError(t, controller.getGenerateQRCode(c)) {
+				assert.Equal(t, http.StatusOK, rec.Code)
+			}
+		})
+	})
+
+	t.Run("failed scenario", func(t *testing.T) {
+		testCases := []struct {
+			name                 string
+			expectedStatusCode   int
+			expectedErrorMessage string
+			mockBehaviour        func()
+		}{
+			{
+				name:                 "it should return 404 status code, when group ID not found",
+				expectedStatusCode:   http.StatusNotFound,
+				expectedErrorMessage: "Resource with given ID not found.",
+				mockBehaviour: func() {
+					mockGroupService.On(
+						"GenerateQRCode",
+						mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					).Return(
+						func(ctx context.Context, id string) []byte {
+							return []byte{}
+						},
+						func(ctx context.Context, id string) error {
+							return service.ErrDataNotFound
+						},
+					).Once()
+				},
+			},
+			{
+				name:                 "it should return 500 status code, when error happened",
+				expectedStatusCode:   http.StatusInternalServerError,
+				expectedErrorMessage: "Something went wrong.",
+				mockBehaviour: func() {
+					mockGroupService.On(
+						"GenerateQRCode",
+						mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					).Return(
+						func(ctx context.Context, id string) []byte {
+							return []byte{}
+						},
+						func(ctx context.Context, id string) error {
+							return service.ErrRepository
+						},
+					).Once()
+				},
+			},
+		}
+
+		for _, testCase := range testCases {
+			t.Run(testCase.name, func(t *testing.T) {
+				testCase.mockBehaviour()
+
+				controller := NewGroupsController(mockGroupService, mockPropertyService, mockAddressService, mockTokenGen)
+
+				e := echo.New()
+				req := httptest.NewRequest(http.MethodGet, "/api/v1/groups", nil)
+				req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+				rec := httptest.NewRecorder()
+				c := e.NewContext(req, rec)
+				c.SetPath("/:id/generate")
+				c.SetParamNames("id")
+				c.SetParamValues("g-xyz")
+
+				gotError := controller.getGenerateQRCode(c)
+				if assert.Error(t, gotError) {
+					if echoHTTPError, ok := gotError.(*echo.HTTPError); assert.Equal(t, true, ok) {
+						assert.Equal(t, testCase.expectedStatusCode, echoHTTPError.Code)
+						assert.Equal(t, testCase.expectedErrorMessage, echoHTTPError.Message)
+					}
+				}
+			})
+		}
+	})
+}
+
+func TestGetGenerateCertificate(t *testing.T) {
+	mockGroupService := &mgs.GroupService{}
+	mockPropertyService := &mps.PropertyService{}
+	mockAddressService := &mas.AddressService{}
+	mockTokenGen := &mig.TokenGenerator{}
+
+	t.Run("success scenario", func(t *testing.T) {
+		mockGroupService.On(
+			"GenerateCertificate",
+			mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+			mock.AnythingOfType(fmt.Sprintf("%T", "")),
+		).Return(
+			func(ctx context.Context, id string) []byte {
+				return []byte{1}
+			},
+			func(ctx context.Context, id string) error {
+				return nil
+			},
+		).Once()
+
+		t.Run("it should return 200 status code with valid response, when there is no error", func(t *testing.T) {
+			controller := NewGroupsController(mockGroupService, mockPropertyService, mockAddressService, mockTokenGen)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/groups", nil)
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/:id/certificate")
+			c.SetParamNames("id")
+			c.SetParamValues("g-xyz")
 
-				if err := json.Unmarshal([]byte(body), &gotResponse); assert.NoError(t, err) {
-					reflect.DeepEqual(dummyResp, gotResponse)
-				}
+			if assert.NoError(t, controller.getGenerateCertificate(c)) {
+				assert.Equal(t, http.StatusOK, rec.Code)
+				assert.Equal(t, "application/pdf", rec.Header().Get(echo.HeaderContentType))
+				assert.Equal(t, `inline; filename="certificate-g-xyz.pdf"`, rec.Header().Get(echo.HeaderContentDisposition))
 			}
 		})
 	})
@@ -400,18 +2179,37 @@ func TestGetGroupByID(t *testing.T) {
 			expectedErrorMessage string
 			mockBehaviour        func()
 		}{
+			{
+				name:                 "it should return 409 status code, when the group has no registration number yet",
+				expectedStatusCode:   http.StatusConflict,
+				expectedErrorMessage: "Group has no registration number yet. It is given when the server starts.",
+				mockBehaviour: func() {
+					mockGroupService.On(
+						"GenerateCertificate",
+						mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					).Return(
+						func(ctx context.Context, id string) []byte {
+							return nil
+						},
+						func(ctx context.Context, id string) error {
+							return service.ErrNotRegistered
+						},
+					).Once()
+				},
+			},
 			{
 				name:                 "it should return 404 status code, when group ID not found",
 				expectedStatusCode:   http.StatusNotFound,
 				expectedErrorMessage: "Resource with given ID not found.",
 				mockBehaviour: func() {
 					mockGroupService.On(
-						"GetByID",
+						"GenerateCertificate",
 						mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
 						mock.AnythingOfType(fmt.Sprintf("%T", "")),
 					).Return(
-						func(ctx context.Context, id string) response.Group {
-							return response.Group{}
+						func(ctx context.Context, id string) []byte {
+							return []byte{}
 						},
 						func(ctx context.Context, id string) error {
 							return service.ErrDataNotFound
@@ -425,12 +2223,12 @@ func TestGetGroupByID(t *testing.T) {
 				expectedErrorMessage: "Something went wrong.",
 				mockBehaviour: func() {
 					mockGroupService.On(
-						"GetByID",
+						"GenerateCertificate",
 						mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
 						mock.AnythingOfType(fmt.Sprintf("%T", "")),
 					).Return(
-						func(ctx context.Context, id string) response.Group {
-							return response.Group{}
+						func(ctx context.Context, id string) []byte {
+							return []byte{}
 						},
 						func(ctx context.Context, id string) error {
 							return service.ErrRepository
@@ -444,18 +2242,18 @@ func TestGetGroupByID(t *testing.T) {
 			t.Run(testCase.name, func(t *testing.T) {
 				testCase.mockBehaviour()
 
-				controller := NewGroupsController(mockGroupService, mockPropertyService, mockAddressService)
+				controller := NewGroupsController(mockGroupService, mockPropertyService, mockAddressService, mockTokenGen)
 
 				e := echo.New()
 				req := httptest.NewRequest(http.MethodGet, "/api/v1/groups", nil)
 				req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 				rec := httptest.NewRecorder()
 				c := e.NewContext(req, rec)
-				c.SetPath("/:id")
+				c.SetPath("/:id/certificate")
 				c.SetParamNames("id")
 				c.SetParamValues("g-xyz")
 
-				gotError := controller.getGroupByID(c)
+				gotError := controller.getGenerateCertificate(c)
 				if assert.Error(t, gotError) {
 					if echoHTTPError, ok := gotError.(*echo.HTTPError); assert.Equal(t, true, ok) {
 						assert.Equal(t, testCase.expectedStatusCode, echoHTTPError.Code)
@@ -467,110 +2265,332 @@ func TestGetGroupByID(t *testing.T) {
 	})
 }
 
-func TestPutUpdateGroupByID(t *testing.T) {
+func TestPutUpdateGroupContacts(t *testing.T) {
+	mockGroupService := &mgs.GroupService{}
+	mockPropertyService := &mps.PropertyService{}
+	mockAddressService := &mas.AddressService{}
+	mockTokenGen := &mig.TokenGenerator{}
+
+	dummyReq := payload.UpdateGroupContacts{
+		Phone:     "(0352) 481234",
+		WhatsApp:  "0812-3456-7890",
+		Instagram: "@reogsingomudho",
+	}
+
+	testCases := []struct {
+		name                 string
+		inputIfMatch         string
+		inputError           error
+		expectedStatusCode   int
+		expectedErrorMessage string
+	}{
+		{
+			name:               "it should return 204 status code, when there is no error",
+			inputIfMatch:       `"3"`,
+			inputError:         nil,
+			expectedStatusCode: http.StatusNoContent,
+		},
+		{
+			name:                 "it should return 400 status code, when a contact is invalid",
+			inputIfMatch:         `"3"`,
+			inputError:           service.ErrInvalidPayload,
+			expectedStatusCode:   http.StatusBadRequest,
+			expectedErrorMessage: "Invalid payload. Please check the payload schema in the API Documentation.",
+		},
+		{
+			name:                 "it should return 404 status code, when group ID not found",
+			inputIfMatch:         `"3"`,
+			inputError:           service.ErrDataNotFound,
+			expectedStatusCode:   http.StatusNotFound,
+			expectedErrorMessage: "Resource with given ID not found.",
+		},
+		{
+			name:                 "it should return 412 status code, when the group has been modified",
+			inputIfMatch:         `"3"`,
+			inputError:           service.ErrVersionMismatch,
+			expectedStatusCode:   http.StatusPreconditionFailed,
+			expectedErrorMessage: "Resource has been modified since it was read. Please get it again and retry.",
+		},
+		{
+			name:                 "it should return 428 status code, when If-Match header is missing",
+			inputError:           service.ErrVersionRequired,
+			expectedStatusCode:   http.StatusPreconditionRequired,
+			expectedErrorMessage: "If-Match header is required. Please send the ETag of the resource the change is based on.",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			if testCase.inputIfMatch != "" {
+				mockGroupService.On(
+					"UpdateContacts",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					"g-xyz",
+					3,
+					dummyReq,
+				).Return(
+					func(ctx context.Context, id string, version int, p payload.UpdateGroupContacts) error {
+						return testCase.inputError
+					},
+				).Once()
+			}
+
+			controller := NewGroupsController(mockGroupService, mockPropertyService, mockAddressService, mockTokenGen)
+			requestBody, err := json.Marshal(dummyReq)
+			assert.NoError(t, err)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPut, "/", strings.NewReader(string(requestBody)))
+			if testCase.inputIfMatch != "" {
+				req.Header.Set(headerIfMatch, testCase.inputIfMatch)
+			}
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/api/v1/groups/:id/contacts")
+			c.SetParamNames("id")
+			c.SetParamValues("g-xyz")
+
+			gotError := controller.putUpdateGroupContacts(c)
+			if testCase.inputError == nil {
+				if assert.NoError(t, gotError) {
+					assert.Equal(t, testCase.expectedStatusCode, rec.Code)
+				}
+				return
+			}
+
+			if assert.Error(t, gotError) {
+				if echoHTTPError, ok := gotError.(*echo.HTTPError); assert.Equal(t, true, ok) {
+					assert.Equal(t, testCase.expectedStatusCode, echoHTTPError.Code)
+					assert.Equal(t, testCase.expectedErrorMessage, echoHTTPError.Message)
+				}
+			}
+		})
+	}
+}
+
+func TestGetGenerateVCard(t *testing.T) {
 	mockGroupService := &mgs.GroupService{}
 	mockPropertyService := &mps.PropertyService{}
 	mockAddressService := &mas.AddressService{}
+	mockTokenGen := &mig.TokenGenerator{}
+
+	testCases := []struct {
+		name                 string
+		inputError           error
+		expectedStatusCode   int
+		expectedErrorMessage string
+	}{
+		{
+			name:               "it should return 200 status code with the vCard, when there is no error",
+			inputError:         nil,
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name:                 "it should return 404 status code, when group ID not found",
+			inputError:           service.ErrDataNotFound,
+			expectedStatusCode:   http.StatusNotFound,
+			expectedErrorMessage: "Resource with given ID not found.",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockGroupService.On(
+				"GenerateVCard",
+				mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+				"g-xyz",
+			).Return(
+				func(ctx context.Context, id string) []byte {
+					return []byte("BEGIN:VCARD\r\nEND:VCARD\r\n")
+				},
+				func(ctx context.Context, id string) error {
+					return testCase.inputError
+				},
+			).Once()
 
-	t.Run("success scenario", func(t *testing.T) {
-		dummyReq := payload.UpdateGroup{
-			Name:   "Paguyuban Reog",
-			Leader: "Erik Rio S",
-		}
+			controller := NewGroupsController(mockGroupService, mockPropertyService, mockAddressService, mockTokenGen)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/api/v1/groups/:id/vcard")
+			c.SetParamNames("id")
+			c.SetParamValues("g-xyz")
+
+			gotError := controller.getGenerateVCard(c)
+			if testCase.inputError == nil {
+				if assert.NoError(t, gotError) {
+					assert.Equal(t, testCase.expectedStatusCode, rec.Code)
+					assert.Equal(t, "text/vcard; charset=utf-8", rec.Header().Get(echo.HeaderContentType))
+					assert.Equal(t, `attachment; filename="leader-g-xyz.vcf"`, rec.Header().Get(echo.HeaderContentDisposition))
+					assert.Equal(t, "BEGIN:VCARD\r\nEND:VCARD\r\n", rec.Body.String())
+				}
+				return
+			}
+
+			if assert.Error(t, gotError) {
+				if echoHTTPError, ok := gotError.(*echo.HTTPError); assert.Equal(t, true, ok) {
+					assert.Equal(t, testCase.expectedStatusCode, echoHTTPError.Code)
+					assert.Equal(t, testCase.expectedErrorMessage, echoHTTPError.Message)
+				}
+			}
+		})
+	}
+}
+
+func TestPutUpdateGroupStatus(t *testing.T) {
+	mockGroupService := &mgs.GroupService{}
+	mockPropertyService := &mps.PropertyService{}
+	mockAddressService := &mas.AddressService{}
+	mockTokenGen := &mig.TokenGenerator{}
 
+	dummyReq := payload.UpdateGroupStatus{
+		Status: "suspended",
+		Reason: "Unpaid membership fee",
+	}
+
+	mockTokenGen.On("ExtractToken", mock.Anything).Return("a-XU", "erikrios")
+
+	t.Run("success scenario", func(t *testing.T) {
 		mockGroupService.On(
-			"Update",
+			"UpdateStatus",
 			mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
-			mock.AnythingOfType(fmt.Sprintf("%T", "")),
-			mock.AnythingOfType(fmt.Sprintf("%T", payload.UpdateGroup{})),
+			"g-xyz",
+			3,
+			"a-XU",
+			"erikrios",
+			dummyReq,
 		).Return(
-			func(ctx context.Context, id string, p payload.UpdateGroup) error {
+			func(ctx context.Context, id string, version int, adminID, adminUsername string, p payload.UpdateGroupStatus) error {
 				return nil
 			},
 		).Once()
 
-		t.Run("it should return 204 status code with valid response, when there is no error", func(t *testing.T) {
-			controller := NewGroupsController(mockGroupService, mockPropertyService, mockAddressService)
+		t.Run("it should return 204 status code, when there is no error", func(t *testing.T) {
+			controller := NewGroupsController(mockGroupService, mockPropertyService, mockAddressService, mockTokenGen)
 			requestBody, err := json.Marshal(dummyReq)
 			assert.NoError(t, err)
 
 			e := echo.New()
 			req := httptest.NewRequest(http.MethodPut, "/api/v1/groups", strings.NewReader(string(requestBody)))
+			req.Header.Set(headerIfMatch, `"3"`)
 			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 			rec := httptest.NewRecorder()
 			c := e.NewContext(req, rec)
-			c.SetPath("/:id")
+			c.SetPath("/:id/status")
 			c.SetParamNames("id")
 			c.SetParamValues("g-xyz")
 
-			if assert.NoError(t, controller.putUpdateGroupByID(c)) {
+			if assert.NoError(t, controller.putUpdateGroupStatus(c)) {
 				assert.Equal(t, http.StatusNoContent, rec.Code)
 			}
 		})
 	})
 
 	t.Run("failed scenario", func(t *testing.T) {
-		dummyReq := payload.UpdateGroup{
-			Name:   "Paguyuban Reog",
-			Leader: "Erik Rio S",
-		}
-
 		testCases := []struct {
 			name                 string
-			inputPayload         payload.UpdateGroup
 			expectedStatusCode   int
 			expectedErrorMessage string
 			mockBehaviour        func()
 		}{
 			{
 				name:                 "it should return 400 status code, when payload is invalid",
-				inputPayload:         dummyReq,
 				expectedStatusCode:   http.StatusBadRequest,
 				expectedErrorMessage: "Invalid payload. Please check the payload schema in the API Documentation.",
 				mockBehaviour: func() {
 					mockGroupService.On(
-						"Update",
+						"UpdateStatus",
 						mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
 						mock.AnythingOfType(fmt.Sprintf("%T", "")),
-						mock.AnythingOfType(fmt.Sprintf("%T", payload.UpdateGroup{})),
+						mock.AnythingOfType(fmt.Sprintf("%T", 0)),
+						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+						mock.AnythingOfType(fmt.Sprintf("%T", payload.UpdateGroupStatus{})),
 					).Return(
-						func(ctx context.Context, id string, p payload.UpdateGroup) error {
+						func(ctx context.Context, id string, version int, adminID, adminUsername string, p payload.UpdateGroupStatus) error {
 							return service.ErrInvalidPayload
 						},
 					).Once()
 				},
 			},
 			{
-				name:                 "it should return 404 status code, when village ID not found",
-				inputPayload:         dummyReq,
+				name:                 "it should return 404 status code, when group ID not found",
 				expectedStatusCode:   http.StatusNotFound,
 				expectedErrorMessage: "Resource with given ID not found.",
 				mockBehaviour: func() {
 					mockGroupService.On(
-						"Update",
+						"UpdateStatus",
 						mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
 						mock.AnythingOfType(fmt.Sprintf("%T", "")),
-						mock.AnythingOfType(fmt.Sprintf("%T", payload.UpdateGroup{})),
+						mock.AnythingOfType(fmt.Sprintf("%T", 0)),
+						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+						mock.AnythingOfType(fmt.Sprintf("%T", payload.UpdateGroupStatus{})),
 					).Return(
-						func(ctx context.Context, id string, p payload.UpdateGroup) error {
+						func(ctx context.Context, id string, version int, adminID, adminUsername string, p payload.UpdateGroupStatus) error {
 							return service.ErrDataNotFound
 						},
 					).Once()
 				},
 			},
+			{
+				name:                 "it should return 409 status code, when group already has the given status",
+				expectedStatusCode:   http.StatusConflict,
+				expectedErrorMessage: "Group already has the given status.",
+				mockBehaviour: func() {
+					mockGroupService.On(
+						"UpdateStatus",
+						mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+						mock.AnythingOfType(fmt.Sprintf("%T", 0)),
+						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+						mock.AnythingOfType(fmt.Sprintf("%T", payload.UpdateGroupStatus{})),
+					).Return(
+						func(ctx context.Context, id string, version int, adminID, adminUsername string, p payload.UpdateGroupStatus) error {
+							return service.ErrStatusUnchanged
+						},
+					).Once()
+				},
+			},
+			{
+				name:                 "it should return 412 status code, when the group has been modified",
+				expectedStatusCode:   http.StatusPreconditionFailed,
+				expectedErrorMessage: "Resource has been modified since it was read. Please get it again and retry.",
+				mockBehaviour: func() {
+					mockGroupService.On(
+						"UpdateStatus",
+						mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+						mock.AnythingOfType(fmt.Sprintf("%T", 0)),
+						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+						mock.AnythingOfType(fmt.Sprintf("%T", payload.UpdateGroupStatus{})),
+					).Return(
+						func(ctx context.Context, id string, version int, adminID, adminUsername string, p payload.UpdateGroupStatus) error {
+							return service.ErrVersionMismatch
+						},
+					).Once()
+				},
+			},
 			{
 				name:                 "it should return 500 status code, when error happened",
-				inputPayload:         dummyReq,
 				expectedStatusCode:   http.StatusInternalServerError,
 				expectedErrorMessage: "Something went wrong.",
 				mockBehaviour: func() {
 					mockGroupService.On(
-						"Update",
+						"UpdateStatus",
 						mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
 						mock.AnythingOfType(fmt.Sprintf("%T", "")),
-						mock.AnythingOfType(fmt.Sprintf("%T", payload.UpdateGroup{})),
+						mock.AnythingOfType(fmt.Sprintf("%T", 0)),
+						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+						mock.AnythingOfType(fmt.Sprintf("%T", payload.UpdateGroupStatus{})),
 					).Return(
-						func(ctx context.Context, id string, p payload.UpdateGroup) error {
+						func(ctx context.Context, id string, version int, adminID, adminUsername string, p payload.UpdateGroupStatus) error {
 							return service.ErrRepository
 						},
 					).Once()
@@ -582,20 +2602,21 @@ func TestPutUpdateGroupByID(t *testing.T) {
 			t.Run(testCase.name, func(t *testing.T) {
 				testCase.mockBehaviour()
 
-				controller := NewGroupsController(mockGroupService, mockPropertyService, mockAddressService)
+				controller := NewGroupsController(mockGroupService, mockPropertyService, mockAddressService, mockTokenGen)
 				requestBody, err := json.Marshal(dummyReq)
 				assert.NoError(t, err)
 
 				e := echo.New()
 				req := httptest.NewRequest(http.MethodPut, "/api/v1/groups", strings.NewReader(string(requestBody)))
+				req.Header.Set(headerIfMatch, `"3"`)
 				req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 				rec := httptest.NewRecorder()
 				c := e.NewContext(req, rec)
-				c.SetPath("/:id")
+				c.SetPath("/:id/status")
 				c.SetParamNames("id")
 				c.SetParamValues("g-xyz")
 
-				gotError := controller.putUpdateGroupByID(c)
+				gotError := controller.putUpdateGroupStatus(c)
 				if assert.Error(t, gotError) {
 					if echoHTTPError, ok := gotError.(*echo.HTTPError); assert.Equal(t, true, ok) {
 						assert.Equal(t, testCase.expectedStatusCode, echoHTTPError.Code)
@@ -604,39 +2625,90 @@ func TestPutUpdateGroupByID(t *testing.T) {
 				}
 			})
 		}
+
+		t.Run("it should return 428 status code, when If-Match header is missing", func(t *testing.T) {
+			controller := NewGroupsController(mockGroupService, mockPropertyService, mockAddressService, mockTokenGen)
+			requestBody, err := json.Marshal(dummyReq)
+			assert.NoError(t, err)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPut, "/api/v1/groups", strings.NewReader(string(requestBody)))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/:id/status")
+			c.SetParamNames("id")
+			c.SetParamValues("g-xyz")
+
+			gotError := controller.putUpdateGroupStatus(c)
+			if assert.Error(t, gotError) {
+				if echoHTTPError, ok := gotError.(*echo.HTTPError); assert.Equal(t, true, ok) {
+					assert.Equal(t, http.StatusPreconditionRequired, echoHTTPError.Code)
+					assert.Equal(t, "If-Match header is required. Please send the ETag of the resource the change is based on.", echoHTTPError.Message)
+				}
+			}
+		})
 	})
 }
 
-func TestDeleteGroupByID(t *testing.T) {
+func TestGetGroupStatusHistory(t *testing.T) {
 	mockGroupService := &mgs.GroupService{}
 	mockPropertyService := &mps.PropertyService{}
 	mockAddressService := &mas.AddressService{}
+	mockTokenGen := &mig.TokenGenerator{}
 
 	t.Run("success scenario", func(t *testing.T) {
+		dummyTransitions := []response.GroupStatusTransition{
+			{
+				ID:            "t-Ay8LmNI",
+				FromStatus:    "active",
+				ToStatus:      "suspended",
+				Reason:        "Unpaid membership fee",
+				AdminID:       "a-XU",
+				AdminUsername: "erikrios",
+				ChangedAt:     "01 Jun 22 09:30 UTC",
+			},
+		}
+
 		mockGroupService.On(
-			"Delete",
+			"GetStatusHistory",
 			mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
-			mock.AnythingOfType(fmt.Sprintf("%T", "")),
+			"g-xyz",
 		).Return(
+			func(ctx context.Context, id string) []response.GroupStatusTransition {
+				return dummyTransitions
+			},
 			func(ctx context.Context, id string) error {
 				return nil
 			},
 		).Once()
 
-		t.Run("it should return 204 status code with valid response, when there is no error", func(t *testing.T) {
-			controller := NewGroupsController(mockGroupService, mockPropertyService, mockAddressService)
+		t.Run("it should return 200 status code with valid response, when there is no error", func(t *testing.T) {
+			controller := NewGroupsController(mockGroupService, mockPropertyService, mockAddressService, mockTokenGen)
 
 			e := echo.New()
-			req := httptest.NewRequest(http.MethodDelete, "/api/v1/groups", nil)
-			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/groups", nil)
 			rec := httptest.NewRecorder()
 			c := e.NewContext(req, rec)
-			c.SetPath("/:id")
+			c.SetPath("/:id/status/history")
 			c.SetParamNames("id")
 			c.SetParamValues("g-xyz")
 
-			if assert.NoError(t, controller.deleteGroupByID(c)) {
-				assert.Equal(t, http.StatusNoContent, rec.Code)
+			if assert.NoError(t, controller.getGroupStatusHistory(c)) {
+				assert.Equal(t, http.StatusOK, rec.Code)
+
+				body := rec.Body.String()
+
+				gotResponse := make(map[string]any)
+
+				if err := json.Unmarshal([]byte(body), &gotResponse); assert.NoError(t, err) {
+					gotTransitions := gotResponse["data"].(map[string]any)["transitions"].([]any)
+					if assert.Len(t, gotTransitions, 1) {
+						gotTransition := gotTransitions[0].(map[string]any)
+						assert.Equal(t, "suspended", gotTransition["toStatus"])
+						assert.Equal(t, "erikrios", gotTransition["adminUsername"])
+					}
+				}
 			}
 		})
 	})
@@ -646,58 +2718,48 @@ func TestDeleteGroupByID(t *testing.T) {
 			name                 string
 			expectedStatusCode   int
 			expectedErrorMessage string
-			mockBehaviour        func()
+			mockError            error
 		}{
 			{
-				name:                 "it should return 404 status code, when village ID not found",
+				name:                 "it should return 404 status code, when group ID not found",
 				expectedStatusCode:   http.StatusNotFound,
 				expectedErrorMessage: "Resource with given ID not found.",
-				mockBehaviour: func() {
-					mockGroupService.On(
-						"Delete",
-						mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
-						mock.AnythingOfType(fmt.Sprintf("%T", "")),
-					).Return(
-						func(ctx context.Context, id string) error {
-							return service.ErrDataNotFound
-						},
-					).Once()
-				},
+				mockError:            service.ErrDataNotFound,
 			},
 			{
 				name:                 "it should return 500 status code, when error happened",
 				expectedStatusCode:   http.StatusInternalServerError,
 				expectedErrorMessage: "Something went wrong.",
-				mockBehaviour: func() {
-					mockGroupService.On(
-						"Delete",
-						mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
-						mock.AnythingOfType(fmt.Sprintf("%T", "")),
-					).Return(
-						func(ctx context.Context, id string) error {
-							return service.ErrRepository
-						},
-					).Once()
-				},
+				mockError:            service.ErrRepository,
 			},
 		}
 
 		for _, testCase := range testCases {
 			t.Run(testCase.name, func(t *testing.T) {
-				testCase.mockBehaviour()
+				mockGroupService.On(
+					"GetStatusHistory",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, id string) []response.GroupStatusTransition {
+						return nil
+					},
+					func(ctx context.Context, id string) error {
+						return testCase.mockError
+					},
+				).Once()
 
-				controller := NewGroupsController(mockGroupService, mockPropertyService, mockAddressService)
+				controller := NewGroupsController(mockGroupService, mockPropertyService, mockAddressService, mockTokenGen)
 
 				e := echo.New()
-				req := httptest.NewRequest(http.MethodDelete, "/api/v1/groups", nil)
-				req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+				req := httptest.NewRequest(http.MethodGet, "/api/v1/groups", nil)
 				rec := httptest.NewRecorder()
 				c := e.NewContext(req, rec)
-				c.SetPath("/:id")
+				c.SetPath("/:id/status/history")
 				c.SetParamNames("id")
 				c.SetParamValues("g-xyz")
 
-				gotError := controller.deleteGroupByID(c)
+				gotError := controller.getGroupStatusHistory(c)
 				if assert.Error(t, gotError) {
 					if echoHTTPError, ok := gotError.(*echo.HTTPError); assert.Equal(t, true, ok) {
 						assert.Equal(t, testCase.expectedStatusCode, echoHTTPError.Code)
@@ -709,19 +2771,33 @@ func TestDeleteGroupByID(t *testing.T) {
 	})
 }
 
-func TestGetGenerateQRCode(t *testing.T) {
+func TestGetGroupLeadershipHistory(t *testing.T) {
 	mockGroupService := &mgs.GroupService{}
 	mockPropertyService := &mps.PropertyService{}
 	mockAddressService := &mas.AddressService{}
+	mockTokenGen := &mig.TokenGenerator{}
 
 	t.Run("success scenario", func(t *testing.T) {
+		dummyChanges := []response.LeadershipChange{
+			{
+				ID:             "l-Ay8LmNI",
+				PreviousLeader: "Erik R",
+				NewLeader:      "Rio S",
+				EffectiveOn:    "2022-01-02",
+				Note:           "Elected at the annual meeting",
+				AdminID:        "a-XU",
+				AdminUsername:  "erikrios",
+				RecordedAt:     "01 Jun 22 09:30 UTC",
+			},
+		}
+
 		mockGroupService.On(
-			"GenerateQRCode",
+			"GetLeadershipHistory",
 			mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
-			mock.AnythingOfType(fmt.Sprintf("%T", "")),
+			"g-xyz",
 		).Return(
-			func(ctx context.Context, id string) []byte {
-				return []byte{1}
+			func(ctx context.Context, id string) []response.LeadershipChange {
+				return dummyChanges
 			},
 			func(ctx context.Context, id string) error {
 				return nil
@@ -729,86 +2805,83 @@ func TestGetGenerateQRCode(t *testing.T) {
 		).Once()
 
 		t.Run("it should return 200 status code with valid response, when there is no error", func(t *testing.T) {
-			controller := NewGroupsController(mockGroupService, mockPropertyService, mockAddressService)
+			controller := NewGroupsController(mockGroupService, mockPropertyService, mockAddressService, mockTokenGen)
 
 			e := echo.New()
 			req := httptest.NewRequest(http.MethodGet, "/api/v1/groups", nil)
-			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 			rec := httptest.NewRecorder()
 			c := e.NewContext(req, rec)
-			c.SetPath("/:id/generate")
+			c.SetPath("/:id/leaders")
 			c.SetParamNames("id")
 			c.SetParamValues("g-xyz")
 
-			if assert.NoError(t, controller.getGenerateQRCode(c)) {
+			if assert.NoError(t, controller.getGroupLeadershipHistory(c)) {
 				assert.Equal(t, http.StatusOK, rec.Code)
+
+				body := rec.Body.String()
+
+				gotResponse := make(map[string]any)
+
+				if err := json.Unmarshal([]byte(body), &gotResponse); assert.NoError(t, err) {
+					gotChanges := gotResponse["data"].(map[string]any)["leaders"].([]any)
+					if assert.Len(t, gotChanges, 1) {
+						gotChange := gotChanges[0].(map[string]any)
+						assert.Equal(t, "Erik R", gotChange["previousLeader"])
+						assert.Equal(t, "Rio S", gotChange["newLeader"])
+						assert.Equal(t, "2022-01-02", gotChange["effectiveOn"])
+					}
+				}
 			}
 		})
 	})
-
-	t.Run("failed scenario", func(t *testing.T) {
-		testCases := []struct {
-			name                 string
-			expectedStatusCode   int
-			expectedErrorMessage string
-			mockBehaviour        func()
-		}{
-			{
-				name:                 "it should return 404 status code, when group ID not found",
-				expectedStatusCode:   http.StatusNotFound,
-				expectedErrorMessage: "Resource with given ID not found.",
-				mockBehaviour: func() {
-					mockGroupService.On(
-						"GenerateQRCode",
-						mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
-						mock.AnythingOfType(fmt.Sprintf("%T", "")),
-					).Return(
-						func(ctx context.Context, id string) []byte {
-							return []byte{}
-						},
-						func(ctx context.Context, id string) error {
-							return service.ErrDataNotFound
-						},
-					).Once()
-				},
+
+	t.Run("failed scenario", func(t *testing.T) {
+		testCases := []struct {
+			name                 string
+			expectedStatusCode   int
+			expectedErrorMessage string
+			mockError            error
+		}{
+			{
+				name:                 "it should return 404 status code, when group ID not found",
+				expectedStatusCode:   http.StatusNotFound,
+				expectedErrorMessage: "Resource with given ID not found.",
+				mockError:            service.ErrDataNotFound,
 			},
 			{
 				name:                 "it should return 500 status code, when error happened",
 				expectedStatusCode:   http.StatusInternalServerError,
 				expectedErrorMessage: "Something went wrong.",
-				mockBehaviour: func() {
-					mockGroupService.On(
-						"GenerateQRCode",
-						mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
-						mock.AnythingOfType(fmt.Sprintf("%T", "")),
-					).Return(
-						func(ctx context.Context, id string) []byte {
-							return []byte{}
-						},
-						func(ctx context.Context, id string) error {
-							return service.ErrRepository
-						},
-					).Once()
-				},
+				mockError:            service.ErrRepository,
 			},
 		}
 
 		for _, testCase := range testCases {
 			t.Run(testCase.name, func(t *testing.T) {
-				testCase.mockBehaviour()
+				mockGroupService.On(
+					"GetLeadershipHistory",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, id string) []response.LeadershipChange {
+						return nil
+					},
+					func(ctx context.Context, id string) error {
+						return testCase.mockError
+					},
+				).Once()
 
-				controller := NewGroupsController(mockGroupService, mockPropertyService, mockAddressService)
+				controller := NewGroupsController(mockGroupService, mockPropertyService, mockAddressService, mockTokenGen)
 
 				e := echo.New()
 				req := httptest.NewRequest(http.MethodGet, "/api/v1/groups", nil)
-				req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 				rec := httptest.NewRecorder()
 				c := e.NewContext(req, rec)
-				c.SetPath("/:id/generate")
+				c.SetPath("/:id/leaders")
 				c.SetParamNames("id")
 				c.SetParamValues("g-xyz")
 
-				gotError := controller.getGenerateQRCode(c)
+				gotError := controller.getGroupLeadershipHistory(c)
 				if assert.Error(t, gotError) {
 					if echoHTTPError, ok := gotError.(*echo.HTTPError); assert.Equal(t, true, ok) {
 						assert.Equal(t, testCase.expectedStatusCode, echoHTTPError.Code)
@@ -824,6 +2897,7 @@ func TestPutUpdateAddress(t *testing.T) {
 	mockGroupService := &mgs.GroupService{}
 	mockPropertyService := &mps.PropertyService{}
 	mockAddressService := &mas.AddressService{}
+	mockTokenGen := &mig.TokenGenerator{}
 
 	t.Run("success scenario", func(t *testing.T) {
 		dummyReq := payload.UpdateAddress{
@@ -843,7 +2917,7 @@ func TestPutUpdateAddress(t *testing.T) {
 		).Once()
 
 		t.Run("it should return 204 status code with valid response, when there is no error", func(t *testing.T) {
-			controller := NewGroupsController(mockGroupService, mockPropertyService, mockAddressService)
+			controller := NewGroupsController(mockGroupService, mockPropertyService, mockAddressService, mockTokenGen)
 			requestBody, err := json.Marshal(dummyReq)
 			assert.NoError(t, err)
 
@@ -935,7 +3009,7 @@ func TestPutUpdateAddress(t *testing.T) {
 			t.Run(testCase.name, func(t *testing.T) {
 				testCase.mockBehaviour()
 
-				controller := NewGroupsController(mockGroupService, mockPropertyService, mockAddressService)
+				controller := NewGroupsController(mockGroupService, mockPropertyService, mockAddressService, mockTokenGen)
 				requestBody, err := json.Marshal(dummyReq)
 				assert.NoError(t, err)
 
@@ -960,10 +3034,111 @@ func TestPutUpdateAddress(t *testing.T) {
 	})
 }
 
+func TestPatchAddress(t *testing.T) {
+	mockGroupService := &mgs.GroupService{}
+	mockPropertyService := &mps.PropertyService{}
+	mockAddressService := &mas.AddressService{}
+	mockTokenGen := &mig.TokenGenerator{}
+
+	t.Run("success scenario", func(t *testing.T) {
+		villageID := "3502111"
+
+		mockAddressService.On(
+			"Patch",
+			mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+			"g-xyz",
+			payload.PatchAddress{VillageID: &villageID},
+		).Return(
+			func(ctx context.Context, id string, p payload.PatchAddress) error {
+				return nil
+			},
+		).Once()
+
+		t.Run("it should return 204 status code, when there is no error", func(t *testing.T) {
+			controller := NewGroupsController(mockGroupService, mockPropertyService, mockAddressService, mockTokenGen)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPatch, "/api/v1/groups/addresses", strings.NewReader(`{"villageID":"3502111"}`))
+			req.Header.Set(echo.HeaderContentType, mimeApplicationMergePatchJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/:id")
+			c.SetParamNames("id")
+			c.SetParamValues("g-xyz")
+
+			if assert.NoError(t, controller.patchAddress(c)) {
+				assert.Equal(t, http.StatusNoContent, rec.Code)
+			}
+		})
+	})
+
+	t.Run("failed scenario", func(t *testing.T) {
+		testCases := []struct {
+			name                 string
+			inputBody            string
+			expectedStatusCode   int
+			expectedErrorMessage string
+			mockBehaviour        func()
+		}{
+			{
+				name:                 "it should return 400 status code, when the location is null",
+				inputBody:            `{"latitude":null,"longitude":null}`,
+				expectedStatusCode:   http.StatusBadRequest,
+				expectedErrorMessage: "Invalid payload. Please check the payload schema in the API Documentation.",
+				mockBehaviour:        func() {},
+			},
+			{
+				name:                 "it should return 404 status code, when village ID not found",
+				inputBody:            `{"villageID":"3502999"}`,
+				expectedStatusCode:   http.StatusNotFound,
+				expectedErrorMessage: "Resource with given ID not found.",
+				mockBehaviour: func() {
+					mockAddressService.On(
+						"Patch",
+						mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+						mock.AnythingOfType(fmt.Sprintf("%T", payload.PatchAddress{})),
+					).Return(
+						func(ctx context.Context, id string, p payload.PatchAddress) error {
+							return service.ErrDataNotFound
+						},
+					).Once()
+				},
+			},
+		}
+
+		for _, testCase := range testCases {
+			t.Run(testCase.name, func(t *testing.T) {
+				testCase.mockBehaviour()
+
+				controller := NewGroupsController(mockGroupService, mockPropertyService, mockAddressService, mockTokenGen)
+
+				e := echo.New()
+				req := httptest.NewRequest(http.MethodPatch, "/api/v1/groups/addresses", strings.NewReader(testCase.inputBody))
+				req.Header.Set(echo.HeaderContentType, mimeApplicationMergePatchJSON)
+				rec := httptest.NewRecorder()
+				c := e.NewContext(req, rec)
+				c.SetPath("/:id")
+				c.SetParamNames("id")
+				c.SetParamValues("g-xyz")
+
+				gotError := controller.patchAddress(c)
+				if assert.Error(t, gotError) {
+					if echoHTTPError, ok := gotError.(*echo.HTTPError); assert.Equal(t, true, ok) {
+						assert.Equal(t, testCase.expectedStatusCode, echoHTTPError.Code)
+						assert.Equal(t, testCase.expectedErrorMessage, echoHTTPError.Message)
+					}
+				}
+			})
+		}
+	})
+}
+
 func TestPostCreateProperty(t *testing.T) {
 	mockGroupService := &mgs.GroupService{}
 	mockPropertyService := &mps.PropertyService{}
 	mockAddressService := &mas.AddressService{}
+	mockTokenGen := &mig.TokenGenerator{}
 
 	t.Run("success scenario", func(t *testing.T) {
 		dummyReq := payload.CreateProperty{
@@ -991,7 +3166,7 @@ func TestPostCreateProperty(t *testing.T) {
 		).Once()
 
 		t.Run("it should return 201 status code with valid response, when there is no error", func(t *testing.T) {
-			controller := NewGroupsController(mockGroupService, mockPropertyService, mockAddressService)
+			controller := NewGroupsController(mockGroupService, mockPropertyService, mockAddressService, mockTokenGen)
 			requestBody, err := json.Marshal(dummyReq)
 			assert.NoError(t, err)
 
@@ -1102,7 +3277,7 @@ func TestPostCreateProperty(t *testing.T) {
 			t.Run(testCase.name, func(t *testing.T) {
 				testCase.mockBehaviour()
 
-				controller := NewGroupsController(mockGroupService, mockPropertyService, mockAddressService)
+				controller := NewGroupsController(mockGroupService, mockPropertyService, mockAddressService, mockTokenGen)
 				requestBody, err := json.Marshal(dummyReq)
 				assert.NoError(t, err)
 
@@ -1131,6 +3306,7 @@ func TestPutUpdateProperty(t *testing.T) {
 	mockGroupService := &mgs.GroupService{}
 	mockPropertyService := &mps.PropertyService{}
 	mockAddressService := &mas.AddressService{}
+	mockTokenGen := &mig.TokenGenerator{}
 
 	t.Run("success scenario", func(t *testing.T) {
 		dummyReq := payload.UpdateProperty{
@@ -1143,20 +3319,22 @@ func TestPutUpdateProperty(t *testing.T) {
 			"Update",
 			mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
 			mock.AnythingOfType(fmt.Sprintf("%T", "")),
+			mock.AnythingOfType(fmt.Sprintf("%T", 0)),
 			mock.AnythingOfType(fmt.Sprintf("%T", payload.UpdateProperty{})),
 		).Return(
-			func(ctx context.Context, id string, p payload.UpdateProperty) error {
+			func(ctx context.Context, id string, version int, p payload.UpdateProperty) error {
 				return nil
 			},
 		).Once()
 
 		t.Run("it should return 204 status code with valid response, when there is no error", func(t *testing.T) {
-			controller := NewGroupsController(mockGroupService, mockPropertyService, mockAddressService)
+			controller := NewGroupsController(mockGroupService, mockPropertyService, mockAddressService, mockTokenGen)
 			requestBody, err := json.Marshal(dummyReq)
 			assert.NoError(t, err)
 
 			e := echo.New()
 			req := httptest.NewRequest(http.MethodPut, "/api/v1/groups", strings.NewReader(string(requestBody)))
+			req.Header.Set(headerIfMatch, `"2"`)
 			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 			rec := httptest.NewRecorder()
 			c := e.NewContext(req, rec)
@@ -1196,9 +3374,10 @@ func TestPutUpdateProperty(t *testing.T) {
 						"Update",
 						mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
 						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+						mock.AnythingOfType(fmt.Sprintf("%T", 0)),
 						mock.AnythingOfType(fmt.Sprintf("%T", payload.UpdateProperty{})),
 					).Return(
-						func(ctx context.Context, id string, p payload.UpdateProperty) error {
+						func(ctx context.Context, id string, version int, p payload.UpdateProperty) error {
 							return service.ErrInvalidPayload
 						},
 					).Once()
@@ -1214,14 +3393,34 @@ func TestPutUpdateProperty(t *testing.T) {
 						"Update",
 						mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
 						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+						mock.AnythingOfType(fmt.Sprintf("%T", 0)),
 						mock.AnythingOfType(fmt.Sprintf("%T", payload.UpdateProperty{})),
 					).Return(
-						func(ctx context.Context, id string, p payload.UpdateProperty) error {
+						func(ctx context.Context, id string, version int, p payload.UpdateProperty) error {
 							return service.ErrDataNotFound
 						},
 					).Once()
 				},
 			},
+			{
+				name:                 "it should return 412 status code, when the resource has been modified",
+				inputPayload:         dummyReq,
+				expectedStatusCode:   http.StatusPreconditionFailed,
+				expectedErrorMessage: "Resource has been modified since it was read. Please get it again and retry.",
+				mockBehaviour: func() {
+					mockPropertyService.On(
+						"Update",
+						mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+						mock.AnythingOfType(fmt.Sprintf("%T", 0)),
+						mock.AnythingOfType(fmt.Sprintf("%T", payload.UpdateProperty{})),
+					).Return(
+						func(ctx context.Context, id string, version int, p payload.UpdateProperty) error {
+							return service.ErrVersionMismatch
+						},
+					).Once()
+				},
+			},
 			{
 				name:                 "it should return 500 status code, when error happened",
 				inputPayload:         dummyReq,
@@ -1232,9 +3431,10 @@ func TestPutUpdateProperty(t *testing.T) {
 						"Update",
 						mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
 						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+						mock.AnythingOfType(fmt.Sprintf("%T", 0)),
 						mock.AnythingOfType(fmt.Sprintf("%T", payload.UpdateProperty{})),
 					).Return(
-						func(ctx context.Context, id string, p payload.UpdateProperty) error {
+						func(ctx context.Context, id string, version int, p payload.UpdateProperty) error {
 							return service.ErrRepository
 						},
 					).Once()
@@ -1246,12 +3446,13 @@ func TestPutUpdateProperty(t *testing.T) {
 			t.Run(testCase.name, func(t *testing.T) {
 				testCase.mockBehaviour()
 
-				controller := NewGroupsController(mockGroupService, mockPropertyService, mockAddressService)
+				controller := NewGroupsController(mockGroupService, mockPropertyService, mockAddressService, mockTokenGen)
 				requestBody, err := json.Marshal(dummyReq)
 				assert.NoError(t, err)
 
 				e := echo.New()
 				req := httptest.NewRequest(http.MethodPut, "/api/v1/groups", strings.NewReader(string(requestBody)))
+				req.Header.Set(headerIfMatch, `"2"`)
 				req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 				rec := httptest.NewRecorder()
 				c := e.NewContext(req, rec)
@@ -1270,6 +3471,148 @@ func TestPutUpdateProperty(t *testing.T) {
 				}
 			})
 		}
+
+		t.Run("it should return 428 status code, when If-Match header is missing", func(t *testing.T) {
+			controller := NewGroupsController(mockGroupService, mockPropertyService, mockAddressService, mockTokenGen)
+			requestBody, err := json.Marshal(dummyReq)
+			assert.NoError(t, err)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPut, "/api/v1/groups", strings.NewReader(string(requestBody)))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/:id/properties/:propertyID")
+			c.SetParamNames("id")
+			c.SetParamValues("g-xyz")
+			c.SetParamNames("propertyID")
+			c.SetParamValues("p-Ay8LmNI")
+
+			gotError := controller.putUpdateProperty(c)
+			if assert.Error(t, gotError) {
+				if echoHTTPError, ok := gotError.(*echo.HTTPError); assert.Equal(t, true, ok) {
+					assert.Equal(t, http.StatusPreconditionRequired, echoHTTPError.Code)
+					assert.Equal(t, "If-Match header is required. Please send the ETag of the resource the change is based on.", echoHTTPError.Message)
+				}
+			}
+		})
+	})
+}
+
+func TestPatchProperty(t *testing.T) {
+	mockGroupService := &mgs.GroupService{}
+	mockPropertyService := &mps.PropertyService{}
+	mockAddressService := &mas.AddressService{}
+	mockTokenGen := &mig.TokenGenerator{}
+
+	t.Run("success scenario", func(t *testing.T) {
+		var amount uint16 = 2
+
+		mockPropertyService.On(
+			"Patch",
+			mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+			"p-Ay8LmNI",
+			2,
+			payload.PatchProperty{Amount: &amount},
+		).Return(
+			func(ctx context.Context, id string, version int, p payload.PatchProperty) error {
+				return nil
+			},
+		).Once()
+
+		t.Run("it should return 204 status code, when there is no error", func(t *testing.T) {
+			controller := NewGroupsController(mockGroupService, mockPropertyService, mockAddressService, mockTokenGen)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPatch, "/api/v1/groups", strings.NewReader(`{"amount":2}`))
+			req.Header.Set(headerIfMatch, `"2"`)
+			req.Header.Set(echo.HeaderContentType, mimeApplicationMergePatchJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/:id/properties/:propertyID")
+			c.SetParamNames("id", "propertyID")
+			c.SetParamValues("g-xyz", "p-Ay8LmNI")
+
+			if assert.NoError(t, controller.patchProperty(c)) {
+				assert.Equal(t, http.StatusNoContent, rec.Code)
+			}
+		})
+	})
+
+	t.Run("failed scenario", func(t *testing.T) {
+		testCases := []struct {
+			name                 string
+			inputBody            string
+			expectedStatusCode   int
+			expectedErrorMessage string
+			mockBehaviour        func()
+		}{
+			{
+				name:                 "it should return 400 status code, when the amount is 0",
+				inputBody:            `{"amount":0}`,
+				expectedStatusCode:   http.StatusBadRequest,
+				expectedErrorMessage: "Invalid payload. Please check the payload schema in the API Documentation.",
+				mockBehaviour: func() {
+					var zeroAmount uint16
+					mockPropertyService.On(
+						"Patch",
+						mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+						mock.AnythingOfType(fmt.Sprintf("%T", 0)),
+						payload.PatchProperty{Amount: &zeroAmount},
+					).Return(
+						func(ctx context.Context, id string, version int, p payload.PatchProperty) error {
+							return service.ErrInvalidPayload
+						},
+					).Once()
+				},
+			},
+			{
+				name:                 "it should return 412 status code, when the property has been modified",
+				inputBody:            `{"name":"Dadak Merak"}`,
+				expectedStatusCode:   http.StatusPreconditionFailed,
+				expectedErrorMessage: "Resource has been modified since it was read. Please get it again and retry.",
+				mockBehaviour: func() {
+					mockPropertyService.On(
+						"Patch",
+						mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+						mock.AnythingOfType(fmt.Sprintf("%T", 0)),
+						mock.AnythingOfType(fmt.Sprintf("%T", payload.PatchProperty{})),
+					).Return(
+						func(ctx context.Context, id string, version int, p payload.PatchProperty) error {
+							return service.ErrVersionMismatch
+						},
+					).Once()
+				},
+			},
+		}
+
+		for _, testCase := range testCases {
+			t.Run(testCase.name, func(t *testing.T) {
+				testCase.mockBehaviour()
+
+				controller := NewGroupsController(mockGroupService, mockPropertyService, mockAddressService, mockTokenGen)
+
+				e := echo.New()
+				req := httptest.NewRequest(http.MethodPatch, "/api/v1/groups", strings.NewReader(testCase.inputBody))
+				req.Header.Set(headerIfMatch, `"2"`)
+				req.Header.Set(echo.HeaderContentType, mimeApplicationMergePatchJSON)
+				rec := httptest.NewRecorder()
+				c := e.NewContext(req, rec)
+				c.SetPath("/:id/properties/:propertyID")
+				c.SetParamNames("id", "propertyID")
+				c.SetParamValues("g-xyz", "p-Ay8LmNI")
+
+				gotError := controller.patchProperty(c)
+				if assert.Error(t, gotError) {
+					if echoHTTPError, ok := gotError.(*echo.HTTPError); assert.Equal(t, true, ok) {
+						assert.Equal(t, testCase.expectedStatusCode, echoHTTPError.Code)
+						assert.Equal(t, testCase.expectedErrorMessage, echoHTTPError.Message)
+					}
+				}
+			})
+		}
 	})
 }
 
@@ -1277,23 +3620,26 @@ func TestDeleteProperty(t *testing.T) {
 	mockGroupService := &mgs.GroupService{}
 	mockPropertyService := &mps.PropertyService{}
 	mockAddressService := &mas.AddressService{}
+	mockTokenGen := &mig.TokenGenerator{}
 
 	t.Run("success scenario", func(t *testing.T) {
 		mockPropertyService.On(
 			"Delete",
 			mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
 			mock.AnythingOfType(fmt.Sprintf("%T", "")),
+			mock.AnythingOfType(fmt.Sprintf("%T", 0)),
 		).Return(
-			func(ctx context.Context, id string) error {
+			func(ctx context.Context, id string, version int) error {
 				return nil
 			},
 		).Once()
 
 		t.Run("it should return 204 status code with valid response, when there is no error", func(t *testing.T) {
-			controller := NewGroupsController(mockGroupService, mockPropertyService, mockAddressService)
+			controller := NewGroupsController(mockGroupService, mockPropertyService, mockAddressService, mockTokenGen)
 
 			e := echo.New()
 			req := httptest.NewRequest(http.MethodDelete, "/api/v1/groups", nil)
+			req.Header.Set(headerIfMatch, `"2"`)
 			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 			rec := httptest.NewRecorder()
 			c := e.NewContext(req, rec)
@@ -1325,13 +3671,48 @@ func TestDeleteProperty(t *testing.T) {
 						"Delete",
 						mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
 						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+						mock.AnythingOfType(fmt.Sprintf("%T", 0)),
 					).Return(
-						func(ctx context.Context, id string) error {
+						func(ctx context.Context, id string, version int) error {
 							return service.ErrDataNotFound
 						},
 					).Once()
 				},
 			},
+			{
+				name:                 "it should return 412 status code, when the resource has been modified",
+				expectedStatusCode:   http.StatusPreconditionFailed,
+				expectedErrorMessage: "Resource has been modified since it was read. Please get it again and retry.",
+				mockBehaviour: func() {
+					mockPropertyService.On(
+						"Delete",
+						mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+						mock.AnythingOfType(fmt.Sprintf("%T", 0)),
+					).Return(
+						func(ctx context.Context, id string, version int) error {
+							return service.ErrVersionMismatch
+						},
+					).Once()
+				},
+			},
+			{
+				name:                 "it should return 409 status code, when some of the items are out on loan",
+				expectedStatusCode:   http.StatusConflict,
+				expectedErrorMessage: "Property still has items out on loan. Please return them first.",
+				mockBehaviour: func() {
+					mockPropertyService.On(
+						"Delete",
+						mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+						mock.AnythingOfType(fmt.Sprintf("%T", 0)),
+					).Return(
+						func(ctx context.Context, id string, version int) error {
+							return service.ErrPropertyOnLoan
+						},
+					).Once()
+				},
+			},
 			{
 				name:                 "it should return 500 status code, when error happened",
 				expectedStatusCode:   http.StatusInternalServerError,
@@ -1341,8 +3722,9 @@ func TestDeleteProperty(t *testing.T) {
 						"Delete",
 						mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
 						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+						mock.AnythingOfType(fmt.Sprintf("%T", 0)),
 					).Return(
-						func(ctx context.Context, id string) error {
+						func(ctx context.Context, id string, version int) error {
 							return service.ErrRepository
 						},
 					).Once()
@@ -1354,10 +3736,11 @@ func TestDeleteProperty(t *testing.T) {
 			t.Run(testCase.name, func(t *testing.T) {
 				testCase.mockBehaviour()
 
-				controller := NewGroupsController(mockGroupService, mockPropertyService, mockAddressService)
+				controller := NewGroupsController(mockGroupService, mockPropertyService, mockAddressService, mockTokenGen)
 
 				e := echo.New()
 				req := httptest.NewRequest(http.MethodDelete, "/api/v1/groups", nil)
+				req.Header.Set(headerIfMatch, `"2"`)
 				req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 				rec := httptest.NewRecorder()
 				c := e.NewContext(req, rec)
@@ -1376,6 +3759,29 @@ func TestDeleteProperty(t *testing.T) {
 				}
 			})
 		}
+
+		t.Run("it should return 428 status code, when If-Match header is missing", func(t *testing.T) {
+			controller := NewGroupsController(mockGroupService, mockPropertyService, mockAddressService, mockTokenGen)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodDelete, "/api/v1/groups", nil)
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/:id/properties/:propertyID")
+			c.SetParamNames("id")
+			c.SetParamValues("g-xyz")
+			c.SetParamNames("propertyID")
+			c.SetParamValues("p-Ay8LmNI")
+
+			gotError := controller.deleteProperty(c)
+			if assert.Error(t, gotError) {
+				if echoHTTPError, ok := gotError.(*echo.HTTPError); assert.Equal(t, true, ok) {
+					assert.Equal(t, http.StatusPreconditionRequired, echoHTTPError.Code)
+					assert.Equal(t, "If-Match header is required. Please send the ETag of the resource the change is based on.", echoHTTPError.Message)
+				}
+			}
+		})
 	})
 }
 
@@ -1383,6 +3789,7 @@ func TestGetGeneratePropertyQRCode(t *testing.T) {
 	mockGroupService := &mgs.GroupService{}
 	mockPropertyService := &mps.PropertyService{}
 	mockAddressService := &mas.AddressService{}
+	mockTokenGen := &mig.TokenGenerator{}
 
 	t.Run("success scenario", func(t *testing.T) {
 		mockPropertyService.On(
@@ -1399,7 +3806,7 @@ func TestGetGeneratePropertyQRCode(t *testing.T) {
 		).Once()
 
 		t.Run("it should return 200 status code with valid response, when there is no error", func(t *testing.T) {
-			controller := NewGroupsController(mockGroupService, mockPropertyService, mockAddressService)
+			controller := NewGroupsController(mockGroupService, mockPropertyService, mockAddressService, mockTokenGen)
 
 			e := echo.New()
 			req := httptest.NewRequest(http.MethodGet, "/api/v1/groups", nil)
@@ -1469,7 +3876,7 @@ func TestGetGeneratePropertyQRCode(t *testing.T) {
 			t.Run(testCase.name, func(t *testing.T) {
 				testCase.mockBehaviour()
 
-				controller := NewGroupsController(mockGroupService, mockPropertyService, mockAddressService)
+				controller := NewGroupsController(mockGroupService, mockPropertyService, mockAddressService, mockTokenGen)
 
 				e := echo.New()
 				req := httptest.NewRequest(http.MethodGet, "/api/v1/groups", nil)
@@ -0,0 +1,143 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/erikrios/reog-apps-apis/middleware"
+	"github.com/erikrios/reog-apps-apis/model"
+	"github.com/erikrios/reog-apps-apis/model/payload"
+	"github.com/erikrios/reog-apps-apis/model/response"
+	"github.com/erikrios/reog-apps-apis/service"
+	"github.com/erikrios/reog-apps-apis/service/loan"
+	"github.com/labstack/echo/v4"
+)
+
+type loansController struct {
+	service loan.LoanService
+}
+
+func NewLoansController(service loan.LoanService) *loansController {
+	return &loansController{service: service}
+}
+
+func (l *loansController) Route(e *echo.Group) {
+	group := e.Group("/groups/:id/properties/:propertyID/loans", middleware.JWTMiddleware())
+	group.POST("", l.postCreateLoan)
+	group.GET("", l.getLoans)
+	group.POST("/:loanID/return", l.postReturnLoan)
+}
+
+// postCreateLoan godoc
+// @Summary      Lend a Property
+// @Description  Check items of a property out to another group, or to a borrower such as a school. The quantity must not exceed the available quantity.
+// @Tags         loans
+// @Accept       json
+// @Produce      json
+// @Param        default     body  payload.CreateLoan  true  "request body"
+// @Param        id          path  string              true  "group ID"
+// @Param        propertyID  path  string              true  "property ID"
+// @Security     ApiKeyAuth
+// @Success      201  {object}  createLoanResponse
+// @Failure      400  {object}  echo.HTTPError
+// @Failure      401  {object}  echo.HTTPError
+// @Failure      404  {object}  echo.HTTPError
+// @Failure      409  {object}  echo.HTTPError
+// @Failure      500  {object}  echo.HTTPError
+// @Router       /groups/{id}/properties/{propertyID}/loans [post]
+func (l *loansController) postCreateLoan(c echo.Context) error {
+	groupID := c.Param("id")
+	propertyID := c.Param("propertyID")
+
+	payload := new(payload.CreateLoan)
+	if err := c.Bind(payload); err != nil {
+		return newErrorResponse(service.ErrInvalidPayload)
+	}
+
+	id, err := l.service.Create(c.Request().Context(), groupID, propertyID, *payload)
+	if err != nil {
+		return newErrorResponse(err)
+	}
+
+	idResponse := map[string]any{"id": id}
+	response := model.NewResponse("success", "loan successfully created", idResponse)
+	return c.JSON(http.StatusCreated, response)
+}
+
+// getLoans godoc
+// @Summary      Get Loans
+// @Description  Get the loans of a property, the ones still out first, along with the quantity available to lend
+// @Tags         loans
+// @Produce      json
+// @Param        id          path  string  true  "group ID"
+// @Param        propertyID  path  string  true  "property ID"
+// @Security     ApiKeyAuth
+// @Success      200  {object}  propertyLoansResponse
+// @Failure      401  {object}  echo.HTTPError
+// @Failure      404  {object}  echo.HTTPError
+// @Failure      500  {object}  echo.HTTPError
+// @Router       /groups/{id}/properties/{propertyID}/loans [get]
+func (l *loansController) getLoans(c echo.Context) error {
+	groupID := c.Param("id")
+	propertyID := c.Param("propertyID")
+
+	loans, err := l.service.GetByPropertyID(c.Request().Context(), groupID, propertyID)
+	if err != nil {
+		return newErrorResponse(err)
+	}
+
+	loansResponse := map[string]any{"loans": loans}
+	response := model.NewResponse("success", "successfully get loans of property with id "+propertyID, loansResponse)
+	return c.JSON(http.StatusOK, response)
+}
+
+// postReturnLoan godoc
+// @Summary      Return a Loan
+// @Description  Check the items of a loan back in
+// @Tags         loans
+// @Accept       json
+// @Produce      json
+// @Param        default     body  payload.ReturnLoan  false  "request body"
+// @Param        id          path  string              true   "group ID"
+// @Param        propertyID  path  string              true   "property ID"
+// @Param        loanID      path  string              true   "loan ID"
+// @Security     ApiKeyAuth
+// @Success      204
+// @Failure      400  {object}  echo.HTTPError
+// @Failure      401  {object}  echo.HTTPError
+// @Failure      404  {object}  echo.HTTPError
+// @Failure      409  {object}  echo.HTTPError
+// @Failure      500  {object}  echo.HTTPError
+// @Router       /groups/{id}/properties/{propertyID}/loans/{loanID}/return [post]
+func (l *loansController) postReturnLoan(c echo.Context) error {
+	groupID := c.Param("id")
+	propertyID := c.Param("propertyID")
+	id := c.Param("loanID")
+
+	payload := new(payload.ReturnLoan)
+	if err := c.Bind(payload); err != nil {
+		return newErrorResponse(service.ErrInvalidPayload)
+	}
+
+	if err := l.service.Return(c.Request().Context(), groupID, propertyID, id, *payload); err != nil {
+		return newErrorResponse(err)
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+// createLoanResponse struct is used for swaggo to generate the API documentation, as it doesn't support generic yet.
+type createLoanResponse struct {
+	Status  string `json:"status" extensions:"x-order=0"`
+	Message string `json:"message" extensions:"x-order=1"`
+	Data    idData `json:"data" extensions:"x-order=2"`
+}
+
+// propertyLoansResponse struct is used for swaggo to generate the API documentation, as it doesn't support generic yet.
+type propertyLoansResponse struct {
+	Status  string            `json:"status" extensions:"x-order=0"`
+	Message string            `json:"message" extensions:"x-order=1"`
+	Data    propertyLoansData `json:"data" extensions:"x-order=2"`
+}
+
+type propertyLoansData struct {
+	Loans response.PropertyLoans `json:"loans"`
+}
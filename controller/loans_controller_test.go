@@ -0,0 +1,286 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/erikrios/reog-apps-apis/model"
+	"github.com/erikrios/reog-apps-apis/model/payload"
+	"github.com/erikrios/reog-apps-apis/model/response"
+	"github.com/erikrios/reog-apps-apis/service"
+	"github.com/erikrios/reog-apps-apis/service/loan/mocks"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestRouteLoans(t *testing.T) {
+	mockLoanService := &mocks.LoanService{}
+	controller := NewLoansController(mockLoanService)
+	g := echo.New().Group("/api/v1")
+	controller.Route(g)
+	assert.NotNil(t, controller)
+}
+
+func TestPostCreateLoan(t *testing.T) {
+	mockLoanService := &mocks.LoanService{}
+
+	dummyReq := payload.CreateLoan{
+		BorrowerGroupID: "g-abc",
+		Quantity:        2,
+		DueOn:           "2022-12-31",
+	}
+
+	testCases := []struct {
+		name                 string
+		inputError           error
+		expectedStatusCode   int
+		expectedErrorMessage string
+	}{
+		{
+			name:               "it should return 201 status code, when there is no error",
+			inputError:         nil,
+			expectedStatusCode: http.StatusCreated,
+		},
+		{
+			name:                 "it should return 400 status code, when payload is invalid",
+			inputError:           service.ErrInvalidPayload,
+			expectedStatusCode:   http.StatusBadRequest,
+			expectedErrorMessage: "Invalid payload. Please check the payload schema in the API Documentation.",
+		},
+		{
+			name:                 "it should return 404 status code, when property ID not found",
+			inputError:           service.ErrDataNotFound,
+			expectedStatusCode:   http.StatusNotFound,
+			expectedErrorMessage: "Resource with given ID not found.",
+		},
+		{
+			name:                 "it should return 409 status code, when not enough items are available",
+			inputError:           service.ErrNotEnoughAvailable,
+			expectedStatusCode:   http.StatusConflict,
+			expectedErrorMessage: "Not enough items available. Please lend at most the available quantity, and keep at least the quantity on loan.",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockLoanService.On(
+				"Create",
+				mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+				"g-xyz",
+				"p-xyz",
+				dummyReq,
+			).Return(
+				func(ctx context.Context, groupID string, propertyID string, p payload.CreateLoan) string {
+					return "o-aBcdEfG"
+				},
+				func(ctx context.Context, groupID string, propertyID string, p payload.CreateLoan) error {
+					return testCase.inputError
+				},
+			).Once()
+
+			controller := NewLoansController(mockLoanService)
+			requestBody, err := json.Marshal(dummyReq)
+			assert.NoError(t, err)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(string(requestBody)))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/api/v1/groups/:id/properties/:propertyID/loans")
+			c.SetParamNames("id", "propertyID")
+			c.SetParamValues("g-xyz", "p-xyz")
+
+			gotError := controller.postCreateLoan(c)
+			if testCase.inputError == nil {
+				if assert.NoError(t, gotError) {
+					assert.Equal(t, testCase.expectedStatusCode, rec.Code)
+
+					gotResponse := make(map[string]any)
+					if err := json.Unmarshal(rec.Body.Bytes(), &gotResponse); assert.NoError(t, err) {
+						assert.Equal(t, "o-aBcdEfG", gotResponse["data"].(map[string]any)["id"])
+					}
+				}
+				return
+			}
+
+			if assert.Error(t, gotError) {
+				if echoHTTPError, ok := gotError.(*echo.HTTPError); assert.Equal(t, true, ok) {
+					assert.Equal(t, testCase.expectedStatusCode, echoHTTPError.Code)
+					assert.Equal(t, testCase.expectedErrorMessage, echoHTTPError.Message)
+				}
+			}
+		})
+	}
+}
+
+func TestGetLoans(t *testing.T) {
+	mockLoanService := &mocks.LoanService{}
+
+	dummyLoans := response.PropertyLoans{
+		PropertyID: "p-xyz",
+		Amount:     10,
+		OnLoan:     2,
+		Available:  8,
+		Loans: []response.Loan{
+			{
+				ID:              "o-aBcdEfG",
+				PropertyID:      "p-xyz",
+				BorrowerGroupID: "g-abc",
+				BorrowerName:    "Paguyuban Reog Sardulo Nareswara",
+				Quantity:        2,
+				LoanedOn:        "2022-12-01",
+				DueOn:           "2022-12-31",
+			},
+		},
+	}
+
+	testCases := []struct {
+		name                 string
+		inputError           error
+		expectedStatusCode   int
+		expectedErrorMessage string
+	}{
+		{
+			name:               "it should return 200 status code with valid response, when there is no error",
+			inputError:         nil,
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name:                 "it should return 404 status code, when property ID not found",
+			inputError:           service.ErrDataNotFound,
+			expectedStatusCode:   http.StatusNotFound,
+			expectedErrorMessage: "Resource with given ID not found.",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockLoanService.On(
+				"GetByPropertyID",
+				mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+				"g-xyz",
+				"p-xyz",
+			).Return(
+				func(ctx context.Context, groupID string, propertyID string) response.PropertyLoans {
+					return dummyLoans
+				},
+				func(ctx context.Context, groupID string, propertyID string) error {
+					return testCase.inputError
+				},
+			).Once()
+
+			controller := NewLoansController(mockLoanService)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/api/v1/groups/:id/properties/:propertyID/loans")
+			c.SetParamNames("id", "propertyID")
+			c.SetParamValues("g-xyz", "p-xyz")
+
+			gotError := controller.getLoans(c)
+			if testCase.inputError == nil {
+				if assert.NoError(t, gotError) {
+					assert.Equal(t, testCase.expectedStatusCode, rec.Code)
+
+					gotResponse := &model.Response[propertyLoansData]{}
+					if err := json.Unmarshal(rec.Body.Bytes(), gotResponse); assert.NoError(t, err) {
+						assert.Equal(t, dummyLoans, gotResponse.Data.Loans)
+					}
+				}
+				return
+			}
+
+			if assert.Error(t, gotError) {
+				if echoHTTPError, ok := gotError.(*echo.HTTPError); assert.Equal(t, true, ok) {
+					assert.Equal(t, testCase.expectedStatusCode, echoHTTPError.Code)
+					assert.Equal(t, testCase.expectedErrorMessage, echoHTTPError.Message)
+				}
+			}
+		})
+	}
+}
+
+func TestPostReturnLoan(t *testing.T) {
+	mockLoanService := &mocks.LoanService{}
+
+	dummyReq := payload.ReturnLoan{ReturnedOn: "2022-12-20"}
+
+	testCases := []struct {
+		name                 string
+		inputError           error
+		expectedStatusCode   int
+		expectedErrorMessage string
+	}{
+		{
+			name:               "it should return 204 status code, when there is no error",
+			inputError:         nil,
+			expectedStatusCode: http.StatusNoContent,
+		},
+		{
+			name:                 "it should return 404 status code, when loan ID not found",
+			inputError:           service.ErrDataNotFound,
+			expectedStatusCode:   http.StatusNotFound,
+			expectedErrorMessage: "Resource with given ID not found.",
+		},
+		{
+			name:                 "it should return 409 status code, when the loan has already been returned",
+			inputError:           service.ErrLoanReturned,
+			expectedStatusCode:   http.StatusConflict,
+			expectedErrorMessage: "Loan has already been returned.",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockLoanService.On(
+				"Return",
+				mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+				"g-xyz",
+				"p-xyz",
+				"o-aBcdEfG",
+				dummyReq,
+			).Return(
+				func(ctx context.Context, groupID string, propertyID string, id string, p payload.ReturnLoan) error {
+					return testCase.inputError
+				},
+			).Once()
+
+			controller := NewLoansController(mockLoanService)
+			requestBody, err := json.Marshal(dummyReq)
+			assert.NoError(t, err)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(string(requestBody)))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/api/v1/groups/:id/properties/:propertyID/loans/:loanID/return")
+			c.SetParamNames("id", "propertyID", "loanID")
+			c.SetParamValues("g-xyz", "p-xyz", "o-aBcdEfG")
+
+			gotError := controller.postReturnLoan(c)
+			if testCase.inputError == nil {
+				if assert.NoError(t, gotError) {
+					assert.Equal(t, testCase.expectedStatusCode, rec.Code)
+				}
+				return
+			}
+
+			if assert.Error(t, gotError) {
+				if echoHTTPError, ok := gotError.(*echo.HTTPError); assert.Equal(t, true, ok) {
+					assert.Equal(t, testCase.expectedStatusCode, echoHTTPError.Code)
+					assert.Equal(t, testCase.expectedErrorMessage, echoHTTPError.Message)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,250 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/erikrios/reog-apps-apis/middleware"
+	"github.com/erikrios/reog-apps-apis/model"
+	"github.com/erikrios/reog-apps-apis/model/payload"
+	"github.com/erikrios/reog-apps-apis/model/response"
+	"github.com/erikrios/reog-apps-apis/service"
+	"github.com/erikrios/reog-apps-apis/service/maintenance"
+	"github.com/labstack/echo/v4"
+)
+
+type maintenanceController struct {
+	service maintenance.MaintenanceService
+}
+
+func NewMaintenanceController(service maintenance.MaintenanceService) *maintenanceController {
+	return &maintenanceController{service: service}
+}
+
+func (m *maintenanceController) Route(e *echo.Group) {
+	group := e.Group("/groups/:id/properties/:propertyID/maintenance", middleware.JWTMiddleware())
+	group.POST("", m.postCreateMaintenance)
+	group.GET("", m.getMaintenances)
+	group.GET("/:maintenanceID", m.getMaintenanceByID)
+	group.PUT("/:maintenanceID", m.putUpdateMaintenance)
+	group.DELETE("/:maintenanceID", m.deleteMaintenance)
+
+	report := e.Group("/maintenance/poor-condition", middleware.JWTMiddleware())
+	report.GET("", m.getPoorConditionReport)
+}
+
+// postCreateMaintenance godoc
+// @Summary      Add a Maintenance Record
+// @Description  Record the condition of a property found on an inspection, with the damage and its repair if any. Photos are uploaded separately.
+// @Tags         maintenance
+// @Accept       json
+// @Produce      json
+// @Param        default     body  payload.CreateMaintenance  true  "request body"
+// @Param        id          path  string                     true  "group ID"
+// @Param        propertyID  path  string                     true  "property ID"
+// @Security     ApiKeyAuth
+// @Success      201  {object}  createMaintenanceResponse
+// @Failure      400  {object}  echo.HTTPError
+// @Failure      401  {object}  echo.HTTPError
+// @Failure      404  {object}  echo.HTTPError
+// @Failure      500  {object}  echo.HTTPError
+// @Router       /groups/{id}/properties/{propertyID}/maintenance [post]
+func (m *maintenanceController) postCreateMaintenance(c echo.Context) error {
+	groupID := c.Param("id")
+	propertyID := c.Param("propertyID")
+
+	payload := new(payload.CreateMaintenance)
+	if err := c.Bind(payload); err != nil {
+		return newErrorResponse(service.ErrInvalidPayload)
+	}
+
+	id, err := m.service.Create(c.Request().Context(), groupID, propertyID, *payload)
+	if err != nil {
+		return newErrorResponse(err)
+	}
+
+	idResponse := map[string]any{"id": id}
+	response := model.NewResponse("success", "maintenance record successfully created", idResponse)
+	return c.JSON(http.StatusCreated, response)
+}
+
+// getMaintenances godoc
+// @Summary      Get Maintenance Records
+// @Description  Get the maintenance records of a property, from the latest inspection
+// @Tags         maintenance
+// @Produce      json
+// @Param        id          path  string  true  "group ID"
+// @Param        propertyID  path  string  true  "property ID"
+// @Security     ApiKeyAuth
+// @Success      200  {object}  maintenancesResponse
+// @Failure      401  {object}  echo.HTTPError
+// @Failure      404  {object}  echo.HTTPError
+// @Failure      500  {object}  echo.HTTPError
+// @Router       /groups/{id}/properties/{propertyID}/maintenance [get]
+func (m *maintenanceController) getMaintenances(c echo.Context) error {
+	groupID := c.Param("id")
+	propertyID := c.Param("propertyID")
+
+	maintenances, err := m.service.GetByPropertyID(c.Request().Context(), groupID, propertyID)
+	if err != nil {
+		return newErrorResponse(err)
+	}
+
+	maintenancesResponse := map[string]any{"maintenances": maintenances}
+	response := model.NewResponse("success", "successfully get maintenance records", maintenancesResponse)
+	return c.JSON(http.StatusOK, response)
+}
+
+// getMaintenanceByID godoc
+// @Summary      Get Maintenance Record by ID
+// @Description  Get maintenance record by ID
+// @Tags         maintenance
+// @Produce      json
+// @Param        id             path  string  true  "group ID"
+// @Param        propertyID     path  string  true  "property ID"
+// @Param        maintenanceID  path  string  true  "maintenance ID"
+// @Security     ApiKeyAuth
+// @Success      200  {object}  maintenanceResponse
+// @Failure      401  {object}  echo.HTTPError
+// @Failure      404  {object}  echo.HTTPError
+// @Failure      500  {object}  echo.HTTPError
+// @Router       /groups/{id}/properties/{propertyID}/maintenance/{maintenanceID} [get]
+func (m *maintenanceController) getMaintenanceByID(c echo.Context) error {
+	groupID := c.Param("id")
+	propertyID := c.Param("propertyID")
+	id := c.Param("maintenanceID")
+
+	maintenance, err := m.service.GetByID(c.Request().Context(), groupID, propertyID, id)
+	if err != nil {
+		return newErrorResponse(err)
+	}
+
+	maintenanceResponse := map[string]any{"maintenance": maintenance}
+	response := model.NewResponse("success", "successfully get maintenance record with id "+id, maintenanceResponse)
+	return c.JSON(http.StatusOK, response)
+}
+
+// putUpdateMaintenance godoc
+// @Summary      Update a Maintenance Record
+// @Description  Update a maintenance record, for example to set the repair date once the damage is repaired
+// @Tags         maintenance
+// @Accept       json
+// @Produce      json
+// @Param        default        body  payload.UpdateMaintenance  true  "request body"
+// @Param        id             path  string                     true  "group ID"
+// @Param        propertyID     path  string                     true  "property ID"
+// @Param        maintenanceID  path  string                     true  "maintenance ID"
+// @Security     ApiKeyAuth
+// @Success      204
+// @Failure      400  {object}  echo.HTTPError
+// @Failure      401  {object}  echo.HTTPError
+// @Failure      404  {object}  echo.HTTPError
+// @Failure      500  {object}  echo.HTTPError
+// @Router       /groups/{id}/properties/{propertyID}/maintenance/{maintenanceID} [put]
+func (m *maintenanceController) putUpdateMaintenance(c echo.Context) error {
+	groupID := c.Param("id")
+	propertyID := c.Param("propertyID")
+	id := c.Param("maintenanceID")
+
+	payload := new(payload.UpdateMaintenance)
+	if err := c.Bind(payload); err != nil {
+		return newErrorResponse(service.ErrInvalidPayload)
+	}
+
+	if err := m.service.Update(c.Request().Context(), groupID, propertyID, id, *payload); err != nil {
+		return newErrorResponse(err)
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+// deleteMaintenance godoc
+// @Summary      Delete a Maintenance Record
+// @Description  Delete a maintenance record
+// @Tags         maintenance
+// @Produce      json
+// @Param        id             path  string  true  "group ID"
+// @Param        propertyID     path  string  true  "property ID"
+// @Param        maintenanceID  path  string  true  "maintenance ID"
+// @Security     ApiKeyAuth
+// @Success      204
+// @Failure      401  {object}  echo.HTTPError
+// @Failure      404  {object}  echo.HTTPError
+// @Failure      500  {object}  echo.HTTPError
+// @Router       /groups/{id}/properties/{propertyID}/maintenance/{maintenanceID} [delete]
+func (m *maintenanceController) deleteMaintenance(c echo.Context) error {
+	groupID := c.Param("id")
+	propertyID := c.Param("propertyID")
+	id := c.Param("maintenanceID")
+
+	if err := m.service.Delete(c.Request().Context(), groupID, propertyID, id); err != nil {
+		return newErrorResponse(err)
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+// getPoorConditionReport godoc
+// @Summary      Get Poor Condition Report
+// @Description  Get the properties across the regency whose latest inspection found them in poor condition or broken and which are not repaired yet, ordered by district and group
+// @Tags         maintenance
+// @Produce      json
+// @Param        districtID  query  string  false  "report only the groups of the district"
+// @Security     ApiKeyAuth
+// @Success      200  {object}  poorConditionReportResponse
+// @Failure      400  {object}  echo.HTTPError
+// @Failure      401  {object}  echo.HTTPError
+// @Failure      500  {object}  echo.HTTPError
+// @Router       /maintenance/poor-condition [get]
+func (m *maintenanceController) getPoorConditionReport(c echo.Context) error {
+	payload := new(payload.GetPoorConditionReport)
+	if err := c.Bind(payload); err != nil {
+		return newErrorResponse(service.ErrInvalidPayload)
+	}
+
+	items, err := m.service.GetPoorConditionReport(c.Request().Context(), *payload)
+	if err != nil {
+		return newErrorResponse(err)
+	}
+
+	itemsResponse := map[string]any{"items": items}
+	response := model.NewResponse("success", "successfully get poor condition report", itemsResponse)
+	return c.JSON(http.StatusOK, response)
+}
+
+// createMaintenanceResponse struct is used for swaggo to generate the API documentation, as it doesn't support generic yet.
+type createMaintenanceResponse struct {
+	Status  string `json:"status" extensions:"x-order=0"`
+	Message string `json:"message" extensions:"x-order=1"`
+	Data    idData `json:"data" extensions:"x-order=2"`
+}
+
+// maintenancesResponse struct is used for swaggo to generate the API documentation, as it doesn't support generic yet.
+type maintenancesResponse struct {
+	Status  string           `json:"status" extensions:"x-order=0"`
+	Message string           `json:"message" extensions:"x-order=1"`
+	Data    maintenancesData `json:"data" extensions:"x-order=2"`
+}
+
+type maintenancesData struct {
+	Maintenances []response.Maintenance `json:"maintenances"`
+}
+
+// maintenanceResponse struct is used for swaggo to generate the API documentation, as it doesn't support generic yet.
+type maintenanceResponse struct {
+	Status  string          `json:"status" extensions:"x-order=0"`
+	Message string          `json:"message" extensions:"x-order=1"`
+	Data    maintenanceData `json:"data" extensions:"x-order=2"`
+}
+
+type maintenanceData struct {
+	Maintenance response.Maintenance `json:"maintenance"`
+}
+
+// poorConditionReportResponse struct is used for swaggo to generate the API documentation, as it doesn't support generic yet.
+type poorConditionReportResponse struct {
+	Status  string                  `json:"status" extensions:"x-order=0"`
+	Message string                  `json:"message" extensions:"x-order=1"`
+	Data    poorConditionReportData `json:"data" extensions:"x-order=2"`
+}
+
+type poorConditionReportData struct {
+	Items []response.PoorConditionItem `json:"items"`
+}
@@ -0,0 +1,506 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/erikrios/reog-apps-apis/model"
+	"github.com/erikrios/reog-apps-apis/model/payload"
+	"github.com/erikrios/reog-apps-apis/model/response"
+	"github.com/erikrios/reog-apps-apis/service"
+	"github.com/erikrios/reog-apps-apis/service/maintenance/mocks"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestRouteMaintenance(t *testing.T) {
+	mockMaintenanceService := &mocks.MaintenanceService{}
+	controller := NewMaintenanceController(mockMaintenanceService)
+	g := echo.New().Group("/api/v1")
+	controller.Route(g)
+	assert.NotNil(t, controller)
+}
+
+func TestPostCreateMaintenance(t *testing.T) {
+	mockMaintenanceService := &mocks.MaintenanceService{}
+
+	dummyReq := payload.CreateMaintenance{
+		Condition:         "poor",
+		InspectedOn:       "2022-06-01",
+		DamageDescription: "Bulu merak barongan rontok di bagian kiri",
+	}
+
+	testCases := []struct {
+		name                 string
+		inputError           error
+		expectedStatusCode   int
+		expectedErrorMessage string
+	}{
+		{
+			name:               "it should return 201 status code, when there is no error",
+			inputError:         nil,
+			expectedStatusCode: http.StatusCreated,
+		},
+		{
+			name:                 "it should return 400 status code, when payload is invalid",
+			inputError:           service.ErrInvalidPayload,
+			expectedStatusCode:   http.StatusBadRequest,
+			expectedErrorMessage: "Invalid payload. Please check the payload schema in the API Documentation.",
+		},
+		{
+			name:                 "it should return 404 status code, when property ID not found",
+			inputError:           service.ErrDataNotFound,
+			expectedStatusCode:   http.StatusNotFound,
+			expectedErrorMessage: "Resource with given ID not found.",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockMaintenanceService.On(
+				"Create",
+				mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+				"g-xyz",
+				"p-xyz",
+				dummyReq,
+			).Return(
+				func(ctx context.Context, groupID string, propertyID string, p payload.CreateMaintenance) string {
+					return "i-aBcdEfG"
+				},
+				func(ctx context.Context, groupID string, propertyID string, p payload.CreateMaintenance) error {
+					return testCase.inputError
+				},
+			).Once()
+
+			controller := NewMaintenanceController(mockMaintenanceService)
+			requestBody, err := json.Marshal(dummyReq)
+			assert.NoError(t, err)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(string(requestBody)))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/api/v1/groups/:id/properties/:propertyID/maintenance")
+			c.SetParamNames("id", "propertyID")
+			c.SetParamValues("g-xyz", "p-xyz")
+
+			gotError := controller.postCreateMaintenance(c)
+			if testCase.inputError == nil {
+				if assert.NoError(t, gotError) {
+					assert.Equal(t, testCase.expectedStatusCode, rec.Code)
+
+					gotResponse := make(map[string]any)
+					if err := json.Unmarshal(rec.Body.Bytes(), &gotResponse); assert.NoError(t, err) {
+						assert.Equal(t, "i-aBcdEfG", gotResponse["data"].(map[string]any)["id"])
+					}
+				}
+				return
+			}
+
+			if assert.Error(t, gotError) {
+				if echoHTTPError, ok := gotError.(*echo.HTTPError); assert.Equal(t, true, ok) {
+					assert.Equal(t, testCase.expectedStatusCode, echoHTTPError.Code)
+					assert.Equal(t, testCase.expectedErrorMessage, echoHTTPError.Message)
+				}
+			}
+		})
+	}
+}
+
+func TestGetMaintenances(t *testing.T) {
+	mockMaintenanceService := &mocks.MaintenanceService{}
+
+	dummyMaintenances := []response.Maintenance{
+		{
+			ID:                "i-aBcdEfG",
+			PropertyID:        "p-xyz",
+			Condition:         "poor",
+			InspectedOn:       "2022-06-01",
+			DamageDescription: "Bulu merak barongan rontok di bagian kiri",
+			Photos:            []response.Attachment{},
+		},
+	}
+
+	testCases := []struct {
+		name                 string
+		inputError           error
+		expectedStatusCode   int
+		expectedErrorMessage string
+	}{
+		{
+			name:               "it should return 200 status code with valid response, when there is no error",
+			inputError:         nil,
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name:                 "it should return 404 status code, when property ID not found",
+			inputError:           service.ErrDataNotFound,
+			expectedStatusCode:   http.StatusNotFound,
+			expectedErrorMessage: "Resource with given ID not found.",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockMaintenanceService.On(
+				"GetByPropertyID",
+				mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+				"g-xyz",
+				"p-xyz",
+			).Return(
+				func(ctx context.Context, groupID string, propertyID string) []response.Maintenance {
+					return dummyMaintenances
+				},
+				func(ctx context.Context, groupID string, propertyID string) error {
+					return testCase.inputError
+				},
+			).Once()
+
+			controller := NewMaintenanceController(mockMaintenanceService)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/api/v1/groups/:id/properties/:propertyID/maintenance")
+			c.SetParamNames("id", "propertyID")
+			c.SetParamValues("g-xyz", "p-xyz")
+
+			gotError := controller.getMaintenances(c)
+			if testCase.inputError == nil {
+				if assert.NoError(t, gotError) {
+					assert.Equal(t, testCase.expectedStatusCode, rec.Code)
+
+					gotResponse := &model.Response[maintenancesData]{}
+					if err := json.Unmarshal(rec.Body.Bytes(), gotResponse); assert.NoError(t, err) {
+						assert.Equal(t, dummyMaintenances, gotResponse.Data.Maintenances)
+					}
+				}
+				return
+			}
+
+			if assert.Error(t, gotError) {
+				if echoHTTPError, ok := gotError.(*echo.HTTPError); assert.Equal(t, true, ok) {
+					assert.Equal(t, testCase.expectedStatusCode, echoHTTPError.Code)
+					assert.Equal(t, testCase.expectedErrorMessage, echoHTTPError.Message)
+				}
+			}
+		})
+	}
+}
+
+func TestGetMaintenanceByID(t *testing.T) {
+	mockMaintenanceService := &mocks.MaintenanceService{}
+
+	dummyMaintenance := response.Maintenance{
+		ID:          "i-aBcdEfG",
+		PropertyID:  "p-xyz",
+		Condition:   "good",
+		InspectedOn: "2022-06-01",
+		Photos:      []response.Attachment{},
+	}
+
+	testCases := []struct {
+		name                 string
+		inputError           error
+		expectedStatusCode   int
+		expectedErrorMessage string
+	}{
+		{
+			name:               "it should return 200 status code with valid response, when there is no error",
+			inputError:         nil,
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name:                 "it should return 404 status code, when maintenance ID not found",
+			inputError:           service.ErrDataNotFound,
+			expectedStatusCode:   http.StatusNotFound,
+			expectedErrorMessage: "Resource with given ID not found.",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockMaintenanceService.On(
+				"GetByID",
+				mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+				"g-xyz",
+				"p-xyz",
+				"i-aBcdEfG",
+			).Return(
+				func(ctx context.Context, groupID string, propertyID string, id string) response.Maintenance {
+					return dummyMaintenance
+				},
+				func(ctx context.Context, groupID string, propertyID string, id string) error {
+					return testCase.inputError
+				},
+			).Once()
+
+			controller := NewMaintenanceController(mockMaintenanceService)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/api/v1/groups/:id/properties/:propertyID/maintenance/:maintenanceID")
+			c.SetParamNames("id", "propertyID", "maintenanceID")
+			c.SetParamValues("g-xyz", "p-xyz", "i-aBcdEfG")
+
+			gotError := controller.getMaintenanceByID(c)
+			if testCase.inputError == nil {
+				if assert.NoError(t, gotError) {
+					assert.Equal(t, testCase.expectedStatusCode, rec.Code)
+
+					gotResponse := &model.Response[maintenanceData]{}
+					if err := json.Unmarshal(rec.Body.Bytes(), gotResponse); assert.NoError(t, err) {
+						assert.Equal(t, dummyMaintenance, gotResponse.Data.Maintenance)
+					}
+				}
+				return
+			}
+
+			if assert.Error(t, gotError) {
+				if echoHTTPError, ok := gotError.(*echo.HTTPError); assert.Equal(t, true, ok) {
+					assert.Equal(t, testCase.expectedStatusCode, echoHTTPError.Code)
+					assert.Equal(t, testCase.expectedErrorMessage, echoHTTPError.Message)
+				}
+			}
+		})
+	}
+}
+
+func TestPutUpdateMaintenance(t *testing.T) {
+	mockMaintenanceService := &mocks.MaintenanceService{}
+
+	dummyReq := payload.UpdateMaintenance{
+		Condition:   "good",
+		InspectedOn: "2022-06-01",
+		RepairedOn:  "2022-06-10",
+		Cost:        750000,
+	}
+
+	testCases := []struct {
+		name                 string
+		inputError           error
+		expectedStatusCode   int
+		expectedErrorMessage string
+	}{
+		{
+			name:               "it should return 204 status code, when there is no error",
+			inputError:         nil,
+			expectedStatusCode: http.StatusNoContent,
+		},
+		{
+			name:                 "it should return 400 status code, when the date cannot be parsed",
+			inputError:           service.ErrDateParsing,
+			expectedStatusCode:   http.StatusBadRequest,
+			expectedErrorMessage: "Invalid date format. Please use ISO 8601 date format (2006-01-02)",
+		},
+		{
+			name:                 "it should return 404 status code, when maintenance ID not found",
+			inputError:           service.ErrDataNotFound,
+			expectedStatusCode:   http.StatusNotFound,
+			expectedErrorMessage: "Resource with given ID not found.",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockMaintenanceService.On(
+				"Update",
+				mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+				"g-xyz",
+				"p-xyz",
+				"i-aBcdEfG",
+				dummyReq,
+			).Return(
+				func(ctx context.Context, groupID string, propertyID string, id string, p payload.UpdateMaintenance) error {
+					return testCase.inputError
+				},
+			).Once()
+
+			controller := NewMaintenanceController(mockMaintenanceService)
+			requestBody, err := json.Marshal(dummyReq)
+			assert.NoError(t, err)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPut, "/", strings.NewReader(string(requestBody)))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/api/v1/groups/:id/properties/:propertyID/maintenance/:maintenanceID")
+			c.SetParamNames("id", "propertyID", "maintenanceID")
+			c.SetParamValues("g-xyz", "p-xyz", "i-aBcdEfG")
+
+			gotError := controller.putUpdateMaintenance(c)
+			if testCase.inputError == nil {
+				if assert.NoError(t, gotError) {
+					assert.Equal(t, testCase.expectedStatusCode, rec.Code)
+				}
+				return
+			}
+
+			if assert.Error(t, gotError) {
+				if echoHTTPError, ok := gotError.(*echo.HTTPError); assert.Equal(t, true, ok) {
+					assert.Equal(t, testCase.expectedStatusCode, echoHTTPError.Code)
+					assert.Equal(t, testCase.expectedErrorMessage, echoHTTPError.Message)
+				}
+			}
+		})
+	}
+}
+
+func TestDeleteMaintenance(t *testing.T) {
+	mockMaintenanceService := &mocks.MaintenanceService{}
+
+	testCases := []struct {
+		name                 string
+		inputError           error
+		expectedStatusCode   int
+		expectedErrorMessage string
+	}{
+		{
+			name:               "it should return 204 status code, when there is no error",
+			inputError:         nil,
+			expectedStatusCode: http.StatusNoContent,
+		},
+		{
+			name:                 "it should return 404 status code, when maintenance ID not found",
+			inputError:           service.ErrDataNotFound,
+			expectedStatusCode:   http.StatusNotFound,
+			expectedErrorMessage: "Resource with given ID not found.",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockMaintenanceService.On(
+				"Delete",
+				mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+				"g-xyz",
+				"p-xyz",
+				"i-aBcdEfG",
+			).Return(
+				func(ctx context.Context, groupID string, propertyID string, id string) error {
+					return testCase.inputError
+				},
+			).Once()
+
+			controller := NewMaintenanceController(mockMaintenanceService)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodDelete, "/", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/api/v1/groups/:id/properties/:propertyID/maintenance/:maintenanceID")
+			c.SetParamNames("id", "propertyID", "maintenanceID")
+			c.SetParamValues("g-xyz", "p-xyz", "i-aBcdEfG")
+
+			gotError := controller.deleteMaintenance(c)
+			if testCase.inputError == nil {
+				if assert.NoError(t, gotError) {
+					assert.Equal(t, testCase.expectedStatusCode, rec.Code)
+				}
+				return
+			}
+
+			if assert.Error(t, gotError) {
+				if echoHTTPError, ok := gotError.(*echo.HTTPError); assert.Equal(t, true, ok) {
+					assert.Equal(t, testCase.expectedStatusCode, echoHTTPError.Code)
+					assert.Equal(t, testCase.expectedErrorMessage, echoHTTPError.Message)
+				}
+			}
+		})
+	}
+}
+
+func TestGetPoorConditionReport(t *testing.T) {
+	mockMaintenanceService := &mocks.MaintenanceService{}
+
+	dummyItems := []response.PoorConditionItem{
+		{
+			PropertyID:        "p-xyz",
+			PropertyName:      "Barongan",
+			Amount:            2,
+			GroupID:           "g-xyz",
+			GroupName:         "Paguyuban Reog Sardulo Nareswara",
+			DistrictID:        "3502030",
+			DistrictName:      "Sampung",
+			MaintenanceID:     "i-aBcdEfG",
+			Condition:         "broken",
+			InspectedOn:       "2022-06-01",
+			DamageDescription: "Rangka bambu patah",
+		},
+	}
+
+	testCases := []struct {
+		name                 string
+		inputError           error
+		expectedStatusCode   int
+		expectedErrorMessage string
+	}{
+		{
+			name:               "it should return 200 status code with valid response, when there is no error",
+			inputError:         nil,
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name:                 "it should return 400 status code, when query is invalid",
+			inputError:           service.ErrInvalidPayload,
+			expectedStatusCode:   http.StatusBadRequest,
+			expectedErrorMessage: "Invalid payload. Please check the payload schema in the API Documentation.",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockMaintenanceService.On(
+				"GetPoorConditionReport",
+				mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+				payload.GetPoorConditionReport{DistrictID: "3502030"},
+			).Return(
+				func(ctx context.Context, p payload.GetPoorConditionReport) []response.PoorConditionItem {
+					return dummyItems
+				},
+				func(ctx context.Context, p payload.GetPoorConditionReport) error {
+					return testCase.inputError
+				},
+			).Once()
+
+			controller := NewMaintenanceController(mockMaintenanceService)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/?districtID=3502030", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/api/v1/maintenance/poor-condition")
+
+			gotError := controller.getPoorConditionReport(c)
+			if testCase.inputError == nil {
+				if assert.NoError(t, gotError) {
+					assert.Equal(t, testCase.expectedStatusCode, rec.Code)
+
+					gotResponse := &model.Response[poorConditionReportData]{}
+					if err := json.Unmarshal(rec.Body.Bytes(), gotResponse); assert.NoError(t, err) {
+						assert.Equal(t, dummyItems, gotResponse.Data.Items)
+					}
+				}
+				return
+			}
+
+			if assert.Error(t, gotError) {
+				if echoHTTPError, ok := gotError.(*echo.HTTPError); assert.Equal(t, true, ok) {
+					assert.Equal(t, testCase.expectedStatusCode, echoHTTPError.Code)
+					assert.Equal(t, testCase.expectedErrorMessage, echoHTTPError.Message)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,198 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/erikrios/reog-apps-apis/middleware"
+	"github.com/erikrios/reog-apps-apis/model"
+	"github.com/erikrios/reog-apps-apis/model/payload"
+	"github.com/erikrios/reog-apps-apis/model/response"
+	"github.com/erikrios/reog-apps-apis/service"
+	"github.com/erikrios/reog-apps-apis/service/member"
+	"github.com/labstack/echo/v4"
+)
+
+type membersController struct {
+	service member.MemberService
+}
+
+func NewMembersController(service member.MemberService) *membersController {
+	return &membersController{service: service}
+}
+
+func (m *membersController) Route(e *echo.Group) {
+	group := e.Group("/groups/:id/members", middleware.JWTMiddleware())
+	group.POST("", m.postCreateMember)
+	group.GET("", m.getMembers)
+	group.GET("/:memberID", m.getMemberByID)
+	group.PUT("/:memberID", m.putUpdateMember)
+	group.DELETE("/:memberID", m.deleteMember)
+}
+
+// postCreateMember godoc
+// @Summary      Add a Member
+// @Description  Add a member to a group
+// @Tags         members
+// @Accept       json
+// @Produce      json
+// @Param        default  body  payload.CreateMember  true  "request body"
+// @Param        id       path  string                true  "group ID"
+// @Security     ApiKeyAuth
+// @Success      201  {object}  createMemberResponse
+// @Failure      400  {object}  echo.HTTPError
+// @Failure      401  {object}  echo.HTTPError
+// @Failure      404  {object}  echo.HTTPError
+// @Failure      500  {object}  echo.HTTPError
+// @Router       /groups/{id}/members [post]
+func (m *membersController) postCreateMember(c echo.Context) error {
+	groupID := c.Param("id")
+
+	payload := new(payload.CreateMember)
+	if err := c.Bind(payload); err != nil {
+		return newErrorResponse(service.ErrInvalidPayload)
+	}
+
+	id, err := m.service.Create(c.Request().Context(), groupID, *payload)
+	if err != nil {
+		return newErrorResponse(err)
+	}
+
+	idResponse := map[string]any{"id": id}
+	response := model.NewResponse("success", "member successfully created", idResponse)
+	return c.JSON(http.StatusCreated, response)
+}
+
+// getMembers    godoc
+// @Summary      Get Members
+// @Description  Get the members of a group
+// @Tags         members
+// @Produce      json
+// @Param        id  path  string  true  "group ID"
+// @Security     ApiKeyAuth
+// @Success      200  {object}  membersResponse
+// @Failure      401  {object}  echo.HTTPError
+// @Failure      404  {object}  echo.HTTPError
+// @Failure      500  {object}  echo.HTTPError
+// @Router       /groups/{id}/members [get]
+func (m *membersController) getMembers(c echo.Context) error {
+	groupID := c.Param("id")
+
+	members, err := m.service.GetByGroupID(c.Request().Context(), groupID)
+	if err != nil {
+		return newErrorResponse(err)
+	}
+
+	membersResponses := map[string]any{"members": members}
+	responses := model.NewResponse("success", "successfully get members", membersResponses)
+	return c.JSON(http.StatusOK, responses)
+}
+
+// getMemberByID godoc
+// @Summary      Get Member by ID
+// @Description  Get member by ID
+// @Tags         members
+// @Produce      json
+// @Param        id        path  string  true  "group ID"
+// @Param        memberID  path  string  true  "member ID"
+// @Security     ApiKeyAuth
+// @Success      200  {object}  memberResponse
+// @Failure      401  {object}  echo.HTTPError
+// @Failure      404  {object}  echo.HTTPError
+// @Failure      500  {object}  echo.HTTPError
+// @Router       /groups/{id}/members/{memberID} [get]
+func (m *membersController) getMemberByID(c echo.Context) error {
+	groupID := c.Param("id")
+	id := c.Param("memberID")
+
+	member, err := m.service.GetByID(c.Request().Context(), groupID, id)
+	if err != nil {
+		return newErrorResponse(err)
+	}
+
+	memberResponse := map[string]any{"member": member}
+	response := model.NewResponse("success", "successfully get member with id "+id, memberResponse)
+	return c.JSON(http.StatusOK, response)
+}
+
+// putUpdateMember godoc
+// @Summary      Update a Member
+// @Description  Update a member
+// @Tags         members
+// @Accept       json
+// @Produce      json
+// @Param        default   body  payload.UpdateMember  true  "request body"
+// @Param        id        path  string                true  "group ID"
+// @Param        memberID  path  string                true  "member ID"
+// @Security     ApiKeyAuth
+// @Success      204
+// @Failure      400  {object}  echo.HTTPError
+// @Failure      401  {object}  echo.HTTPError
+// @Failure      404  {object}  echo.HTTPError
+// @Failure      500  {object}  echo.HTTPError
+// @Router       /groups/{id}/members/{memberID} [put]
+func (m *membersController) putUpdateMember(c echo.Context) error {
+	groupID := c.Param("id")
+	id := c.Param("memberID")
+
+	payload := new(payload.UpdateMember)
+	if err := c.Bind(payload); err != nil {
+		return newErrorResponse(service.ErrInvalidPayload)
+	}
+
+	if err := m.service.Update(c.Request().Context(), groupID, id, *payload); err != nil {
+		return newErrorResponse(err)
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+// deleteMember  godoc
+// @Summary      Delete a Member
+// @Description  Delete a member
+// @Tags         members
+// @Produce      json
+// @Param        id        path  string  true  "group ID"
+// @Param        memberID  path  string  true  "member ID"
+// @Security     ApiKeyAuth
+// @Success      204
+// @Failure      401  {object}  echo.HTTPError
+// @Failure      404  {object}  echo.HTTPError
+// @Failure      500  {object}  echo.HTTPError
+// @Router       /groups/{id}/members/{memberID} [delete]
+func (m *membersController) deleteMember(c echo.Context) error {
+	groupID := c.Param("id")
+	id := c.Param("memberID")
+
+	if err := m.service.Delete(c.Request().Context(), groupID, id); err != nil {
+		return newErrorResponse(err)
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+// createMemberResponse struct is used for swaggo to generate the API documentation, as it doesn't support generic yet.
+type createMemberResponse struct {
+	Status  string `json:"status" extensions:"x-order=0"`
+	Message string `json:"message" extensions:"x-order=1"`
+	Data    idData `json:"data" extensions:"x-order=2"`
+}
+
+// membersResponse struct is used for swaggo to generate the API documentation, as it doesn't support generic yet.
+type membersResponse struct {
+	Status  string      `json:"status" extensions:"x-order=0"`
+	Message string      `json:"message" extensions:"x-order=1"`
+	Data    membersData `json:"data" extensions:"x-order=2"`
+}
+
+type membersData struct {
+	Members []response.Member `json:"members"`
+}
+
+// memberResponse struct is used for swaggo to generate the API documentation, as it doesn't support generic yet.
+type memberResponse struct {
+	Status  string     `json:"status" extensions:"x-order=0"`
+	Message string     `json:"message" extensions:"x-order=1"`
+	Data    memberData `json:"data" extensions:"x-order=2"`
+}
+
+type memberData struct {
+	Member response.Member `json:"member"`
+}
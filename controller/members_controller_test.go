@@ -0,0 +1,531 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/erikrios/reog-apps-apis/model"
+	"github.com/erikrios/reog-apps-apis/model/payload"
+	"github.com/erikrios/reog-apps-apis/model/response"
+	"github.com/erikrios/reog-apps-apis/service"
+	"github.com/erikrios/reog-apps-apis/service/member/mocks"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestRouteMembers(t *testing.T) {
+	mockMemberService := &mocks.MemberService{}
+	controller := NewMembersController(mockMemberService)
+	g := echo.New().Group("/api/v1")
+	controller.Route(g)
+	assert.NotNil(t, controller)
+}
+
+func TestPostCreateMember(t *testing.T) {
+	mockMemberService := &mocks.MemberService{}
+
+	dummyReq := payload.CreateMember{
+		Name:      "Erik Rio Setiawan",
+		Role:      "warok",
+		Gender:    "male",
+		BirthYear: 1998,
+		Phone:     "+6281234567890",
+		JoinedOn:  "2015-08-17",
+	}
+
+	t.Run("success scenario", func(t *testing.T) {
+		dummyID := "m-aBcdEfG"
+
+		mockMemberService.On(
+			"Create",
+			mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+			mock.AnythingOfType(fmt.Sprintf("%T", "")),
+			mock.AnythingOfType(fmt.Sprintf("%T", payload.CreateMember{})),
+		).Return(
+			func(ctx context.Context, groupID string, p payload.CreateMember) string {
+				return dummyID
+			},
+			func(ctx context.Context, groupID string, p payload.CreateMember) error {
+				return nil
+			},
+		).Once()
+
+		t.Run("it should return 201 status code with valid response, when there is no error", func(t *testing.T) {
+			controller := NewMembersController(mockMemberService)
+			requestBody, err := json.Marshal(dummyReq)
+			assert.NoError(t, err)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(string(requestBody)))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/api/v1/groups/:id/members")
+			c.SetParamNames("id")
+			c.SetParamValues("g-xyz")
+
+			if assert.NoError(t, controller.postCreateMember(c)) {
+				assert.Equal(t, http.StatusCreated, rec.Code)
+
+				gotResponse := make(map[string]any)
+				if err := json.Unmarshal(rec.Body.Bytes(), &gotResponse); assert.NoError(t, err) {
+					gotID := gotResponse["data"].(map[string]any)["id"].(string)
+					assert.Equal(t, dummyID, gotID)
+				}
+			}
+		})
+	})
+
+	t.Run("failed scenario", func(t *testing.T) {
+		testCases := []struct {
+			name                 string
+			inputError           error
+			expectedStatusCode   int
+			expectedErrorMessage string
+		}{
+			{
+				name:                 "it should return 400 status code, when payload is invalid",
+				inputError:           service.ErrInvalidPayload,
+				expectedStatusCode:   http.StatusBadRequest,
+				expectedErrorMessage: "Invalid payload. Please check the payload schema in the API Documentation.",
+			},
+			{
+				name:                 "it should return 400 status code, when joined on date is invalid",
+				inputError:           service.ErrDateParsing,
+				expectedStatusCode:   http.StatusBadRequest,
+				expectedErrorMessage: "Invalid date format. Please use ISO 8601 date format (2006-01-02)",
+			},
+			{
+				name:                 "it should return 404 status code, when group ID not found",
+				inputError:           service.ErrDataNotFound,
+				expectedStatusCode:   http.StatusNotFound,
+				expectedErrorMessage: "Resource with given ID not found.",
+			},
+			{
+				name:                 "it should return 500 status code, when error happened",
+				inputError:           service.ErrRepository,
+				expectedStatusCode:   http.StatusInternalServerError,
+				expectedErrorMessage: "Something went wrong.",
+			},
+		}
+
+		for _, testCase := range testCases {
+			t.Run(testCase.name, func(t *testing.T) {
+				mockMemberService.On(
+					"Create",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", payload.CreateMember{})),
+				).Return(
+					func(ctx context.Context, groupID string, p payload.CreateMember) string {
+						return ""
+					},
+					func(ctx context.Context, groupID string, p payload.CreateMember) error {
+						return testCase.inputError
+					},
+				).Once()
+
+				controller := NewMembersController(mockMemberService)
+				requestBody, err := json.Marshal(dummyReq)
+				assert.NoError(t, err)
+
+				e := echo.New()
+				req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(string(requestBody)))
+				req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+				rec := httptest.NewRecorder()
+				c := e.NewContext(req, rec)
+				c.SetPath("/api/v1/groups/:id/members")
+				c.SetParamNames("id")
+				c.SetParamValues("g-xyz")
+
+				gotError := controller.postCreateMember(c)
+				if assert.Error(t, gotError) {
+					if echoHTTPError, ok := gotError.(*echo.HTTPError); assert.Equal(t, true, ok) {
+						assert.Equal(t, testCase.expectedStatusCode, echoHTTPError.Code)
+						assert.Equal(t, testCase.expectedErrorMessage, echoHTTPError.Message)
+					}
+				}
+			})
+		}
+	})
+}
+
+func TestGetMembers(t *testing.T) {
+	mockMemberService := &mocks.MemberService{}
+
+	t.Run("success scenario", func(t *testing.T) {
+		dummyMembers := []response.Member{
+			{
+				ID:        "m-aBcdEfG",
+				GroupID:   "g-xyz",
+				Name:      "Erik Rio Setiawan",
+				Role:      "warok",
+				Gender:    "male",
+				BirthYear: 1998,
+				JoinedOn:  "2015-08-17",
+			},
+		}
+
+		mockMemberService.On(
+			"GetByGroupID",
+			mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+			mock.AnythingOfType(fmt.Sprintf("%T", "")),
+		).Return(
+			func(ctx context.Context, groupID string) []response.Member {
+				return dummyMembers
+			},
+			func(ctx context.Context, groupID string) error {
+				return nil
+			},
+		).Once()
+
+		t.Run("it should return 200 status code with valid response, when there is no error", func(t *testing.T) {
+			controller := NewMembersController(mockMemberService)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/api/v1/groups/:id/members")
+			c.SetParamNames("id")
+			c.SetParamValues("g-xyz")
+
+			if assert.NoError(t, controller.getMembers(c)) {
+				assert.Equal(t, http.StatusOK, rec.Code)
+
+				gotResponse := &model.Response[membersData]{}
+				if err := json.Unmarshal(rec.Body.Bytes(), gotResponse); assert.NoError(t, err) {
+					assert.Equal(t, dummyMembers, gotResponse.Data.Members)
+				}
+			}
+		})
+	})
+
+	t.Run("failed scenario", func(t *testing.T) {
+		testCases := []struct {
+			name                 string
+			inputError           error
+			expectedStatusCode   int
+			expectedErrorMessage string
+		}{
+			{
+				name:                 "it should return 404 status code, when group ID not found",
+				inputError:           service.ErrDataNotFound,
+				expectedStatusCode:   http.StatusNotFound,
+				expectedErrorMessage: "Resource with given ID not found.",
+			},
+			{
+				name:                 "it should return 500 status code, when error happened",
+				inputError:           service.ErrRepository,
+				expectedStatusCode:   http.StatusInternalServerError,
+				expectedErrorMessage: "Something went wrong.",
+			},
+		}
+
+		for _, testCase := range testCases {
+			t.Run(testCase.name, func(t *testing.T) {
+				mockMemberService.On(
+					"GetByGroupID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, groupID string) []response.Member {
+						return nil
+					},
+					func(ctx context.Context, groupID string) error {
+						return testCase.inputError
+					},
+				).Once()
+
+				controller := NewMembersController(mockMemberService)
+
+				e := echo.New()
+				req := httptest.NewRequest(http.MethodGet, "/", nil)
+				rec := httptest.NewRecorder()
+				c := e.NewContext(req, rec)
+				c.SetPath("/api/v1/groups/:id/members")
+				c.SetParamNames("id")
+				c.SetParamValues("g-xyz")
+
+				gotError := controller.getMembers(c)
+				if assert.Error(t, gotError) {
+					if echoHTTPError, ok := gotError.(*echo.HTTPError); assert.Equal(t, true, ok) {
+						assert.Equal(t, testCase.expectedStatusCode, echoHTTPError.Code)
+						assert.Equal(t, testCase.expectedErrorMessage, echoHTTPError.Message)
+					}
+				}
+			})
+		}
+	})
+}
+
+func TestGetMemberByID(t *testing.T) {
+	mockMemberService := &mocks.MemberService{}
+
+	t.Run("success scenario", func(t *testing.T) {
+		dummyMember := response.Member{
+			ID:        "m-aBcdEfG",
+			GroupID:   "g-xyz",
+			Name:      "Sri Wahyuni",
+			Role:      "jathil",
+			Gender:    "female",
+			BirthYear: 2001,
+			JoinedOn:  "2019-01-05",
+		}
+
+		mockMemberService.On(
+			"GetByID",
+			mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+			mock.AnythingOfType(fmt.Sprintf("%T", "")),
+			mock.AnythingOfType(fmt.Sprintf("%T", "")),
+		).Return(
+			func(ctx context.Context, groupID string, id string) response.Member {
+				return dummyMember
+			},
+			func(ctx context.Context, groupID string, id string) error {
+				return nil
+			},
+		).Once()
+
+		t.Run("it should return 200 status code with valid response, when there is no error", func(t *testing.T) {
+			controller := NewMembersController(mockMemberService)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/api/v1/groups/:id/members/:memberID")
+			c.SetParamNames("id", "memberID")
+			c.SetParamValues("g-xyz", "m-aBcdEfG")
+
+			if assert.NoError(t, controller.getMemberByID(c)) {
+				assert.Equal(t, http.StatusOK, rec.Code)
+
+				gotResponse := &model.Response[memberData]{}
+				if err := json.Unmarshal(rec.Body.Bytes(), gotResponse); assert.NoError(t, err) {
+					assert.Equal(t, dummyMember, gotResponse.Data.Member)
+				}
+			}
+		})
+	})
+
+	t.Run("failed scenario", func(t *testing.T) {
+		testCases := []struct {
+			name                 string
+			inputError           error
+			expectedStatusCode   int
+			expectedErrorMessage string
+		}{
+			{
+				name:                 "it should return 404 status code, when member ID not found",
+				inputError:           service.ErrDataNotFound,
+				expectedStatusCode:   http.StatusNotFound,
+				expectedErrorMessage: "Resource with given ID not found.",
+			},
+			{
+				name:                 "it should return 500 status code, when error happened",
+				inputError:           service.ErrRepository,
+				expectedStatusCode:   http.StatusInternalServerError,
+				expectedErrorMessage: "Something went wrong.",
+			},
+		}
+
+		for _, testCase := range testCases {
+			t.Run(testCase.name, func(t *testing.T) {
+				mockMemberService.On(
+					"GetByID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, groupID string, id string) response.Member {
+						return response.Member{}
+					},
+					func(ctx context.Context, groupID string, id string) error {
+						return testCase.inputError
+					},
+				).Once()
+
+				controller := NewMembersController(mockMemberService)
+
+				e := echo.New()
+				req := httptest.NewRequest(http.MethodGet, "/", nil)
+				rec := httptest.NewRecorder()
+				c := e.NewContext(req, rec)
+				c.SetPath("/api/v1/groups/:id/members/:memberID")
+				c.SetParamNames("id", "memberID")
+				c.SetParamValues("g-xyz", "m-aBcdEfG")
+
+				gotError := controller.getMemberByID(c)
+				if assert.Error(t, gotError) {
+					if echoHTTPError, ok := gotError.(*echo.HTTPError); assert.Equal(t, true, ok) {
+						assert.Equal(t, testCase.expectedStatusCode, echoHTTPError.Code)
+						assert.Equal(t, testCase.expectedErrorMessage, echoHTTPError.Message)
+					}
+				}
+			})
+		}
+	})
+}
+
+func TestPutUpdateMember(t *testing.T) {
+	mockMemberService := &mocks.MemberService{}
+
+	dummyReq := payload.UpdateMember{
+		Name:      "Erik Rio Setiawan",
+		Role:      "pembarong",
+		Gender:    "male",
+		BirthYear: 1998,
+		JoinedOn:  "2015-08-17",
+	}
+
+	testCases := []struct {
+		name                 string
+		inputError           error
+		expectedStatusCode   int
+		expectedErrorMessage string
+	}{
+		{
+			name:               "it should return 204 status code, when there is no error",
+			inputError:         nil,
+			expectedStatusCode: http.StatusNoContent,
+		},
+		{
+			name:                 "it should return 400 status code, when payload is invalid",
+			inputError:           service.ErrInvalidPayload,
+			expectedStatusCode:   http.StatusBadRequest,
+			expectedErrorMessage: "Invalid payload. Please check the payload schema in the API Documentation.",
+		},
+		{
+			name:                 "it should return 404 status code, when member ID not found",
+			inputError:           service.ErrDataNotFound,
+			expectedStatusCode:   http.StatusNotFound,
+			expectedErrorMessage: "Resource with given ID not found.",
+		},
+		{
+			name:                 "it should return 500 status code, when error happened",
+			inputError:           service.ErrRepository,
+			expectedStatusCode:   http.StatusInternalServerError,
+			expectedErrorMessage: "Something went wrong.",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockMemberService.On(
+				"Update",
+				mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+				mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				mock.AnythingOfType(fmt.Sprintf("%T", payload.UpdateMember{})),
+			).Return(
+				func(ctx context.Context, groupID string, id string, p payload.UpdateMember) error {
+					return testCase.inputError
+				},
+			).Once()
+
+			controller := NewMembersController(mockMemberService)
+			requestBody, err := json.Marshal(dummyReq)
+			assert.NoError(t, err)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPut, "/", strings.NewReader(string(requestBody)))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/api/v1/groups/:id/members/:memberID")
+			c.SetParamNames("id", "memberID")
+			c.SetParamValues("g-xyz", "m-aBcdEfG")
+
+			gotError := controller.putUpdateMember(c)
+			if testCase.inputError == nil {
+				if assert.NoError(t, gotError) {
+					assert.Equal(t, testCase.expectedStatusCode, rec.Code)
+				}
+				return
+			}
+
+			if assert.Error(t, gotError) {
+				if echoHTTPError, ok := gotError.(*echo.HTTPError); assert.Equal(t, true, ok) {
+					assert.Equal(t, testCase.expectedStatusCode, echoHTTPError.Code)
+					assert.Equal(t, testCase.expectedErrorMessage, echoHTTPError.Message)
+				}
+			}
+		})
+	}
+}
+
+func TestDeleteMember(t *testing.T) {
+	mockMemberService := &mocks.MemberService{}
+
+	testCases := []struct {
+		name                 string
+		inputError           error
+		expectedStatusCode   int
+		expectedErrorMessage string
+	}{
+		{
+			name:               "it should return 204 status code, when there is no error",
+			inputError:         nil,
+			expectedStatusCode: http.StatusNoContent,
+		},
+		{
+			name:                 "it should return 404 status code, when member ID not found",
+			inputError:           service.ErrDataNotFound,
+			expectedStatusCode:   http.StatusNotFound,
+			expectedErrorMessage: "Resource with given ID not found.",
+		},
+		{
+			name:                 "it should return 500 status code, when error happened",
+			inputError:           service.ErrRepository,
+			expectedStatusCode:   http.StatusInternalServerError,
+			expectedErrorMessage: "Something went wrong.",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockMemberService.On(
+				"Delete",
+				mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+				mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				mock.AnythingOfType(fmt.Sprintf("%T", "")),
+			).Return(
+				func(ctx context.Context, groupID string, id string) error {
+					return testCase.inputError
+				},
+			).Once()
+
+			controller := NewMembersController(mockMemberService)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodDelete, "/", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/api/v1/groups/:id/members/:memberID")
+			c.SetParamNames("id", "memberID")
+			c.SetParamValues("g-xyz", "m-aBcdEfG")
+
+			gotError := controller.deleteMember(c)
+			if testCase.inputError == nil {
+				if assert.NoError(t, gotError) {
+					assert.Equal(t, testCase.expectedStatusCode, rec.Code)
+				}
+				return
+			}
+
+			if assert.Error(t, gotError) {
+				if echoHTTPError, ok := gotError.(*echo.HTTPError); assert.Equal(t, true, ok) {
+					assert.Equal(t, testCase.expectedStatusCode, echoHTTPError.Code)
+					assert.Equal(t, testCase.expectedErrorMessage, echoHTTPError.Message)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package controller
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"mime"
+	"net/http"
+
+	"github.com/erikrios/reog-apps-apis/service"
+	"github.com/labstack/echo/v4"
+)
+
+const mimeApplicationMergePatchJSON = "application/merge-patch+json"
+
+// bindMergePatch binds the JSON merge patch (RFC 7396) in the request body to patch, a struct of pointers where a
+// nil pointer is an absent member. In a merge patch null removes a member, while none of the patchable members can
+// be removed, so null is rejected instead of being mistaken for an absent member.
+func bindMergePatch(c echo.Context, patch any) error {
+	mediaType, _, _ := mime.ParseMediaType(c.Request().Header.Get(echo.HeaderContentType))
+	if mediaType != mimeApplicationMergePatchJSON && mediaType != echo.MIMEApplicationJSON {
+		return echo.NewHTTPError(http.StatusUnsupportedMediaType, "Unsupported media type. Please send the patch as application/merge-patch+json.")
+	}
+
+	body, err := io.ReadAll(c.Request().Body)
+	if err != nil {
+		return newErrorResponse(service.ErrInvalidPayload)
+	}
+
+	// A patch other than an object would replace the whole resource
+	var members map[string]json.RawMessage
+	if err := json.Unmarshal(body, &members); err != nil || members == nil {
+		return newErrorResponse(service.ErrInvalidPayload)
+	}
+
+	for _, value := range members {
+		if bytes.Equal(value, []byte("null")) {
+			return newErrorResponse(service.ErrInvalidPayload)
+		}
+	}
+
+	if err := json.Unmarshal(body, patch); err != nil {
+		return newErrorResponse(service.ErrInvalidPayload)
+	}
+	return nil
+}
@@ -0,0 +1,16 @@
+package controller
+
+import (
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+)
+
+// pageLink returns the current request URI with the page query parameter replaced.
+func pageLink(c echo.Context, page int) string {
+	url := *c.Request().URL
+	query := url.Query()
+	query.Set("page", strconv.Itoa(page))
+	url.RawQuery = query.Encode()
+	return url.RequestURI()
+}
@@ -0,0 +1,250 @@
+package controller
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+
+	"github.com/erikrios/reog-apps-apis/middleware"
+	"github.com/erikrios/reog-apps-apis/model"
+	"github.com/erikrios/reog-apps-apis/model/payload"
+	"github.com/erikrios/reog-apps-apis/model/response"
+	"github.com/erikrios/reog-apps-apis/service"
+	"github.com/erikrios/reog-apps-apis/service/public"
+	"github.com/labstack/echo/v4"
+)
+
+type publicController struct {
+	service public.PublicService
+}
+
+func NewPublicController(service public.PublicService) *publicController {
+	return &publicController{service: service}
+}
+
+func (p *publicController) Route(e *echo.Group) {
+	group := e.Group("/public", middleware.PublicCORS(), middleware.PublicRateLimiter())
+	group.GET("/groups", p.getPublicGroups)
+	group.GET("/groups/:id", p.getPublicGroupByID)
+	group.GET("/shows", p.getUpcomingShows)
+
+	scan := e.Group("/scan", middleware.PublicCORS(), middleware.PublicRateLimiter())
+	scan.GET("/:code", p.getScan)
+	scan.GET("/:code/verify", p.getVerifyCode)
+}
+
+// getPublicGroups godoc
+// @Summary      Get Public Groups
+// @Description  Get the active groups without their leader and private contacts, by name. No token is needed.
+// @Tags         public
+// @Produce      json
+// @Param        page         query  int     false  "page number, start from 1, maximum 10000"
+// @Param        limit        query  int     false  "number of groups per page, 20 by default and 100 at most"
+// @Param        district_id  query  string  false  "filter groups by district ID"
+// @Param        village_id   query  string  false  "filter groups by village ID"
+// @Param        name         query  string  false  "filter groups by name substring"
+// @Success      200  {object}  publicGroupsResponse
+// @Failure      400  {object}  echo.HTTPError
+// @Failure      429  {object}  echo.HTTPError
+// @Failure      500  {object}  echo.HTTPError
+// @Router       /public/groups [get]
+func (p *publicController) getPublicGroups(c echo.Context) error {
+	payload := new(payload.GetPublicGroups)
+	if err := c.Bind(payload); err != nil {
+		return newErrorResponse(service.ErrInvalidPayload)
+	}
+
+	groups, pagination, err := p.service.GetGroups(c.Request().Context(), *payload)
+	if err != nil {
+		return newErrorResponse(err)
+	}
+
+	if pagination.Page < pagination.TotalPages {
+		pagination.Next = pageLink(c, pagination.Page+1)
+	}
+	if pagination.Page > 1 {
+		pagination.Previous = pageLink(c, pagination.Page-1)
+	}
+
+	groupsResponses := map[string]any{"groups": groups, "pagination": pagination}
+	responses := model.NewResponse("success", "successfully get groups", groupsResponses)
+	return c.JSON(http.StatusOK, responses)
+}
+
+// getPublicGroupByID godoc
+// @Summary      Get Public Group by ID
+// @Description  Get an active group without its leader and private contacts. No token is needed.
+// @Tags         public
+// @Produce      json
+// @Param        id  path  string  true  "group ID"
+// @Success      200  {object}  publicGroupResponse
+// @Failure      404  {object}  echo.HTTPError
+// @Failure      429  {object}  echo.HTTPError
+// @Failure      500  {object}  echo.HTTPError
+// @Router       /public/groups/{id} [get]
+func (p *publicController) getPublicGroupByID(c echo.Context) error {
+	id := c.Param("id")
+
+	group, err := p.service.GetGroupByID(c.Request().Context(), id)
+	if err != nil {
+		return newErrorResponse(err)
+	}
+
+	groupResponse := map[string]any{"group": group}
+	response := model.NewResponse("success", "successfully get group with id "+id, groupResponse)
+	return c.JSON(http.StatusOK, response)
+}
+
+// getUpcomingShows godoc
+// @Summary      Get Upcoming Shows
+// @Description  Get the show schedules of the active groups that are not finished yet, from the earliest one. No token is needed.
+// @Tags         public
+// @Produce      json
+// @Param        group_id     query  string  false  "filter show schedules by group ID"
+// @Param        district_id  query  string  false  "filter show schedules by the district ID of the group"
+// @Param        limit        query  int     false  "number of show schedules, 20 by default and 100 at most"
+// @Success      200  {object}  upcomingShowsResponse
+// @Failure      400  {object}  echo.HTTPError
+// @Failure      429  {object}  echo.HTTPError
+// @Failure      500  {object}  echo.HTTPError
+// @Router       /public/shows [get]
+func (p *publicController) getUpcomingShows(c echo.Context) error {
+	payload := new(payload.GetUpcomingShows)
+	if err := c.Bind(payload); err != nil {
+		return newErrorResponse(service.ErrInvalidPayload)
+	}
+
+	shows, err := p.service.GetUpcomingShows(c.Request().Context(), *payload)
+	if err != nil {
+		return newErrorResponse(err)
+	}
+
+	showsResponses := map[string]any{"shows": shows}
+	responses := model.NewResponse("success", "successfully get upcoming show schedules", showsResponses)
+	return c.JSON(http.StatusOK, responses)
+}
+
+// getScan godoc
+// @Summary      Resolve Scanned Code
+// @Description  Resolve the code encoded in a group or property QR code. Browsers get a landing page, other clients get JSON. No token is needed.
+// @Tags         public
+// @Produce      json,html
+// @Param        code  path  string  true  "signed code, or the bare group or property ID of the QR codes printed before the codes were signed"
+// @Success      200  {object}  scanResponse
+// @Failure      400  {object}  echo.HTTPError
+// @Failure      404  {object}  echo.HTTPError
+// @Failure      429  {object}  echo.HTTPError
+// @Failure      500  {object}  echo.HTTPError
+// @Router       /scan/{code} [get]
+func (p *publicController) getScan(c echo.Context) error {
+	code := c.Param("code")
+	c.Response().Header().Add(echo.HeaderVary, echo.HeaderAccept)
+
+	result, err := p.service.Scan(c.Request().Context(), code)
+	forged := errors.Is(err, service.ErrCodeNotGenuine)
+	if prefersHTML(c) && (err == nil || forged || errors.Is(err, service.ErrDataNotFound)) {
+		status := http.StatusOK
+		if forged {
+			status = http.StatusBadRequest
+		} else if err != nil {
+			status = http.StatusNotFound
+		}
+
+		page := new(bytes.Buffer)
+		data := struct {
+			Found  bool
+			Forged bool
+			Result response.ScanResult
+		}{Found: err == nil, Forged: forged, Result: result}
+		if err := scanPageTemplate.Execute(page, data); err != nil {
+			return newErrorResponse(service.ErrRepository)
+		}
+		return c.HTMLBlob(status, page.Bytes())
+	}
+	if err != nil {
+		return newErrorResponse(err)
+	}
+
+	scanResponse := map[string]any{"scan": result}
+	response := model.NewResponse("success", "successfully resolve code "+code, scanResponse)
+	return c.JSON(http.StatusOK, response)
+}
+
+// getVerifyCode godoc
+// @Summary      Verify Signed Code
+// @Description  Verify the signature of the code encoded in a group or property QR code, and that it still stands for an existing group or property. The groups that are not active are reported too. No token is needed.
+// @Tags         public
+// @Produce      json
+// @Param        code  path  string  true  "signed code"
+// @Success      200  {object}  codeVerificationResponse
+// @Failure      400  {object}  echo.HTTPError
+// @Failure      404  {object}  echo.HTTPError
+// @Failure      429  {object}  echo.HTTPError
+// @Failure      500  {object}  echo.HTTPError
+// @Router       /scan/{code}/verify [get]
+func (p *publicController) getVerifyCode(c echo.Context) error {
+	verification, err := p.service.Verify(c.Request().Context(), c.Param("code"))
+	if err != nil {
+		return newErrorResponse(err)
+	}
+
+	verificationResponse := map[string]any{"verification": verification}
+	response := model.NewResponse("success", "successfully verify code", verificationResponse)
+	return c.JSON(http.StatusOK, response)
+}
+
+// publicGroupsResponse struct is used for swaggo to generate the API documentation, as it doesn't support generic yet.
+type publicGroupsResponse struct {
+	Status  string           `json:"status" extensions:"x-order=0"`
+	Message string           `json:"message" extensions:"x-order=1"`
+	Data    publicGroupsData `json:"data" extensions:"x-order=2"`
+}
+
+type publicGroupsData struct {
+	Groups     []response.PublicGroup `json:"groups"`
+	Pagination response.Pagination    `json:"pagination"`
+}
+
+// publicGroupResponse struct is used for swaggo to generate the API documentation, as it doesn't support generic yet.
+type publicGroupResponse struct {
+	Status  string          `json:"status" extensions:"x-order=0"`
+	Message string          `json:"message" extensions:"x-order=1"`
+	Data    publicGroupData `json:"data" extensions:"x-order=2"`
+}
+
+type publicGroupData struct {
+	Group response.PublicGroup `json:"group"`
+}
+
+// upcomingShowsResponse struct is used for swaggo to generate the API documentation, as it doesn't support generic yet.
+type upcomingShowsResponse struct {
+	Status  string            `json:"status" extensions:"x-order=0"`
+	Message string            `json:"message" extensions:"x-order=1"`
+	Data    upcomingShowsData `json:"data" extensions:"x-order=2"`
+}
+
+type upcomingShowsData struct {
+	Shows []response.PublicShowSchedule `json:"shows"`
+}
+
+// scanResponse struct is used for swaggo to generate the API documentation, as it doesn't support generic yet.
+type scanResponse struct {
+	Status  string   `json:"status" extensions:"x-order=0"`
+	Message string   `json:"message" extensions:"x-order=1"`
+	Data    scanData `json:"data" extensions:"x-order=2"`
+}
+
+type scanData struct {
+	Scan response.ScanResult `json:"scan"`
+}
+
+// codeVerificationResponse struct is used for swaggo to generate the API documentation, as it doesn't support generic yet.
+type codeVerificationResponse struct {
+	Status  string               `json:"status" extensions:"x-order=0"`
+	Message string               `json:"message" extensions:"x-order=1"`
+	Data    codeVerificationData `json:"data" extensions:"x-order=2"`
+}
+
+type codeVerificationData struct {
+	Verification response.CodeVerification `json:"verification"`
+}
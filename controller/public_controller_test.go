@@ -0,0 +1,517 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/erikrios/reog-apps-apis/model/payload"
+	"github.com/erikrios/reog-apps-apis/model/response"
+	"github.com/erikrios/reog-apps-apis/service"
+	"github.com/erikrios/reog-apps-apis/service/public/mocks"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestRoutePublic(t *testing.T) {
+	mockPublicService := &mocks.PublicService{}
+	mockPublicService.On(
+		"GetUpcomingShows",
+		mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+		payload.GetUpcomingShows{},
+	).Return(
+		func(ctx context.Context, p payload.GetUpcomingShows) []response.PublicShowSchedule {
+			return []response.PublicShowSchedule{}
+		},
+		func(ctx context.Context, p payload.GetUpcomingShows) error {
+			return nil
+		},
+	)
+
+	controller := NewPublicController(mockPublicService)
+	e := echo.New()
+	controller.Route(e.Group("/api/v1"))
+
+	t.Run("it should not require a token", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/public/shows", nil)
+		req.Header.Set(echo.HeaderOrigin, "https://wisata.ponorogo.go.id")
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "*", rec.Header().Get(echo.HeaderAccessControlAllowOrigin))
+	})
+
+	t.Run("it should answer the CORS preflight requests", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodOptions, "/api/v1/public/shows", nil)
+		req.Header.Set(echo.HeaderOrigin, "https://wisata.ponorogo.go.id")
+		req.Header.Set(echo.HeaderAccessControlRequestMethod, http.MethodGet)
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusNoContent, rec.Code)
+		assert.Contains(t, rec.Header().Get(echo.HeaderAccessControlAllowMethods), http.MethodGet)
+	})
+}
+
+func TestGetPublicGroups(t *testing.T) {
+	mockPublicService := &mocks.PublicService{}
+
+	dummyGroups := []response.PublicGroup{
+		{
+			ID:           "g-xyz",
+			Name:         "Paguyuban Reog Singo Mudho",
+			VillageName:  "Bibis",
+			DistrictName: "Bungkal",
+		},
+	}
+
+	testCases := []struct {
+		name                 string
+		inputError           error
+		expectedStatusCode   int
+		expectedErrorMessage string
+	}{
+		{
+			name:               "it should return 200 status code, when there is no error",
+			inputError:         nil,
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name:                 "it should return 400 status code, when payload is invalid",
+			inputError:           service.ErrInvalidPayload,
+			expectedStatusCode:   http.StatusBadRequest,
+			expectedErrorMessage: "Invalid payload. Please check the payload schema in the API Documentation.",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockPublicService.On(
+				"GetGroups",
+				mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+				payload.GetPublicGroups{Page: 1, Limit: 1},
+			).Return(
+				func(ctx context.Context, p payload.GetPublicGroups) []response.PublicGroup {
+					return dummyGroups
+				},
+				func(ctx context.Context, p payload.GetPublicGroups) response.Pagination {
+					return response.Pagination{Page: 1, Limit: 1, TotalItems: 2, TotalPages: 2}
+				},
+				func(ctx context.Context, p payload.GetPublicGroups) error {
+					return testCase.inputError
+				},
+			).Once()
+
+			controller := NewPublicController(mockPublicService)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/public/groups?page=1&limit=1", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/api/v1/public/groups")
+
+			gotError := controller.getPublicGroups(c)
+			if testCase.inputError == nil {
+				if assert.NoError(t, gotError) {
+					assert.Equal(t, testCase.expectedStatusCode, rec.Code)
+
+					gotResponse := publicGroupsResponse{}
+					if err := json.Unmarshal(rec.Body.Bytes(), &gotResponse); assert.NoError(t, err) {
+						assert.Equal(t, dummyGroups, gotResponse.Data.Groups)
+						assert.Equal(t, "/api/v1/public/groups?limit=1&page=2", gotResponse.Data.Pagination.Next)
+					}
+				}
+				return
+			}
+
+			if assert.Error(t, gotError) {
+				if echoHTTPError, ok := gotError.(*echo.HTTPError); assert.Equal(t, true, ok) {
+					assert.Equal(t, testCase.expectedStatusCode, echoHTTPError.Code)
+					assert.Equal(t, testCase.expectedErrorMessage, echoHTTPError.Message)
+				}
+			}
+		})
+	}
+}
+
+func TestGetPublicGroupByID(t *testing.T) {
+	mockPublicService := &mocks.PublicService{}
+
+	dummyGroup := response.PublicGroup{
+		ID:           "g-xyz",
+		Name:         "Paguyuban Reog Singo Mudho",
+		VillageName:  "Bibis",
+		DistrictName: "Bungkal",
+	}
+
+	testCases := []struct {
+		name                 string
+		inputError           error
+		expectedStatusCode   int
+		expectedErrorMessage string
+	}{
+		{
+			name:               "it should return 200 status code, when there is no error",
+			inputError:         nil,
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name:                 "it should return 404 status code, when group ID not found",
+			inputError:           service.ErrDataNotFound,
+			expectedStatusCode:   http.StatusNotFound,
+			expectedErrorMessage: "Resource with given ID not found.",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockPublicService.On(
+				"GetGroupByID",
+				mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+				"g-xyz",
+			).Return(
+				func(ctx context.Context, id string) response.PublicGroup {
+					return dummyGroup
+				},
+				func(ctx context.Context, id string) error {
+					return testCase.inputError
+				},
+			).Once()
+
+			controller := NewPublicController(mockPublicService)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/api/v1/public/groups/:id")
+			c.SetParamNames("id")
+			c.SetParamValues("g-xyz")
+
+			gotError := controller.getPublicGroupByID(c)
+			if testCase.inputError == nil {
+				if assert.NoError(t, gotError) {
+					assert.Equal(t, testCase.expectedStatusCode, rec.Code)
+
+					gotResponse := publicGroupResponse{}
+					if err := json.Unmarshal(rec.Body.Bytes(), &gotResponse); assert.NoError(t, err) {
+						assert.Equal(t, dummyGroup, gotResponse.Data.Group)
+					}
+				}
+				return
+			}
+
+			if assert.Error(t, gotError) {
+				if echoHTTPError, ok := gotError.(*echo.HTTPError); assert.Equal(t, true, ok) {
+					assert.Equal(t, testCase.expectedStatusCode, echoHTTPError.Code)
+					assert.Equal(t, testCase.expectedErrorMessage, echoHTTPError.Message)
+				}
+			}
+		})
+	}
+}
+
+func TestGetUpcomingShows(t *testing.T) {
+	mockPublicService := &mocks.PublicService{}
+
+	dummyShows := []response.PublicShowSchedule{
+		{
+			ID:           "s-aBcdEfG",
+			GroupID:      "g-xyz",
+			GroupName:    "Paguyuban Reog Singo Mudho",
+			VillageName:  "Bibis",
+			DistrictName: "Bungkal",
+			Place:        "Alun-Alun Ponorogo",
+			StartOn:      "14 May 22 19:00 UTC",
+			FinishOn:     "14 May 22 21:00 UTC",
+		},
+	}
+
+	testCases := []struct {
+		name                 string
+		inputError           error
+		expectedStatusCode   int
+		expectedErrorMessage string
+	}{
+		{
+			name:               "it should return 200 status code, when there is no error",
+			inputError:         nil,
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name:                 "it should return 500 status code, when error happened",
+			inputError:           service.ErrRepository,
+			expectedStatusCode:   http.StatusInternalServerError,
+			expectedErrorMessage: "Something went wrong.",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockPublicService.On(
+				"GetUpcomingShows",
+				mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+				payload.GetUpcomingShows{GroupID: "g-xyz"},
+			).Return(
+				func(ctx context.Context, p payload.GetUpcomingShows) []response.PublicShowSchedule {
+					return dummyShows
+				},
+				func(ctx context.Context, p payload.GetUpcomingShows) error {
+					return testCase.inputError
+				},
+			).Once()
+
+			controller := NewPublicController(mockPublicService)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/?group_id=g-xyz", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/api/v1/public/shows")
+
+			gotError := controller.getUpcomingShows(c)
+			if testCase.inputError == nil {
+				if assert.NoError(t, gotError) {
+					assert.Equal(t, testCase.expectedStatusCode, rec.Code)
+
+					gotResponse := upcomingShowsResponse{}
+					if err := json.Unmarshal(rec.Body.Bytes(), &gotResponse); assert.NoError(t, err) {
+						assert.Equal(t, dummyShows, gotResponse.Data.Shows)
+					}
+				}
+				return
+			}
+
+			if assert.Error(t, gotError) {
+				if echoHTTPError, ok := gotError.(*echo.HTTPError); assert.Equal(t, true, ok) {
+					assert.Equal(t, testCase.expectedStatusCode, echoHTTPError.Code)
+					assert.Equal(t, testCase.expectedErrorMessage, echoHTTPError.Message)
+				}
+			}
+		})
+	}
+}
+
+func TestGetScan(t *testing.T) {
+	mockPublicService := &mocks.PublicService{}
+
+	dummyResult := response.ScanResult{
+		Type: "property",
+		Group: response.PublicGroup{
+			ID:           "g-xyz",
+			Name:         "Paguyuban Reog Singo Mudho",
+			VillageName:  "Bibis",
+			DistrictName: "Bungkal",
+			Instagram:    "https://instagram.com/singomudho",
+		},
+		Property: &response.PublicProperty{
+			ID:          "p-abc",
+			Name:        "Dadak Merak",
+			Description: "Topeng kepala singa dengan bulu merak",
+			Amount:      2,
+		},
+	}
+
+	testCases := []struct {
+		name                 string
+		inputAccept          string
+		inputError           error
+		expectedStatusCode   int
+		expectedContentType  string
+		expectedBody         string
+		expectedErrorMessage string
+	}{
+		{
+			name:                "it should return the scan result as JSON, when the client is not a browser",
+			inputAccept:         echo.MIMEApplicationJSON,
+			expectedStatusCode:  http.StatusOK,
+			expectedContentType: echo.MIMEApplicationJSONCharsetUTF8,
+		},
+		{
+			name:                "it should return a landing page, when the client is a browser",
+			inputAccept:         "text/html,application/xhtml+xml,application/xml;q=0.9,*/*;q=0.8",
+			expectedStatusCode:  http.StatusOK,
+			expectedContentType: echo.MIMETextHTMLCharsetUTF8,
+			expectedBody:        "Dadak Merak",
+		},
+		{
+			name:                "it should return a not found page, when a browser scans an unknown code",
+			inputAccept:         "text/html,*/*;q=0.8",
+			inputError:          service.ErrDataNotFound,
+			expectedStatusCode:  http.StatusNotFound,
+			expectedContentType: echo.MIMETextHTMLCharsetUTF8,
+			expectedBody:        "Not Found",
+		},
+		{
+			name:                "it should return a warning page, when a browser scans a forged code",
+			inputAccept:         "text/html,*/*;q=0.8",
+			inputError:          service.ErrCodeNotGenuine,
+			expectedStatusCode:  http.StatusBadRequest,
+			expectedContentType: echo.MIMETextHTMLCharsetUTF8,
+			expectedBody:        "Not Genuine",
+		},
+		{
+			name:                 "it should return 404 status code, when an API client scans an unknown code",
+			inputAccept:          echo.MIMEApplicationJSON,
+			inputError:           service.ErrDataNotFound,
+			expectedStatusCode:   http.StatusNotFound,
+			expectedErrorMessage: "Resource with given ID not found.",
+		},
+		{
+			name:                 "it should return 500 status code, when repository error happened",
+			inputAccept:          "text/html",
+			inputError:           service.ErrRepository,
+			expectedStatusCode:   http.StatusInternalServerError,
+			expectedErrorMessage: "Something went wrong.",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockPublicService.On(
+				"Scan",
+				mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+				"p-abc",
+			).Return(
+				func(ctx context.Context, code string) response.ScanResult {
+					if testCase.inputError != nil {
+						return response.ScanResult{}
+					}
+					return dummyResult
+				},
+				func(ctx context.Context, code string) error {
+					return testCase.inputError
+				},
+			).Once()
+
+			controller := NewPublicController(mockPublicService)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			req.Header.Set(echo.HeaderAccept, testCase.inputAccept)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/api/v1/scan/:code")
+			c.SetParamNames("code")
+			c.SetParamValues("p-abc")
+
+			gotError := controller.getScan(c)
+			if testCase.expectedErrorMessage == "" {
+				if assert.NoError(t, gotError) {
+					assert.Equal(t, testCase.expectedStatusCode, rec.Code)
+					assert.Equal(t, testCase.expectedContentType, rec.Header().Get(echo.HeaderContentType))
+					assert.Equal(t, echo.HeaderAccept, rec.Header().Get(echo.HeaderVary))
+
+					if testCase.expectedBody != "" {
+						assert.Contains(t, rec.Body.String(), testCase.expectedBody)
+						return
+					}
+
+					gotResponse := scanResponse{}
+					if err := json.Unmarshal(rec.Body.Bytes(), &gotResponse); assert.NoError(t, err) {
+						assert.Equal(t, dummyResult, gotResponse.Data.Scan)
+					}
+				}
+				return
+			}
+
+			if assert.Error(t, gotError) {
+				if echoHTTPError, ok := gotError.(*echo.HTTPError); assert.Equal(t, true, ok) {
+					assert.Equal(t, testCase.expectedStatusCode, echoHTTPError.Code)
+					assert.Equal(t, testCase.expectedErrorMessage, echoHTTPError.Message)
+				}
+			}
+		})
+	}
+}
+
+func TestGetVerifyCode(t *testing.T) {
+	mockPublicService := &mocks.PublicService{}
+
+	dummyVerification := response.CodeVerification{
+		KeyID:       "k1",
+		Type:        "property",
+		ID:          "p-abc",
+		GroupID:     "g-xyz",
+		GroupName:   "Paguyuban Reog Singo Mudho",
+		GroupStatus: "active",
+		IssuedOn:    "2022-10-18",
+	}
+
+	testCases := []struct {
+		name                 string
+		inputError           error
+		expectedStatusCode   int
+		expectedErrorMessage string
+	}{
+		{
+			name:               "it should return 200 status code, when the code is genuine",
+			inputError:         nil,
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name:                 "it should return 400 status code, when the code is not genuine",
+			inputError:           service.ErrCodeNotGenuine,
+			expectedStatusCode:   http.StatusBadRequest,
+			expectedErrorMessage: "Code is not genuine. It is malformed, tampered with or signed with an unknown key.",
+		},
+		{
+			name:                 "it should return 404 status code, when what the code stands for is deleted",
+			inputError:           service.ErrDataNotFound,
+			expectedStatusCode:   http.StatusNotFound,
+			expectedErrorMessage: "Resource with given ID not found.",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockPublicService.On(
+				"Verify",
+				mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+				"k1.property.p-abc.g-xyz.20221018.c2lnbmF0dXJl",
+			).Return(
+				func(ctx context.Context, code string) response.CodeVerification {
+					return dummyVerification
+				},
+				func(ctx context.Context, code string) error {
+					return testCase.inputError
+				},
+			).Once()
+
+			controller := NewPublicController(mockPublicService)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/api/v1/scan/:code/verify")
+			c.SetParamNames("code")
+			c.SetParamValues("k1.property.p-abc.g-xyz.20221018.c2lnbmF0dXJl")
+
+			gotError := controller.getVerifyCode(c)
+			if testCase.inputError == nil {
+				if assert.NoError(t, gotError) {
+					assert.Equal(t, testCase.expectedStatusCode, rec.Code)
+
+					gotResponse := codeVerificationResponse{}
+					if err := json.Unmarshal(rec.Body.Bytes(), &gotResponse); assert.NoError(t, err) {
+						assert.Equal(t, dummyVerification, gotResponse.Data.Verification)
+					}
+				}
+				return
+			}
+
+			if assert.Error(t, gotError) {
+				if echoHTTPError, ok := gotError.(*echo.HTTPError); assert.Equal(t, true, ok) {
+					assert.Equal(t, testCase.expectedStatusCode, echoHTTPError.Code)
+					assert.Equal(t, testCase.expectedErrorMessage, echoHTTPError.Message)
+				}
+			}
+		})
+	}
+}
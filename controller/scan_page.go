@@ -0,0 +1,67 @@
+package controller
+
+import (
+	"html/template"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+)
+
+// scanPageTemplate is the landing page shown when a QR code is scanned with a phone camera, which opens the
+// deep link in a browser instead of calling the API.
+var scanPageTemplate = template.Must(template.New("scan").Parse(`<!DOCTYPE html>
+<html lang="id">
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<title>{{if .Found}}{{if .Result.Property}}{{.Result.Property.Name}} - {{end}}{{.Result.Group.Name}}{{else if .Forged}}Not Genuine{{else}}Not Found{{end}}</title>
+<style>
+body{font-family:sans-serif;margin:0 auto;max-width:32rem;padding:1.5rem;color:#222}
+h1{font-size:1.5rem;margin-bottom:.25rem}
+.muted{color:#666}
+.verified{color:#1a7f37}
+.forged{color:#b42318}
+ul{padding-left:1.25rem}
+</style>
+</head>
+<body>
+{{if .Found}}{{with .Result}}
+{{if .Verified}}<p class="verified">&#10003; Genuine code</p>{{end}}
+{{if .Property}}
+<h1>{{.Property.Name}}</h1>
+<p class="muted">Property of {{.Group.Name}}</p>
+{{if .Property.Description}}<p>{{.Property.Description}}</p>{{end}}
+<p>Amount: {{.Property.Amount}}</p>
+<hr>
+{{end}}
+<h2>{{.Group.Name}}</h2>
+<p class="muted">{{.Group.VillageName}}, {{.Group.DistrictName}}</p>
+<ul>
+{{if .Group.Website}}<li><a href="{{.Group.Website}}">Website</a></li>{{end}}
+{{if .Group.Instagram}}<li><a href="{{.Group.Instagram}}">Instagram</a></li>{{end}}
+{{if .Group.Facebook}}<li><a href="{{.Group.Facebook}}">Facebook</a></li>{{end}}
+{{if .Group.YouTube}}<li><a href="{{.Group.YouTube}}">YouTube</a></li>{{end}}
+{{if .Group.TikTok}}<li><a href="{{.Group.TikTok}}">TikTok</a></li>{{end}}
+</ul>
+{{end}}{{else if .Forged}}
+<h1 class="forged">Not Genuine</h1>
+<p class="muted">The scanned code has been tampered with or was not issued by the registry. Please report it.</p>
+{{else}}
+<h1>Not Found</h1>
+<p class="muted">The scanned code does not belong to any active group or property.</p>
+{{end}}
+</body>
+</html>
+`))
+
+// prefersHTML reports whether the client asks for text/html before application/json, as browsers do.
+func prefersHTML(c echo.Context) bool {
+	accept := c.Request().Header.Get(echo.HeaderAccept)
+	html := strings.Index(accept, echo.MIMETextHTML)
+	if html < 0 {
+		return false
+	}
+
+	json := strings.Index(accept, echo.MIMEApplicationJSON)
+	return json < 0 || html < json
+}
@@ -0,0 +1,66 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/erikrios/reog-apps-apis/middleware"
+	"github.com/erikrios/reog-apps-apis/model"
+	"github.com/erikrios/reog-apps-apis/model/payload"
+	"github.com/erikrios/reog-apps-apis/model/response"
+	"github.com/erikrios/reog-apps-apis/service"
+	"github.com/erikrios/reog-apps-apis/service/search"
+	"github.com/labstack/echo/v4"
+)
+
+type searchController struct {
+	service search.SearchService
+}
+
+func NewSearchController(service search.SearchService) *searchController {
+	return &searchController{service: service}
+}
+
+func (s *searchController) Route(e *echo.Group) {
+	group := e.Group("/search", middleware.JWTMiddleware())
+	group.GET("", s.getSearch)
+}
+
+// getSearch     godoc
+// @Summary      Search
+// @Description  Search group names, leader names, addresses, property names and descriptions, and show schedule places, from the most relevant result
+// @Tags         search
+// @Produce      json
+// @Param        q      query  string  true   "search query, quoted phrases, OR and -word are supported"
+// @Param        limit  query  int     false  "number of results, 20 by default, maximum 50"
+// @Security     ApiKeyAuth
+// @Success      200  {object}  searchResponse
+// @Failure      400  {object}  echo.HTTPError
+// @Failure      401  {object}  echo.HTTPError
+// @Failure      500  {object}  echo.HTTPError
+// @Router       /search [get]
+func (s *searchController) getSearch(c echo.Context) error {
+	payload := new(payload.Search)
+	if err := c.Bind(payload); err != nil {
+		return newErrorResponse(service.ErrInvalidPayload)
+	}
+
+	results, err := s.service.Search(c.Request().Context(), *payload)
+	if err != nil {
+		return newErrorResponse(err)
+	}
+
+	resultsResponse := map[string]any{"results": results}
+	response := model.NewResponse("success", "successfully search", resultsResponse)
+	return c.JSON(http.StatusOK, response)
+}
+
+// searchResponse struct is used for swaggo to generate the API documentation, as it doesn't support generic yet.
+type searchResponse struct {
+	Status  string     `json:"status" extensions:"x-order=0"`
+	Message string     `json:"message" extensions:"x-order=1"`
+	Data    searchData `json:"data" extensions:"x-order=2"`
+}
+
+type searchData struct {
+	Results []response.SearchResult `json:"results"`
+}
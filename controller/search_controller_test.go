@@ -0,0 +1,113 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/erikrios/reog-apps-apis/model"
+	"github.com/erikrios/reog-apps-apis/model/payload"
+	"github.com/erikrios/reog-apps-apis/model/response"
+	"github.com/erikrios/reog-apps-apis/service"
+	"github.com/erikrios/reog-apps-apis/service/search/mocks"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestRouteSearch(t *testing.T) {
+	mockSearchService := &mocks.SearchService{}
+	controller := NewSearchController(mockSearchService)
+	g := echo.New().Group("/api/v1")
+	controller.Route(g)
+	assert.NotNil(t, controller)
+}
+
+func TestGetSearch(t *testing.T) {
+	mockSearchService := &mocks.SearchService{}
+
+	dummyResults := []response.SearchResult{
+		{
+			Type:      "property",
+			ID:        "p-YIhpPgp",
+			GroupID:   "g-Nzo",
+			GroupName: "Paguyuban Reog Sukorejo",
+			Title:     "Barongan",
+			Snippet:   "<mark>Barongan</mark> milik paguyuban",
+			Rank:      0.06,
+		},
+	}
+
+	testCases := []struct {
+		name                 string
+		inputError           error
+		expectedStatusCode   int
+		expectedErrorMessage string
+	}{
+		{
+			name:               "it should return 200 status code with valid response, when there is no error",
+			inputError:         nil,
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name:                 "it should return 400 status code, when query is invalid",
+			inputError:           service.ErrInvalidPayload,
+			expectedStatusCode:   http.StatusBadRequest,
+			expectedErrorMessage: "Invalid payload. Please check the payload schema in the API Documentation.",
+		},
+		{
+			name:                 "it should return 500 status code, when error happened",
+			inputError:           service.ErrRepository,
+			expectedStatusCode:   http.StatusInternalServerError,
+			expectedErrorMessage: "Something went wrong.",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockSearchService.On(
+				"Search",
+				mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+				payload.Search{Query: "barongan", Limit: 10},
+			).Return(
+				func(ctx context.Context, p payload.Search) []response.SearchResult {
+					return dummyResults
+				},
+				func(ctx context.Context, p payload.Search) error {
+					return testCase.inputError
+				},
+			).Once()
+
+			controller := NewSearchController(mockSearchService)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/?q=barongan&limit=10", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/api/v1/search")
+
+			gotError := controller.getSearch(c)
+			if testCase.inputError == nil {
+				if assert.NoError(t, gotError) {
+					assert.Equal(t, testCase.expectedStatusCode, rec.Code)
+
+					gotResponse := &model.Response[searchData]{}
+					if err := json.Unmarshal(rec.Body.Bytes(), gotResponse); assert.NoError(t, err) {
+						assert.Equal(t, dummyResults, gotResponse.Data.Results)
+					}
+				}
+				return
+			}
+
+			if assert.Error(t, gotError) {
+				if echoHTTPError, ok := gotError.(*echo.HTTPError); assert.Equal(t, true, ok) {
+					assert.Equal(t, testCase.expectedStatusCode, echoHTTPError.Code)
+					assert.Equal(t, testCase.expectedErrorMessage, echoHTTPError.Message)
+				}
+			}
+		})
+	}
+}
@@ -26,7 +26,10 @@ func (s *showSchedulesController) Route(e *echo.Group) {
 	group.GET("", s.getShowSchedules)
 	group.GET("/:id", s.getShowScheduleByID)
 	group.PUT("/:id", s.putUpdateShowScheduleByID)
+	group.PATCH("/:id", s.patchShowScheduleByID)
 	group.DELETE("/:id", s.deleteShowScheduleByID)
+
+	e.GET("/shows.geojson", s.getShowSchedulesGeoJSON, middleware.JWTMiddleware())
 }
 
 // postCreateShowSchedule godoc
@@ -72,16 +75,7 @@ func (s *showSchedulesController) postCreateShowSchedule(c echo.Context) error {
 // @Failure      500  {object}  echo.HTTPError
 // @Router       /shows [get]
 func (s *showSchedulesController) getShowSchedules(c echo.Context) error {
-	groupID := c.QueryParam("group_id")
-	var showSchedules []response.ShowSchedule
-	var err error
-
-	if groupID == "" {
-		showSchedules, err = s.service.GetAll(c.Request().Context())
-	} else {
-		showSchedules, err = s.service.GetByGroupID(c.Request().Context(), groupID)
-	}
-
+	showSchedules, err := s.findShowSchedules(c)
 	if err != nil {
 		return newErrorResponse(err)
 	}
@@ -91,18 +85,50 @@ func (s *showSchedulesController) getShowSchedules(c echo.Context) error {
 	return c.JSON(http.StatusOK, responses)
 }
 
+// getShowSchedulesGeoJSON godoc
+// @Summary      Get Show Schedules as GeoJSON
+// @Description  Get show schedules as a GeoJSON feature collection, located by their venue. Shows without a known venue location have a null geometry.
+// @Tags         shows
+// @Produce      application/geo+json
+// @Param        group_id  query  string  false  "filter show schedules by group ID"
+// @Security     ApiKeyAuth
+// @Success      200  {object}  showsGeoJSON
+// @Failure      401  {object}  echo.HTTPError
+// @Failure      404  {object}  echo.HTTPError
+// @Failure      500  {object}  echo.HTTPError
+// @Router       /shows.geojson [get]
+func (s *showSchedulesController) getShowSchedulesGeoJSON(c echo.Context) error {
+	showSchedules, err := s.findShowSchedules(c)
+	if err != nil {
+		return newErrorResponse(err)
+	}
+
+	c.Response().Header().Set(echo.HeaderContentType, mimeGeoJSON)
+	return c.JSON(http.StatusOK, showsFeatureCollection(showSchedules))
+}
+
+// findShowSchedules returns the show schedules matching the group_id query param, or all of them when it is empty.
+func (s *showSchedulesController) findShowSchedules(c echo.Context) ([]response.ShowSchedule, error) {
+	if groupID := c.QueryParam("group_id"); groupID != "" {
+		return s.service.GetByGroupID(c.Request().Context(), groupID)
+	}
+	return s.service.GetAll(c.Request().Context())
+}
+
 // getShowScheduleByID godoc
 // @Summary      Get Show Schedule by ID
-// @Description  Get Show Schedule by ID
+// @Description  Get Show Schedule by ID. The ETag header is sent back in the If-Match header to update or delete the show schedule.
 // @Tags         shows
 // @Produce      json
-// @Param        id  path  string  true  "show schedule ID"
+// @Param        id             path    string  true   "show schedule ID"
+// @Param        If-None-Match  header  string  false  "ETag of the show schedule the client already has"
 // @Security     ApiKeyAuth
 // @Success      200  {object}  showScheduleResponse
+// @Success      304
 // @Failure      401  {object}  echo.HTTPError
 // @Failure      404  {object}  echo.HTTPError
 // @Failure      500  {object}  echo.HTTPError
-// @Router       /groups/{id} [get]
+// @Router       /shows/{id} [get]
 func (s *showSchedulesController) getShowScheduleByID(c echo.Context) error {
 	id := c.Param("id")
 
@@ -112,6 +138,10 @@ func (s *showSchedulesController) getShowScheduleByID(c echo.Context) error {
 		return newErrorResponse(err)
 	}
 
+	if notModified(c, newETag(show.Version, show)) {
+		return c.NoContent(http.StatusNotModified)
+	}
+
 	showScheduleResponse := map[string]any{"show": show}
 	response := model.NewResponse("success", "successfully get show schedule with id "+id, showScheduleResponse)
 	return c.JSON(http.StatusOK, response)
@@ -123,28 +153,74 @@ func (s *showSchedulesController) getShowScheduleByID(c echo.Context) error {
 // @Tags         shows
 // @Accept       json
 // @Produce      json
-// @Param        default  body  payload.UpdateShowSchedule  true  "request body"
-// @Param        id       path  string                      true  "show schedule ID"
+// @Param        default   body    payload.UpdateShowSchedule  true  "request body"
+// @Param        id        path    string                      true  "show schedule ID"
+// @Param        If-Match  header  string                      true  "ETag of the show schedule the update is based on"
 // @Security     ApiKeyAuth
 // @Success      204
 // @Failure      400  {object}  echo.HTTPError
 // @Failure      401  {object}  echo.HTTPError
 // @Failure      404  {object}  echo.HTTPError
+// @Failure      412  {object}  echo.HTTPError
+// @Failure      428  {object}  echo.HTTPError
 // @Failure      500  {object}  echo.HTTPError
 // @Router       /shows/{id} [put]
 func (s *showSchedulesController) putUpdateShowScheduleByID(c echo.Context) error {
 	id := c.Param("id")
 
+	version, err := ifMatchVersion(c)
+	if err != nil {
+		return newErrorResponse(err)
+	}
+
 	payload := new(payload.UpdateShowSchedule)
 	if err := c.Bind(payload); err != nil {
 		return newErrorResponse(service.ErrInvalidPayload)
 	}
 
-	err := s.service.Update(c.Request().Context(), id, *payload)
+	if err := s.service.Update(c.Request().Context(), id, version, *payload); err != nil {
+		return newErrorResponse(err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// patchShowScheduleByID godoc
+// @Summary      Patch a Show Schedule
+// @Description  Change some fields of a show schedule with a JSON merge patch (RFC 7396). Absent fields are kept, null is rejected.
+// @Tags         shows
+// @Accept       application/merge-patch+json,json
+// @Produce      json
+// @Param        default   body    payload.PatchShowSchedule  true  "merge patch"
+// @Param        id        path    string                     true  "show schedule ID"
+// @Param        If-Match  header  string                     true  "ETag of the show schedule the patch is based on"
+// @Security     ApiKeyAuth
+// @Success      204
+// @Failure      400  {object}  echo.HTTPError
+// @Failure      401  {object}  echo.HTTPError
+// @Failure      404  {object}  echo.HTTPError
+// @Failure      412  {object}  echo.HTTPError
+// @Failure      415  {object}  echo.HTTPError
+// @Failure      428  {object}  echo.HTTPError
+// @Failure      500  {object}  echo.HTTPError
+// @Router       /shows/{id} [patch]
+func (s *showSchedulesController) patchShowScheduleByID(c echo.Context) error {
+	id := c.Param("id")
+
+	version, err := ifMatchVersion(c)
 	if err != nil {
 		return newErrorResponse(err)
 	}
 
+	payload := new(payload.PatchShowSchedule)
+	if err := bindMergePatch(c, payload); err != nil {
+		return err
+	}
+
+	if err := s.service.Patch(c.Request().Context(), id, version, *payload); err != nil {
+		return newErrorResponse(err)
+	}
+
 	return c.NoContent(http.StatusNoContent)
 }
 
@@ -153,22 +229,28 @@ func (s *showSchedulesController) putUpdateShowScheduleByID(c echo.Context) erro
 // @Description  Delete show schedule by ID
 // @Tags         shows
 // @Produce      json
-// @Param        id  path  string  true  "show schedule ID"
+// @Param        id        path    string  true  "show schedule ID"
+// @Param        If-Match  header  string  true  "ETag of the show schedule the deletion is based on"
 // @Security     ApiKeyAuth
 // @Success      204
 // @Failure      404  {object}  echo.HTTPError
 // @Failure      401  {object}  echo.HTTPError
+// @Failure      412  {object}  echo.HTTPError
+// @Failure      428  {object}  echo.HTTPError
 // @Failure      500  {object}  echo.HTTPError
 // @Router       /shows/{id} [delete]
 func (s *showSchedulesController) deleteShowScheduleByID(c echo.Context) error {
 	id := c.Param("id")
 
-	err := s.service.Delete(c.Request().Context(), id)
-
+	version, err := ifMatchVersion(c)
 	if err != nil {
 		return newErrorResponse(err)
 	}
 
+	if err := s.service.Delete(c.Request().Context(), id, version); err != nil {
+		return newErrorResponse(err)
+	}
+
 	return c.NoContent(http.StatusNoContent)
 }
 
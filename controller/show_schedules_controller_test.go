@@ -324,6 +324,101 @@ func TestGetShowSchedules(t *testing.T) {
 	})
 }
 
+func TestGetShowSchedulesGeoJSON(t *testing.T) {
+	mockShowScheduleService := &mocks.ShowScheduleService{}
+
+	t.Run("success scenario", func(t *testing.T) {
+		latitude, longitude := -7.95, 111.42
+		dummyShowSchedules := []response.ShowSchedule{
+			{
+				ID:        "s-abcdefg",
+				GroupID:   "g-xyz",
+				Place:     "Lapangan Bungkal",
+				StartOn:   "09 May 22 13:00 WIB",
+				FinishOn:  "09 May 22 17:00 WIB",
+				Latitude:  &latitude,
+				Longitude: &longitude,
+			},
+			{
+				ID:       "s-hijklmn",
+				GroupID:  "g-xyz",
+				Place:    "Alun-Alun Ponorogo",
+				StartOn:  "10 May 22 19:00 WIB",
+				FinishOn: "10 May 22 22:00 WIB",
+			},
+		}
+
+		mockShowScheduleService.On(
+			"GetByGroupID",
+			mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+			"g-xyz",
+		).Return(
+			func(ctx context.Context, groupID string) []response.ShowSchedule {
+				return dummyShowSchedules
+			},
+			func(ctx context.Context, groupID string) error {
+				return nil
+			},
+		).Once()
+
+		t.Run("it should return a feature collection located by the venues, when there is no error", func(t *testing.T) {
+			controller := NewShowSchedulesController(mockShowScheduleService)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/shows.geojson?group_id=g-xyz", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			if assert.NoError(t, controller.getShowSchedulesGeoJSON(c)) {
+				assert.Equal(t, http.StatusOK, rec.Code)
+				assert.Equal(t, "application/geo+json", rec.Header().Get(echo.HeaderContentType))
+
+				gotCollection := featureCollection[response.ShowSchedule]{}
+				if err := json.Unmarshal(rec.Body.Bytes(), &gotCollection); assert.NoError(t, err) {
+					assert.Equal(t, "FeatureCollection", gotCollection.Type)
+					if assert.Len(t, gotCollection.Features, 2) {
+						assert.Equal(t, "s-abcdefg", gotCollection.Features[0].ID)
+						assert.Equal(t, &geometry{Type: "Point", Coordinates: []float64{111.42, -7.95}}, gotCollection.Features[0].Geometry)
+						assert.Equal(t, dummyShowSchedules[0], gotCollection.Features[0].Properties)
+						assert.Nil(t, gotCollection.Features[1].Geometry)
+					}
+				}
+			}
+		})
+	})
+
+	t.Run("failed scenario", func(t *testing.T) {
+		mockShowScheduleService.On(
+			"GetAll",
+			mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+		).Return(
+			func(ctx context.Context) []response.ShowSchedule {
+				return []response.ShowSchedule{}
+			},
+			func(ctx context.Context) error {
+				return service.ErrRepository
+			},
+		).Once()
+
+		t.Run("it should return 500 status code, when error happened", func(t *testing.T) {
+			controller := NewShowSchedulesController(mockShowScheduleService)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/shows.geojson", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			gotError := controller.getShowSchedulesGeoJSON(c)
+			if assert.Error(t, gotError) {
+				if echoHTTPError, ok := gotError.(*echo.HTTPError); assert.Equal(t, true, ok) {
+					assert.Equal(t, http.StatusInternalServerError, echoHTTPError.Code)
+					assert.Equal(t, "Something went wrong.", echoHTTPError.Message)
+				}
+			}
+		})
+	})
+}
+
 func TestGetShowScheduleByID(t *testing.T) {
 	mockShowScheduleService := &mocks.ShowScheduleService{}
 
@@ -335,6 +430,7 @@ func TestGetShowScheduleByID(t *testing.T) {
 			Place:     "Lapangan Bungkal",
 			StartOn:   "09 May 22 13:00 WIB",
 			FinishOn:  "09 May 22 17:00 WIB",
+			Version:   2,
 		}
 
 		dummyShowsResponse := map[string]any{"show": dummyShow}
@@ -351,7 +447,7 @@ func TestGetShowScheduleByID(t *testing.T) {
 			func(ctx context.Context, id string) error {
 				return nil
 			},
-		).Once()
+		).Times(2)
 
 		t.Run("it should return 200 status code with valid response, when there is no error", func(t *testing.T) {
 			controller := NewShowSchedulesController(mockShowScheduleService)
@@ -367,6 +463,7 @@ func TestGetShowScheduleByID(t *testing.T) {
 
 			if assert.NoError(t, controller.getShowScheduleByID(c)) {
 				assert.Equal(t, http.StatusOK, rec.Code)
+				assert.Equal(t, newETag(2, dummyShow), rec.Header().Get(headerETag))
 
 				body := rec.Body.String()
 
@@ -377,6 +474,25 @@ func TestGetShowScheduleByID(t *testing.T) {
 				}
 			}
 		})
+
+		t.Run("it should return 304 status code without body, when If-None-Match has the current ETag", func(t *testing.T) {
+			controller := NewShowSchedulesController(mockShowScheduleService)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/shows", nil)
+			req.Header.Set(headerIfNoneMatch, `W/"1-0000000000000000", `+newETag(2, dummyShow))
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/:id")
+			c.SetParamNames("id")
+			c.SetParamValues(dummyShow.ID)
+
+			if assert.NoError(t, controller.getShowScheduleByID(c)) {
+				assert.Equal(t, http.StatusNotModified, rec.Code)
+				assert.Equal(t, newETag(2, dummyShow), rec.Header().Get(headerETag))
+				assert.Empty(t, rec.Body.String())
+			}
+		})
 	})
 
 	t.Run("failed scenario", func(t *testing.T) {
@@ -467,9 +583,10 @@ func TestPutUpdateShowScheduleByID(t *testing.T) {
 			"Update",
 			mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
 			mock.AnythingOfType(fmt.Sprintf("%T", "")),
+			mock.AnythingOfType(fmt.Sprintf("%T", 0)),
 			mock.AnythingOfType(fmt.Sprintf("%T", payload.UpdateShowSchedule{})),
 		).Return(
-			func(ctx context.Context, id string, p payload.UpdateShowSchedule) error {
+			func(ctx context.Context, id string, version int, p payload.UpdateShowSchedule) error {
 				return nil
 			},
 		).Once()
@@ -481,6 +598,7 @@ func TestPutUpdateShowScheduleByID(t *testing.T) {
 
 			e := echo.New()
 			req := httptest.NewRequest(http.MethodPut, "/api/v1/shows", strings.NewReader(string(requestBody)))
+			req.Header.Set(headerIfMatch, `"2"`)
 			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 			rec := httptest.NewRecorder()
 			c := e.NewContext(req, rec)
@@ -518,9 +636,10 @@ func TestPutUpdateShowScheduleByID(t *testing.T) {
 						"Update",
 						mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
 						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+						mock.AnythingOfType(fmt.Sprintf("%T", 0)),
 						mock.AnythingOfType(fmt.Sprintf("%T", payload.UpdateShowSchedule{})),
 					).Return(
-						func(ctx context.Context, id string, p payload.UpdateShowSchedule) error {
+						func(ctx context.Context, id string, version int, p payload.UpdateShowSchedule) error {
 							return service.ErrInvalidPayload
 						},
 					).Once()
@@ -536,14 +655,34 @@ func TestPutUpdateShowScheduleByID(t *testing.T) {
 						"Update",
 						mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
 						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+						mock.AnythingOfType(fmt.Sprintf("%T", 0)),
 						mock.AnythingOfType(fmt.Sprintf("%T", payload.UpdateShowSchedule{})),
 					).Return(
-						func(ctx context.Context, id string, p payload.UpdateShowSchedule) error {
+						func(ctx context.Context, id string, version int, p payload.UpdateShowSchedule) error {
 							return service.ErrDataNotFound
 						},
 					).Once()
 				},
 			},
+			{
+				name:                 "it should return 412 status code, when the resource has been modified",
+				inputPayload:         dummyReq,
+				expectedStatusCode:   http.StatusPreconditionFailed,
+				expectedErrorMessage: "Resource has been modified since it was read. Please get it again and retry.",
+				mockBehaviour: func() {
+					mockShowScheduleService.On(
+						"Update",
+						mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+						mock.AnythingOfType(fmt.Sprintf("%T", 0)),
+						mock.AnythingOfType(fmt.Sprintf("%T", payload.UpdateShowSchedule{})),
+					).Return(
+						func(ctx context.Context, id string, version int, p payload.UpdateShowSchedule) error {
+							return service.ErrVersionMismatch
+						},
+					).Once()
+				},
+			},
 			{
 				name:                 "it should return 500 status code, when error happened",
 				inputPayload:         dummyReq,
@@ -554,9 +693,10 @@ func TestPutUpdateShowScheduleByID(t *testing.T) {
 						"Update",
 						mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
 						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+						mock.AnythingOfType(fmt.Sprintf("%T", 0)),
 						mock.AnythingOfType(fmt.Sprintf("%T", payload.UpdateShowSchedule{})),
 					).Return(
-						func(ctx context.Context, id string, p payload.UpdateShowSchedule) error {
+						func(ctx context.Context, id string, version int, p payload.UpdateShowSchedule) error {
 							return service.ErrRepository
 						},
 					).Once()
@@ -574,6 +714,7 @@ func TestPutUpdateShowScheduleByID(t *testing.T) {
 
 				e := echo.New()
 				req := httptest.NewRequest(http.MethodPut, "/api/v1/shows", strings.NewReader(string(requestBody)))
+				req.Header.Set(headerIfMatch, `"2"`)
 				req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 				rec := httptest.NewRecorder()
 				c := e.NewContext(req, rec)
@@ -590,6 +731,149 @@ func TestPutUpdateShowScheduleByID(t *testing.T) {
 				}
 			})
 		}
+
+		t.Run("it should return 428 status code, when If-Match header is missing", func(t *testing.T) {
+			controller := NewShowSchedulesController(mockShowScheduleService)
+			requestBody, err := json.Marshal(dummyReq)
+			assert.NoError(t, err)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPut, "/api/v1/shows", strings.NewReader(string(requestBody)))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/:id")
+			c.SetParamNames("id")
+			c.SetParamValues("s-abcdefg")
+
+			gotError := controller.putUpdateShowScheduleByID(c)
+			if assert.Error(t, gotError) {
+				if echoHTTPError, ok := gotError.(*echo.HTTPError); assert.Equal(t, true, ok) {
+					assert.Equal(t, http.StatusPreconditionRequired, echoHTTPError.Code)
+					assert.Equal(t, "If-Match header is required. Please send the ETag of the resource the change is based on.", echoHTTPError.Message)
+				}
+			}
+		})
+	})
+}
+
+func TestPatchShowScheduleByID(t *testing.T) {
+	mockShowScheduleService := &mocks.ShowScheduleService{}
+
+	t.Run("success scenario", func(t *testing.T) {
+		finishOn := "09 May 22 19:00 WIB"
+
+		mockShowScheduleService.On(
+			"Patch",
+			mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+			"s-abcdefg",
+			2,
+			payload.PatchShowSchedule{FinishOn: &finishOn},
+		).Return(
+			func(ctx context.Context, id string, version int, p payload.PatchShowSchedule) error {
+				return nil
+			},
+		).Once()
+
+		t.Run("it should return 204 status code, when there is no error", func(t *testing.T) {
+			controller := NewShowSchedulesController(mockShowScheduleService)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPatch, "/api/v1/shows", strings.NewReader(`{"finishOn":"09 May 22 19:00 WIB"}`))
+			req.Header.Set(headerIfMatch, `"2-5f1c9a0e3b7d4c26"`)
+			req.Header.Set(echo.HeaderContentType, mimeApplicationMergePatchJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/:id")
+			c.SetParamNames("id")
+			c.SetParamValues("s-abcdefg")
+
+			if assert.NoError(t, controller.patchShowScheduleByID(c)) {
+				assert.Equal(t, http.StatusNoContent, rec.Code)
+			}
+		})
+	})
+
+	t.Run("failed scenario", func(t *testing.T) {
+		testCases := []struct {
+			name                 string
+			inputBody            string
+			expectedStatusCode   int
+			expectedErrorMessage string
+			mockBehaviour        func()
+		}{
+			{
+				name:                 "it should return 400 status code, when a member is null",
+				inputBody:            `{"place":null}`,
+				expectedStatusCode:   http.StatusBadRequest,
+				expectedErrorMessage: "Invalid payload. Please check the payload schema in the API Documentation.",
+				mockBehaviour:        func() {},
+			},
+			{
+				name:                 "it should return 400 status code, when the time format is invalid",
+				inputBody:            `{"startOn":"2022-05-09 13:00"}`,
+				expectedStatusCode:   http.StatusBadRequest,
+				expectedErrorMessage: "Invalid time format. Please use RFC822 time format (02 Jan 06 15:04 MST)",
+				mockBehaviour: func() {
+					mockShowScheduleService.On(
+						"Patch",
+						mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+						mock.AnythingOfType(fmt.Sprintf("%T", 0)),
+						mock.AnythingOfType(fmt.Sprintf("%T", payload.PatchShowSchedule{})),
+					).Return(
+						func(ctx context.Context, id string, version int, p payload.PatchShowSchedule) error {
+							return service.ErrTimeParsing
+						},
+					).Once()
+				},
+			},
+			{
+				name:                 "it should return 412 status code, when the show schedule has been modified",
+				inputBody:            `{"place":"Lapangan Bungkal"}`,
+				expectedStatusCode:   http.StatusPreconditionFailed,
+				expectedErrorMessage: "Resource has been modified since it was read. Please get it again and retry.",
+				mockBehaviour: func() {
+					mockShowScheduleService.On(
+						"Patch",
+						mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+						mock.AnythingOfType(fmt.Sprintf("%T", 0)),
+						mock.AnythingOfType(fmt.Sprintf("%T", payload.PatchShowSchedule{})),
+					).Return(
+						func(ctx context.Context, id string, version int, p payload.PatchShowSchedule) error {
+							return service.ErrVersionMismatch
+						},
+					).Once()
+				},
+			},
+		}
+
+		for _, testCase := range testCases {
+			t.Run(testCase.name, func(t *testing.T) {
+				testCase.mockBehaviour()
+
+				controller := NewShowSchedulesController(mockShowScheduleService)
+
+				e := echo.New()
+				req := httptest.NewRequest(http.MethodPatch, "/api/v1/shows", strings.NewReader(testCase.inputBody))
+				req.Header.Set(headerIfMatch, `"2"`)
+				req.Header.Set(echo.HeaderContentType, mimeApplicationMergePatchJSON)
+				rec := httptest.NewRecorder()
+				c := e.NewContext(req, rec)
+				c.SetPath("/:id")
+				c.SetParamNames("id")
+				c.SetParamValues("s-abcdefg")
+
+				gotError := controller.patchShowScheduleByID(c)
+				if assert.Error(t, gotError) {
+					if echoHTTPError, ok := gotError.(*echo.HTTPError); assert.Equal(t, true, ok) {
+						assert.Equal(t, testCase.expectedStatusCode, echoHTTPError.Code)
+						assert.Equal(t, testCase.expectedErrorMessage, echoHTTPError.Message)
+					}
+				}
+			})
+		}
 	})
 }
 
@@ -601,8 +885,9 @@ func TestDeleteShowScheduleByID(t *testing.T) {
 			"Delete",
 			mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
 			mock.AnythingOfType(fmt.Sprintf("%T", "")),
+			mock.AnythingOfType(fmt.Sprintf("%T", 0)),
 		).Return(
-			func(ctx context.Context, id string) error {
+			func(ctx context.Context, id string, version int) error {
 				return nil
 			},
 		).Once()
@@ -612,6 +897,7 @@ func TestDeleteShowScheduleByID(t *testing.T) {
 
 			e := echo.New()
 			req := httptest.NewRequest(http.MethodDelete, "/api/v1/shows", nil)
+			req.Header.Set(headerIfMatch, `"2"`)
 			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 			rec := httptest.NewRecorder()
 			c := e.NewContext(req, rec)
@@ -641,13 +927,31 @@ func TestDeleteShowScheduleByID(t *testing.T) {
 						"Delete",
 						mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
 						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+						mock.AnythingOfType(fmt.Sprintf("%T", 0)),
 					).Return(
-						func(ctx context.Context, id string) error {
+						func(ctx context.Context, id string, version int) error {
 							return service.ErrDataNotFound
 						},
 					).Once()
 				},
 			},
+			{
+				name:                 "it should return 412 status code, when the resource has been modified",
+				expectedStatusCode:   http.StatusPreconditionFailed,
+				expectedErrorMessage: "Resource has been modified since it was read. Please get it again and retry.",
+				mockBehaviour: func() {
+					mockShowScheduleService.On(
+						"Delete",
+						mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+						mock.AnythingOfType(fmt.Sprintf("%T", 0)),
+					).Return(
+						func(ctx context.Context, id string, version int) error {
+							return service.ErrVersionMismatch
+						},
+					).Once()
+				},
+			},
 			{
 				name:                 "it should return 500 status code, when error happened",
 				expectedStatusCode:   http.StatusInternalServerError,
@@ -657,8 +961,9 @@ func TestDeleteShowScheduleByID(t *testing.T) {
 						"Delete",
 						mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
 						mock.AnythingOfType(fmt.Sprintf("%T", "")),
+						mock.AnythingOfType(fmt.Sprintf("%T", 0)),
 					).Return(
-						func(ctx context.Context, id string) error {
+						func(ctx context.Context, id string, version int) error {
 							return service.ErrRepository
 						},
 					).Once()
@@ -674,6 +979,7 @@ func TestDeleteShowScheduleByID(t *testing.T) {
 
 				e := echo.New()
 				req := httptest.NewRequest(http.MethodDelete, "/api/v1/shows", nil)
+				req.Header.Set(headerIfMatch, `"2"`)
 				req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 				rec := httptest.NewRecorder()
 				c := e.NewContext(req, rec)
@@ -690,5 +996,26 @@ func TestDeleteShowScheduleByID(t *testing.T) {
 				}
 			})
 		}
+
+		t.Run("it should return 428 status code, when If-Match header is missing", func(t *testing.T) {
+			controller := NewShowSchedulesController(mockShowScheduleService)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodDelete, "/api/v1/shows", nil)
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/:id")
+			c.SetParamNames("id")
+			c.SetParamValues("s-abcdefg")
+
+			gotError := controller.deleteShowScheduleByID(c)
+			if assert.Error(t, gotError) {
+				if echoHTTPError, ok := gotError.(*echo.HTTPError); assert.Equal(t, true, ok) {
+					assert.Equal(t, http.StatusPreconditionRequired, echoHTTPError.Code)
+					assert.Equal(t, "If-Match header is required. Please send the ETag of the resource the change is based on.", echoHTTPError.Message)
+				}
+			}
+		})
 	})
 }
@@ -0,0 +1,66 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/erikrios/reog-apps-apis/middleware"
+	"github.com/erikrios/reog-apps-apis/model"
+	"github.com/erikrios/reog-apps-apis/model/payload"
+	"github.com/erikrios/reog-apps-apis/model/response"
+	"github.com/erikrios/reog-apps-apis/service"
+	"github.com/erikrios/reog-apps-apis/service/stats"
+	"github.com/labstack/echo/v4"
+)
+
+type statsController struct {
+	service stats.StatsService
+}
+
+func NewStatsController(service stats.StatsService) *statsController {
+	return &statsController{service: service}
+}
+
+func (s *statsController) Route(e *echo.Group) {
+	group := e.Group("/stats", middleware.JWTMiddleware())
+	group.GET("/districts", s.getDistrictStats)
+}
+
+// getDistrictStats godoc
+// @Summary      Get District Statistics
+// @Description  Get the number of active groups, the total property amounts by property name and the number of show schedules starting in the date range of each district
+// @Tags         stats
+// @Produce      json
+// @Param        from  query  string  false  "first day of the show schedules counted, in the 2006-01-02 layout, the first day of the current year by default"
+// @Param        to    query  string  false  "last day of the show schedules counted, in the 2006-01-02 layout, the last day of the current year by default"
+// @Security     ApiKeyAuth
+// @Success      200  {object}  districtStatsResponse
+// @Failure      400  {object}  echo.HTTPError
+// @Failure      401  {object}  echo.HTTPError
+// @Failure      500  {object}  echo.HTTPError
+// @Router       /stats/districts [get]
+func (s *statsController) getDistrictStats(c echo.Context) error {
+	payload := new(payload.GetDistrictStats)
+	if err := c.Bind(payload); err != nil {
+		return newErrorResponse(service.ErrInvalidPayload)
+	}
+
+	report, err := s.service.GetDistricts(c.Request().Context(), *payload)
+	if err != nil {
+		return newErrorResponse(err)
+	}
+
+	statsResponse := map[string]any{"stats": report}
+	response := model.NewResponse("success", "successfully get district statistics", statsResponse)
+	return c.JSON(http.StatusOK, response)
+}
+
+// districtStatsResponse struct is used for swaggo to generate the API documentation, as it doesn't support generic yet.
+type districtStatsResponse struct {
+	Status  string            `json:"status" extensions:"x-order=0"`
+	Message string            `json:"message" extensions:"x-order=1"`
+	Data    districtStatsData `json:"data" extensions:"x-order=2"`
+}
+
+type districtStatsData struct {
+	Stats response.DistrictStatsReport `json:"stats"`
+}
@@ -0,0 +1,114 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/erikrios/reog-apps-apis/model/payload"
+	"github.com/erikrios/reog-apps-apis/model/response"
+	"github.com/erikrios/reog-apps-apis/service"
+	"github.com/erikrios/reog-apps-apis/service/stats/mocks"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestRouteStats(t *testing.T) {
+	mockStatsService := &mocks.StatsService{}
+	controller := NewStatsController(mockStatsService)
+	g := echo.New().Group("/api/v1")
+	controller.Route(g)
+	assert.NotNil(t, controller)
+}
+
+func TestGetDistrictStats(t *testing.T) {
+	mockStatsService := &mocks.StatsService{}
+
+	dummyReport := response.DistrictStatsReport{
+		From: "2022-05-01",
+		To:   "2022-05-31",
+		Districts: []response.DistrictStats{
+			{
+				DistrictID:    "3502010",
+				DistrictName:  "Ngrayun",
+				ActiveGroups:  3,
+				Properties:    []response.PropertyTotal{{Name: "Dadak Merak", Amount: 4}},
+				ShowSchedules: 5,
+			},
+		},
+	}
+
+	testCases := []struct {
+		name                 string
+		inputError           error
+		expectedStatusCode   int
+		expectedErrorMessage string
+	}{
+		{
+			name:               "it should return 200 status code, when there is no error",
+			inputError:         nil,
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name:                 "it should return 400 status code, when a date is invalid",
+			inputError:           service.ErrDateParsing,
+			expectedStatusCode:   http.StatusBadRequest,
+			expectedErrorMessage: "Invalid date format. Please use ISO 8601 date format (2006-01-02)",
+		},
+		{
+			name:                 "it should return 500 status code, when error happened",
+			inputError:           service.ErrRepository,
+			expectedStatusCode:   http.StatusInternalServerError,
+			expectedErrorMessage: "Something went wrong.",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockStatsService.On(
+				"GetDistricts",
+				mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+				payload.GetDistrictStats{From: "2022-05-01", To: "2022-05-31"},
+			).Return(
+				func(ctx context.Context, p payload.GetDistrictStats) response.DistrictStatsReport {
+					return dummyReport
+				},
+				func(ctx context.Context, p payload.GetDistrictStats) error {
+					return testCase.inputError
+				},
+			).Once()
+
+			controller := NewStatsController(mockStatsService)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/?from=2022-05-01&to=2022-05-31", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/api/v1/stats/districts")
+
+			gotError := controller.getDistrictStats(c)
+			if testCase.inputError == nil {
+				if assert.NoError(t, gotError) {
+					assert.Equal(t, testCase.expectedStatusCode, rec.Code)
+
+					gotResponse := districtStatsResponse{}
+					if err := json.Unmarshal(rec.Body.Bytes(), &gotResponse); assert.NoError(t, err) {
+						assert.Equal(t, dummyReport, gotResponse.Data.Stats)
+					}
+				}
+				return
+			}
+
+			if assert.Error(t, gotError) {
+				if echoHTTPError, ok := gotError.(*echo.HTTPError); assert.Equal(t, true, ok) {
+					assert.Equal(t, testCase.expectedStatusCode, echoHTTPError.Code)
+					assert.Equal(t, testCase.expectedErrorMessage, echoHTTPError.Message)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,204 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/erikrios/reog-apps-apis/middleware"
+	"github.com/erikrios/reog-apps-apis/model"
+	"github.com/erikrios/reog-apps-apis/model/payload"
+	"github.com/erikrios/reog-apps-apis/model/response"
+	"github.com/erikrios/reog-apps-apis/service"
+	"github.com/erikrios/reog-apps-apis/service/submission"
+	"github.com/erikrios/reog-apps-apis/utils/generator"
+	"github.com/labstack/echo/v4"
+)
+
+type submissionsController struct {
+	service        submission.SubmissionService
+	tokenGenerator generator.TokenGenerator
+}
+
+func NewSubmissionsController(service submission.SubmissionService, tokenGenerator generator.TokenGenerator) *submissionsController {
+	return &submissionsController{service: service, tokenGenerator: tokenGenerator}
+}
+
+func (s *submissionsController) Route(e *echo.Group) {
+	// Submitting is open to everyone, only the review is restricted to admins
+	e.POST("/submissions", s.postSubmitGroup)
+	e.GET("/submissions", s.getSubmissions, middleware.JWTMiddleware())
+	e.GET("/submissions/:id", s.getSubmissionByID, middleware.JWTMiddleware())
+	e.POST("/submissions/:id/approve", s.postApproveSubmission, middleware.JWTMiddleware())
+	e.POST("/submissions/:id/reject", s.postRejectSubmission, middleware.JWTMiddleware())
+}
+
+// postSubmitGroup godoc
+// @Summary      Submit a Group
+// @Description  Propose a new group, to be approved by an admin. At most 3 submissions are accepted from an IP address per day.
+// @Tags         submissions
+// @Accept       json
+// @Produce      json
+// @Param        default  body  payload.SubmitGroup  true  "request body"
+// @Success      201  {object}  createSubmissionResponse
+// @Failure      400  {object}  echo.HTTPError
+// @Failure      404  {object}  echo.HTTPError
+// @Failure      429  {object}  echo.HTTPError
+// @Failure      500  {object}  echo.HTTPError
+// @Router       /submissions [post]
+func (s *submissionsController) postSubmitGroup(c echo.Context) error {
+	payload := new(payload.SubmitGroup)
+	if err := c.Bind(payload); err != nil {
+		return newErrorResponse(service.ErrInvalidPayload)
+	}
+
+	id, err := s.service.Submit(c.Request().Context(), c.RealIP(), *payload)
+	if err != nil {
+		return newErrorResponse(err)
+	}
+
+	idResponse := map[string]any{"id": id}
+	response := model.NewResponse("success", "group successfully submitted, it will be published once approved", idResponse)
+	return c.JSON(http.StatusCreated, response)
+}
+
+// getSubmissions godoc
+// @Summary      Get Submissions
+// @Description  Get the submitted groups, the oldest first
+// @Tags         submissions
+// @Produce      json
+// @Param        status  query  string  false  "return only the submissions with the status: pending, approving, approved or rejected"
+// @Security     ApiKeyAuth
+// @Success      200  {object}  submissionsResponse
+// @Failure      400  {object}  echo.HTTPError
+// @Failure      401  {object}  echo.HTTPError
+// @Failure      500  {object}  echo.HTTPError
+// @Router       /submissions [get]
+func (s *submissionsController) getSubmissions(c echo.Context) error {
+	payload := new(payload.GetSubmissions)
+	if err := c.Bind(payload); err != nil {
+		return newErrorResponse(service.ErrInvalidPayload)
+	}
+
+	submissions, err := s.service.GetAll(c.Request().Context(), *payload)
+	if err != nil {
+		return newErrorResponse(err)
+	}
+
+	submissionsResponses := map[string]any{"submissions": submissions}
+	responses := model.NewResponse("success", "successfully get submissions", submissionsResponses)
+	return c.JSON(http.StatusOK, responses)
+}
+
+// getSubmissionByID godoc
+// @Summary      Get Submission by ID
+// @Description  Get submission by ID
+// @Tags         submissions
+// @Produce      json
+// @Param        id  path  string  true  "submission ID"
+// @Security     ApiKeyAuth
+// @Success      200  {object}  submissionResponse
+// @Failure      401  {object}  echo.HTTPError
+// @Failure      404  {object}  echo.HTTPError
+// @Failure      500  {object}  echo.HTTPError
+// @Router       /submissions/{id} [get]
+func (s *submissionsController) getSubmissionByID(c echo.Context) error {
+	id := c.Param("id")
+
+	submission, err := s.service.GetByID(c.Request().Context(), id)
+	if err != nil {
+		return newErrorResponse(err)
+	}
+
+	submissionResponse := map[string]any{"submission": submission}
+	response := model.NewResponse("success", "successfully get submission with id "+id, submissionResponse)
+	return c.JSON(http.StatusOK, response)
+}
+
+// postApproveSubmission godoc
+// @Summary      Approve a Submission
+// @Description  Approve a pending submission, creating the group it proposes
+// @Tags         submissions
+// @Produce      json
+// @Param        id  path  string  true  "submission ID"
+// @Security     ApiKeyAuth
+// @Success      201  {object}  createGroupResponse
+// @Failure      400  {object}  echo.HTTPError
+// @Failure      401  {object}  echo.HTTPError
+// @Failure      404  {object}  echo.HTTPError
+// @Failure      409  {object}  echo.HTTPError
+// @Failure      500  {object}  echo.HTTPError
+// @Router       /submissions/{id}/approve [post]
+func (s *submissionsController) postApproveSubmission(c echo.Context) error {
+	id := c.Param("id")
+
+	_, adminUsername := s.tokenGenerator.ExtractToken(c)
+
+	groupID, err := s.service.Approve(c.Request().Context(), id, adminUsername)
+	if err != nil {
+		return newErrorResponse(err)
+	}
+
+	idResponse := map[string]any{"id": groupID}
+	response := model.NewResponse("success", "submission successfully approved", idResponse)
+	return c.JSON(http.StatusCreated, response)
+}
+
+// postRejectSubmission godoc
+// @Summary      Reject a Submission
+// @Description  Reject a pending submission
+// @Tags         submissions
+// @Accept       json
+// @Produce      json
+// @Param        default  body  payload.RejectSubmission  true  "request body"
+// @Param        id       path  string                    true  "submission ID"
+// @Security     ApiKeyAuth
+// @Success      204
+// @Failure      400  {object}  echo.HTTPError
+// @Failure      401  {object}  echo.HTTPError
+// @Failure      404  {object}  echo.HTTPError
+// @Failure      409  {object}  echo.HTTPError
+// @Failure      500  {object}  echo.HTTPError
+// @Router       /submissions/{id}/reject [post]
+func (s *submissionsController) postRejectSubmission(c echo.Context) error {
+	id := c.Param("id")
+
+	payload := new(payload.RejectSubmission)
+	if err := c.Bind(payload); err != nil {
+		return newErrorResponse(service.ErrInvalidPayload)
+	}
+
+	_, adminUsername := s.tokenGenerator.ExtractToken(c)
+
+	if err := s.service.Reject(c.Request().Context(), id, adminUsername, *payload); err != nil {
+		return newErrorResponse(err)
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+// createSubmissionResponse struct is used for swaggo to generate the API documentation, as it doesn't support generic yet.
+type createSubmissionResponse struct {
+	Status  string `json:"status" extensions:"x-order=0"`
+	Message string `json:"message" extensions:"x-order=1"`
+	Data    idData `json:"data" extensions:"x-order=2"`
+}
+
+// submissionsResponse struct is used for swaggo to generate the API documentation, as it doesn't support generic yet.
+type submissionsResponse struct {
+	Status  string          `json:"status" extensions:"x-order=0"`
+	Message string          `json:"message" extensions:"x-order=1"`
+	Data    submissionsData `json:"data" extensions:"x-order=2"`
+}
+
+type submissionsData struct {
+	Submissions []response.Submission `json:"submissions"`
+}
+
+// submissionResponse struct is used for swaggo to generate the API documentation, as it doesn't support generic yet.
+type submissionResponse struct {
+	Status  string         `json:"status" extensions:"x-order=0"`
+	Message string         `json:"message" extensions:"x-order=1"`
+	Data    submissionData `json:"data" extensions:"x-order=2"`
+}
+
+type submissionData struct {
+	Submission response.Submission `json:"submission"`
+}
@@ -0,0 +1,429 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/erikrios/reog-apps-apis/model/payload"
+	"github.com/erikrios/reog-apps-apis/model/response"
+	"github.com/erikrios/reog-apps-apis/service"
+	mss "github.com/erikrios/reog-apps-apis/service/submission/mocks"
+	mig "github.com/erikrios/reog-apps-apis/utils/generator/mocks"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestRouteSubmissions(t *testing.T) {
+	mockSubmissionService := &mss.SubmissionService{}
+	mockTokenGen := &mig.TokenGenerator{}
+	controller := NewSubmissionsController(mockSubmissionService, mockTokenGen)
+	g := echo.New().Group("/api/v1")
+	controller.Route(g)
+	assert.NotNil(t, controller)
+}
+
+func TestPostSubmitGroup(t *testing.T) {
+	mockSubmissionService := &mss.SubmissionService{}
+	mockTokenGen := &mig.TokenGenerator{}
+
+	dummyReq := payload.SubmitGroup{
+		CreateGroup: payload.CreateGroup{
+			Name:      "Paguyuban Reog Singo Mudho",
+			Leader:    "Erik Rio Setiawan",
+			Address:   "RT 01 RW 01 Dukuh Bibis",
+			VillageID: "3502030007",
+		},
+		ContactPhone: "081234567890",
+	}
+
+	testCases := []struct {
+		name                 string
+		inputError           error
+		expectedStatusCode   int
+		expectedErrorMessage string
+	}{
+		{
+			name:               "it should return 201 status code, when there is no error",
+			inputError:         nil,
+			expectedStatusCode: http.StatusCreated,
+		},
+		{
+			name:                 "it should return 400 status code, when payload is invalid",
+			inputError:           service.ErrInvalidPayload,
+			expectedStatusCode:   http.StatusBadRequest,
+			expectedErrorMessage: "Invalid payload. Please check the payload schema in the API Documentation.",
+		},
+		{
+			name:                 "it should return 429 status code, when the IP address used up its quota",
+			inputError:           service.ErrTooManyRequests,
+			expectedStatusCode:   http.StatusTooManyRequests,
+			expectedErrorMessage: "Too many submissions. Please try again tomorrow.",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockSubmissionService.On(
+				"Submit",
+				mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+				"192.0.2.1",
+				dummyReq,
+			).Return(
+				func(ctx context.Context, ip string, p payload.SubmitGroup) string {
+					return "r-aBcdEfG"
+				},
+				func(ctx context.Context, ip string, p payload.SubmitGroup) error {
+					return testCase.inputError
+				},
+			).Once()
+
+			controller := NewSubmissionsController(mockSubmissionService, mockTokenGen)
+			requestBody, err := json.Marshal(dummyReq)
+			assert.NoError(t, err)
+
+			e := echo.New()
+			e.IPExtractor = echo.ExtractIPDirect()
+			req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(string(requestBody)))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			req.Header.Set(echo.HeaderXForwardedFor, "198.51.100.7")
+			req.RemoteAddr = "192.0.2.1:54321"
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/api/v1/submissions")
+
+			gotError := controller.postSubmitGroup(c)
+			if testCase.inputError == nil {
+				if assert.NoError(t, gotError) {
+					assert.Equal(t, testCase.expectedStatusCode, rec.Code)
+
+					gotResponse := make(map[string]any)
+					if err := json.Unmarshal(rec.Body.Bytes(), &gotResponse); assert.NoError(t, err) {
+						assert.Equal(t, "r-aBcdEfG", gotResponse["data"].(map[string]any)["id"])
+					}
+				}
+				return
+			}
+
+			if assert.Error(t, gotError) {
+				if echoHTTPError, ok := gotError.(*echo.HTTPError); assert.Equal(t, true, ok) {
+					assert.Equal(t, testCase.expectedStatusCode, echoHTTPError.Code)
+					assert.Equal(t, testCase.expectedErrorMessage, echoHTTPError.Message)
+				}
+			}
+		})
+	}
+}
+
+func TestGetSubmissions(t *testing.T) {
+	mockSubmissionService := &mss.SubmissionService{}
+	mockTokenGen := &mig.TokenGenerator{}
+
+	dummySubmissions := []response.Submission{
+		{
+			ID:           "r-aBcdEfG",
+			Name:         "Paguyuban Reog Singo Mudho",
+			Leader:       "Erik Rio Setiawan",
+			Address:      "RT 01 RW 01 Dukuh Bibis",
+			VillageID:    "3502030007",
+			ContactPhone: "081234567890",
+			SubmitterIP:  "192.0.2.1",
+			Status:       "pending",
+			SubmittedAt:  "01 May 22 08:00 UTC",
+		},
+	}
+
+	testCases := []struct {
+		name                 string
+		inputError           error
+		expectedStatusCode   int
+		expectedErrorMessage string
+	}{
+		{
+			name:               "it should return 200 status code, when there is no error",
+			inputError:         nil,
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name:                 "it should return 400 status code, when status is invalid",
+			inputError:           service.ErrInvalidPayload,
+			expectedStatusCode:   http.StatusBadRequest,
+			expectedErrorMessage: "Invalid payload. Please check the payload schema in the API Documentation.",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockSubmissionService.On(
+				"GetAll",
+				mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+				payload.GetSubmissions{Status: "pending"},
+			).Return(
+				func(ctx context.Context, p payload.GetSubmissions) []response.Submission {
+					return dummySubmissions
+				},
+				func(ctx context.Context, p payload.GetSubmissions) error {
+					return testCase.inputError
+				},
+			).Once()
+
+			controller := NewSubmissionsController(mockSubmissionService, mockTokenGen)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/?status=pending", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/api/v1/submissions")
+
+			gotError := controller.getSubmissions(c)
+			if testCase.inputError == nil {
+				if assert.NoError(t, gotError) {
+					assert.Equal(t, testCase.expectedStatusCode, rec.Code)
+
+					gotResponse := submissionsResponse{}
+					if err := json.Unmarshal(rec.Body.Bytes(), &gotResponse); assert.NoError(t, err) {
+						assert.Equal(t, dummySubmissions, gotResponse.Data.Submissions)
+					}
+				}
+				return
+			}
+
+			if assert.Error(t, gotError) {
+				if echoHTTPError, ok := gotError.(*echo.HTTPError); assert.Equal(t, true, ok) {
+					assert.Equal(t, testCase.expectedStatusCode, echoHTTPError.Code)
+					assert.Equal(t, testCase.expectedErrorMessage, echoHTTPError.Message)
+				}
+			}
+		})
+	}
+}
+
+func TestGetSubmissionByID(t *testing.T) {
+	mockSubmissionService := &mss.SubmissionService{}
+	mockTokenGen := &mig.TokenGenerator{}
+
+	dummySubmission := response.Submission{
+		ID:              "r-aBcdEfG",
+		Name:            "Paguyuban Reog Singo Mudho",
+		Status:          "rejected",
+		RejectionReason: "The group is already registered",
+		ReviewedBy:      "erikrios",
+	}
+
+	testCases := []struct {
+		name                 string
+		inputError           error
+		expectedStatusCode   int
+		expectedErrorMessage string
+	}{
+		{
+			name:               "it should return 200 status code, when there is no error",
+			inputError:         nil,
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name:                 "it should return 404 status code, when submission ID not found",
+			inputError:           service.ErrDataNotFound,
+			expectedStatusCode:   http.StatusNotFound,
+			expectedErrorMessage: "Resource with given ID not found.",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockSubmissionService.On(
+				"GetByID",
+				mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+				"r-aBcdEfG",
+			).Return(
+				func(ctx context.Context, id string) response.Submission {
+					return dummySubmission
+				},
+				func(ctx context.Context, id string) error {
+					return testCase.inputError
+				},
+			).Once()
+
+			controller := NewSubmissionsController(mockSubmissionService, mockTokenGen)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/api/v1/submissions/:id")
+			c.SetParamNames("id")
+			c.SetParamValues("r-aBcdEfG")
+
+			gotError := controller.getSubmissionByID(c)
+			if testCase.inputError == nil {
+				if assert.NoError(t, gotError) {
+					assert.Equal(t, testCase.expectedStatusCode, rec.Code)
+
+					gotResponse := submissionResponse{}
+					if err := json.Unmarshal(rec.Body.Bytes(), &gotResponse); assert.NoError(t, err) {
+						assert.Equal(t, dummySubmission, gotResponse.Data.Submission)
+					}
+				}
+				return
+			}
+
+			if assert.Error(t, gotError) {
+				if echoHTTPError, ok := gotError.(*echo.HTTPError); assert.Equal(t, true, ok) {
+					assert.Equal(t, testCase.expectedStatusCode, echoHTTPError.Code)
+					assert.Equal(t, testCase.expectedErrorMessage, echoHTTPError.Message)
+				}
+			}
+		})
+	}
+}
+
+func TestPostApproveSubmission(t *testing.T) {
+	mockSubmissionService := &mss.SubmissionService{}
+	mockTokenGen := &mig.TokenGenerator{}
+
+	mockTokenGen.On("ExtractToken", mock.Anything).Return("a-XU", "erikrios")
+
+	testCases := []struct {
+		name                 string
+		inputError           error
+		expectedStatusCode   int
+		expectedErrorMessage string
+	}{
+		{
+			name:               "it should return 201 status code, when there is no error",
+			inputError:         nil,
+			expectedStatusCode: http.StatusCreated,
+		},
+		{
+			name:                 "it should return 409 status code, when the submission was already reviewed",
+			inputError:           service.ErrAlreadyReviewed,
+			expectedStatusCode:   http.StatusConflict,
+			expectedErrorMessage: "Submission has already been approved or rejected.",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockSubmissionService.On(
+				"Approve",
+				mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+				"r-aBcdEfG",
+				"erikrios",
+			).Return(
+				func(ctx context.Context, id, adminUsername string) string {
+					return "g-abc"
+				},
+				func(ctx context.Context, id, adminUsername string) error {
+					return testCase.inputError
+				},
+			).Once()
+
+			controller := NewSubmissionsController(mockSubmissionService, mockTokenGen)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPost, "/", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/api/v1/submissions/:id/approve")
+			c.SetParamNames("id")
+			c.SetParamValues("r-aBcdEfG")
+
+			gotError := controller.postApproveSubmission(c)
+			if testCase.inputError == nil {
+				if assert.NoError(t, gotError) {
+					assert.Equal(t, testCase.expectedStatusCode, rec.Code)
+
+					gotResponse := make(map[string]any)
+					if err := json.Unmarshal(rec.Body.Bytes(), &gotResponse); assert.NoError(t, err) {
+						assert.Equal(t, "g-abc", gotResponse["data"].(map[string]any)["id"])
+					}
+				}
+				return
+			}
+
+			if assert.Error(t, gotError) {
+				if echoHTTPError, ok := gotError.(*echo.HTTPError); assert.Equal(t, true, ok) {
+					assert.Equal(t, testCase.expectedStatusCode, echoHTTPError.Code)
+					assert.Equal(t, testCase.expectedErrorMessage, echoHTTPError.Message)
+				}
+			}
+		})
+	}
+}
+
+func TestPostRejectSubmission(t *testing.T) {
+	mockSubmissionService := &mss.SubmissionService{}
+	mockTokenGen := &mig.TokenGenerator{}
+
+	mockTokenGen.On("ExtractToken", mock.Anything).Return("a-XU", "erikrios")
+
+	dummyReq := payload.RejectSubmission{Reason: "The group is already registered"}
+
+	testCases := []struct {
+		name                 string
+		inputError           error
+		expectedStatusCode   int
+		expectedErrorMessage string
+	}{
+		{
+			name:               "it should return 204 status code, when there is no error",
+			inputError:         nil,
+			expectedStatusCode: http.StatusNoContent,
+		},
+		{
+			name:                 "it should return 404 status code, when submission ID not found",
+			inputError:           service.ErrDataNotFound,
+			expectedStatusCode:   http.StatusNotFound,
+			expectedErrorMessage: "Resource with given ID not found.",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockSubmissionService.On(
+				"Reject",
+				mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+				"r-aBcdEfG",
+				"erikrios",
+				dummyReq,
+			).Return(
+				func(ctx context.Context, id, adminUsername string, p payload.RejectSubmission) error {
+					return testCase.inputError
+				},
+			).Once()
+
+			controller := NewSubmissionsController(mockSubmissionService, mockTokenGen)
+			requestBody, err := json.Marshal(dummyReq)
+			assert.NoError(t, err)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(string(requestBody)))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/api/v1/submissions/:id/reject")
+			c.SetParamNames("id")
+			c.SetParamValues("r-aBcdEfG")
+
+			gotError := controller.postRejectSubmission(c)
+			if testCase.inputError == nil {
+				if assert.NoError(t, gotError) {
+					assert.Equal(t, testCase.expectedStatusCode, rec.Code)
+				}
+				return
+			}
+
+			if assert.Error(t, gotError) {
+				if echoHTTPError, ok := gotError.(*echo.HTTPError); assert.Equal(t, true, ok) {
+					assert.Equal(t, testCase.expectedStatusCode, echoHTTPError.Code)
+					assert.Equal(t, testCase.expectedErrorMessage, echoHTTPError.Message)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,257 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/erikrios/reog-apps-apis/middleware"
+	"github.com/erikrios/reog-apps-apis/model"
+	"github.com/erikrios/reog-apps-apis/model/response"
+	"github.com/erikrios/reog-apps-apis/service/trash"
+	"github.com/labstack/echo/v4"
+)
+
+type trashController struct {
+	service trash.TrashService
+}
+
+func NewTrashController(service trash.TrashService) *trashController {
+	return &trashController{service: service}
+}
+
+func (t *trashController) Route(e *echo.Group) {
+	group := e.Group("/trash", middleware.JWTMiddleware())
+	group.GET("/groups", t.getDeletedGroups)
+	group.POST("/groups/:id/restore", t.postRestoreGroup)
+	group.DELETE("/groups/:id", t.deletePurgeGroup)
+	group.GET("/properties", t.getDeletedProperties)
+	group.POST("/properties/:id/restore", t.postRestoreProperty)
+	group.DELETE("/properties/:id", t.deletePurgeProperty)
+	group.GET("/shows", t.getDeletedShowSchedules)
+	group.POST("/shows/:id/restore", t.postRestoreShowSchedule)
+	group.DELETE("/shows/:id", t.deletePurgeShowSchedule)
+}
+
+// getDeletedGroups godoc
+// @Summary      Get Deleted Groups
+// @Description  Get the groups in the trash, most recently deleted first
+// @Tags         trash
+// @Produce      json
+// @Security     ApiKeyAuth
+// @Success      200  {object}  deletedGroupsResponse
+// @Failure      401  {object}  echo.HTTPError
+// @Failure      500  {object}  echo.HTTPError
+// @Router       /trash/groups [get]
+func (t *trashController) getDeletedGroups(c echo.Context) error {
+	groups, err := t.service.GetGroups(c.Request().Context())
+	if err != nil {
+		return newErrorResponse(err)
+	}
+
+	groupsResponses := map[string]any{"groups": groups}
+	responses := model.NewResponse("success", "successfully get deleted groups", groupsResponses)
+	return c.JSON(http.StatusOK, responses)
+}
+
+// getDeletedProperties godoc
+// @Summary      Get Deleted Properties
+// @Description  Get the properties in the trash, most recently deleted first
+// @Tags         trash
+// @Produce      json
+// @Security     ApiKeyAuth
+// @Success      200  {object}  deletedPropertiesResponse
+// @Failure      401  {object}  echo.HTTPError
+// @Failure      500  {object}  echo.HTTPError
+// @Router       /trash/properties [get]
+func (t *trashController) getDeletedProperties(c echo.Context) error {
+	properties, err := t.service.GetProperties(c.Request().Context())
+	if err != nil {
+		return newErrorResponse(err)
+	}
+
+	propertiesResponses := map[string]any{"properties": properties}
+	responses := model.NewResponse("success", "successfully get deleted properties", propertiesResponses)
+	return c.JSON(http.StatusOK, responses)
+}
+
+// getDeletedShowSchedules godoc
+// @Summary      Get Deleted Show Schedules
+// @Description  Get the show schedules in the trash, most recently deleted first
+// @Tags         trash
+// @Produce      json
+// @Security     ApiKeyAuth
+// @Success      200  {object}  deletedShowSchedulesResponse
+// @Failure      401  {object}  echo.HTTPError
+// @Failure      500  {object}  echo.HTTPError
+// @Router       /trash/shows [get]
+func (t *trashController) getDeletedShowSchedules(c echo.Context) error {
+	showSchedules, err := t.service.GetShowSchedules(c.Request().Context())
+	if err != nil {
+		return newErrorResponse(err)
+	}
+
+	showSchedulesResponses := map[string]any{"showSchedules": showSchedules}
+	responses := model.NewResponse("success", "successfully get deleted show schedules", showSchedulesResponses)
+	return c.JSON(http.StatusOK, responses)
+}
+
+// postRestoreGroup godoc
+// @Summary      Restore a Group
+// @Description  Restore a deleted group together with the address, properties and members deleted with it
+// @Tags         trash
+// @Produce      json
+// @Param        id  path  string  true  "group ID"
+// @Security     ApiKeyAuth
+// @Success      204
+// @Failure      401  {object}  echo.HTTPError
+// @Failure      404  {object}  echo.HTTPError
+// @Failure      500  {object}  echo.HTTPError
+// @Router       /trash/groups/{id}/restore [post]
+func (t *trashController) postRestoreGroup(c echo.Context) error {
+	id := c.Param("id")
+
+	if err := t.service.RestoreGroup(c.Request().Context(), id); err != nil {
+		return newErrorResponse(err)
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+// postRestoreProperty godoc
+// @Summary      Restore a Property
+// @Description  Restore a deleted property. The group it belongs to must not be deleted.
+// @Tags         trash
+// @Produce      json
+// @Param        id  path  string  true  "property ID"
+// @Security     ApiKeyAuth
+// @Success      204
+// @Failure      401  {object}  echo.HTTPError
+// @Failure      404  {object}  echo.HTTPError
+// @Failure      409  {object}  echo.HTTPError
+// @Failure      500  {object}  echo.HTTPError
+// @Router       /trash/properties/{id}/restore [post]
+func (t *trashController) postRestoreProperty(c echo.Context) error {
+	id := c.Param("id")
+
+	if err := t.service.RestoreProperty(c.Request().Context(), id); err != nil {
+		return newErrorResponse(err)
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+// postRestoreShowSchedule godoc
+// @Summary      Restore a Show Schedule
+// @Description  Restore a deleted show schedule. The group it belongs to must not be deleted.
+// @Tags         trash
+// @Produce      json
+// @Param        id  path  string  true  "show schedule ID"
+// @Security     ApiKeyAuth
+// @Success      204
+// @Failure      401  {object}  echo.HTTPError
+// @Failure      404  {object}  echo.HTTPError
+// @Failure      409  {object}  echo.HTTPError
+// @Failure      500  {object}  echo.HTTPError
+// @Router       /trash/shows/{id}/restore [post]
+func (t *trashController) postRestoreShowSchedule(c echo.Context) error {
+	id := c.Param("id")
+
+	if err := t.service.RestoreShowSchedule(c.Request().Context(), id); err != nil {
+		return newErrorResponse(err)
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+// deletePurgeGroup godoc
+// @Summary      Purge a Group
+// @Description  Permanently delete a group in the trash with everything that belongs to it, including the attachment files
+// @Tags         trash
+// @Produce      json
+// @Param        id  path  string  true  "group ID"
+// @Security     ApiKeyAuth
+// @Success      204
+// @Failure      401  {object}  echo.HTTPError
+// @Failure      404  {object}  echo.HTTPError
+// @Failure      500  {object}  echo.HTTPError
+// @Router       /trash/groups/{id} [delete]
+func (t *trashController) deletePurgeGroup(c echo.Context) error {
+	id := c.Param("id")
+
+	if err := t.service.PurgeGroup(c.Request().Context(), id); err != nil {
+		return newErrorResponse(err)
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+// deletePurgeProperty godoc
+// @Summary      Purge a Property
+// @Description  Permanently delete a property in the trash, including the attachment files. A property with items still out on loan cannot be purged.
+// @Tags         trash
+// @Produce      json
+// @Param        id  path  string  true  "property ID"
+// @Security     ApiKeyAuth
+// @Success      204
+// @Failure      401  {object}  echo.HTTPError
+// @Failure      404  {object}  echo.HTTPError
+// @Failure      409  {object}  echo.HTTPError
+// @Failure      500  {object}  echo.HTTPError
+// @Router       /trash/properties/{id} [delete]
+func (t *trashController) deletePurgeProperty(c echo.Context) error {
+	id := c.Param("id")
+
+	if err := t.service.PurgeProperty(c.Request().Context(), id); err != nil {
+		return newErrorResponse(err)
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+// deletePurgeShowSchedule godoc
+// @Summary      Purge a Show Schedule
+// @Description  Permanently delete a show schedule in the trash
+// @Tags         trash
+// @Produce      json
+// @Param        id  path  string  true  "show schedule ID"
+// @Security     ApiKeyAuth
+// @Success      204
+// @Failure      401  {object}  echo.HTTPError
+// @Failure      404  {object}  echo.HTTPError
+// @Failure      500  {object}  echo.HTTPError
+// @Router       /trash/shows/{id} [delete]
+func (t *trashController) deletePurgeShowSchedule(c echo.Context) error {
+	id := c.Param("id")
+
+	if err := t.service.PurgeShowSchedule(c.Request().Context(), id); err != nil {
+		return newErrorResponse(err)
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+// deletedGroupsResponse struct is used for swaggo to generate the API documentation, as it doesn't support generic yet.
+type deletedGroupsResponse struct {
+	Status  string            `json:"status" extensions:"x-order=0"`
+	Message string            `json:"message" extensions:"x-order=1"`
+	Data    deletedGroupsData `json:"data" extensions:"x-order=2"`
+}
+
+type deletedGroupsData struct {
+	Groups []response.DeletedGroup `json:"groups"`
+}
+
+// deletedPropertiesResponse struct is used for swaggo to generate the API documentation, as it doesn't support generic yet.
+type deletedPropertiesResponse struct {
+	Status  string                `json:"status" extensions:"x-order=0"`
+	Message string                `json:"message" extensions:"x-order=1"`
+	Data    deletedPropertiesData `json:"data" extensions:"x-order=2"`
+}
+
+type deletedPropertiesData struct {
+	Properties []response.DeletedProperty `json:"properties"`
+}
+
+// deletedShowSchedulesResponse struct is used for swaggo to generate the API documentation, as it doesn't support generic yet.
+type deletedShowSchedulesResponse struct {
+	Status  string                   `json:"status" extensions:"x-order=0"`
+	Message string                   `json:"message" extensions:"x-order=1"`
+	Data    deletedShowSchedulesData `json:"data" extensions:"x-order=2"`
+}
+
+type deletedShowSchedulesData struct {
+	ShowSchedules []response.DeletedShowSchedule `json:"showSchedules"`
+}
@@ -0,0 +1,240 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/erikrios/reog-apps-apis/model"
+	"github.com/erikrios/reog-apps-apis/model/response"
+	"github.com/erikrios/reog-apps-apis/service"
+	mts "github.com/erikrios/reog-apps-apis/service/trash/mocks"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestRouteTrash(t *testing.T) {
+	mockTrashService := &mts.TrashService{}
+	controller := NewTrashController(mockTrashService)
+	g := echo.New().Group("/api/v1")
+	controller.Route(g)
+	assert.NotNil(t, controller)
+}
+
+func TestGetDeletedGroups(t *testing.T) {
+	mockTrashService := &mts.TrashService{}
+
+	t.Run("success scenario", func(t *testing.T) {
+		dummyGroups := []response.DeletedGroup{
+			{
+				ID:        "g-xyz",
+				Name:      "Paguyuban Reog",
+				Leader:    "Erik Rio S",
+				DeletedAt: "01 Jun 22 09:30 UTC",
+			},
+		}
+
+		mockTrashService.On(
+			"GetGroups",
+			mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+		).Return(
+			func(ctx context.Context) []response.DeletedGroup {
+				return dummyGroups
+			},
+			func(ctx context.Context) error {
+				return nil
+			},
+		).Once()
+
+		t.Run("it should return 200 status code with valid response, when there is no error", func(t *testing.T) {
+			controller := NewTrashController(mockTrashService)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/api/v1/trash/groups")
+
+			if assert.NoError(t, controller.getDeletedGroups(c)) {
+				assert.Equal(t, http.StatusOK, rec.Code)
+
+				gotResponse := &model.Response[deletedGroupsData]{}
+				if err := json.Unmarshal(rec.Body.Bytes(), gotResponse); assert.NoError(t, err) {
+					assert.Equal(t, dummyGroups, gotResponse.Data.Groups)
+				}
+			}
+		})
+	})
+
+	t.Run("failed scenario", func(t *testing.T) {
+		mockTrashService.On(
+			"GetGroups",
+			mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+		).Return(
+			func(ctx context.Context) []response.DeletedGroup {
+				return nil
+			},
+			func(ctx context.Context) error {
+				return service.ErrRepository
+			},
+		).Once()
+
+		t.Run("it should return 500 status code, when error happened", func(t *testing.T) {
+			controller := NewTrashController(mockTrashService)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/api/v1/trash/groups")
+
+			gotError := controller.getDeletedGroups(c)
+			if assert.Error(t, gotError) {
+				if echoHTTPError, ok := gotError.(*echo.HTTPError); assert.Equal(t, true, ok) {
+					assert.Equal(t, http.StatusInternalServerError, echoHTTPError.Code)
+					assert.Equal(t, "Something went wrong.", echoHTTPError.Message)
+				}
+			}
+		})
+	})
+}
+
+func TestPostRestoreProperty(t *testing.T) {
+	mockTrashService := &mts.TrashService{}
+
+	testCases := []struct {
+		name                 string
+		inputError           error
+		expectedStatusCode   int
+		expectedErrorMessage string
+	}{
+		{
+			name:               "it should return 204 status code, when there is no error",
+			inputError:         nil,
+			expectedStatusCode: http.StatusNoContent,
+		},
+		{
+			name:                 "it should return 404 status code, when property is not in the trash",
+			inputError:           service.ErrDataNotFound,
+			expectedStatusCode:   http.StatusNotFound,
+			expectedErrorMessage: "Resource with given ID not found.",
+		},
+		{
+			name:                 "it should return 409 status code, when the group of the property is deleted",
+			inputError:           service.ErrParentDeleted,
+			expectedStatusCode:   http.StatusConflict,
+			expectedErrorMessage: "The group it belongs to is deleted. Please restore the group first.",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockTrashService.On(
+				"RestoreProperty",
+				mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+				"p-Ay8LmNI",
+			).Return(
+				func(ctx context.Context, id string) error {
+					return testCase.inputError
+				},
+			).Once()
+
+			controller := NewTrashController(mockTrashService)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPost, "/", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/api/v1/trash/properties/:id/restore")
+			c.SetParamNames("id")
+			c.SetParamValues("p-Ay8LmNI")
+
+			gotError := controller.postRestoreProperty(c)
+			if testCase.inputError == nil {
+				if assert.NoError(t, gotError) {
+					assert.Equal(t, testCase.expectedStatusCode, rec.Code)
+				}
+				return
+			}
+
+			if assert.Error(t, gotError) {
+				if echoHTTPError, ok := gotError.(*echo.HTTPError); assert.Equal(t, true, ok) {
+					assert.Equal(t, testCase.expectedStatusCode, echoHTTPError.Code)
+					assert.Equal(t, testCase.expectedErrorMessage, echoHTTPError.Message)
+				}
+			}
+		})
+	}
+}
+
+func TestDeletePurgeGroup(t *testing.T) {
+	mockTrashService := &mts.TrashService{}
+
+	testCases := []struct {
+		name                 string
+		inputError           error
+		expectedStatusCode   int
+		expectedErrorMessage string
+	}{
+		{
+			name:               "it should return 204 status code, when there is no error",
+			inputError:         nil,
+			expectedStatusCode: http.StatusNoContent,
+		},
+		{
+			name:                 "it should return 404 status code, when group is not in the trash",
+			inputError:           service.ErrDataNotFound,
+			expectedStatusCode:   http.StatusNotFound,
+			expectedErrorMessage: "Resource with given ID not found.",
+		},
+		{
+			name:                 "it should return 500 status code, when error happened",
+			inputError:           service.ErrRepository,
+			expectedStatusCode:   http.StatusInternalServerError,
+			expectedErrorMessage: "Something went wrong.",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockTrashService.On(
+				"PurgeGroup",
+				mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+				"g-xyz",
+			).Return(
+				func(ctx context.Context, id string) error {
+					return testCase.inputError
+				},
+			).Once()
+
+			controller := NewTrashController(mockTrashService)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodDelete, "/", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/api/v1/trash/groups/:id")
+			c.SetParamNames("id")
+			c.SetParamValues("g-xyz")
+
+			gotError := controller.deletePurgeGroup(c)
+			if testCase.inputError == nil {
+				if assert.NoError(t, gotError) {
+					assert.Equal(t, testCase.expectedStatusCode, rec.Code)
+				}
+				return
+			}
+
+			if assert.Error(t, gotError) {
+				if echoHTTPError, ok := gotError.(*echo.HTTPError); assert.Equal(t, true, ok) {
+					assert.Equal(t, testCase.expectedStatusCode, echoHTTPError.Code)
+					assert.Equal(t, testCase.expectedErrorMessage, echoHTTPError.Message)
+				}
+			}
+		})
+	}
+}
@@ -25,6 +25,63 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
+        "/achievements/ranking": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get the medal table of the districts, counting first, second and third places as gold, silver and bronze medals",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "achievements"
+                ],
+                "summary": "Get Achievement Ranking",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "count only achievements of the level: village, regency or national",
+                        "name": "level",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "count only achievements of the year",
+                        "name": "year",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/controller.achievementRankingResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
         "/admins": {
             "post": {
                 "description": "Administrator login",
@@ -90,7 +147,7 @@ const docTemplate = `{
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Get Groups",
+                "description": "Get Groups. The attachments and achievements are left empty, get a group by ID for them.",
                 "produces": [
                     "application/json"
                 ],
@@ -98,6 +155,50 @@ const docTemplate = `{
                     "groups"
                 ],
                 "summary": "Get Groups",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "page number, starting from 1, maximum 10000",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "number of groups per page, maximum 100",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter groups by district ID",
+                        "name": "district_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter groups by village ID",
+                        "name": "village_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter groups by name substring",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter groups by status: active, dormant, suspended or dissolved",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "sort by name, created_at or property_count, prefix with - for descending order",
+                        "name": "sort",
+                        "in": "query"
+                    }
+                ],
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -105,6 +206,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/controller.groupsResponse"
                         }
                     },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
                     "401": {
                         "description": "Unauthorized",
                         "schema": {
@@ -181,38 +288,59 @@ const docTemplate = `{
                 }
             }
         },
-        "/groups/addresses/{id}": {
-            "put": {
+        "/groups.geojson": {
+            "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Update an address",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Get every group matching the filters as a GeoJSON feature collection, located by its address. Groups without a known location have a null geometry.",
                 "produces": [
-                    "application/json"
+                    "application/geo+json"
                 ],
                 "tags": [
                     "groups"
                 ],
-                "summary": "Update an Address",
+                "summary": "Get Groups as GeoJSON",
                 "parameters": [
                     {
-                        "description": "request body",
-                        "name": "default",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/payload.UpdateAddress"
-                        }
+                        "type": "string",
+                        "description": "filter groups by district ID",
+                        "name": "district_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter groups by village ID",
+                        "name": "village_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter groups by name substring",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter groups by status: active, dormant, suspended or dissolved",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "sort by name, created_at or property_count, prefix with - for descending order",
+                        "name": "sort",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": ""
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/controller.groupsGeoJSON"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
@@ -226,12 +354,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/echo.HTTPError"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/echo.HTTPError"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -241,35 +363,43 @@ const docTemplate = `{
                 }
             }
         },
-        "/groups/{id}": {
-            "get": {
+        "/groups/addresses/{id}": {
+            "put": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Get Show Schedule by ID",
+                "description": "Update an address",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "shows"
+                    "groups"
                 ],
-                "summary": "Get Show Schedule by ID",
+                "summary": "Update an Address",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "show schedule ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "request body",
+                        "name": "default",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/payload.UpdateAddress"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "204": {
+                        "description": ""
+                    },
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/controller.showScheduleResponse"
+                            "$ref": "#/definitions/echo.HTTPError"
                         }
                     },
                     "401": {
@@ -292,14 +422,15 @@ const docTemplate = `{
                     }
                 }
             },
-            "put": {
+            "patch": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Update a group",
+                "description": "Change some fields of an address with a JSON merge patch (RFC 7396). Absent fields are kept, null is rejected.",
                 "consumes": [
+                    "application/merge-patch+json",
                     "application/json"
                 ],
                 "produces": [
@@ -308,20 +439,20 @@ const docTemplate = `{
                 "tags": [
                     "groups"
                 ],
-                "summary": "Update a Group",
+                "summary": "Patch an Address",
                 "parameters": [
                     {
-                        "description": "request body",
+                        "description": "merge patch",
                         "name": "default",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/payload.UpdateGroup"
+                            "$ref": "#/definitions/payload.PatchAddress"
                         }
                     },
                     {
                         "type": "string",
-                        "description": "group ID",
+                        "description": "address ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -349,6 +480,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/echo.HTTPError"
                         }
                     },
+                    "415": {
+                        "description": "Unsupported Media Type",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -356,42 +493,58 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/groups/duplicates": {
+            "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Delete group by ID",
+                "description": "Get the pairs of groups of the same village whose names and leaders are spelled alike, from the most similar one",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "groups"
                 ],
-                "summary": "Delete Group by ID",
+                "summary": "Get Duplicate Groups",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "group ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "compare only the groups of the district",
+                        "name": "district_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "compare only the groups of the village",
+                        "name": "village_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "lowest similarity of the pairs, between 0 and 1, default to 0.8",
+                        "name": "min_similarity",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": ""
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/controller.duplicateGroupsResponse"
+                        }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/echo.HTTPError"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/echo.HTTPError"
                         }
@@ -405,28 +558,64 @@ const docTemplate = `{
                 }
             }
         },
-        "/groups/{id}/generate": {
+        "/groups/export": {
             "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Generate QR Code",
+                "description": "Export groups and their properties as CSV or XLSX. The XLSX workbook holds a groups sheet and a properties sheet.",
                 "produces": [
-                    "image/png"
+                    "text/csv",
+                    "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
                 ],
                 "tags": [
                     "groups"
                 ],
-                "summary": "Generate QR Code",
+                "summary": "Export Groups",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "group ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "csv or xlsx, default to csv",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "groups or properties for the csv format, default to groups",
+                        "name": "sheet",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter groups by district ID",
+                        "name": "district_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter groups by village ID",
+                        "name": "village_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter groups by name substring",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter groups by status: active, dormant, suspended or dissolved",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "sort by name, created_at or property_count, prefix with - for descending order",
+                        "name": "sort",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -436,14 +625,14 @@ const docTemplate = `{
                             "type": "file"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/echo.HTTPError"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/echo.HTTPError"
                         }
@@ -457,16 +646,17 @@ const docTemplate = `{
                 }
             }
         },
-        "/groups/{id}/properties": {
+        "/groups/import": {
             "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Add a Property",
+                "description": "Create groups from a CSV file with name, leader, address and villageID columns. Without atomic, the rows are created one by one and a row that cannot be saved is reported as failed.",
                 "consumes": [
-                    "application/json"
+                    "multipart/form-data",
+                    "text/csv"
                 ],
                 "produces": [
                     "application/json"
@@ -474,30 +664,32 @@ const docTemplate = `{
                 "tags": [
                     "groups"
                 ],
-                "summary": "Add a Property",
+                "summary": "Import Groups",
                 "parameters": [
                     {
-                        "description": "request body",
-                        "name": "default",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/payload.CreateProperty"
-                        }
+                        "type": "file",
+                        "description": "CSV file",
+                        "name": "file",
+                        "in": "formData"
                     },
                     {
-                        "type": "string",
-                        "description": "group ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "type": "boolean",
+                        "description": "validate the rows without creating any group",
+                        "name": "dry_run",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "create no group at all when one of the rows is invalid or cannot be saved",
+                        "name": "atomic",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/controller.createPropertyResponse"
+                            "$ref": "#/definitions/controller.importGroupsResponse"
                         }
                     },
                     "400": {
@@ -512,8 +704,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/echo.HTTPError"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "413": {
+                        "description": "Request Entity Too Large",
                         "schema": {
                             "$ref": "#/definitions/echo.HTTPError"
                         }
@@ -527,52 +719,55 @@ const docTemplate = `{
                 }
             }
         },
-        "/groups/{id}/properties/{propertyID}": {
-            "put": {
+        "/groups/nearby": {
+            "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Update a Property",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Get the groups located within the radius of a point, from the closest one",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "groups"
                 ],
-                "summary": "Update a Property",
+                "summary": "Get Nearby Groups",
                 "parameters": [
                     {
-                        "description": "request body",
-                        "name": "default",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/payload.UpdateProperty"
-                        }
+                        "type": "number",
+                        "description": "latitude of the point",
+                        "name": "lat",
+                        "in": "query",
+                        "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "group ID",
-                        "name": "id",
-                        "in": "path",
+                        "type": "number",
+                        "description": "longitude of the point",
+                        "name": "lng",
+                        "in": "query",
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "property ID",
-                        "name": "propertyID",
-                        "in": "path",
-                        "required": true
+                        "type": "number",
+                        "description": "radius in meters, maximum 50000, default to 5000",
+                        "name": "radius",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "maximum number of groups, maximum 100, default to 20",
+                        "name": "limit",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": ""
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/controller.nearbyGroupsResponse"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
@@ -586,12 +781,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/echo.HTTPError"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/echo.HTTPError"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -599,21 +788,23 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/groups/{id}": {
+            "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Delete a Property",
+                "description": "Get group by ID. The ETag header is sent back in the If-Match header to update or delete the group, and in the If-None-Match header to skip downloading it again while it is unchanged.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "groups"
                 ],
-                "summary": "Delete a Property",
+                "summary": "Get Group by ID",
                 "parameters": [
                     {
                         "type": "string",
@@ -624,14 +815,19 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "property ID",
-                        "name": "propertyID",
-                        "in": "path",
-                        "required": true
+                        "description": "ETag of the group the client already has",
+                        "name": "If-None-Match",
+                        "in": "header"
                     }
                 ],
                 "responses": {
-                    "204": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/controller.groupResponse"
+                        }
+                    },
+                    "304": {
                         "description": ""
                     },
                     "401": {
@@ -653,24 +849,34 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/groups/{id}/properties/{propertyID}/generate": {
-            "get": {
+            },
+            "put": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Generate Property QR Code",
+                "description": "Update a group. Replacing the leader records a leadership change, dated by leaderSince.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
-                    "image/png"
+                    "application/json"
                 ],
                 "tags": [
                     "groups"
                 ],
-                "summary": "Generate Property QR Code",
+                "summary": "Update a Group",
                 "parameters": [
+                    {
+                        "description": "request body",
+                        "name": "default",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/payload.UpdateGroup"
+                        }
+                    },
                     {
                         "type": "string",
                         "description": "group ID",
@@ -680,17 +886,20 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "property ID",
-                        "name": "propertyID",
-                        "in": "path",
+                        "description": "ETag of the group the update is based on",
+                        "name": "If-Match",
+                        "in": "header",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "204": {
+                        "description": ""
+                    },
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
-                            "type": "file"
+                            "$ref": "#/definitions/echo.HTTPError"
                         }
                     },
                     "401": {
@@ -705,6 +914,18 @@ const docTemplate = `{
                             "$ref": "#/definitions/echo.HTTPError"
                         }
                     },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "428": {
+                        "description": "Precondition Required",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -712,36 +933,68 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/shows": {
-            "get": {
+            },
+            "delete": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Get show schedules",
+                "description": "Delete group by ID, with its address, properties, members and achievements. The show schedules not finished yet and the finished ones are each deleted along (cascade), prevent the deletion (block) or are kept without a group (detach). With dry_run, the records that would be affected are reported and nothing is deleted.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "shows"
+                    "groups"
                 ],
-                "summary": "Get Show Schedules",
+                "summary": "Delete Group by ID",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "filter show schedules by group ID",
-                        "name": "group_id",
+                        "description": "group ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "cascade, block or detach the show schedules not finished yet, default to cascade",
+                        "name": "future_shows",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "cascade, block or detach the finished show schedules, default to cascade",
+                        "name": "past_shows",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "report the affected records without deleting anything",
+                        "name": "dry_run",
                         "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the group the deletion is based on, required unless dry_run",
+                        "name": "If-Match",
+                        "in": "header"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/controller.showSchedulesResponse"
+                            "$ref": "#/definitions/controller.groupDeletionResponse"
+                        }
+                    },
+                    "204": {
+                        "description": ""
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
                         }
                     },
                     "401": {
@@ -756,6 +1009,24 @@ const docTemplate = `{
                             "$ref": "#/definitions/echo.HTTPError"
                         }
                     },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "428": {
+                        "description": "Precondition Required",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -764,40 +1035,52 @@ const docTemplate = `{
                     }
                 }
             },
-            "post": {
+            "patch": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Create a new show schedule",
+                "description": "Change some fields of a group with a JSON merge patch (RFC 7396). Absent fields are kept, null is rejected. Replacing the leader records a leadership change, as with the update.",
                 "consumes": [
+                    "application/merge-patch+json",
                     "application/json"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "shows"
+                    "groups"
                 ],
-                "summary": "Create a Show Schedule",
+                "summary": "Patch a Group",
                 "parameters": [
                     {
-                        "description": "request body",
+                        "description": "merge patch",
                         "name": "default",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/payload.CreateShowSchedule"
+                            "$ref": "#/definitions/payload.PatchGroup"
                         }
+                    },
+                    {
+                        "type": "string",
+                        "description": "group ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the group the patch is based on",
+                        "name": "If-Match",
+                        "in": "header",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
-                        "schema": {
-                            "$ref": "#/definitions/controller.createShowScheduleResponse"
-                        }
+                    "204": {
+                        "description": ""
                     },
                     "400": {
                         "description": "Bad Request",
@@ -817,6 +1100,24 @@ const docTemplate = `{
                             "$ref": "#/definitions/echo.HTTPError"
                         }
                     },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "415": {
+                        "description": "Unsupported Media Type",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "428": {
+                        "description": "Precondition Required",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -826,50 +1127,35 @@ const docTemplate = `{
                 }
             }
         },
-        "/shows/{id}": {
-            "put": {
+        "/groups/{id}/achievements": {
+            "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Update a show schedule",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Get the achievements of a group, from the most recent one",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "shows"
+                    "achievements"
                 ],
-                "summary": "Update a Show Schedule",
+                "summary": "Get Achievements",
                 "parameters": [
-                    {
-                        "description": "request body",
-                        "name": "default",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/payload.UpdateShowSchedule"
-                        }
-                    },
                     {
                         "type": "string",
-                        "description": "show schedule ID",
+                        "description": "group ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": ""
-                    },
-                    "400": {
-                        "description": "Bad Request",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/echo.HTTPError"
+                            "$ref": "#/definitions/controller.achievementsResponse"
                         }
                     },
                     "401": {
@@ -892,37 +1178,58 @@ const docTemplate = `{
                     }
                 }
             },
-            "delete": {
+            "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Delete show schedule by ID",
+                "description": "Add an achievement won by a group",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "shows"
+                    "achievements"
                 ],
-                "summary": "Delete Show Schedule by ID",
+                "summary": "Add an Achievement",
                 "parameters": [
+                    {
+                        "description": "request body",
+                        "name": "default",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/payload.CreateAchievement"
+                        }
+                    },
                     {
                         "type": "string",
-                        "description": "show schedule ID",
+                        "description": "group ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": ""
-                    },
-                    "401": {
-                        "description": "Unauthorized",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/echo.HTTPError"
+                            "$ref": "#/definitions/controller.createAchievementResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
                         }
                     },
                     "404": {
@@ -939,401 +1246,7239 @@ const docTemplate = `{
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "controller.createGroupResponse": {
-            "type": "object",
-            "properties": {
-                "status": {
-                    "type": "string",
-                    "x-order": "0"
-                },
-                "message": {
-                    "type": "string",
-                    "x-order": "1"
-                },
-                "data": {
-                    "x-order": "2",
-                    "$ref": "#/definitions/controller.idData"
+        },
+        "/groups/{id}/achievements/{achievementID}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get achievement by ID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "achievements"
+                ],
+                "summary": "Get Achievement by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "group ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "achievement ID",
+                        "name": "achievementID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/controller.achievementResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Update an achievement",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "achievements"
+                ],
+                "summary": "Update an Achievement",
+                "parameters": [
+                    {
+                        "description": "request body",
+                        "name": "default",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/payload.UpdateAchievement"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "group ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "achievement ID",
+                        "name": "achievementID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": ""
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Delete an achievement",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "achievements"
+                ],
+                "summary": "Delete an Achievement",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "group ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "achievement ID",
+                        "name": "achievementID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": ""
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/groups/{id}/achievements/{achievementID}/certificate": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Upload the certificate scan of an achievement, as a photo (JPEG, PNG, GIF or WebP) or a PDF document of at most 10 MB. An achievement holds one certificate, delete the current one to replace it.",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "attachments"
+                ],
+                "summary": "Upload an Achievement Certificate",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "group ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "achievement ID",
+                        "name": "achievementID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "file",
+                        "description": "certificate file",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/controller.createAttachmentResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "413": {
+                        "description": "Request Entity Too Large",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "415": {
+                        "description": "Unsupported Media Type",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Delete the certificate scan of an achievement, together with its files",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "attachments"
+                ],
+                "summary": "Delete an Achievement Certificate",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "group ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "achievement ID",
+                        "name": "achievementID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": ""
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/groups/{id}/attachments": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Upload a photo (JPEG, PNG, GIF or WebP) or a PDF document of at most 10 MB for a group",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "attachments"
+                ],
+                "summary": "Upload a Group Attachment",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "group ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "file",
+                        "description": "attachment file",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/controller.createAttachmentResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "413": {
+                        "description": "Request Entity Too Large",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "415": {
+                        "description": "Unsupported Media Type",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/groups/{id}/attachments/{attachmentID}": {
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Delete an attachment of a group, together with its files",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "attachments"
+                ],
+                "summary": "Delete a Group Attachment",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "group ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "attachment ID",
+                        "name": "attachmentID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": ""
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/groups/{id}/certificate": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Generate a printable PDF registration certificate with the group data, address and QR code",
+                "produces": [
+                    "application/pdf"
+                ],
+                "tags": [
+                    "groups"
+                ],
+                "summary": "Generate Registration Certificate",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "group ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/groups/{id}/contacts": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Replace the contacts of a group. Phone numbers are normalized to the +62 format and social media profile URLs to handles.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "groups"
+                ],
+                "summary": "Update Group Contacts",
+                "parameters": [
+                    {
+                        "description": "request body",
+                        "name": "default",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/payload.UpdateGroupContacts"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "group ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the group the update is based on",
+                        "name": "If-Match",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": ""
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "428": {
+                        "description": "Precondition Required",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/groups/{id}/generate": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Generate QR Code",
+                "produces": [
+                    "image/png"
+                ],
+                "tags": [
+                    "groups"
+                ],
+                "summary": "Generate QR Code",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "group ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/groups/{id}/leaders": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get the leadership changes of a group, ordered by the date they took effect",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "groups"
+                ],
+                "summary": "Get Group Leadership History",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "group ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/controller.groupLeadershipHistoryResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/groups/{id}/members": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get the members of a group",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "members"
+                ],
+                "summary": "Get Members",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "group ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/controller.membersResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Add a member to a group",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "members"
+                ],
+                "summary": "Add a Member",
+                "parameters": [
+                    {
+                        "description": "request body",
+                        "name": "default",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/payload.CreateMember"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "group ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/controller.createMemberResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/groups/{id}/members/{memberID}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get member by ID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "members"
+                ],
+                "summary": "Get Member by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "group ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "member ID",
+                        "name": "memberID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/controller.memberResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Update a member",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "members"
+                ],
+                "summary": "Update a Member",
+                "parameters": [
+                    {
+                        "description": "request body",
+                        "name": "default",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/payload.UpdateMember"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "group ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "member ID",
+                        "name": "memberID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": ""
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Delete a member",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "members"
+                ],
+                "summary": "Delete a Member",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "group ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "member ID",
+                        "name": "memberID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": ""
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/groups/{id}/merge": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Move the properties, show schedules, members, achievements and attachments of a group to the target group in one transaction. The group is left soft-deleted, pointing to the target. The address is not moved: the target keeps its own and only takes the location of the merged one when it has none, while the merged address stays with the soft-deleted group. Both groups must be unchanged since their ETags were read, the If-Match header for the merged group and targetETag for the target.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "groups"
+                ],
+                "summary": "Merge a Group",
+                "parameters": [
+                    {
+                        "description": "request body",
+                        "name": "default",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/payload.MergeGroup"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID of the group to merge",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the group to merge",
+                        "name": "If-Match",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": ""
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "428": {
+                        "description": "Precondition Required",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/groups/{id}/properties": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Add a Property",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "groups"
+                ],
+                "summary": "Add a Property",
+                "parameters": [
+                    {
+                        "description": "request body",
+                        "name": "default",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/payload.CreateProperty"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "group ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/controller.createPropertyResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/groups/{id}/properties/{propertyID}": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Update a Property. The amount cannot be lowered below the quantity still out on loan.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "groups"
+                ],
+                "summary": "Update a Property",
+                "parameters": [
+                    {
+                        "description": "request body",
+                        "name": "default",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/payload.UpdateProperty"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "group ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "property ID",
+                        "name": "propertyID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "quoted version of the property the update is based on",
+                        "name": "If-Match",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": ""
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "428": {
+                        "description": "Precondition Required",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Delete a Property. A property with items still out on loan cannot be deleted.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "groups"
+                ],
+                "summary": "Delete a Property",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "group ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "property ID",
+                        "name": "propertyID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "quoted version of the property the deletion is based on",
+                        "name": "If-Match",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": ""
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "428": {
+                        "description": "Precondition Required",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Change some fields of a property with a JSON merge patch (RFC 7396). Absent fields are kept, null is rejected. The amount cannot be lowered below the quantity still out on loan.",
+                "consumes": [
+                    "application/merge-patch+json",
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "groups"
+                ],
+                "summary": "Patch a Property",
+                "parameters": [
+                    {
+                        "description": "merge patch",
+                        "name": "default",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/payload.PatchProperty"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "group ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "property ID",
+                        "name": "propertyID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "quoted version of the property the patch is based on",
+                        "name": "If-Match",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": ""
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "415": {
+                        "description": "Unsupported Media Type",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "428": {
+                        "description": "Precondition Required",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/groups/{id}/properties/{propertyID}/attachments": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Upload a photo (JPEG, PNG, GIF or WebP) or a PDF document of at most 10 MB for a property",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "attachments"
+                ],
+                "summary": "Upload a Property Attachment",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "group ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "property ID",
+                        "name": "propertyID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "file",
+                        "description": "attachment file",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/controller.createAttachmentResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "413": {
+                        "description": "Request Entity Too Large",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "415": {
+                        "description": "Unsupported Media Type",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/groups/{id}/properties/{propertyID}/attachments/{attachmentID}": {
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Delete an attachment of a property, together with its files",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "attachments"
+                ],
+                "summary": "Delete a Property Attachment",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "group ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "property ID",
+                        "name": "propertyID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "attachment ID",
+                        "name": "attachmentID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": ""
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/groups/{id}/properties/{propertyID}/generate": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Generate Property QR Code",
+                "produces": [
+                    "image/png"
+                ],
+                "tags": [
+                    "groups"
+                ],
+                "summary": "Generate Property QR Code",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "group ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "property ID",
+                        "name": "propertyID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/groups/{id}/properties/{propertyID}/loans": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get the loans of a property, the ones still out first, along with the quantity available to lend",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "loans"
+                ],
+                "summary": "Get Loans",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "group ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "property ID",
+                        "name": "propertyID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/controller.propertyLoansResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Check items of a property out to another group, or to a borrower such as a school. The quantity must not exceed the available quantity.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "loans"
+                ],
+                "summary": "Lend a Property",
+                "parameters": [
+                    {
+                        "description": "request body",
+                        "name": "default",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/payload.CreateLoan"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "group ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "property ID",
+                        "name": "propertyID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/controller.createLoanResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/groups/{id}/properties/{propertyID}/loans/{loanID}/return": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Check the items of a loan back in",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "loans"
+                ],
+                "summary": "Return a Loan",
+                "parameters": [
+                    {
+                        "description": "request body",
+                        "name": "default",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/payload.ReturnLoan"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "group ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "property ID",
+                        "name": "propertyID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "loan ID",
+                        "name": "loanID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": ""
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/groups/{id}/properties/{propertyID}/maintenance": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get the maintenance records of a property, from the latest inspection",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "maintenance"
+                ],
+                "summary": "Get Maintenance Records",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "group ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "property ID",
+                        "name": "propertyID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/controller.maintenancesResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Record the condition of a property found on an inspection, with the damage and its repair if any. Photos are uploaded separately.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "maintenance"
+                ],
+                "summary": "Add a Maintenance Record",
+                "parameters": [
+                    {
+                        "description": "request body",
+                        "name": "default",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/payload.CreateMaintenance"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "group ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "property ID",
+                        "name": "propertyID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/controller.createMaintenanceResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/groups/{id}/properties/{propertyID}/maintenance/{maintenanceID}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get maintenance record by ID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "maintenance"
+                ],
+                "summary": "Get Maintenance Record by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "group ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "property ID",
+                        "name": "propertyID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "maintenance ID",
+                        "name": "maintenanceID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/controller.maintenanceResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Update a maintenance record, for example to set the repair date once the damage is repaired",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "maintenance"
+                ],
+                "summary": "Update a Maintenance Record",
+                "parameters": [
+                    {
+                        "description": "request body",
+                        "name": "default",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/payload.UpdateMaintenance"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "group ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "property ID",
+                        "name": "propertyID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "maintenance ID",
+                        "name": "maintenanceID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": ""
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Delete a maintenance record",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "maintenance"
+                ],
+                "summary": "Delete a Maintenance Record",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "group ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "property ID",
+                        "name": "propertyID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "maintenance ID",
+                        "name": "maintenanceID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": ""
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/groups/{id}/properties/{propertyID}/maintenance/{maintenanceID}/photos": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Upload a photo (JPEG, PNG, GIF or WebP) of at most 10 MB showing the condition of a property on a maintenance record",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "attachments"
+                ],
+                "summary": "Upload a Maintenance Photo",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "group ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "property ID",
+                        "name": "propertyID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "maintenance ID",
+                        "name": "maintenanceID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "file",
+                        "description": "photo file",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/controller.createAttachmentResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "413": {
+                        "description": "Request Entity Too Large",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "415": {
+                        "description": "Unsupported Media Type",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/groups/{id}/properties/{propertyID}/maintenance/{maintenanceID}/photos/{attachmentID}": {
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Delete a photo of a maintenance record, together with its files",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "attachments"
+                ],
+                "summary": "Delete a Maintenance Photo",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "group ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "property ID",
+                        "name": "propertyID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "maintenance ID",
+                        "name": "maintenanceID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "attachment ID",
+                        "name": "attachmentID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": ""
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/groups/{id}/status": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Change the lifecycle status of a group and record the transition with its reason and the acting admin",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "groups"
+                ],
+                "summary": "Update a Group Status",
+                "parameters": [
+                    {
+                        "description": "request body",
+                        "name": "default",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/payload.UpdateGroupStatus"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "group ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the group the status change is based on",
+                        "name": "If-Match",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": ""
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "428": {
+                        "description": "Precondition Required",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/groups/{id}/status/history": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get the status transitions of a group, oldest first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "groups"
+                ],
+                "summary": "Get Group Status History",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "group ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/controller.groupStatusHistoryResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/groups/{id}/vcard": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Generate a vCard of the group leader with the group contacts, to import in an address book",
+                "produces": [
+                    "text/vcard"
+                ],
+                "tags": [
+                    "groups"
+                ],
+                "summary": "Generate a Leader vCard",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "group ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/maintenance/poor-condition": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get the properties across the regency whose latest inspection found them in poor condition or broken and which are not repaired yet, ordered by district and group",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "maintenance"
+                ],
+                "summary": "Get Poor Condition Report",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "report only the groups of the district",
+                        "name": "districtID",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/controller.poorConditionReportResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/public/groups": {
+            "get": {
+                "description": "Get the active groups without their leader and private contacts, by name. No token is needed.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "public"
+                ],
+                "summary": "Get Public Groups",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "page number, start from 1, maximum 10000",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "number of groups per page, 20 by default and 100 at most",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter groups by district ID",
+                        "name": "district_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter groups by village ID",
+                        "name": "village_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter groups by name substring",
+                        "name": "name",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/controller.publicGroupsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/public/groups/{id}": {
+            "get": {
+                "description": "Get an active group without its leader and private contacts. No token is needed.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "public"
+                ],
+                "summary": "Get Public Group by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "group ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/controller.publicGroupResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/public/shows": {
+            "get": {
+                "description": "Get the show schedules of the active groups that are not finished yet, from the earliest one. No token is needed.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "public"
+                ],
+                "summary": "Get Upcoming Shows",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "filter show schedules by group ID",
+                        "name": "group_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter show schedules by the district ID of the group",
+                        "name": "district_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "number of show schedules, 20 by default and 100 at most",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/controller.upcomingShowsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/scan/{code}": {
+            "get": {
+                "description": "Resolve the code encoded in a group or property QR code. Browsers get a landing page, other clients get JSON. No token is needed.",
+                "produces": [
+                    "application/json",
+                    "text/html"
+                ],
+                "tags": [
+                    "public"
+                ],
+                "summary": "Resolve Scanned Code",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "signed code, or the bare group or property ID of the QR codes printed before the codes were signed",
+                        "name": "code",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/controller.scanResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/scan/{code}/verify": {
+            "get": {
+                "description": "Verify the signature of the code encoded in a group or property QR code, and that it still stands for an existing group or property. The groups that are not active are reported too. No token is needed.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "public"
+                ],
+                "summary": "Verify Signed Code",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "signed code",
+                        "name": "code",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/controller.codeVerificationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/search": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Search group names, leader names, addresses, property names and descriptions, and show schedule places, from the most relevant result",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "search"
+                ],
+                "summary": "Search",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "search query, quoted phrases, OR and -word are supported",
+                        "name": "q",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "number of results, 20 by default, maximum 50",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/controller.searchResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/shows": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get show schedules",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "shows"
+                ],
+                "summary": "Get Show Schedules",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "filter show schedules by group ID",
+                        "name": "group_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/controller.showSchedulesResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Create a new show schedule",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "shows"
+                ],
+                "summary": "Create a Show Schedule",
+                "parameters": [
+                    {
+                        "description": "request body",
+                        "name": "default",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/payload.CreateShowSchedule"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/controller.createShowScheduleResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/shows.geojson": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get show schedules as a GeoJSON feature collection, located by their venue. Shows without a known venue location have a null geometry.",
+                "produces": [
+                    "application/geo+json"
+                ],
+                "tags": [
+                    "shows"
+                ],
+                "summary": "Get Show Schedules as GeoJSON",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "filter show schedules by group ID",
+                        "name": "group_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/controller.showsGeoJSON"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/shows/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get Show Schedule by ID. The ETag header is sent back in the If-Match header to update or delete the show schedule.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "shows"
+                ],
+                "summary": "Get Show Schedule by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "show schedule ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the show schedule the client already has",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/controller.showScheduleResponse"
+                        }
+                    },
+                    "304": {
+                        "description": ""
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Update a show schedule",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "shows"
+                ],
+                "summary": "Update a Show Schedule",
+                "parameters": [
+                    {
+                        "description": "request body",
+                        "name": "default",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/payload.UpdateShowSchedule"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "show schedule ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the show schedule the update is based on",
+                        "name": "If-Match",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": ""
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "428": {
+                        "description": "Precondition Required",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Delete show schedule by ID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "shows"
+                ],
+                "summary": "Delete Show Schedule by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "show schedule ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the show schedule the deletion is based on",
+                        "name": "If-Match",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": ""
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "428": {
+                        "description": "Precondition Required",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Change some fields of a show schedule with a JSON merge patch (RFC 7396). Absent fields are kept, null is rejected.",
+                "consumes": [
+                    "application/merge-patch+json",
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "shows"
+                ],
+                "summary": "Patch a Show Schedule",
+                "parameters": [
+                    {
+                        "description": "merge patch",
+                        "name": "default",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/payload.PatchShowSchedule"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "show schedule ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the show schedule the patch is based on",
+                        "name": "If-Match",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": ""
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "415": {
+                        "description": "Unsupported Media Type",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "428": {
+                        "description": "Precondition Required",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/stats/districts": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get the number of active groups, the total property amounts by property name and the number of show schedules starting in the date range of each district",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "stats"
+                ],
+                "summary": "Get District Statistics",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "first day of the show schedules counted, in the 2006-01-02 layout, the first day of the current year by default",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "last day of the show schedules counted, in the 2006-01-02 layout, the last day of the current year by default",
+                        "name": "to",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/controller.districtStatsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/submissions": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get the submitted groups, the oldest first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "submissions"
+                ],
+                "summary": "Get Submissions",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "return only the submissions with the status: pending, approving, approved or rejected",
+                        "name": "status",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/controller.submissionsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Propose a new group, to be approved by an admin. At most 3 submissions are accepted from an IP address per day.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "submissions"
+                ],
+                "summary": "Submit a Group",
+                "parameters": [
+                    {
+                        "description": "request body",
+                        "name": "default",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/payload.SubmitGroup"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/controller.createSubmissionResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/submissions/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get submission by ID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "submissions"
+                ],
+                "summary": "Get Submission by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "submission ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/controller.submissionResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/submissions/{id}/approve": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Approve a pending submission, creating the group it proposes",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "submissions"
+                ],
+                "summary": "Approve a Submission",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "submission ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/controller.createGroupResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/submissions/{id}/reject": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Reject a pending submission",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "submissions"
+                ],
+                "summary": "Reject a Submission",
+                "parameters": [
+                    {
+                        "description": "request body",
+                        "name": "default",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/payload.RejectSubmission"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "submission ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": ""
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/trash/groups": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get the groups in the trash, most recently deleted first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "trash"
+                ],
+                "summary": "Get Deleted Groups",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/controller.deletedGroupsResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/trash/groups/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Permanently delete a group in the trash with everything that belongs to it, including the attachment files",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "trash"
+                ],
+                "summary": "Purge a Group",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "group ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": ""
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/trash/groups/{id}/restore": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Restore a deleted group together with the address, properties and members deleted with it",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "trash"
+                ],
+                "summary": "Restore a Group",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "group ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": ""
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/trash/properties": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get the properties in the trash, most recently deleted first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "trash"
+                ],
+                "summary": "Get Deleted Properties",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/controller.deletedPropertiesResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/trash/properties/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Permanently delete a property in the trash, including the attachment files. A property with items still out on loan cannot be purged.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "trash"
+                ],
+                "summary": "Purge a Property",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "property ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": ""
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/trash/properties/{id}/restore": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Restore a deleted property. The group it belongs to must not be deleted.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "trash"
+                ],
+                "summary": "Restore a Property",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "property ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": ""
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/trash/shows": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get the show schedules in the trash, most recently deleted first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "trash"
+                ],
+                "summary": "Get Deleted Show Schedules",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/controller.deletedShowSchedulesResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/trash/shows/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Permanently delete a show schedule in the trash",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "trash"
+                ],
+                "summary": "Purge a Show Schedule",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "show schedule ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": ""
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/trash/shows/{id}/restore": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Restore a deleted show schedule. The group it belongs to must not be deleted.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "trash"
+                ],
+                "summary": "Restore a Show Schedule",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "show schedule ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": ""
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "controller.achievementData": {
+            "type": "object",
+            "properties": {
+                "achievement": {
+                    "$ref": "#/definitions/response.Achievement"
+                }
+            }
+        },
+        "controller.achievementRankingData": {
+            "type": "object",
+            "properties": {
+                "ranking": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.DistrictRanking"
+                    }
+                }
+            }
+        },
+        "controller.achievementRankingResponse": {
+            "type": "object",
+            "properties": {
+                "status": {
+                    "type": "string",
+                    "x-order": "0"
+                },
+                "message": {
+                    "type": "string",
+                    "x-order": "1"
+                },
+                "data": {
+                    "x-order": "2",
+                    "$ref": "#/definitions/controller.achievementRankingData"
+                }
+            }
+        },
+        "controller.achievementResponse": {
+            "type": "object",
+            "properties": {
+                "status": {
+                    "type": "string",
+                    "x-order": "0"
+                },
+                "message": {
+                    "type": "string",
+                    "x-order": "1"
+                },
+                "data": {
+                    "x-order": "2",
+                    "$ref": "#/definitions/controller.achievementData"
+                }
+            }
+        },
+        "controller.achievementsData": {
+            "type": "object",
+            "properties": {
+                "achievements": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.Achievement"
+                    }
+                }
+            }
+        },
+        "controller.achievementsResponse": {
+            "type": "object",
+            "properties": {
+                "status": {
+                    "type": "string",
+                    "x-order": "0"
+                },
+                "message": {
+                    "type": "string",
+                    "x-order": "1"
+                },
+                "data": {
+                    "x-order": "2",
+                    "$ref": "#/definitions/controller.achievementsData"
+                }
+            }
+        },
+        "controller.codeVerificationData": {
+            "type": "object",
+            "properties": {
+                "verification": {
+                    "$ref": "#/definitions/response.CodeVerification"
+                }
+            }
+        },
+        "controller.codeVerificationResponse": {
+            "type": "object",
+            "properties": {
+                "status": {
+                    "type": "string",
+                    "x-order": "0"
+                },
+                "message": {
+                    "type": "string",
+                    "x-order": "1"
+                },
+                "data": {
+                    "x-order": "2",
+                    "$ref": "#/definitions/controller.codeVerificationData"
+                }
+            }
+        },
+        "controller.createAchievementResponse": {
+            "type": "object",
+            "properties": {
+                "status": {
+                    "type": "string",
+                    "x-order": "0"
+                },
+                "message": {
+                    "type": "string",
+                    "x-order": "1"
+                },
+                "data": {
+                    "x-order": "2",
+                    "$ref": "#/definitions/controller.idData"
+                }
+            }
+        },
+        "controller.createAttachmentResponse": {
+            "type": "object",
+            "properties": {
+                "status": {
+                    "type": "string",
+                    "x-order": "0"
+                },
+                "message": {
+                    "type": "string",
+                    "x-order": "1"
+                },
+                "data": {
+                    "x-order": "2",
+                    "$ref": "#/definitions/controller.idData"
+                }
+            }
+        },
+        "controller.createGroupResponse": {
+            "type": "object",
+            "properties": {
+                "status": {
+                    "type": "string",
+                    "x-order": "0"
+                },
+                "message": {
+                    "type": "string",
+                    "x-order": "1"
+                },
+                "data": {
+                    "x-order": "2",
+                    "$ref": "#/definitions/controller.idData"
+                }
+            }
+        },
+        "controller.createLoanResponse": {
+            "type": "object",
+            "properties": {
+                "status": {
+                    "type": "string",
+                    "x-order": "0"
+                },
+                "message": {
+                    "type": "string",
+                    "x-order": "1"
+                },
+                "data": {
+                    "x-order": "2",
+                    "$ref": "#/definitions/controller.idData"
+                }
+            }
+        },
+        "controller.createMaintenanceResponse": {
+            "type": "object",
+            "properties": {
+                "status": {
+                    "type": "string",
+                    "x-order": "0"
+                },
+                "message": {
+                    "type": "string",
+                    "x-order": "1"
+                },
+                "data": {
+                    "x-order": "2",
+                    "$ref": "#/definitions/controller.idData"
+                }
+            }
+        },
+        "controller.createMemberResponse": {
+            "type": "object",
+            "properties": {
+                "status": {
+                    "type": "string",
+                    "x-order": "0"
+                },
+                "message": {
+                    "type": "string",
+                    "x-order": "1"
+                },
+                "data": {
+                    "x-order": "2",
+                    "$ref": "#/definitions/controller.idData"
+                }
+            }
+        },
+        "controller.createPropertyResponse": {
+            "type": "object",
+            "properties": {
+                "status": {
+                    "type": "string",
+                    "x-order": "0"
+                },
+                "message": {
+                    "type": "string",
+                    "x-order": "1"
+                },
+                "data": {
+                    "x-order": "2",
+                    "$ref": "#/definitions/controller.idData"
+                }
+            }
+        },
+        "controller.createShowScheduleResponse": {
+            "type": "object",
+            "properties": {
+                "status": {
+                    "type": "string",
+                    "x-order": "0"
+                },
+                "message": {
+                    "type": "string",
+                    "x-order": "1"
+                },
+                "data": {
+                    "x-order": "2",
+                    "$ref": "#/definitions/controller.idData"
+                }
+            }
+        },
+        "controller.createSubmissionResponse": {
+            "type": "object",
+            "properties": {
+                "status": {
+                    "type": "string",
+                    "x-order": "0"
+                },
+                "message": {
+                    "type": "string",
+                    "x-order": "1"
+                },
+                "data": {
+                    "x-order": "2",
+                    "$ref": "#/definitions/controller.idData"
+                }
+            }
+        },
+        "controller.deletedGroupsData": {
+            "type": "object",
+            "properties": {
+                "groups": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.DeletedGroup"
+                    }
+                }
+            }
+        },
+        "controller.deletedGroupsResponse": {
+            "type": "object",
+            "properties": {
+                "status": {
+                    "type": "string",
+                    "x-order": "0"
+                },
+                "message": {
+                    "type": "string",
+                    "x-order": "1"
+                },
+                "data": {
+                    "x-order": "2",
+                    "$ref": "#/definitions/controller.deletedGroupsData"
+                }
+            }
+        },
+        "controller.deletedPropertiesData": {
+            "type": "object",
+            "properties": {
+                "properties": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.DeletedProperty"
+                    }
+                }
+            }
+        },
+        "controller.deletedPropertiesResponse": {
+            "type": "object",
+            "properties": {
+                "status": {
+                    "type": "string",
+                    "x-order": "0"
+                },
+                "message": {
+                    "type": "string",
+                    "x-order": "1"
+                },
+                "data": {
+                    "x-order": "2",
+                    "$ref": "#/definitions/controller.deletedPropertiesData"
+                }
+            }
+        },
+        "controller.deletedShowSchedulesData": {
+            "type": "object",
+            "properties": {
+                "showSchedules": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.DeletedShowSchedule"
+                    }
+                }
+            }
+        },
+        "controller.deletedShowSchedulesResponse": {
+            "type": "object",
+            "properties": {
+                "status": {
+                    "type": "string",
+                    "x-order": "0"
+                },
+                "message": {
+                    "type": "string",
+                    "x-order": "1"
+                },
+                "data": {
+                    "x-order": "2",
+                    "$ref": "#/definitions/controller.deletedShowSchedulesData"
+                }
+            }
+        },
+        "controller.districtStatsData": {
+            "type": "object",
+            "properties": {
+                "stats": {
+                    "$ref": "#/definitions/response.DistrictStatsReport"
+                }
+            }
+        },
+        "controller.districtStatsResponse": {
+            "type": "object",
+            "properties": {
+                "status": {
+                    "type": "string",
+                    "x-order": "0"
+                },
+                "message": {
+                    "type": "string",
+                    "x-order": "1"
+                },
+                "data": {
+                    "x-order": "2",
+                    "$ref": "#/definitions/controller.districtStatsData"
+                }
+            }
+        },
+        "controller.duplicateGroupsData": {
+            "type": "object",
+            "properties": {
+                "duplicates": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.DuplicateGroups"
+                    }
+                }
+            }
+        },
+        "controller.duplicateGroupsResponse": {
+            "type": "object",
+            "properties": {
+                "status": {
+                    "type": "string",
+                    "x-order": "0"
+                },
+                "message": {
+                    "type": "string",
+                    "x-order": "1"
+                },
+                "data": {
+                    "x-order": "2",
+                    "$ref": "#/definitions/controller.duplicateGroupsData"
+                }
+            }
+        },
+        "controller.geometry": {
+            "type": "object",
+            "properties": {
+                "coordinates": {
+                    "description": "Coordinates are in longitude, latitude order",
+                    "type": "array",
+                    "items": {
+                        "type": "number"
+                    }
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "controller.groupData": {
+            "type": "object",
+            "properties": {
+                "group": {
+                    "$ref": "#/definitions/response.Group"
+                }
+            }
+        },
+        "controller.groupDeletionData": {
+            "type": "object",
+            "properties": {
+                "deletion": {
+                    "$ref": "#/definitions/response.GroupDeletion"
+                }
+            }
+        },
+        "controller.groupDeletionResponse": {
+            "type": "object",
+            "properties": {
+                "status": {
+                    "type": "string",
+                    "x-order": "0"
+                },
+                "message": {
+                    "type": "string",
+                    "x-order": "1"
+                },
+                "data": {
+                    "x-order": "2",
+                    "$ref": "#/definitions/controller.groupDeletionData"
+                }
+            }
+        },
+        "controller.groupFeature": {
+            "type": "object",
+            "properties": {
+                "type": {
+                    "type": "string",
+                    "x-order": "0",
+                    "example": "Feature"
+                },
+                "id": {
+                    "type": "string",
+                    "x-order": "1"
+                },
+                "geometry": {
+                    "x-order": "2",
+                    "$ref": "#/definitions/controller.geometry"
+                },
+                "properties": {
+                    "x-order": "3",
+                    "$ref": "#/definitions/response.Group"
+                }
+            }
+        },
+        "controller.groupLeadershipHistoryData": {
+            "type": "object",
+            "properties": {
+                "leaders": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.LeadershipChange"
+                    }
+                }
+            }
+        },
+        "controller.groupLeadershipHistoryResponse": {
+            "type": "object",
+            "properties": {
+                "status": {
+                    "type": "string",
+                    "x-order": "0"
+                },
+                "message": {
+                    "type": "string",
+                    "x-order": "1"
+                },
+                "data": {
+                    "x-order": "2",
+                    "$ref": "#/definitions/controller.groupLeadershipHistoryData"
+                }
+            }
+        },
+        "controller.groupResponse": {
+            "type": "object",
+            "properties": {
+                "status": {
+                    "type": "string",
+                    "x-order": "0"
+                },
+                "message": {
+                    "type": "string",
+                    "x-order": "1"
+                },
+                "data": {
+                    "x-order": "2",
+                    "$ref": "#/definitions/controller.groupData"
+                }
+            }
+        },
+        "controller.groupStatusHistoryData": {
+            "type": "object",
+            "properties": {
+                "transitions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.GroupStatusTransition"
+                    }
+                }
+            }
+        },
+        "controller.groupStatusHistoryResponse": {
+            "type": "object",
+            "properties": {
+                "status": {
+                    "type": "string",
+                    "x-order": "0"
+                },
+                "message": {
+                    "type": "string",
+                    "x-order": "1"
+                },
+                "data": {
+                    "x-order": "2",
+                    "$ref": "#/definitions/controller.groupStatusHistoryData"
+                }
+            }
+        },
+        "controller.groupsData": {
+            "type": "object",
+            "properties": {
+                "groups": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.Group"
+                    }
+                },
+                "pagination": {
+                    "$ref": "#/definitions/response.Pagination"
+                }
+            }
+        },
+        "controller.groupsGeoJSON": {
+            "type": "object",
+            "properties": {
+                "type": {
+                    "type": "string",
+                    "x-order": "0",
+                    "example": "FeatureCollection"
+                },
+                "features": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/controller.groupFeature"
+                    },
+                    "x-order": "1"
+                }
+            }
+        },
+        "controller.groupsResponse": {
+            "type": "object",
+            "properties": {
+                "status": {
+                    "type": "string",
+                    "x-order": "0"
+                },
+                "message": {
+                    "type": "string",
+                    "x-order": "1"
+                },
+                "data": {
+                    "x-order": "2",
+                    "$ref": "#/definitions/controller.groupsData"
+                }
+            }
+        },
+        "controller.idData": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string"
+                }
+            }
+        },
+        "controller.importGroupsData": {
+            "type": "object",
+            "properties": {
+                "groups": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.ImportGroup"
+                    }
+                }
+            }
+        },
+        "controller.importGroupsResponse": {
+            "type": "object",
+            "properties": {
+                "status": {
+                    "type": "string",
+                    "x-order": "0"
+                },
+                "message": {
+                    "type": "string",
+                    "x-order": "1"
+                },
+                "data": {
+                    "x-order": "2",
+                    "$ref": "#/definitions/controller.importGroupsData"
+                }
+            }
+        },
+        "controller.loginResponse": {
+            "type": "object",
+            "properties": {
+                "status": {
+                    "type": "string",
+                    "maxLength": 80,
+                    "minLength": 2,
+                    "x-order": "0"
+                },
+                "message": {
+                    "type": "string",
+                    "maxLength": 80,
+                    "minLength": 2,
+                    "x-order": "1"
+                },
+                "data": {
+                    "x-order": "2",
+                    "$ref": "#/definitions/controller.tokenData"
+                }
+            }
+        },
+        "controller.maintenanceData": {
+            "type": "object",
+            "properties": {
+                "maintenance": {
+                    "$ref": "#/definitions/response.Maintenance"
+                }
+            }
+        },
+        "controller.maintenanceResponse": {
+            "type": "object",
+            "properties": {
+                "status": {
+                    "type": "string",
+                    "x-order": "0"
+                },
+                "message": {
+                    "type": "string",
+                    "x-order": "1"
+                },
+                "data": {
+                    "x-order": "2",
+                    "$ref": "#/definitions/controller.maintenanceData"
+                }
+            }
+        },
+        "controller.maintenancesData": {
+            "type": "object",
+            "properties": {
+                "maintenances": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.Maintenance"
+                    }
+                }
+            }
+        },
+        "controller.maintenancesResponse": {
+            "type": "object",
+            "properties": {
+                "status": {
+                    "type": "string",
+                    "x-order": "0"
+                },
+                "message": {
+                    "type": "string",
+                    "x-order": "1"
+                },
+                "data": {
+                    "x-order": "2",
+                    "$ref": "#/definitions/controller.maintenancesData"
+                }
+            }
+        },
+        "controller.memberData": {
+            "type": "object",
+            "properties": {
+                "member": {
+                    "$ref": "#/definitions/response.Member"
+                }
+            }
+        },
+        "controller.memberResponse": {
+            "type": "object",
+            "properties": {
+                "status": {
+                    "type": "string",
+                    "x-order": "0"
+                },
+                "message": {
+                    "type": "string",
+                    "x-order": "1"
+                },
+                "data": {
+                    "x-order": "2",
+                    "$ref": "#/definitions/controller.memberData"
+                }
+            }
+        },
+        "controller.membersData": {
+            "type": "object",
+            "properties": {
+                "members": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.Member"
+                    }
+                }
+            }
+        },
+        "controller.membersResponse": {
+            "type": "object",
+            "properties": {
+                "status": {
+                    "type": "string",
+                    "x-order": "0"
+                },
+                "message": {
+                    "type": "string",
+                    "x-order": "1"
+                },
+                "data": {
+                    "x-order": "2",
+                    "$ref": "#/definitions/controller.membersData"
+                }
+            }
+        },
+        "controller.nearbyGroupsData": {
+            "type": "object",
+            "properties": {
+                "groups": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.NearbyGroup"
+                    }
+                }
+            }
+        },
+        "controller.nearbyGroupsResponse": {
+            "type": "object",
+            "properties": {
+                "status": {
+                    "type": "string",
+                    "x-order": "0"
+                },
+                "message": {
+                    "type": "string",
+                    "x-order": "1"
+                },
+                "data": {
+                    "x-order": "2",
+                    "$ref": "#/definitions/controller.nearbyGroupsData"
+                }
+            }
+        },
+        "controller.poorConditionReportData": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.PoorConditionItem"
+                    }
+                }
+            }
+        },
+        "controller.poorConditionReportResponse": {
+            "type": "object",
+            "properties": {
+                "status": {
+                    "type": "string",
+                    "x-order": "0"
+                },
+                "message": {
+                    "type": "string",
+                    "x-order": "1"
+                },
+                "data": {
+                    "x-order": "2",
+                    "$ref": "#/definitions/controller.poorConditionReportData"
+                }
+            }
+        },
+        "controller.propertyLoansData": {
+            "type": "object",
+            "properties": {
+                "loans": {
+                    "$ref": "#/definitions/response.PropertyLoans"
+                }
+            }
+        },
+        "controller.propertyLoansResponse": {
+            "type": "object",
+            "properties": {
+                "status": {
+                    "type": "string",
+                    "x-order": "0"
+                },
+                "message": {
+                    "type": "string",
+                    "x-order": "1"
+                },
+                "data": {
+                    "x-order": "2",
+                    "$ref": "#/definitions/controller.propertyLoansData"
+                }
+            }
+        },
+        "controller.publicGroupData": {
+            "type": "object",
+            "properties": {
+                "group": {
+                    "$ref": "#/definitions/response.PublicGroup"
+                }
+            }
+        },
+        "controller.publicGroupResponse": {
+            "type": "object",
+            "properties": {
+                "status": {
+                    "type": "string",
+                    "x-order": "0"
+                },
+                "message": {
+                    "type": "string",
+                    "x-order": "1"
+                },
+                "data": {
+                    "x-order": "2",
+                    "$ref": "#/definitions/controller.publicGroupData"
+                }
+            }
+        },
+        "controller.publicGroupsData": {
+            "type": "object",
+            "properties": {
+                "groups": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.PublicGroup"
+                    }
+                },
+                "pagination": {
+                    "$ref": "#/definitions/response.Pagination"
+                }
+            }
+        },
+        "controller.publicGroupsResponse": {
+            "type": "object",
+            "properties": {
+                "status": {
+                    "type": "string",
+                    "x-order": "0"
+                },
+                "message": {
+                    "type": "string",
+                    "x-order": "1"
+                },
+                "data": {
+                    "x-order": "2",
+                    "$ref": "#/definitions/controller.publicGroupsData"
+                }
+            }
+        },
+        "controller.scanData": {
+            "type": "object",
+            "properties": {
+                "scan": {
+                    "$ref": "#/definitions/response.ScanResult"
+                }
+            }
+        },
+        "controller.scanResponse": {
+            "type": "object",
+            "properties": {
+                "status": {
+                    "type": "string",
+                    "x-order": "0"
+                },
+                "message": {
+                    "type": "string",
+                    "x-order": "1"
+                },
+                "data": {
+                    "x-order": "2",
+                    "$ref": "#/definitions/controller.scanData"
+                }
+            }
+        },
+        "controller.searchData": {
+            "type": "object",
+            "properties": {
+                "results": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.SearchResult"
+                    }
+                }
+            }
+        },
+        "controller.searchResponse": {
+            "type": "object",
+            "properties": {
+                "status": {
+                    "type": "string",
+                    "x-order": "0"
+                },
+                "message": {
+                    "type": "string",
+                    "x-order": "1"
+                },
+                "data": {
+                    "x-order": "2",
+                    "$ref": "#/definitions/controller.searchData"
+                }
+            }
+        },
+        "controller.showFeature": {
+            "type": "object",
+            "properties": {
+                "type": {
+                    "type": "string",
+                    "x-order": "0",
+                    "example": "Feature"
+                },
+                "id": {
+                    "type": "string",
+                    "x-order": "1"
+                },
+                "geometry": {
+                    "x-order": "2",
+                    "$ref": "#/definitions/controller.geometry"
+                },
+                "properties": {
+                    "x-order": "3",
+                    "$ref": "#/definitions/response.ShowSchedule"
+                }
+            }
+        },
+        "controller.showScheduleData": {
+            "type": "object",
+            "properties": {
+                "show": {
+                    "$ref": "#/definitions/response.ShowScheduleDetails"
+                }
+            }
+        },
+        "controller.showScheduleResponse": {
+            "type": "object",
+            "properties": {
+                "status": {
+                    "type": "string",
+                    "x-order": "0"
+                },
+                "message": {
+                    "type": "string",
+                    "x-order": "1"
+                },
+                "data": {
+                    "x-order": "2",
+                    "$ref": "#/definitions/controller.showScheduleData"
+                }
+            }
+        },
+        "controller.showSchedulesData": {
+            "type": "object",
+            "properties": {
+                "shows": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.ShowSchedule"
+                    }
+                }
+            }
+        },
+        "controller.showSchedulesResponse": {
+            "type": "object",
+            "properties": {
+                "status": {
+                    "type": "string",
+                    "x-order": "0"
+                },
+                "message": {
+                    "type": "string",
+                    "x-order": "1"
+                },
+                "data": {
+                    "x-order": "2",
+                    "$ref": "#/definitions/controller.showSchedulesData"
+                }
+            }
+        },
+        "controller.showsGeoJSON": {
+            "type": "object",
+            "properties": {
+                "type": {
+                    "type": "string",
+                    "x-order": "0",
+                    "example": "FeatureCollection"
+                },
+                "features": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/controller.showFeature"
+                    },
+                    "x-order": "1"
+                }
+            }
+        },
+        "controller.submissionData": {
+            "type": "object",
+            "properties": {
+                "submission": {
+                    "$ref": "#/definitions/response.Submission"
+                }
+            }
+        },
+        "controller.submissionResponse": {
+            "type": "object",
+            "properties": {
+                "status": {
+                    "type": "string",
+                    "x-order": "0"
+                },
+                "message": {
+                    "type": "string",
+                    "x-order": "1"
+                },
+                "data": {
+                    "x-order": "2",
+                    "$ref": "#/definitions/controller.submissionData"
+                }
+            }
+        },
+        "controller.submissionsData": {
+            "type": "object",
+            "properties": {
+                "submissions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.Submission"
+                    }
+                }
+            }
+        },
+        "controller.submissionsResponse": {
+            "type": "object",
+            "properties": {
+                "status": {
+                    "type": "string",
+                    "x-order": "0"
+                },
+                "message": {
+                    "type": "string",
+                    "x-order": "1"
+                },
+                "data": {
+                    "x-order": "2",
+                    "$ref": "#/definitions/controller.submissionsData"
+                }
+            }
+        },
+        "controller.tokenData": {
+            "type": "object",
+            "properties": {
+                "token": {
+                    "type": "string"
+                }
+            }
+        },
+        "controller.upcomingShowsData": {
+            "type": "object",
+            "properties": {
+                "shows": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.PublicShowSchedule"
+                    }
+                }
+            }
+        },
+        "controller.upcomingShowsResponse": {
+            "type": "object",
+            "properties": {
+                "status": {
+                    "type": "string",
+                    "x-order": "0"
+                },
+                "message": {
+                    "type": "string",
+                    "x-order": "1"
+                },
+                "data": {
+                    "x-order": "2",
+                    "$ref": "#/definitions/controller.upcomingShowsData"
+                }
+            }
+        },
+        "echo.HTTPError": {
+            "type": "object",
+            "properties": {
+                "message": {}
+            }
+        },
+        "payload.CreateAchievement": {
+            "type": "object",
+            "properties": {
+                "eventName": {
+                    "type": "string",
+                    "maxLength": 120,
+                    "minLength": 2,
+                    "x-order": "0"
+                },
+                "year": {
+                    "type": "integer",
+                    "minimum": 1900,
+                    "x-order": "1"
+                },
+                "level": {
+                    "description": "Level is one of village, regency or national",
+                    "type": "string",
+                    "x-order": "2"
+                },
+                "rank": {
+                    "description": "Rank is the place the group finished in, 1 to 3 count as gold, silver and bronze medals",
+                    "type": "integer",
+                    "minimum": 1,
+                    "x-order": "3"
+                }
+            }
+        },
+        "payload.CreateGroup": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "maxLength": 80,
+                    "minLength": 2,
+                    "x-order": "0"
+                },
+                "leader": {
+                    "type": "string",
+                    "maxLength": 80,
+                    "minLength": 2,
+                    "x-order": "1"
+                },
+                "address": {
+                    "type": "string",
+                    "maxLength": 1000,
+                    "minLength": 2,
+                    "x-order": "2"
+                },
+                "villageID": {
+                    "type": "string",
+                    "maxLength": 20,
+                    "minLength": 2,
+                    "x-order": "3"
+                },
+                "latitude": {
+                    "description": "Latitude and Longitude are optional, but go together and must lie in Ponorogo",
+                    "type": "number",
+                    "x-order": "4"
+                },
+                "longitude": {
+                    "type": "number",
+                    "x-order": "5"
+                }
+            }
+        },
+        "payload.CreateLoan": {
+            "type": "object",
+            "properties": {
+                "borrowerGroupID": {
+                    "description": "Either BorrowerGroupID, when another group borrows the items, or BorrowerName, such as a school, is given",
+                    "type": "string",
+                    "maxLength": 10,
+                    "x-order": "0"
+                },
+                "borrowerName": {
+                    "type": "string",
+                    "maxLength": 120,
+                    "x-order": "1"
+                },
+                "quantity": {
+                    "type": "integer",
+                    "minimum": 1,
+                    "x-order": "2"
+                },
+                "dueOn": {
+                    "description": "DueOn layout format: 2006-01-02",
+                    "type": "string",
+                    "maxLength": 10,
+                    "x-order": "3"
+                },
+                "note": {
+                    "type": "string",
+                    "maxLength": 500,
+                    "x-order": "4"
+                }
+            }
+        },
+        "payload.CreateMaintenance": {
+            "type": "object",
+            "properties": {
+                "condition": {
+                    "description": "Condition is one of good, fair, poor or broken",
+                    "type": "string",
+                    "x-order": "0"
+                },
+                "inspectedOn": {
+                    "description": "InspectedOn layout format: 2006-01-02",
+                    "type": "string",
+                    "maxLength": 10,
+                    "x-order": "1"
+                },
+                "damageDescription": {
+                    "type": "string",
+                    "maxLength": 500,
+                    "x-order": "2"
+                },
+                "repairedOn": {
+                    "description": "RepairedOn is left out while the damage is not repaired\nRepairedOn layout format: 2006-01-02",
+                    "type": "string",
+                    "maxLength": 10,
+                    "x-order": "3"
+                },
+                "cost": {
+                    "description": "Cost is the cost of the repair in rupiah",
+                    "type": "integer",
+                    "x-order": "4"
+                }
+            }
+        },
+        "payload.CreateMember": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "maxLength": 80,
+                    "minLength": 2,
+                    "x-order": "0"
+                },
+                "role": {
+                    "description": "Role is one of warok, jathil, bujang_ganong, klono_sewandono, pembarong or musician",
+                    "type": "string",
+                    "x-order": "1"
+                },
+                "gender": {
+                    "description": "Gender is one of male or female",
+                    "type": "string",
+                    "x-order": "2"
+                },
+                "birthYear": {
+                    "type": "integer",
+                    "minimum": 1900,
+                    "x-order": "3"
+                },
+                "phone": {
+                    "type": "string",
+                    "maxLength": 20,
+                    "x-order": "4"
+                },
+                "joinedOn": {
+                    "description": "JoinedOn layout format: 2006-01-02",
+                    "type": "string",
+                    "maxLength": 10,
+                    "x-order": "5"
+                }
+            }
+        },
+        "payload.CreateProperty": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "maxLength": 80,
+                    "minLength": 2,
+                    "x-order": "0"
+                },
+                "description": {
+                    "type": "string",
+                    "maxLength": 1000,
+                    "minLength": 2,
+                    "x-order": "1"
+                },
+                "amount": {
+                    "type": "integer",
+                    "minimum": 1,
+                    "x-order": "2"
+                }
+            }
+        },
+        "payload.CreateShowSchedule": {
+            "type": "object",
+            "properties": {
+                "groupID": {
+                    "type": "string",
+                    "maxLength": 10,
+                    "minLength": 2,
+                    "x-order": "0"
+                },
+                "place": {
+                    "type": "string",
+                    "maxLength": 1000,
+                    "minLength": 2,
+                    "x-order": "1"
+                },
+                "startOn": {
+                    "description": "StartOn layout format: time.RFC822 (02 Jan 06 15:04 MST)",
+                    "type": "string",
+                    "maxLength": 30,
+                    "minLength": 2,
+                    "x-order": "2"
+                },
+                "finishOn": {
+                    "description": "FinishOn layout format: time.RFC822 (02 Jan 06 15:04 MST)",
+                    "type": "string",
+                    "maxLength": 30,
+                    "minLength": 2,
+                    "x-order": "3"
+                },
+                "latitude": {
+                    "description": "Latitude and Longitude of the venue are optional, but go together",
+                    "type": "number",
+                    "maximum": 90,
+                    "minimum": -90,
+                    "x-order": "4"
+                },
+                "longitude": {
+                    "type": "number",
+                    "maximum": 180,
+                    "minimum": -180,
+                    "x-order": "5"
+                }
+            }
+        },
+        "payload.Credential": {
+            "type": "object",
+            "properties": {
+                "username": {
+                    "type": "string",
+                    "maxLength": 20,
+                    "minLength": 2,
+                    "x-order": "0"
+                },
+                "password": {
+                    "type": "string",
+                    "maxLength": 50,
+                    "minLength": 2,
+                    "x-order": "1"
+                }
+            }
+        },
+        "payload.MergeGroup": {
+            "type": "object",
+            "properties": {
+                "targetID": {
+                    "description": "TargetID is the group that receives the properties, show schedules, members and achievements",
+                    "type": "string",
+                    "maxLength": 10,
+                    "minLength": 2,
+                    "x-order": "0"
+                },
+                "targetETag": {
+                    "description": "TargetETag is the ETag of the target group the merge is based on, as the If-Match header is for the merged group",
+                    "type": "string",
+                    "x-order": "1"
+                }
+            }
+        },
+        "payload.PatchAddress": {
+            "type": "object",
+            "properties": {
+                "address": {
+                    "type": "string",
+                    "maxLength": 1000,
+                    "minLength": 2,
+                    "x-order": "0"
+                },
+                "villageID": {
+                    "type": "string",
+                    "maxLength": 20,
+                    "minLength": 2,
+                    "x-order": "1"
+                },
+                "latitude": {
+                    "description": "Latitude and Longitude go together and must lie in Ponorogo, they replace the current location",
+                    "type": "number",
+                    "x-order": "2"
+                },
+                "longitude": {
+                    "type": "number",
+                    "x-order": "3"
+                }
+            }
+        },
+        "payload.PatchGroup": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "maxLength": 80,
+                    "minLength": 2,
+                    "x-order": "0"
+                },
+                "leader": {
+                    "type": "string",
+                    "maxLength": 80,
+                    "minLength": 2,
+                    "x-order": "1"
+                },
+                "leaderSince": {
+                    "description": "LeaderSince and LeaderNote are only used when the patch replaces the leader, as in UpdateGroup",
+                    "type": "string",
+                    "maxLength": 10,
+                    "x-order": "2"
+                },
+                "leaderNote": {
+                    "type": "string",
+                    "maxLength": 500,
+                    "x-order": "3"
+                }
+            }
+        },
+        "payload.PatchProperty": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "maxLength": 80,
+                    "minLength": 2,
+                    "x-order": "0"
+                },
+                "description": {
+                    "type": "string",
+                    "maxLength": 1000,
+                    "minLength": 2,
+                    "x-order": "1"
+                },
+                "amount": {
+                    "type": "integer",
+                    "minimum": 1,
+                    "x-order": "2"
+                }
+            }
+        },
+        "payload.PatchShowSchedule": {
+            "type": "object",
+            "properties": {
+                "place": {
+                    "type": "string",
+                    "maxLength": 1000,
+                    "minLength": 2,
+                    "x-order": "0"
+                },
+                "startOn": {
+                    "description": "StartOn layout format: time.RFC822 (02 Jan 06 15:04 MST)",
+                    "type": "string",
+                    "maxLength": 30,
+                    "minLength": 2,
+                    "x-order": "1"
+                },
+                "finishOn": {
+                    "description": "FinishOn layout format: time.RFC822 (02 Jan 06 15:04 MST)",
+                    "type": "string",
+                    "maxLength": 30,
+                    "minLength": 2,
+                    "x-order": "2"
+                },
+                "latitude": {
+                    "description": "Latitude and Longitude of the venue still go together, they replace the current location",
+                    "type": "number",
+                    "maximum": 90,
+                    "minimum": -90,
+                    "x-order": "3"
+                },
+                "longitude": {
+                    "type": "number",
+                    "maximum": 180,
+                    "minimum": -180,
+                    "x-order": "4"
+                }
+            }
+        },
+        "payload.RejectSubmission": {
+            "type": "object",
+            "properties": {
+                "reason": {
+                    "description": "Reason is kept with the submission to explain the decision to the other admins",
+                    "type": "string",
+                    "maxLength": 500,
+                    "minLength": 2,
+                    "x-order": "0"
+                }
+            }
+        },
+        "payload.ReturnLoan": {
+            "type": "object",
+            "properties": {
+                "returnedOn": {
+                    "description": "ReturnedOn defaults to today\nReturnedOn layout format: 2006-01-02",
+                    "type": "string",
+                    "maxLength": 10,
+                    "x-order": "0"
+                }
+            }
+        },
+        "payload.SubmitGroup": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "maxLength": 80,
+                    "minLength": 2,
+                    "x-order": "0"
+                },
+                "leader": {
+                    "type": "string",
+                    "maxLength": 80,
+                    "minLength": 2,
+                    "x-order": "1"
+                },
+                "address": {
+                    "type": "string",
+                    "maxLength": 1000,
+                    "minLength": 2,
+                    "x-order": "2"
+                },
+                "villageID": {
+                    "type": "string",
+                    "maxLength": 20,
+                    "minLength": 2,
+                    "x-order": "3"
+                },
+                "latitude": {
+                    "description": "Latitude and Longitude are optional, but go together and must lie in Ponorogo",
+                    "type": "number",
+                    "x-order": "4"
+                },
+                "longitude": {
+                    "type": "number",
+                    "x-order": "5"
+                },
+                "contactPhone": {
+                    "description": "ContactPhone is an Indonesian number starting with +62, 62 or 0",
+                    "type": "string",
+                    "maxLength": 20,
+                    "x-order": "6"
+                },
+                "contactEmail": {
+                    "type": "string",
+                    "maxLength": 254,
+                    "x-order": "7"
+                },
+                "website": {
+                    "description": "Website is a honeypot. The submission form hides it from people, so only spam bots fill it in.",
+                    "type": "string",
+                    "x-order": "8"
+                }
+            }
+        },
+        "payload.UpdateAchievement": {
+            "type": "object",
+            "properties": {
+                "eventName": {
+                    "type": "string",
+                    "maxLength": 120,
+                    "minLength": 2,
+                    "x-order": "0"
+                },
+                "year": {
+                    "type": "integer",
+                    "minimum": 1900,
+                    "x-order": "1"
+                },
+                "level": {
+                    "description": "Level is one of village, regency or national",
+                    "type": "string",
+                    "x-order": "2"
+                },
+                "rank": {
+                    "description": "Rank is the place the group finished in, 1 to 3 count as gold, silver and bronze medals",
+                    "type": "integer",
+                    "minimum": 1,
+                    "x-order": "3"
+                }
+            }
+        },
+        "payload.UpdateAddress": {
+            "type": "object",
+            "properties": {
+                "address": {
+                    "type": "string",
+                    "maxLength": 1000,
+                    "minLength": 2,
+                    "x-order": "0"
+                },
+                "villageID": {
+                    "type": "string",
+                    "maxLength": 20,
+                    "minLength": 2,
+                    "x-order": "1"
+                },
+                "latitude": {
+                    "description": "Latitude and Longitude are optional, but go together and must lie in Ponorogo. Omit both to keep the current location.",
+                    "type": "number",
+                    "x-order": "2"
+                },
+                "longitude": {
+                    "type": "number",
+                    "x-order": "3"
+                }
+            }
+        },
+        "payload.UpdateGroup": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "maxLength": 80,
+                    "minLength": 2,
+                    "x-order": "0"
+                },
+                "leader": {
+                    "type": "string",
+                    "maxLength": 80,
+                    "minLength": 2,
+                    "x-order": "1"
+                },
+                "leaderSince": {
+                    "description": "LeaderSince is the date the new leader took over, defaults to today. It is ignored when the leader is unchanged.\nLeaderSince layout format: 2006-01-02",
+                    "type": "string",
+                    "maxLength": 10,
+                    "x-order": "2"
+                },
+                "leaderNote": {
+                    "description": "LeaderNote is recorded with the leadership change, it is ignored when the leader is unchanged",
+                    "type": "string",
+                    "maxLength": 500,
+                    "x-order": "3"
+                }
+            }
+        },
+        "payload.UpdateGroupContacts": {
+            "type": "object",
+            "properties": {
+                "phone": {
+                    "description": "Phone and WhatsApp are Indonesian numbers starting with +62, 62 or 0, WhatsApp must be a mobile number",
+                    "type": "string",
+                    "maxLength": 20,
+                    "x-order": "0"
+                },
+                "whatsapp": {
+                    "type": "string",
+                    "maxLength": 20,
+                    "x-order": "1"
+                },
+                "email": {
+                    "type": "string",
+                    "maxLength": 254,
+                    "x-order": "2"
+                },
+                "website": {
+                    "description": "Website is an absolute http or https URL",
+                    "type": "string",
+                    "maxLength": 255,
+                    "x-order": "3"
+                },
+                "instagram": {
+                    "description": "Instagram, Facebook, YouTube and TikTok are either handles or profile URLs",
+                    "type": "string",
+                    "maxLength": 255,
+                    "x-order": "4"
+                },
+                "facebook": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "x-order": "5"
+                },
+                "youtube": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "x-order": "6"
+                },
+                "tiktok": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "x-order": "7"
+                }
+            }
+        },
+        "payload.UpdateGroupStatus": {
+            "type": "object",
+            "properties": {
+                "status": {
+                    "description": "Status is one of active, dormant, suspended or dissolved",
+                    "type": "string",
+                    "x-order": "0"
+                },
+                "reason": {
+                    "type": "string",
+                    "maxLength": 500,
+                    "minLength": 2,
+                    "x-order": "1"
+                }
+            }
+        },
+        "payload.UpdateMaintenance": {
+            "type": "object",
+            "properties": {
+                "condition": {
+                    "description": "Condition is one of good, fair, poor or broken",
+                    "type": "string",
+                    "x-order": "0"
+                },
+                "inspectedOn": {
+                    "description": "InspectedOn layout format: 2006-01-02",
+                    "type": "string",
+                    "maxLength": 10,
+                    "x-order": "1"
+                },
+                "damageDescription": {
+                    "type": "string",
+                    "maxLength": 500,
+                    "x-order": "2"
+                },
+                "repairedOn": {
+                    "description": "RepairedOn is left out while the damage is not repaired\nRepairedOn layout format: 2006-01-02",
+                    "type": "string",
+                    "maxLength": 10,
+                    "x-order": "3"
+                },
+                "cost": {
+                    "description": "Cost is the cost of the repair in rupiah",
+                    "type": "integer",
+                    "x-order": "4"
+                }
+            }
+        },
+        "payload.UpdateMember": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "maxLength": 80,
+                    "minLength": 2,
+                    "x-order": "0"
+                },
+                "role": {
+                    "description": "Role is one of warok, jathil, bujang_ganong, klono_sewandono, pembarong or musician",
+                    "type": "string",
+                    "x-order": "1"
+                },
+                "gender": {
+                    "description": "Gender is one of male or female",
+                    "type": "string",
+                    "x-order": "2"
+                },
+                "birthYear": {
+                    "type": "integer",
+                    "minimum": 1900,
+                    "x-order": "3"
+                },
+                "phone": {
+                    "type": "string",
+                    "maxLength": 20,
+                    "x-order": "4"
+                },
+                "joinedOn": {
+                    "description": "JoinedOn layout format: 2006-01-02",
+                    "type": "string",
+                    "maxLength": 10,
+                    "x-order": "5"
+                }
+            }
+        },
+        "payload.UpdateProperty": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "maxLength": 80,
+                    "minLength": 2,
+                    "x-order": "0"
+                },
+                "description": {
+                    "type": "string",
+                    "maxLength": 1000,
+                    "minLength": 2,
+                    "x-order": "1"
+                },
+                "amount": {
+                    "type": "integer",
+                    "minimum": 1,
+                    "x-order": "2"
+                }
+            }
+        },
+        "payload.UpdateShowSchedule": {
+            "type": "object",
+            "properties": {
+                "place": {
+                    "type": "string",
+                    "maxLength": 1000,
+                    "minLength": 2,
+                    "x-order": "0"
+                },
+                "startOn": {
+                    "description": "StartOn layout format: time.RFC822 (02 Jan 06 15:04 MST)",
+                    "type": "string",
+                    "maxLength": 30,
+                    "minLength": 2,
+                    "x-order": "1"
+                },
+                "finishOn": {
+                    "description": "FinishOn layout format: time.RFC822 (02 Jan 06 15:04 MST)",
+                    "type": "string",
+                    "maxLength": 30,
+                    "minLength": 2,
+                    "x-order": "2"
+                },
+                "latitude": {
+                    "description": "Latitude and Longitude of the venue are optional, but go together. Omit both to keep the current location.",
+                    "type": "number",
+                    "maximum": 90,
+                    "minimum": -90,
+                    "x-order": "3"
+                },
+                "longitude": {
+                    "type": "number",
+                    "maximum": 180,
+                    "minimum": -180,
+                    "x-order": "4"
+                }
+            }
+        },
+        "response.Achievement": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string",
+                    "x-order": "0"
+                },
+                "groupID": {
+                    "type": "string",
+                    "x-order": "1"
+                },
+                "eventName": {
+                    "type": "string",
+                    "x-order": "2"
+                },
+                "year": {
+                    "type": "integer",
+                    "x-order": "3"
+                },
+                "level": {
+                    "type": "string",
+                    "enum": [
+                        "village",
+                        "regency",
+                        "national"
+                    ],
+                    "x-order": "4"
+                },
+                "rank": {
+                    "type": "integer",
+                    "x-order": "5"
+                },
+                "certificate": {
+                    "description": "Certificate is the scanned certificate of the achievement, null when none was uploaded",
+                    "x-order": "6",
+                    "$ref": "#/definitions/response.Attachment"
+                }
+            }
+        },
+        "response.Address": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string",
+                    "x-order": "0"
+                },
+                "address": {
+                    "type": "string",
+                    "x-order": "1"
+                },
+                "longitude": {
+                    "type": "number",
+                    "x-order": "10"
+                },
+                "villageID": {
+                    "type": "string",
+                    "x-order": "2"
+                },
+                "villageName": {
+                    "type": "string",
+                    "x-order": "3"
+                },
+                "districtID": {
+                    "type": "string",
+                    "x-order": "4"
+                },
+                "regencyID": {
+                    "type": "string",
+                    "x-order": "5"
+                },
+                "districtName": {
+                    "type": "string",
+                    "x-order": "5"
+                },
+                "regencyName": {
+                    "type": "string",
+                    "x-order": "6"
+                },
+                "provinceID": {
+                    "type": "string",
+                    "x-order": "7"
+                },
+                "provinceName": {
+                    "type": "string",
+                    "x-order": "8"
+                },
+                "latitude": {
+                    "description": "Latitude and Longitude are null until the location of the group is known",
+                    "type": "number",
+                    "x-order": "9"
+                }
+            }
+        },
+        "response.Attachment": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string",
+                    "x-order": "0"
+                },
+                "fileName": {
+                    "type": "string",
+                    "x-order": "1"
+                },
+                "contentType": {
+                    "type": "string",
+                    "x-order": "2"
+                },
+                "size": {
+                    "type": "integer",
+                    "x-order": "3"
+                },
+                "url": {
+                    "type": "string",
+                    "x-order": "4"
+                },
+                "thumbnailURL": {
+                    "type": "string",
+                    "x-order": "5"
+                }
+            }
+        },
+        "response.CodeVerification": {
+            "type": "object",
+            "properties": {
+                "keyID": {
+                    "type": "string",
+                    "x-order": "0"
+                },
+                "type": {
+                    "type": "string",
+                    "enum": [
+                        "group",
+                        "property"
+                    ],
+                    "x-order": "1"
+                },
+                "id": {
+                    "type": "string",
+                    "x-order": "2"
+                },
+                "groupID": {
+                    "type": "string",
+                    "x-order": "3"
+                },
+                "groupName": {
+                    "type": "string",
+                    "x-order": "4"
+                },
+                "groupStatus": {
+                    "type": "string",
+                    "x-order": "5"
+                },
+                "issuedOn": {
+                    "description": "IssuedOn layout format: ISO 8601 date (2006-01-02)",
+                    "type": "string",
+                    "x-order": "6"
+                }
+            }
+        },
+        "response.DeletedGroup": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string",
+                    "x-order": "0"
+                },
+                "name": {
+                    "type": "string",
+                    "x-order": "1"
+                },
+                "leader": {
+                    "type": "string",
+                    "x-order": "2"
+                },
+                "deletedAt": {
+                    "description": "DeletedAt layout format: time.RFC822 (02 Jan 06 15:04 MST)",
+                    "type": "string",
+                    "x-order": "3"
+                },
+                "mergedIntoID": {
+                    "description": "MergedIntoID is the ID of the group this one was merged into, empty when it was deleted",
+                    "type": "string",
+                    "x-order": "4"
+                }
+            }
+        },
+        "response.DeletedProperty": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string",
+                    "x-order": "0"
+                },
+                "groupID": {
+                    "type": "string",
+                    "x-order": "1"
+                },
+                "name": {
+                    "type": "string",
+                    "x-order": "2"
+                },
+                "description": {
+                    "type": "string",
+                    "x-order": "3"
+                },
+                "amount": {
+                    "type": "integer",
+                    "x-order": "4"
+                },
+                "deletedAt": {
+                    "description": "DeletedAt layout format: time.RFC822 (02 Jan 06 15:04 MST)",
+                    "type": "string",
+                    "x-order": "5"
+                }
+            }
+        },
+        "response.DeletedShowSchedule": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string",
+                    "x-order": "0"
+                },
+                "groupID": {
+                    "type": "string",
+                    "x-order": "1"
+                },
+                "place": {
+                    "type": "string",
+                    "x-order": "2"
+                },
+                "startOn": {
+                    "description": "StartOn layout format: time.RFC822 (02 Jan 06 15:04 MST)",
+                    "type": "string",
+                    "x-order": "3"
+                },
+                "finishOn": {
+                    "description": "FinishOn layout format: time.RFC822 (02 Jan 06 15:04 MST)",
+                    "type": "string",
+                    "x-order": "4"
+                },
+                "deletedAt": {
+                    "description": "DeletedAt layout format: time.RFC822 (02 Jan 06 15:04 MST)",
+                    "type": "string",
+                    "x-order": "5"
+                }
+            }
+        },
+        "response.DistrictRanking": {
+            "type": "object",
+            "properties": {
+                "position": {
+                    "description": "Position is the 1-based place of the district in the medal table",
+                    "type": "integer",
+                    "x-order": "0"
+                },
+                "districtID": {
+                    "type": "string",
+                    "x-order": "1"
+                },
+                "districtName": {
+                    "type": "string",
+                    "x-order": "2"
+                },
+                "gold": {
+                    "type": "integer",
+                    "x-order": "3"
+                },
+                "silver": {
+                    "type": "integer",
+                    "x-order": "4"
+                },
+                "bronze": {
+                    "type": "integer",
+                    "x-order": "5"
+                },
+                "total": {
+                    "type": "integer",
+                    "x-order": "6"
+                }
+            }
+        },
+        "response.DistrictStats": {
+            "type": "object",
+            "properties": {
+                "districtID": {
+                    "type": "string",
+                    "x-order": "0"
+                },
+                "districtName": {
+                    "type": "string",
+                    "x-order": "1"
+                },
+                "activeGroups": {
+                    "type": "integer",
+                    "x-order": "2"
+                },
+                "properties": {
+                    "description": "Properties are the total amounts of the properties of the groups by name, in alphabetical order",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.PropertyTotal"
+                    },
+                    "x-order": "3"
+                },
+                "showSchedules": {
+                    "type": "integer",
+                    "x-order": "4"
+                }
+            }
+        },
+        "response.DistrictStatsReport": {
+            "type": "object",
+            "properties": {
+                "from": {
+                    "description": "From and To are the first and last days of the show schedules counted, in the 2006-01-02 layout",
+                    "type": "string",
+                    "x-order": "0"
+                },
+                "to": {
+                    "type": "string",
+                    "x-order": "1"
+                },
+                "districts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.DistrictStats"
+                    },
+                    "x-order": "2"
+                }
+            }
+        },
+        "response.DuplicateGroup": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string",
+                    "x-order": "0"
+                },
+                "name": {
+                    "type": "string",
+                    "x-order": "1"
+                },
+                "leader": {
+                    "type": "string",
+                    "x-order": "2"
+                },
+                "propertyCount": {
+                    "type": "integer",
+                    "x-order": "3"
+                },
+                "createdAt": {
+                    "description": "CreatedAt layout format: time.RFC822 (02 Jan 06 15:04 MST)",
+                    "type": "string",
+                    "x-order": "4"
+                }
+            }
+        },
+        "response.DuplicateGroups": {
+            "type": "object",
+            "properties": {
+                "villageID": {
+                    "type": "string",
+                    "x-order": "0"
+                },
+                "villageName": {
+                    "type": "string",
+                    "x-order": "1"
+                },
+                "groups": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.DuplicateGroup"
+                    },
+                    "x-order": "2"
+                },
+                "similarity": {
+                    "description": "Similarity is the mean of NameSimilarity and LeaderSimilarity",
+                    "type": "number",
+                    "x-order": "3"
+                },
+                "nameSimilarity": {
+                    "type": "number",
+                    "x-order": "4"
+                },
+                "leaderSimilarity": {
+                    "type": "number",
+                    "x-order": "5"
+                }
+            }
+        },
+        "response.Group": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string",
+                    "x-order": "0"
+                },
+                "name": {
+                    "type": "string",
+                    "x-order": "1"
+                },
+                "contacts": {
+                    "x-order": "10",
+                    "$ref": "#/definitions/response.GroupContacts"
+                },
+                "version": {
+                    "description": "Version changes on every update of the group, it is part of the ETag sent back in the If-Match header",
+                    "type": "integer",
+                    "x-order": "11"
+                },
+                "leader": {
+                    "type": "string",
+                    "x-order": "2"
+                },
+                "address": {
+                    "x-order": "3",
+                    "$ref": "#/definitions/response.Address"
+                },
+                "properties": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.Property"
+                    },
+                    "x-order": "4"
+                },
+                "memberCounts": {
+                    "x-order": "5",
+                    "$ref": "#/definitions/response.MemberCounts"
+                },
+                "attachments": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.Attachment"
+                    },
+                    "x-order": "6"
+                },
+                "registrationNumber": {
+                    "description": "RegistrationNumber is the sequential number officials register the group under",
+                    "type": "string",
+                    "x-order": "7"
+                },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "active",
+                        "dormant",
+                        "suspended",
+                        "dissolved"
+                    ],
+                    "x-order": "8"
+                },
+                "achievements": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.Achievement"
+                    },
+                    "x-order": "9"
+                }
+            }
+        },
+        "response.GroupContacts": {
+            "type": "object",
+            "properties": {
+                "phone": {
+                    "type": "string",
+                    "x-order": "0"
+                },
+                "whatsapp": {
+                    "type": "string",
+                    "x-order": "1"
+                },
+                "email": {
+                    "type": "string",
+                    "x-order": "2"
+                },
+                "website": {
+                    "type": "string",
+                    "x-order": "3"
+                },
+                "instagram": {
+                    "type": "string",
+                    "x-order": "4"
+                },
+                "facebook": {
+                    "type": "string",
+                    "x-order": "5"
+                },
+                "youtube": {
+                    "type": "string",
+                    "x-order": "6"
+                },
+                "tiktok": {
+                    "type": "string",
+                    "x-order": "7"
+                }
+            }
+        },
+        "response.GroupDeletion": {
+            "type": "object",
+            "properties": {
+                "groupID": {
+                    "type": "string",
+                    "x-order": "0"
+                },
+                "deletable": {
+                    "description": "Deletable is false when some show schedules block the deletion, then nothing is deleted at all",
+                    "type": "boolean",
+                    "x-order": "1"
+                },
+                "addresses": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "x-order": "2"
+                },
+                "properties": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "x-order": "3"
+                },
+                "members": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "x-order": "4"
+                },
+                "achievements": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "x-order": "5"
+                },
+                "deletedShowSchedules": {
+                    "description": "DeletedShowSchedules are deleted along with the group",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "x-order": "6"
+                },
+                "detachedShowSchedules": {
+                    "description": "DetachedShowSchedules are kept without a group",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "x-order": "7"
+                },
+                "blockingShowSchedules": {
+                    "description": "BlockingShowSchedules prevent the group from being deleted",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "x-order": "8"
+                }
+            }
+        },
+        "response.GroupStatusTransition": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string",
+                    "x-order": "0"
+                },
+                "fromStatus": {
+                    "type": "string",
+                    "enum": [
+                        "active",
+                        "dormant",
+                        "suspended",
+                        "dissolved"
+                    ],
+                    "x-order": "1"
+                },
+                "toStatus": {
+                    "type": "string",
+                    "enum": [
+                        "active",
+                        "dormant",
+                        "suspended",
+                        "dissolved"
+                    ],
+                    "x-order": "2"
+                },
+                "reason": {
+                    "type": "string",
+                    "x-order": "3"
+                },
+                "adminID": {
+                    "type": "string",
+                    "x-order": "4"
+                },
+                "adminUsername": {
+                    "type": "string",
+                    "x-order": "5"
+                },
+                "changedAt": {
+                    "description": "ChangedAt layout format: time.RFC822 (02 Jan 06 15:04 MST)",
+                    "type": "string",
+                    "x-order": "6"
+                }
+            }
+        },
+        "response.ImportGroup": {
+            "type": "object",
+            "properties": {
+                "row": {
+                    "description": "Row is the 1-based position of the group in the imported file, excluding the header",
+                    "type": "integer",
+                    "x-order": "0"
+                },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "created",
+                        "valid",
+                        "invalid",
+                        "skipped",
+                        "failed"
+                    ],
+                    "x-order": "1"
+                },
+                "id": {
+                    "type": "string",
+                    "x-order": "2"
+                },
+                "error": {
+                    "type": "string",
+                    "x-order": "3"
+                }
+            }
+        },
+        "response.LeadershipChange": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string",
+                    "x-order": "0"
+                },
+                "previousLeader": {
+                    "type": "string",
+                    "x-order": "1"
+                },
+                "newLeader": {
+                    "type": "string",
+                    "x-order": "2"
+                },
+                "effectiveOn": {
+                    "description": "EffectiveOn layout format: 2006-01-02",
+                    "type": "string",
+                    "x-order": "3"
+                },
+                "note": {
+                    "type": "string",
+                    "x-order": "4"
+                },
+                "adminID": {
+                    "type": "string",
+                    "x-order": "5"
+                },
+                "adminUsername": {
+                    "type": "string",
+                    "x-order": "6"
+                },
+                "recordedAt": {
+                    "description": "RecordedAt layout format: time.RFC822 (02 Jan 06 15:04 MST)",
+                    "type": "string",
+                    "x-order": "7"
+                }
+            }
+        },
+        "response.Loan": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string",
+                    "x-order": "0"
+                },
+                "propertyID": {
+                    "type": "string",
+                    "x-order": "1"
+                },
+                "borrowerGroupID": {
+                    "description": "BorrowerGroupID is empty when the borrower is not a group",
+                    "type": "string",
+                    "x-order": "2"
+                },
+                "borrowerName": {
+                    "type": "string",
+                    "x-order": "3"
+                },
+                "quantity": {
+                    "type": "integer",
+                    "x-order": "4"
+                },
+                "loanedOn": {
+                    "description": "LoanedOn, DueOn and ReturnedOn layout format: 2006-01-02",
+                    "type": "string",
+                    "x-order": "5"
+                },
+                "dueOn": {
+                    "type": "string",
+                    "x-order": "6"
+                },
+                "returnedOn": {
+                    "description": "ReturnedOn is empty while the items are still out",
+                    "type": "string",
+                    "x-order": "7"
+                },
+                "overdue": {
+                    "type": "boolean",
+                    "x-order": "8"
+                },
+                "note": {
+                    "type": "string",
+                    "x-order": "9"
                 }
             }
         },
-        "controller.createPropertyResponse": {
+        "response.Maintenance": {
             "type": "object",
             "properties": {
-                "status": {
+                "id": {
                     "type": "string",
                     "x-order": "0"
                 },
-                "message": {
+                "propertyID": {
                     "type": "string",
                     "x-order": "1"
                 },
-                "data": {
-                    "x-order": "2",
-                    "$ref": "#/definitions/controller.idData"
-                }
-            }
-        },
-        "controller.createShowScheduleResponse": {
-            "type": "object",
-            "properties": {
-                "status": {
+                "condition": {
                     "type": "string",
-                    "x-order": "0"
+                    "enum": [
+                        "good",
+                        "fair",
+                        "poor",
+                        "broken"
+                    ],
+                    "x-order": "2"
                 },
-                "message": {
+                "inspectedOn": {
                     "type": "string",
-                    "x-order": "1"
+                    "x-order": "3"
                 },
-                "data": {
-                    "x-order": "2",
-                    "$ref": "#/definitions/controller.idData"
-                }
-            }
-        },
-        "controller.groupData": {
-            "type": "object",
-            "properties": {
-                "group": {
-                    "$ref": "#/definitions/response.Group"
-                }
-            }
-        },
-        "controller.groupResponse": {
-            "type": "object",
-            "properties": {
-                "status": {
+                "damageDescription": {
                     "type": "string",
-                    "x-order": "0"
+                    "x-order": "4"
                 },
-                "message": {
+                "repairedOn": {
+                    "description": "RepairedOn is empty while the damage is not repaired",
                     "type": "string",
-                    "x-order": "1"
+                    "x-order": "5"
                 },
-                "data": {
-                    "x-order": "2",
-                    "$ref": "#/definitions/controller.groupData"
-                }
-            }
-        },
-        "controller.groupsData": {
-            "type": "object",
-            "properties": {
-                "groups": {
+                "cost": {
+                    "type": "integer",
+                    "x-order": "6"
+                },
+                "photos": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/response.Group"
-                    }
+                        "$ref": "#/definitions/response.Attachment"
+                    },
+                    "x-order": "7"
                 }
             }
         },
-        "controller.groupsResponse": {
+        "response.Member": {
             "type": "object",
             "properties": {
-                "status": {
+                "id": {
                     "type": "string",
                     "x-order": "0"
                 },
-                "message": {
+                "groupID": {
                     "type": "string",
                     "x-order": "1"
                 },
-                "data": {
-                    "x-order": "2",
-                    "$ref": "#/definitions/controller.groupsData"
-                }
-            }
-        },
-        "controller.idData": {
-            "type": "object",
-            "properties": {
-                "id": {
-                    "type": "string"
-                }
-            }
-        },
-        "controller.loginResponse": {
-            "type": "object",
-            "properties": {
-                "status": {
+                "name": {
                     "type": "string",
-                    "maxLength": 80,
-                    "minLength": 2,
-                    "x-order": "0"
+                    "x-order": "2"
                 },
-                "message": {
+                "role": {
                     "type": "string",
-                    "maxLength": 80,
-                    "minLength": 2,
-                    "x-order": "1"
+                    "x-order": "3"
                 },
-                "data": {
-                    "x-order": "2",
-                    "$ref": "#/definitions/controller.tokenData"
+                "gender": {
+                    "type": "string",
+                    "x-order": "4"
+                },
+                "birthYear": {
+                    "type": "integer",
+                    "x-order": "5"
+                },
+                "phone": {
+                    "type": "string",
+                    "x-order": "6"
+                },
+                "joinedOn": {
+                    "description": "JoinedOn layout format: 2006-01-02",
+                    "type": "string",
+                    "x-order": "7"
                 }
             }
         },
-        "controller.showScheduleData": {
+        "response.MemberCounts": {
             "type": "object",
             "properties": {
-                "show": {
-                    "$ref": "#/definitions/response.ShowScheduleDetails"
+                "warok": {
+                    "type": "integer",
+                    "x-order": "0"
+                },
+                "jathil": {
+                    "type": "integer",
+                    "x-order": "1"
+                },
+                "bujangGanong": {
+                    "type": "integer",
+                    "x-order": "2"
+                },
+                "klonoSewandono": {
+                    "type": "integer",
+                    "x-order": "3"
+                },
+                "pembarong": {
+                    "type": "integer",
+                    "x-order": "4"
+                },
+                "musician": {
+                    "type": "integer",
+                    "x-order": "5"
+                },
+                "total": {
+                    "type": "integer",
+                    "x-order": "6"
                 }
             }
         },
-        "controller.showScheduleResponse": {
+        "response.NearbyGroup": {
             "type": "object",
             "properties": {
-                "status": {
+                "id": {
                     "type": "string",
                     "x-order": "0"
                 },
-                "message": {
+                "name": {
                     "type": "string",
                     "x-order": "1"
                 },
-                "data": {
-                    "x-order": "2",
-                    "$ref": "#/definitions/controller.showScheduleData"
-                }
-            }
-        },
-        "controller.showSchedulesData": {
-            "type": "object",
-            "properties": {
-                "shows": {
+                "contacts": {
+                    "x-order": "10",
+                    "$ref": "#/definitions/response.GroupContacts"
+                },
+                "version": {
+                    "description": "Version changes on every update of the group, it is part of the ETag sent back in the If-Match header",
+                    "type": "integer",
+                    "x-order": "11"
+                },
+                "distance": {
+                    "description": "Distance is the distance from the given point to the group, in meters",
+                    "type": "number",
+                    "x-order": "12"
+                },
+                "leader": {
+                    "type": "string",
+                    "x-order": "2"
+                },
+                "address": {
+                    "x-order": "3",
+                    "$ref": "#/definitions/response.Address"
+                },
+                "properties": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/response.ShowSchedule"
-                    }
-                }
-            }
-        },
-        "controller.showSchedulesResponse": {
-            "type": "object",
-            "properties": {
-                "status": {
+                        "$ref": "#/definitions/response.Property"
+                    },
+                    "x-order": "4"
+                },
+                "memberCounts": {
+                    "x-order": "5",
+                    "$ref": "#/definitions/response.MemberCounts"
+                },
+                "attachments": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.Attachment"
+                    },
+                    "x-order": "6"
+                },
+                "registrationNumber": {
+                    "description": "RegistrationNumber is the sequential number officials register the group under",
                     "type": "string",
-                    "x-order": "0"
+                    "x-order": "7"
                 },
-                "message": {
+                "status": {
                     "type": "string",
-                    "x-order": "1"
+                    "enum": [
+                        "active",
+                        "dormant",
+                        "suspended",
+                        "dissolved"
+                    ],
+                    "x-order": "8"
                 },
-                "data": {
-                    "x-order": "2",
-                    "$ref": "#/definitions/controller.showSchedulesData"
-                }
-            }
-        },
-        "controller.tokenData": {
-            "type": "object",
-            "properties": {
-                "token": {
-                    "type": "string"
+                "achievements": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.Achievement"
+                    },
+                    "x-order": "9"
                 }
             }
         },
-        "echo.HTTPError": {
-            "type": "object",
-            "properties": {
-                "message": {}
-            }
-        },
-        "payload.CreateGroup": {
+        "response.Pagination": {
             "type": "object",
             "properties": {
-                "name": {
-                    "type": "string",
-                    "maxLength": 80,
-                    "minLength": 2,
+                "page": {
+                    "type": "integer",
                     "x-order": "0"
                 },
-                "leader": {
-                    "type": "string",
-                    "maxLength": 80,
-                    "minLength": 2,
+                "limit": {
+                    "type": "integer",
                     "x-order": "1"
                 },
-                "address": {
-                    "type": "string",
-                    "maxLength": 1000,
-                    "minLength": 2,
+                "totalItems": {
+                    "type": "integer",
                     "x-order": "2"
                 },
-                "villageID": {
-                    "type": "string",
-                    "maxLength": 20,
-                    "minLength": 2,
+                "totalPages": {
+                    "type": "integer",
                     "x-order": "3"
+                },
+                "next": {
+                    "type": "string",
+                    "x-order": "4"
+                },
+                "previous": {
+                    "type": "string",
+                    "x-order": "5"
                 }
             }
         },
-        "payload.CreateProperty": {
+        "response.PoorConditionItem": {
             "type": "object",
             "properties": {
-                "name": {
+                "propertyID": {
                     "type": "string",
-                    "maxLength": 80,
-                    "minLength": 2,
                     "x-order": "0"
                 },
-                "description": {
+                "propertyName": {
                     "type": "string",
-                    "maxLength": 1000,
-                    "minLength": 2,
                     "x-order": "1"
                 },
+                "damageDescription": {
+                    "type": "string",
+                    "x-order": "10"
+                },
                 "amount": {
                     "type": "integer",
-                    "minimum": 1,
                     "x-order": "2"
-                }
-            }
-        },
-        "payload.CreateShowSchedule": {
-            "type": "object",
-            "properties": {
+                },
                 "groupID": {
                     "type": "string",
-                    "maxLength": 10,
-                    "minLength": 2,
-                    "x-order": "0"
+                    "x-order": "3"
+                },
+                "groupName": {
+                    "type": "string",
+                    "x-order": "4"
+                },
+                "districtID": {
+                    "type": "string",
+                    "x-order": "5"
+                },
+                "districtName": {
+                    "type": "string",
+                    "x-order": "6"
                 },
-                "place": {
+                "maintenanceID": {
                     "type": "string",
-                    "maxLength": 1000,
-                    "minLength": 2,
-                    "x-order": "1"
+                    "x-order": "7"
                 },
-                "startOn": {
-                    "description": "StartOn layout format: time.RFC822 (02 Jan 06 15:04 MST)",
+                "condition": {
                     "type": "string",
-                    "maxLength": 30,
-                    "minLength": 2,
-                    "x-order": "2"
+                    "enum": [
+                        "poor",
+                        "broken"
+                    ],
+                    "x-order": "8"
                 },
-                "finishOn": {
-                    "description": "FinishOn layout format: time.RFC822 (02 Jan 06 15:04 MST)",
+                "inspectedOn": {
                     "type": "string",
-                    "maxLength": 30,
-                    "minLength": 2,
-                    "x-order": "3"
+                    "x-order": "9"
                 }
             }
         },
-        "payload.Credential": {
+        "response.Property": {
             "type": "object",
             "properties": {
-                "username": {
+                "id": {
                     "type": "string",
-                    "maxLength": 20,
-                    "minLength": 2,
                     "x-order": "0"
                 },
-                "password": {
+                "name": {
                     "type": "string",
-                    "maxLength": 50,
-                    "minLength": 2,
                     "x-order": "1"
+                },
+                "description": {
+                    "type": "string",
+                    "x-order": "2"
+                },
+                "amount": {
+                    "type": "integer",
+                    "x-order": "3"
+                },
+                "attachments": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.Attachment"
+                    },
+                    "x-order": "4"
+                },
+                "version": {
+                    "description": "Version is sent back quoted in the If-Match header to update or delete the property",
+                    "type": "integer",
+                    "x-order": "5"
                 }
             }
         },
-        "payload.UpdateAddress": {
+        "response.PropertyLoans": {
             "type": "object",
             "properties": {
-                "address": {
+                "propertyID": {
                     "type": "string",
-                    "maxLength": 1000,
-                    "minLength": 2,
                     "x-order": "0"
                 },
-                "villageID": {
-                    "type": "string",
-                    "maxLength": 20,
-                    "minLength": 2,
+                "amount": {
+                    "type": "integer",
                     "x-order": "1"
+                },
+                "onLoan": {
+                    "type": "integer",
+                    "x-order": "2"
+                },
+                "available": {
+                    "description": "Available is the amount minus the quantity on loan, it is what can still be lent",
+                    "type": "integer",
+                    "x-order": "3"
+                },
+                "loans": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.Loan"
+                    },
+                    "x-order": "4"
                 }
             }
         },
-        "payload.UpdateGroup": {
+        "response.PropertyTotal": {
             "type": "object",
             "properties": {
                 "name": {
                     "type": "string",
-                    "maxLength": 80,
-                    "minLength": 2,
                     "x-order": "0"
                 },
-                "leader": {
-                    "type": "string",
-                    "maxLength": 80,
-                    "minLength": 2,
+                "amount": {
+                    "type": "integer",
                     "x-order": "1"
                 }
             }
         },
-        "payload.UpdateProperty": {
+        "response.PublicGroup": {
             "type": "object",
             "properties": {
-                "name": {
+                "id": {
                     "type": "string",
-                    "maxLength": 80,
-                    "minLength": 2,
                     "x-order": "0"
                 },
-                "description": {
+                "name": {
                     "type": "string",
-                    "maxLength": 1000,
-                    "minLength": 2,
                     "x-order": "1"
                 },
-                "amount": {
-                    "type": "integer",
-                    "minimum": 1,
+                "villageName": {
+                    "type": "string",
                     "x-order": "2"
+                },
+                "districtName": {
+                    "type": "string",
+                    "x-order": "3"
+                },
+                "website": {
+                    "type": "string",
+                    "x-order": "4"
+                },
+                "instagram": {
+                    "type": "string",
+                    "x-order": "5"
+                },
+                "facebook": {
+                    "type": "string",
+                    "x-order": "6"
+                },
+                "youtube": {
+                    "type": "string",
+                    "x-order": "7"
+                },
+                "tiktok": {
+                    "type": "string",
+                    "x-order": "8"
                 }
             }
         },
-        "payload.UpdateShowSchedule": {
+        "response.PublicProperty": {
             "type": "object",
             "properties": {
-                "place": {
+                "id": {
                     "type": "string",
-                    "maxLength": 1000,
-                    "minLength": 2,
                     "x-order": "0"
                 },
-                "startOn": {
-                    "description": "StartOn layout format: time.RFC822 (02 Jan 06 15:04 MST)",
+                "name": {
                     "type": "string",
-                    "maxLength": 30,
-                    "minLength": 2,
                     "x-order": "1"
                 },
-                "finishOn": {
-                    "description": "FinishOn layout format: time.RFC822 (02 Jan 06 15:04 MST)",
+                "description": {
                     "type": "string",
-                    "maxLength": 30,
-                    "minLength": 2,
                     "x-order": "2"
+                },
+                "amount": {
+                    "type": "integer",
+                    "x-order": "3"
                 }
             }
         },
-        "response.Address": {
+        "response.PublicShowSchedule": {
             "type": "object",
             "properties": {
                 "id": {
                     "type": "string",
                     "x-order": "0"
                 },
-                "address": {
+                "groupID": {
                     "type": "string",
                     "x-order": "1"
                 },
-                "villageID": {
+                "groupName": {
                     "type": "string",
                     "x-order": "2"
                 },
@@ -1341,78 +8486,98 @@ const docTemplate = `{
                     "type": "string",
                     "x-order": "3"
                 },
-                "districtID": {
-                    "type": "string",
-                    "x-order": "4"
-                },
                 "districtName": {
                     "type": "string",
-                    "x-order": "5"
+                    "x-order": "4"
                 },
-                "regencyID": {
+                "place": {
                     "type": "string",
                     "x-order": "5"
                 },
-                "regencyName": {
+                "startOn": {
+                    "description": "StartOn layout format: time.RFC822 (02 Jan 06 15:04 MST)",
                     "type": "string",
                     "x-order": "6"
                 },
-                "provinceID": {
+                "finishOn": {
+                    "description": "FinishOn layout format: time.RFC822 (02 Jan 06 15:04 MST)",
                     "type": "string",
                     "x-order": "7"
                 },
-                "provinceName": {
-                    "type": "string",
+                "latitude": {
+                    "type": "number",
                     "x-order": "8"
+                },
+                "longitude": {
+                    "type": "number",
+                    "x-order": "9"
                 }
             }
         },
-        "response.Group": {
+        "response.ScanResult": {
             "type": "object",
             "properties": {
-                "id": {
+                "type": {
                     "type": "string",
+                    "enum": [
+                        "group",
+                        "property"
+                    ],
                     "x-order": "0"
                 },
-                "name": {
-                    "type": "string",
-                    "x-order": "1"
-                },
-                "leader": {
-                    "type": "string",
-                    "x-order": "2"
+                "group": {
+                    "x-order": "1",
+                    "$ref": "#/definitions/response.PublicGroup"
                 },
-                "address": {
-                    "x-order": "3",
-                    "$ref": "#/definitions/response.Address"
+                "property": {
+                    "x-order": "2",
+                    "$ref": "#/definitions/response.PublicProperty"
                 },
-                "properties": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/response.Property"
-                    },
-                    "x-order": "4"
+                "verified": {
+                    "description": "Verified is false for the bare IDs encoded in the QR codes printed before the codes were signed.",
+                    "type": "boolean",
+                    "x-order": "3"
                 }
             }
         },
-        "response.Property": {
+        "response.SearchResult": {
             "type": "object",
             "properties": {
-                "id": {
+                "type": {
+                    "description": "Type is what matched the query: a group by its name or leader, the address of a group, a property or a show schedule",
                     "type": "string",
+                    "enum": [
+                        "group",
+                        "address",
+                        "property",
+                        "show_schedule"
+                    ],
                     "x-order": "0"
                 },
-                "name": {
+                "id": {
                     "type": "string",
                     "x-order": "1"
                 },
-                "description": {
+                "groupID": {
                     "type": "string",
                     "x-order": "2"
                 },
-                "amount": {
-                    "type": "integer",
+                "groupName": {
+                    "type": "string",
                     "x-order": "3"
+                },
+                "title": {
+                    "type": "string",
+                    "x-order": "4"
+                },
+                "snippet": {
+                    "description": "Snippet is the HTML escaped matching text, with the matched words wrapped in \u003cmark\u003e tags",
+                    "type": "string",
+                    "x-order": "5"
+                },
+                "rank": {
+                    "type": "number",
+                    "x-order": "6"
                 }
             }
         },
@@ -1440,6 +8605,18 @@ const docTemplate = `{
                     "description": "FinishOn layout format: time.RFC822 (02 Jan 06 15:04 MST)",
                     "type": "string",
                     "x-order": "4"
+                },
+                "latitude": {
+                    "type": "number",
+                    "x-order": "5"
+                },
+                "longitude": {
+                    "type": "number",
+                    "x-order": "6"
+                },
+                "version": {
+                    "type": "integer",
+                    "x-order": "7"
                 }
             }
         },
@@ -1471,6 +8648,96 @@ const docTemplate = `{
                     "description": "FinishOn layout format: time.RFC822 (02 Jan 06 15:04 MST)",
                     "type": "string",
                     "x-order": "5"
+                },
+                "latitude": {
+                    "type": "number",
+                    "x-order": "6"
+                },
+                "longitude": {
+                    "type": "number",
+                    "x-order": "7"
+                },
+                "version": {
+                    "type": "integer",
+                    "x-order": "8"
+                }
+            }
+        },
+        "response.Submission": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string",
+                    "x-order": "0"
+                },
+                "name": {
+                    "type": "string",
+                    "x-order": "1"
+                },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "pending",
+                        "approving",
+                        "approved",
+                        "rejected"
+                    ],
+                    "x-order": "10"
+                },
+                "groupID": {
+                    "description": "GroupID is the group created when the submission was approved",
+                    "type": "string",
+                    "x-order": "11"
+                },
+                "rejectionReason": {
+                    "type": "string",
+                    "x-order": "12"
+                },
+                "reviewedBy": {
+                    "type": "string",
+                    "x-order": "13"
+                },
+                "reviewedAt": {
+                    "description": "ReviewedAt layout format: time.RFC822 (02 Jan 06 15:04 MST)",
+                    "type": "string",
+                    "x-order": "14"
+                },
+                "submittedAt": {
+                    "description": "SubmittedAt layout format: time.RFC822 (02 Jan 06 15:04 MST)",
+                    "type": "string",
+                    "x-order": "15"
+                },
+                "leader": {
+                    "type": "string",
+                    "x-order": "2"
+                },
+                "address": {
+                    "type": "string",
+                    "x-order": "3"
+                },
+                "villageID": {
+                    "type": "string",
+                    "x-order": "4"
+                },
+                "latitude": {
+                    "type": "number",
+                    "x-order": "5"
+                },
+                "longitude": {
+                    "type": "number",
+                    "x-order": "6"
+                },
+                "contactPhone": {
+                    "type": "string",
+                    "x-order": "7"
+                },
+                "contactEmail": {
+                    "type": "string",
+                    "x-order": "8"
+                },
+                "submitterIP": {
+                    "type": "string",
+                    "x-order": "9"
                 }
             }
         }
@@ -0,0 +1,26 @@
+package entity
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	AchievementLevelVillage  = "village"
+	AchievementLevelRegency  = "regency"
+	AchievementLevelNational = "national"
+)
+
+type Achievement struct {
+	ID          string      `gorm:"type:char(9)"`
+	GroupID     string      `gorm:"type:char(5);not null;index"`
+	EventName   string      `gorm:"not null;size:120"`
+	Year        uint16      `gorm:"not null"`
+	Level       string      `gorm:"not null;size:10"`
+	Rank        uint8       `gorm:"not null"`
+	Certificate *Attachment `gorm:"polymorphic:Owner"`
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	DeletedAt   gorm.DeletedAt `gorm:"index"`
+}
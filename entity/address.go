@@ -17,7 +17,10 @@ type Address struct {
 	RegencyName  string `gorm:"not null;size:255"`
 	ProvinceID   string `gorm:"not null;type:char(2)"`
 	ProvinceName string `gorm:"not null;size:255"`
-	CreatedAt    time.Time
-	UpdatedAt    time.Time
-	DeletedAt    gorm.DeletedAt `gorm:"index"`
+	// Latitude and Longitude are nil until the location of the group is known.
+	Latitude  *float64 `gorm:"index:idx_addresses_location"`
+	Longitude *float64 `gorm:"index:idx_addresses_location"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	DeletedAt gorm.DeletedAt `gorm:"index"`
 }
@@ -0,0 +1,28 @@
+package entity
+
+import (
+	"time"
+)
+
+const (
+	AttachmentOwnerGroup       = "groups"
+	AttachmentOwnerProperty    = "properties"
+	AttachmentOwnerAchievement = "achievements"
+	AttachmentOwnerMaintenance = "maintenances"
+)
+
+// Attachment is removed together with its files, so unlike the other entities it is not soft-deleted.
+type Attachment struct {
+	ID           string `gorm:"type:char(9)"`
+	OwnerID      string `gorm:"size:9;not null;index:idx_attachments_owner"`
+	OwnerType    string `gorm:"size:20;not null;index:idx_attachments_owner"`
+	FileName     string `gorm:"not null;size:255"`
+	ContentType  string `gorm:"not null;size:100"`
+	Size         int64  `gorm:"not null"`
+	Key          string `gorm:"not null"`
+	URL          string `gorm:"not null"`
+	ThumbnailKey string
+	ThumbnailURL string
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
@@ -6,14 +6,45 @@ import (
 	"gorm.io/gorm"
 )
 
+const (
+	GroupStatusActive    = "active"
+	GroupStatusDormant   = "dormant"
+	GroupStatusSuspended = "suspended"
+	GroupStatusDissolved = "dissolved"
+)
+
 type Group struct {
-	ID            string         `gorm:"type:char(5)"`
-	Name          string         `gorm:"not null;size:80"`
-	Leader        string         `gorm:"not null;size:80"`
-	Address       Address        `gorm:"foreignKey:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
-	Properties    []Property     `gorm:"foreignKey:GroupID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
-	ShowSchedules []ShowSchedule `gorm:"foreignKey:GroupID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
-	CreatedAt     time.Time
-	UpdatedAt     time.Time
-	DeletedAt     gorm.DeletedAt `gorm:"index"`
+	ID                 string                  `gorm:"type:char(5)"`
+	Name               string                  `gorm:"not null;size:80"`
+	Leader             string                  `gorm:"not null;size:80"`
+	RegistrationNumber string                  `gorm:"size:50;uniqueIndex"`
+	Status             string                  `gorm:"not null;size:10;default:active;index"`
+	MergedIntoID       *string                 `gorm:"type:char(5);index"`
+	Contacts           GroupContacts           `gorm:"embedded;embeddedPrefix:contact_"`
+	Address            Address                 `gorm:"foreignKey:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	Properties         []Property              `gorm:"foreignKey:GroupID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	ShowSchedules      []ShowSchedule          `gorm:"foreignKey:GroupID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	Members            []Member                `gorm:"foreignKey:GroupID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	Attachments        []Attachment            `gorm:"polymorphic:Owner"`
+	Achievements       []Achievement           `gorm:"foreignKey:GroupID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	StatusTransitions  []GroupStatusTransition `gorm:"foreignKey:GroupID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	LeadershipChanges  []LeadershipChange      `gorm:"foreignKey:GroupID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	CreatedAt          time.Time
+	UpdatedAt          time.Time
+	DeletedAt          gorm.DeletedAt `gorm:"index"`
+	// Version is incremented by every update, which only applies to the version it was based on. This keeps two
+	// admins editing the same group from silently overwriting each other.
+	Version int `gorm:"not null;default:1"`
+}
+
+// GroupContacts are stored normalized: phone numbers in the E.164 format and social media handles without their @.
+type GroupContacts struct {
+	Phone     string `gorm:"size:16"`
+	WhatsApp  string `gorm:"column:whatsapp;size:16"`
+	Email     string `gorm:"size:254"`
+	Website   string `gorm:"size:255"`
+	Instagram string `gorm:"size:30"`
+	Facebook  string `gorm:"size:50"`
+	YouTube   string `gorm:"column:youtube;size:30"`
+	TikTok    string `gorm:"column:tiktok;size:24"`
 }
@@ -0,0 +1,14 @@
+package entity
+
+import "time"
+
+type GroupStatusTransition struct {
+	ID            string `gorm:"type:char(9)"`
+	GroupID       string `gorm:"type:char(5);not null;index"`
+	FromStatus    string `gorm:"not null;size:10"`
+	ToStatus      string `gorm:"not null;size:10"`
+	Reason        string `gorm:"not null;size:500"`
+	AdminID       string `gorm:"type:char(4);not null"`
+	AdminUsername string `gorm:"not null;size:20"`
+	CreatedAt     time.Time
+}
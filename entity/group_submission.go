@@ -0,0 +1,31 @@
+package entity
+
+import "time"
+
+const (
+	SubmissionStatusPending = "pending"
+	// SubmissionStatusApproving is held while the group of an approved submission is being created
+	SubmissionStatusApproving = "approving"
+	SubmissionStatusApproved  = "approved"
+	SubmissionStatusRejected  = "rejected"
+)
+
+type GroupSubmission struct {
+	ID              string `gorm:"type:char(9)"`
+	Name            string `gorm:"not null;size:80"`
+	Leader          string `gorm:"not null;size:80"`
+	Address         string `gorm:"not null;size:1000"`
+	VillageID       string `gorm:"not null;size:20"`
+	Latitude        *float64
+	Longitude       *float64
+	ContactPhone    string  `gorm:"not null;size:20"`
+	ContactEmail    string  `gorm:"size:254"`
+	SubmitterIP     string  `gorm:"not null;size:45;index:idx_group_submissions_quota"`
+	Status          string  `gorm:"not null;size:10;index"`
+	GroupID         *string `gorm:"type:char(5)"`
+	RejectionReason string  `gorm:"size:500"`
+	ReviewedBy      string  `gorm:"size:20"`
+	ReviewedAt      *time.Time
+	CreatedAt       time.Time `gorm:"index:idx_group_submissions_quota"`
+	UpdatedAt       time.Time
+}
@@ -0,0 +1,15 @@
+package entity
+
+import "time"
+
+type LeadershipChange struct {
+	ID             string    `gorm:"type:char(9)"`
+	GroupID        string    `gorm:"type:char(5);not null;index"`
+	PreviousLeader string    `gorm:"not null;size:80"`
+	NewLeader      string    `gorm:"not null;size:80"`
+	EffectiveOn    time.Time `gorm:"not null;type:date"`
+	Note           string    `gorm:"size:500"`
+	AdminID        string    `gorm:"type:char(4);not null"`
+	AdminUsername  string    `gorm:"not null;size:20"`
+	CreatedAt      time.Time
+}
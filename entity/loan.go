@@ -0,0 +1,20 @@
+package entity
+
+import "time"
+
+type Loan struct {
+	ID         string `gorm:"type:char(9)"`
+	PropertyID string `gorm:"type:char(9);not null;index"`
+	// BorrowerGroupID is set when another group borrows the items. BorrowerName is the name of that group at the
+	// time of the loan, or the borrower written as free text, such as a school.
+	BorrowerGroupID *string   `gorm:"type:char(5);index"`
+	BorrowerName    string    `gorm:"not null;size:120"`
+	Quantity        uint16    `gorm:"not null"`
+	LoanedOn        time.Time `gorm:"not null;type:date"`
+	DueOn           time.Time `gorm:"not null;type:date"`
+	// ReturnedOn is nil while the items are still out
+	ReturnedOn *time.Time `gorm:"type:date"`
+	Note       string     `gorm:"size:500"`
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
@@ -0,0 +1,31 @@
+package entity
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	ConditionGood   = "good"
+	ConditionFair   = "fair"
+	ConditionPoor   = "poor"
+	ConditionBroken = "broken"
+)
+
+// Maintenance records the condition of a property found on an inspection, and the repair of the damage if any.
+type Maintenance struct {
+	ID                string    `gorm:"type:char(9)"`
+	PropertyID        string    `gorm:"type:char(9);not null;index"`
+	Condition         string    `gorm:"not null;size:10"`
+	InspectedOn       time.Time `gorm:"not null;type:date"`
+	DamageDescription string    `gorm:"size:500"`
+	// RepairedOn is nil while the damage is not repaired
+	RepairedOn *time.Time `gorm:"type:date"`
+	// Cost is the cost of the repair in rupiah
+	Cost      uint32       `gorm:"not null;default:0"`
+	Photos    []Attachment `gorm:"polymorphic:Owner"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	DeletedAt gorm.DeletedAt `gorm:"index"`
+}
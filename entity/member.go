@@ -0,0 +1,30 @@
+package entity
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	MemberRoleWarok          = "warok"
+	MemberRoleJathil         = "jathil"
+	MemberRoleBujangGanong   = "bujang_ganong"
+	MemberRoleKlonoSewandono = "klono_sewandono"
+	MemberRolePembarong      = "pembarong"
+	MemberRoleMusician       = "musician"
+)
+
+type Member struct {
+	ID        string    `gorm:"type:char(9)"`
+	GroupID   string    `gorm:"type:char(5);not null;index"`
+	Name      string    `gorm:"not null;size:80"`
+	Role      string    `gorm:"not null;size:20"`
+	Gender    string    `gorm:"not null;size:6"`
+	BirthYear uint16    `gorm:"not null"`
+	Phone     string    `gorm:"size:20"`
+	JoinedOn  time.Time `gorm:"not null;type:date"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	DeletedAt gorm.DeletedAt `gorm:"index"`
+}
@@ -7,12 +7,19 @@ import (
 )
 
 type Property struct {
-	ID          string `gorm:"type:char(9)"`
-	Name        string `gorm:"not null;size:80"`
-	Description string `gorm:"not null"`
-	Amount      uint16 `gorm:"not null"`
-	GroupID     string `gorm:"type:char(5);not null"`
-	CreatedAt   time.Time
-	UpdatedAt   time.Time
-	DeletedAt   gorm.DeletedAt `gorm:"index"`
+	ID          string       `gorm:"type:char(9)"`
+	Name        string       `gorm:"not null;size:80"`
+	Description string       `gorm:"not null"`
+	Amount      uint16       `gorm:"not null"`
+	GroupID     string       `gorm:"type:char(5);not null"`
+	Attachments []Attachment `gorm:"polymorphic:Owner"`
+	// Maintenances are only loaded when the property is purged, to remove the photos with it
+	Maintenances []Maintenance
+	// Loans are only loaded when the property is purged, and then only the ones still out
+	Loans     []Loan
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	DeletedAt gorm.DeletedAt `gorm:"index"`
+	// Version is incremented by every update of the property, see Group.Version
+	Version int `gorm:"not null;default:1"`
 }
@@ -0,0 +1,7 @@
+package entity
+
+// RegistrationCounter holds the last running number handed out within a registration number scope.
+type RegistrationCounter struct {
+	Scope      string `gorm:"primaryKey;size:100"`
+	LastNumber int    `gorm:"not null"`
+}
@@ -7,12 +7,18 @@ import (
 )
 
 type ShowSchedule struct {
-	ID        string    `gorm:"type:char(9)"`
-	GroupID   string    `gorm:"type:char(5); not null"`
-	Place     string    `gorm:"not null"`
-	StartOn   time.Time `gorm:"not null"`
-	FinishOn  time.Time `gorm:"not null"`
+	ID string `gorm:"type:char(9)"`
+	// GroupID is nil when the show schedule was detached from its group, as the group got deleted.
+	GroupID  *string   `gorm:"type:char(5)"`
+	Place    string    `gorm:"not null"`
+	StartOn  time.Time `gorm:"not null"`
+	FinishOn time.Time `gorm:"not null"`
+	// Latitude and Longitude locate the venue, they are nil when it is unknown.
+	Latitude  *float64
+	Longitude *float64
 	CreatedAt time.Time
 	UpdatedAt time.Time
 	DeletedAt gorm.DeletedAt `gorm:"index"`
+	// Version is incremented by every update of the show schedule, see Group.Version
+	Version int `gorm:"not null;default:1"`
 }
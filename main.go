@@ -1,26 +1,49 @@
 package main
 
 import (
+	"context"
 	"log"
 	"os"
+	"strconv"
+	"time"
 
 	"github.com/erikrios/reog-apps-apis/config"
 	"github.com/erikrios/reog-apps-apis/controller"
 	_ "github.com/erikrios/reog-apps-apis/docs"
 	"github.com/erikrios/reog-apps-apis/middleware"
+	cr "github.com/erikrios/reog-apps-apis/repository/achievement"
 	dr "github.com/erikrios/reog-apps-apis/repository/address"
 	ar "github.com/erikrios/reog-apps-apis/repository/admin"
+	fr "github.com/erikrios/reog-apps-apis/repository/attachment"
 	gr "github.com/erikrios/reog-apps-apis/repository/group"
+	lr "github.com/erikrios/reog-apps-apis/repository/loan"
+	nr "github.com/erikrios/reog-apps-apis/repository/maintenance"
+	mr "github.com/erikrios/reog-apps-apis/repository/member"
 	pr "github.com/erikrios/reog-apps-apis/repository/property"
+	sr "github.com/erikrios/reog-apps-apis/repository/search"
 	ssr "github.com/erikrios/reog-apps-apis/repository/showschedule"
+	str "github.com/erikrios/reog-apps-apis/repository/stats"
+	rr "github.com/erikrios/reog-apps-apis/repository/submission"
+	tr "github.com/erikrios/reog-apps-apis/repository/trash"
 	vr "github.com/erikrios/reog-apps-apis/repository/village"
+	cs "github.com/erikrios/reog-apps-apis/service/achievement"
 	ds "github.com/erikrios/reog-apps-apis/service/address"
 	as "github.com/erikrios/reog-apps-apis/service/admin"
+	fs "github.com/erikrios/reog-apps-apis/service/attachment"
 	gs "github.com/erikrios/reog-apps-apis/service/group"
+	ls "github.com/erikrios/reog-apps-apis/service/loan"
+	ns "github.com/erikrios/reog-apps-apis/service/maintenance"
+	ms "github.com/erikrios/reog-apps-apis/service/member"
 	ps "github.com/erikrios/reog-apps-apis/service/property"
+	pus "github.com/erikrios/reog-apps-apis/service/public"
+	ss "github.com/erikrios/reog-apps-apis/service/search"
 	sss "github.com/erikrios/reog-apps-apis/service/showschedule"
+	sts "github.com/erikrios/reog-apps-apis/service/stats"
+	rs "github.com/erikrios/reog-apps-apis/service/submission"
+	ts "github.com/erikrios/reog-apps-apis/service/trash"
 	"github.com/erikrios/reog-apps-apis/utils/generator"
 	"github.com/erikrios/reog-apps-apis/utils/logging"
+	"github.com/erikrios/reog-apps-apis/utils/qrsign"
 	_ "github.com/erikrios/reog-apps-apis/validation"
 	"github.com/joho/godotenv"
 	"github.com/labstack/echo/v4"
@@ -63,6 +86,11 @@ func main() {
 		log.Println(err)
 	}
 
+	fileStorage, err := config.NewStorage()
+	if err != nil {
+		log.Fatalln(err.Error())
+	}
+
 	config.MigratePostgreSQLDatabase(db)
 	config.SetInitialDataPostgreSQLDatabase(db)
 
@@ -72,6 +100,37 @@ func main() {
 	tokenGenerator := generator.NewJWTTokenGenerator()
 	idGenerator := generator.NewNanoidIDGenerator()
 	qrCodeGenerator := generator.NewQRCodeGeneratorImpl()
+	thumbnailGenerator := generator.NewThumbnailGeneratorImpl()
+	certificateGenerator := generator.NewPDFCertificateGenerator()
+
+	registrationNumberFormat := os.Getenv("REGISTRATION_NUMBER_FORMAT")
+	if registrationNumberFormat == "" {
+		registrationNumberFormat = generator.DefaultRegistrationNumberFormat
+	}
+	registrationNumberGenerator, err := generator.NewTemplateRegistrationNumberGenerator(registrationNumberFormat)
+	if err != nil {
+		log.Fatalln(err.Error())
+	}
+
+	// Without a configured link, the QR codes open the scan endpoint of this server
+	scanLinkFormat := os.Getenv("SCAN_LINK_FORMAT")
+	if scanLinkFormat == "" {
+		scanLinkFormat = "http://localhost" + port + "/api/v1/scan/{code}"
+	}
+	scanLinkGenerator, err := generator.NewTemplateScanLinkGenerator(scanLinkFormat)
+	if err != nil {
+		log.Fatalln(err.Error())
+	}
+
+	// The rotated keys stay in QR_SIGNING_KEYS, to verify the QR codes printed before the rotation
+	signingKeys, err := qrsign.ParseKeys(os.Getenv("QR_SIGNING_KEYS"))
+	if err != nil {
+		log.Fatalln(err.Error())
+	}
+	codeSigner, err := generator.NewHMACCodeSigner(os.Getenv("QR_SIGNING_KEY_ID"), signingKeys)
+	if err != nil {
+		log.Fatalln(err.Error())
+	}
 	logger := logging.NewMongoLogging(client)
 
 	adminRepository := ar.NewAdminRepositoryImpl(db, logger)
@@ -80,18 +139,58 @@ func main() {
 	addressRepository := dr.NewAddressRepositoryImpl(db, logger)
 	propertyRepository := pr.NewPropertyRepositoryImpl(db, logger)
 	showScheduleRepository := ssr.NewShowScheduleRepositoryImpl(db, logger)
+	memberRepository := mr.NewMemberRepositoryImpl(db, logger)
+	attachmentRepository := fr.NewAttachmentRepositoryImpl(db, logger)
+	trashRepository := tr.NewTrashRepositoryImpl(db, logger)
+	achievementRepository := cr.NewAchievementRepositoryImpl(db, logger)
+	searchRepository := sr.NewSearchRepositoryImpl(db, logger)
+	submissionRepository := rr.NewSubmissionRepositoryImpl(db, logger)
+	statsRepository := str.NewStatsRepositoryImpl(db, logger)
+	loanRepository := lr.NewLoanRepositoryImpl(db, logger)
+	maintenanceRepository := nr.NewMaintenanceRepositoryImpl(db, logger)
 
 	adminService := as.NewAdminServiceImpl(adminRepository, passwordGenerator, tokenGenerator)
-	groupService := gs.NewGroupServiceImpl(groupRepository, villageRepository, idGenerator, qrCodeGenerator)
+	groupService := gs.NewGroupServiceImpl(groupRepository, villageRepository, idGenerator, qrCodeGenerator, scanLinkGenerator, codeSigner, registrationNumberGenerator, certificateGenerator)
 	addressService := ds.NewAddressServiceImpl(addressRepository, villageRepository)
-	propertyService := ps.NewPropertyServiceImpl(propertyRepository, groupRepository, idGenerator, qrCodeGenerator)
+	propertyService := ps.NewPropertyServiceImpl(propertyRepository, groupRepository, idGenerator, qrCodeGenerator, scanLinkGenerator, codeSigner)
 	showScheduleService := sss.NewShowScheduleServiceImpl(showScheduleRepository, groupRepository, idGenerator)
+	memberService := ms.NewMemberServiceImpl(memberRepository, groupRepository, idGenerator)
+	attachmentService := fs.NewAttachmentServiceImpl(attachmentRepository, groupRepository, propertyRepository, achievementRepository, maintenanceRepository, idGenerator, thumbnailGenerator, fileStorage)
+	trashService := ts.NewTrashServiceImpl(trashRepository, groupRepository, fileStorage)
+	achievementService := cs.NewAchievementServiceImpl(achievementRepository, groupRepository, idGenerator)
+	searchService := ss.NewSearchServiceImpl(searchRepository)
+	submissionService := rs.NewSubmissionServiceImpl(submissionRepository, villageRepository, groupService, idGenerator)
+	statsService := sts.NewStatsServiceImpl(statsRepository)
+	publicService := pus.NewPublicServiceImpl(groupRepository, showScheduleRepository, propertyRepository, codeSigner)
+	loanService := ls.NewLoanServiceImpl(loanRepository, propertyRepository, groupRepository, idGenerator)
+	maintenanceService := ns.NewMaintenanceServiceImpl(maintenanceRepository, propertyRepository, idGenerator)
+
+	if err := groupService.AssignRegistrationNumbers(context.Background()); err != nil {
+		log.Printf("Error assigning registration numbers: %s\n", err.Error())
+	}
+
+	if retentionDays, _ := strconv.Atoi(os.Getenv("TRASH_RETENTION_DAYS")); retentionDays > 0 {
+		go purgeExpiredTrash(trashService, retentionDays)
+	}
 
 	adminsController := controller.NewAdminsController(adminService)
-	groupsController := controller.NewGroupsController(groupService, propertyService, addressService)
+	groupsController := controller.NewGroupsController(groupService, propertyService, addressService, tokenGenerator)
 	showSchedulesController := controller.NewShowSchedulesController(showScheduleService)
+	membersController := controller.NewMembersController(memberService)
+	attachmentsController := controller.NewAttachmentsController(attachmentService)
+	trashController := controller.NewTrashController(trashService)
+	achievementsController := controller.NewAchievementsController(achievementService)
+	searchController := controller.NewSearchController(searchService)
+	submissionsController := controller.NewSubmissionsController(submissionService, tokenGenerator)
+	statsController := controller.NewStatsController(statsService)
+	publicController := controller.NewPublicController(publicService)
+	loansController := controller.NewLoansController(loanService)
+	maintenanceController := controller.NewMaintenanceController(maintenanceService)
 
 	e := echo.New()
+	// The API is served without a reverse proxy, so X-Forwarded-For and X-Real-IP headers are not trusted.
+	// Otherwise the rate limiter and the submission quota could be bypassed by forging them.
+	e.IPExtractor = echo.ExtractIPDirect()
 
 	if os.Getenv("ENV") == "production" {
 		middleware.BodyLimit(e)
@@ -107,10 +206,36 @@ func main() {
 
 	e.GET("/*", echoSwagger.WrapHandler)
 
+	if os.Getenv("STORAGE_DRIVER") != "s3" {
+		e.Static(config.LocalStoragePath, config.LocalStorageDir())
+	}
+
 	g := e.Group("/api/v1")
 
 	adminsController.Route(g)
 	groupsController.Route(g)
 	showSchedulesController.Route(g)
+	membersController.Route(g)
+	attachmentsController.Route(g)
+	trashController.Route(g)
+	achievementsController.Route(g)
+	searchController.Route(g)
+	submissionsController.Route(g)
+	statsController.Route(g)
+	publicController.Route(g)
+	loansController.Route(g)
+	maintenanceController.Route(g)
 	e.Logger.Fatal(e.Start(port))
 }
+
+// purgeExpiredTrash permanently deletes what has been in the trash for more than retentionDays days, once a day.
+func purgeExpiredTrash(trashService ts.TrashService, retentionDays int) {
+	ticker := time.NewTicker(24 * time.Hour)
+	defer ticker.Stop()
+
+	for ; true; <-ticker.C {
+		if err := trashService.PurgeExpired(context.Background(), retentionDays); err != nil {
+			log.Printf("Error purging expired trash: %s\n", err.Error())
+		}
+	}
+}
@@ -1,10 +1,38 @@
 package middleware
 
 import (
+	"sync"
+
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
 )
 
+// uploadRoutes holds the method and path of the routes registered with UploadRoute, which the global body limit
+// skips.
+var uploadRoutes sync.Map
+
 func BodyLimit(e *echo.Echo) {
-	e.Use(middleware.BodyLimit("128K"))
+	e.Use(middleware.BodyLimitWithConfig(middleware.BodyLimitConfig{
+		Skipper: func(c echo.Context) bool {
+			_, ok := uploadRoutes.Load(routeKey(c.Request().Method, c.Path()))
+			return ok
+		},
+		Limit: "128K",
+	}))
+}
+
+// UploadBodyLimit allows a 10 MB file plus the multipart envelope around it.
+func UploadBodyLimit() echo.MiddlewareFunc {
+	return middleware.BodyLimit("11M")
+}
+
+// UploadRoute registers a POST route limited by UploadBodyLimit instead of the global body limit.
+func UploadRoute(g *echo.Group, path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route {
+	route := g.POST(path, h, append(m, UploadBodyLimit())...)
+	uploadRoutes.Store(routeKey(route.Method, route.Path), struct{}{})
+	return route
+}
+
+func routeKey(method string, path string) string {
+	return method + " " + path
 }
@@ -0,0 +1,40 @@
+package middleware
+
+import (
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/echo/v4/middleware"
+	"golang.org/x/time/rate"
+)
+
+// PublicCORS allows the origins listed, comma separated, in the PUBLIC_CORS_ORIGINS environment variable to read
+// the public routes, or any origin when it is empty.
+func PublicCORS() echo.MiddlewareFunc {
+	origins := []string{"*"}
+	if env := os.Getenv("PUBLIC_CORS_ORIGINS"); env != "" {
+		origins = strings.Split(env, ",")
+		for i := range origins {
+			origins[i] = strings.TrimSpace(origins[i])
+		}
+	}
+
+	return middleware.CORSWithConfig(middleware.CORSConfig{
+		AllowOrigins: origins,
+		AllowMethods: []string{http.MethodGet, http.MethodHead, http.MethodOptions},
+		MaxAge:       int((24 * time.Hour).Seconds()),
+	})
+}
+
+// PublicRateLimiter limits each IP address to 5 requests per second, with bursts of 10, on the public routes.
+// It is stricter than the limiter of the whole API, as the public routes need no token.
+func PublicRateLimiter() echo.MiddlewareFunc {
+	return middleware.RateLimiter(middleware.NewRateLimiterMemoryStoreWithConfig(middleware.RateLimiterMemoryStoreConfig{
+		Rate:      rate.Limit(5),
+		Burst:     10,
+		ExpiresIn: 3 * time.Minute,
+	}))
+}
@@ -0,0 +1,25 @@
+package payload
+
+type CreateAchievement struct {
+	EventName string `json:"eventName" validate:"nonzero,min=2,max=120" extensions:"x-order=0"`
+	Year      uint16 `json:"year" validate:"min=1900" extensions:"x-order=1"`
+	// Level is one of village, regency or national
+	Level string `json:"level" validate:"nonzero,regexp=^(village|regency|national)$" extensions:"x-order=2"`
+	// Rank is the place the group finished in, 1 to 3 count as gold, silver and bronze medals
+	Rank uint8 `json:"rank" validate:"min=1" extensions:"x-order=3"`
+}
+
+type UpdateAchievement struct {
+	EventName string `json:"eventName" validate:"nonzero,min=2,max=120" extensions:"x-order=0"`
+	Year      uint16 `json:"year" validate:"min=1900" extensions:"x-order=1"`
+	// Level is one of village, regency or national
+	Level string `json:"level" validate:"nonzero,regexp=^(village|regency|national)$" extensions:"x-order=2"`
+	// Rank is the place the group finished in, 1 to 3 count as gold, silver and bronze medals
+	Rank uint8 `json:"rank" validate:"min=1" extensions:"x-order=3"`
+}
+
+type GetAchievementRanking struct {
+	// Level is one of village, regency or national
+	Level string `query:"level" validate:"regexp=^(village|regency|national)?$"`
+	Year  uint16 `query:"year"`
+}
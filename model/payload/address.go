@@ -3,4 +3,16 @@ package payload
 type UpdateAddress struct {
 	Address   string `json:"address" validate:"nonzero,min=2,max=1000" extensions:"x-order=0"`
 	VillageID string `json:"villageID" validate:"nonzero,min=2,max=20" extensions:"x-order=1"`
+	// Latitude and Longitude are optional, but go together and must lie in Ponorogo. Omit both to keep the current location.
+	Latitude  *float64 `json:"latitude,omitempty" extensions:"x-order=2"`
+	Longitude *float64 `json:"longitude,omitempty" extensions:"x-order=3"`
+}
+
+// PatchAddress is a JSON merge patch of an address. Patching the village also moves the address to its district.
+type PatchAddress struct {
+	Address   *string `json:"address,omitempty" validate:"min=2,max=1000" extensions:"x-order=0"`
+	VillageID *string `json:"villageID,omitempty" validate:"min=2,max=20" extensions:"x-order=1"`
+	// Latitude and Longitude go together and must lie in Ponorogo, they replace the current location
+	Latitude  *float64 `json:"latitude,omitempty" extensions:"x-order=2"`
+	Longitude *float64 `json:"longitude,omitempty" extensions:"x-order=3"`
 }
@@ -0,0 +1,6 @@
+package payload
+
+type CreateAttachment struct {
+	FileName string `validate:"nonzero,max=255"`
+	Content  []byte `validate:"nonzero"`
+}
@@ -5,9 +5,99 @@ type CreateGroup struct {
 	Leader    string `json:"leader" validate:"nonzero,min=2,max=80" extensions:"x-order=1"`
 	Address   string `json:"address" validate:"nonzero,min=2,max=1000" extensions:"x-order=2"`
 	VillageID string `json:"villageID" validate:"nonzero,min=2,max=20" extensions:"x-order=3"`
+	// Latitude and Longitude are optional, but go together and must lie in Ponorogo
+	Latitude  *float64 `json:"latitude,omitempty" extensions:"x-order=4"`
+	Longitude *float64 `json:"longitude,omitempty" extensions:"x-order=5"`
 }
 
 type UpdateGroup struct {
 	Name   string `json:"name" validate:"nonzero,min=2,max=80" extensions:"x-order=0"`
 	Leader string `json:"leader" validate:"nonzero,min=2,max=80" extensions:"x-order=1"`
+	// LeaderSince is the date the new leader took over, defaults to today. It is ignored when the leader is unchanged.
+	// LeaderSince layout format: 2006-01-02
+	LeaderSince string `json:"leaderSince,omitempty" validate:"max=10" extensions:"x-order=2"`
+	// LeaderNote is recorded with the leadership change, it is ignored when the leader is unchanged
+	LeaderNote string `json:"leaderNote,omitempty" validate:"max=500" extensions:"x-order=3"`
+}
+
+// PatchGroup is a JSON merge patch of a group, only the members present in the patch are validated and changed.
+type PatchGroup struct {
+	Name   *string `json:"name,omitempty" validate:"min=2,max=80" extensions:"x-order=0"`
+	Leader *string `json:"leader,omitempty" validate:"min=2,max=80" extensions:"x-order=1"`
+	// LeaderSince and LeaderNote are only used when the patch replaces the leader, as in UpdateGroup
+	LeaderSince *string `json:"leaderSince,omitempty" validate:"max=10" extensions:"x-order=2"`
+	LeaderNote  *string `json:"leaderNote,omitempty" validate:"max=500" extensions:"x-order=3"`
+}
+
+// UpdateGroupContacts replaces all of the contacts of a group, an empty field removes the contact.
+type UpdateGroupContacts struct {
+	// Phone and WhatsApp are Indonesian numbers starting with +62, 62 or 0, WhatsApp must be a mobile number
+	Phone    string `json:"phone" validate:"max=20" extensions:"x-order=0"`
+	WhatsApp string `json:"whatsapp" validate:"max=20" extensions:"x-order=1"`
+	Email    string `json:"email" validate:"max=254" extensions:"x-order=2"`
+	// Website is an absolute http or https URL
+	Website string `json:"website" validate:"max=255" extensions:"x-order=3"`
+	// Instagram, Facebook, YouTube and TikTok are either handles or profile URLs
+	Instagram string `json:"instagram" validate:"max=255" extensions:"x-order=4"`
+	Facebook  string `json:"facebook" validate:"max=255" extensions:"x-order=5"`
+	YouTube   string `json:"youtube" validate:"max=255" extensions:"x-order=6"`
+	TikTok    string `json:"tiktok" validate:"max=255" extensions:"x-order=7"`
+}
+
+type GetGroups struct {
+	// Page is capped, so that the offset of the page cannot overflow
+	Page       int    `query:"page" validate:"min=0,max=10000"`
+	Limit      int    `query:"limit" validate:"min=0,max=100"`
+	DistrictID string `query:"district_id" validate:"max=20"`
+	VillageID  string `query:"village_id" validate:"max=20"`
+	Name       string `query:"name" validate:"max=80"`
+	// Status is one of active, dormant, suspended or dissolved
+	Status string `query:"status" validate:"regexp=^(active|dormant|suspended|dissolved)?$"`
+	// Sort is one of name, created_at or property_count, prefixed with - for descending order
+	Sort string `query:"sort" validate:"regexp=^-?(name|created_at|property_count)?$"`
+}
+
+type GetNearbyGroups struct {
+	Latitude  *float64 `query:"lat" validate:"nonnil,min=-90,max=90"`
+	Longitude *float64 `query:"lng" validate:"nonnil,min=-180,max=180"`
+	// Radius is in meters, 5000 by default
+	Radius float64 `query:"radius" validate:"min=0,max=50000"`
+	Limit  int     `query:"limit" validate:"min=0,max=100"`
+}
+
+type GetDuplicateGroups struct {
+	DistrictID string `query:"district_id" validate:"max=20"`
+	VillageID  string `query:"village_id" validate:"max=20"`
+	// MinSimilarity is the lowest similarity, between 0 and 1, of the reported pairs. It defaults to 0.8.
+	MinSimilarity float64 `query:"min_similarity" validate:"min=0,max=1"`
+}
+
+type MergeGroup struct {
+	// TargetID is the group that receives the properties, show schedules, members and achievements
+	TargetID string `json:"targetID" validate:"nonzero,min=2,max=10" extensions:"x-order=0"`
+	// TargetETag is the ETag of the target group the merge is based on, as the If-Match header is for the merged group
+	TargetETag string `json:"targetETag" extensions:"x-order=1"`
+}
+
+type DeleteGroup struct {
+	// DryRun reports the records affected by the deletion without deleting anything
+	DryRun bool `query:"dry_run"`
+	// FutureShows is one of cascade, block or detach, cascade by default. It handles the show schedules not
+	// finished yet.
+	FutureShows string `query:"future_shows" validate:"regexp=^(cascade|block|detach)?$"`
+	// PastShows is one of cascade, block or detach, cascade by default. It handles the finished show schedules.
+	PastShows string `query:"past_shows" validate:"regexp=^(cascade|block|detach)?$"`
+}
+
+type ImportGroups struct {
+	// DryRun validates the rows without creating any group
+	DryRun bool `query:"dry_run"`
+	// Atomic creates no group at all when one of the rows is invalid
+	Atomic bool `query:"atomic"`
+}
+
+type UpdateGroupStatus struct {
+	// Status is one of active, dormant, suspended or dissolved
+	Status string `json:"status" validate:"nonzero,regexp=^(active|dormant|suspended|dissolved)$" extensions:"x-order=0"`
+	Reason string `json:"reason" validate:"nonzero,min=2,max=500" extensions:"x-order=1"`
 }
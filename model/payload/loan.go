@@ -0,0 +1,17 @@
+package payload
+
+type CreateLoan struct {
+	// Either BorrowerGroupID, when another group borrows the items, or BorrowerName, such as a school, is given
+	BorrowerGroupID string `json:"borrowerGroupID,omitempty" validate:"max=10" extensions:"x-order=0"`
+	BorrowerName    string `json:"borrowerName,omitempty" validate:"max=120" extensions:"x-order=1"`
+	Quantity        uint16 `json:"quantity" validate:"min=1" extensions:"x-order=2"`
+	// DueOn layout format: 2006-01-02
+	DueOn string `json:"dueOn" validate:"nonzero,max=10" extensions:"x-order=3"`
+	Note  string `json:"note,omitempty" validate:"max=500" extensions:"x-order=4"`
+}
+
+type ReturnLoan struct {
+	// ReturnedOn defaults to today
+	// ReturnedOn layout format: 2006-01-02
+	ReturnedOn string `json:"returnedOn,omitempty" validate:"max=10" extensions:"x-order=0"`
+}
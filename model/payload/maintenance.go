@@ -0,0 +1,32 @@
+package payload
+
+type CreateMaintenance struct {
+	// Condition is one of good, fair, poor or broken
+	Condition string `json:"condition" validate:"nonzero,regexp=^(good|fair|poor|broken)$" extensions:"x-order=0"`
+	// InspectedOn layout format: 2006-01-02
+	InspectedOn       string `json:"inspectedOn" validate:"nonzero,max=10" extensions:"x-order=1"`
+	DamageDescription string `json:"damageDescription,omitempty" validate:"max=500" extensions:"x-order=2"`
+	// RepairedOn is left out while the damage is not repaired
+	// RepairedOn layout format: 2006-01-02
+	RepairedOn string `json:"repairedOn,omitempty" validate:"max=10" extensions:"x-order=3"`
+	// Cost is the cost of the repair in rupiah
+	Cost uint32 `json:"cost,omitempty" extensions:"x-order=4"`
+}
+
+type UpdateMaintenance struct {
+	// Condition is one of good, fair, poor or broken
+	Condition string `json:"condition" validate:"nonzero,regexp=^(good|fair|poor|broken)$" extensions:"x-order=0"`
+	// InspectedOn layout format: 2006-01-02
+	InspectedOn       string `json:"inspectedOn" validate:"nonzero,max=10" extensions:"x-order=1"`
+	DamageDescription string `json:"damageDescription,omitempty" validate:"max=500" extensions:"x-order=2"`
+	// RepairedOn is left out while the damage is not repaired
+	// RepairedOn layout format: 2006-01-02
+	RepairedOn string `json:"repairedOn,omitempty" validate:"max=10" extensions:"x-order=3"`
+	// Cost is the cost of the repair in rupiah
+	Cost uint32 `json:"cost,omitempty" extensions:"x-order=4"`
+}
+
+type GetPoorConditionReport struct {
+	// DistrictID narrows the report down to a district, the whole regency by default
+	DistrictID string `query:"districtID" validate:"max=10"`
+}
@@ -0,0 +1,25 @@
+package payload
+
+type CreateMember struct {
+	Name string `json:"name" validate:"nonzero,min=2,max=80" extensions:"x-order=0"`
+	// Role is one of warok, jathil, bujang_ganong, klono_sewandono, pembarong or musician
+	Role string `json:"role" validate:"nonzero,regexp=^(warok|jathil|bujang_ganong|klono_sewandono|pembarong|musician)$" extensions:"x-order=1"`
+	// Gender is one of male or female
+	Gender    string `json:"gender" validate:"nonzero,regexp=^(male|female)$" extensions:"x-order=2"`
+	BirthYear uint16 `json:"birthYear" validate:"min=1900" extensions:"x-order=3"`
+	Phone     string `json:"phone" validate:"max=20,regexp=^(\\+?[0-9]+)?$" extensions:"x-order=4"`
+	// JoinedOn layout format: 2006-01-02
+	JoinedOn string `json:"joinedOn" validate:"nonzero,max=10" extensions:"x-order=5"`
+}
+
+type UpdateMember struct {
+	Name string `json:"name" validate:"nonzero,min=2,max=80" extensions:"x-order=0"`
+	// Role is one of warok, jathil, bujang_ganong, klono_sewandono, pembarong or musician
+	Role string `json:"role" validate:"nonzero,regexp=^(warok|jathil|bujang_ganong|klono_sewandono|pembarong|musician)$" extensions:"x-order=1"`
+	// Gender is one of male or female
+	Gender    string `json:"gender" validate:"nonzero,regexp=^(male|female)$" extensions:"x-order=2"`
+	BirthYear uint16 `json:"birthYear" validate:"min=1900" extensions:"x-order=3"`
+	Phone     string `json:"phone" validate:"max=20,regexp=^(\\+?[0-9]+)?$" extensions:"x-order=4"`
+	// JoinedOn layout format: 2006-01-02
+	JoinedOn string `json:"joinedOn" validate:"nonzero,max=10" extensions:"x-order=5"`
+}
@@ -11,3 +11,10 @@ type UpdateProperty struct {
 	Description string `json:"description" validate:"nonzero,min=2,max=1000" extensions:"x-order=1"`
 	Amount      uint16 `json:"amount" validate:"nonzero,min=1" extensions:"x-order=2"`
 }
+
+// PatchProperty is a JSON merge patch of a property. An amount of 0 is rejected, rather than taken as absent.
+type PatchProperty struct {
+	Name        *string `json:"name,omitempty" validate:"min=2,max=80" extensions:"x-order=0"`
+	Description *string `json:"description,omitempty" validate:"min=2,max=1000" extensions:"x-order=1"`
+	Amount      *uint16 `json:"amount,omitempty" validate:"min=1" extensions:"x-order=2"`
+}
@@ -0,0 +1,17 @@
+package payload
+
+type GetPublicGroups struct {
+	// Page is capped, so that the offset of the page cannot overflow
+	Page       int    `query:"page" validate:"min=0,max=10000"`
+	Limit      int    `query:"limit" validate:"min=0,max=100"`
+	DistrictID string `query:"district_id" validate:"max=20"`
+	VillageID  string `query:"village_id" validate:"max=20"`
+	Name       string `query:"name" validate:"max=80"`
+}
+
+type GetUpcomingShows struct {
+	GroupID    string `query:"group_id" validate:"max=10"`
+	DistrictID string `query:"district_id" validate:"max=20"`
+	// Limit is 20 by default
+	Limit int `query:"limit" validate:"min=0,max=100"`
+}
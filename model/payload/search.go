@@ -0,0 +1,7 @@
+package payload
+
+type Search struct {
+	// Query supports the web search syntax: quoted phrases, OR and - to exclude a word
+	Query string `query:"q" validate:"nonzero,max=100"`
+	Limit int    `query:"limit" validate:"min=0,max=50"`
+}
@@ -7,6 +7,9 @@ type CreateShowSchedule struct {
 	StartOn string `json:"startOn" validate:"nonzero,min=2,max=30" extensions:"x-order=2"`
 	// FinishOn layout format: time.RFC822 (02 Jan 06 15:04 MST)
 	FinishOn string `json:"finishOn" validate:"nonzero,min=2,max=30" extensions:"x-order=3"`
+	// Latitude and Longitude of the venue are optional, but go together
+	Latitude  *float64 `json:"latitude,omitempty" validate:"min=-90,max=90" extensions:"x-order=4"`
+	Longitude *float64 `json:"longitude,omitempty" validate:"min=-180,max=180" extensions:"x-order=5"`
 }
 
 type UpdateShowSchedule struct {
@@ -15,4 +18,19 @@ type UpdateShowSchedule struct {
 	StartOn string `json:"startOn" validate:"nonzero,min=2,max=30" extensions:"x-order=1"`
 	// FinishOn layout format: time.RFC822 (02 Jan 06 15:04 MST)
 	FinishOn string `json:"finishOn" validate:"nonzero,min=2,max=30" extensions:"x-order=2"`
+	// Latitude and Longitude of the venue are optional, but go together. Omit both to keep the current location.
+	Latitude  *float64 `json:"latitude,omitempty" validate:"min=-90,max=90" extensions:"x-order=3"`
+	Longitude *float64 `json:"longitude,omitempty" validate:"min=-180,max=180" extensions:"x-order=4"`
+}
+
+// PatchShowSchedule is a JSON merge patch of a show schedule, so one of the times can be moved without the other.
+type PatchShowSchedule struct {
+	Place *string `json:"place,omitempty" validate:"min=2,max=1000" extensions:"x-order=0"`
+	// StartOn layout format: time.RFC822 (02 Jan 06 15:04 MST)
+	StartOn *string `json:"startOn,omitempty" validate:"min=2,max=30" extensions:"x-order=1"`
+	// FinishOn layout format: time.RFC822 (02 Jan 06 15:04 MST)
+	FinishOn *string `json:"finishOn,omitempty" validate:"min=2,max=30" extensions:"x-order=2"`
+	// Latitude and Longitude of the venue still go together, they replace the current location
+	Latitude  *float64 `json:"latitude,omitempty" validate:"min=-90,max=90" extensions:"x-order=3"`
+	Longitude *float64 `json:"longitude,omitempty" validate:"min=-180,max=180" extensions:"x-order=4"`
 }
@@ -0,0 +1,8 @@
+package payload
+
+type GetDistrictStats struct {
+	// From and To are the first and last days of the show schedules counted, the current year by default
+	// From and To layout format: 2006-01-02
+	From string `query:"from" validate:"max=10"`
+	To   string `query:"to" validate:"max=10"`
+}
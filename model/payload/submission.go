@@ -0,0 +1,20 @@
+package payload
+
+type SubmitGroup struct {
+	CreateGroup
+	// ContactPhone is an Indonesian number starting with +62, 62 or 0
+	ContactPhone string `json:"contactPhone" validate:"nonzero,max=20" extensions:"x-order=6"`
+	ContactEmail string `json:"contactEmail,omitempty" validate:"max=254" extensions:"x-order=7"`
+	// Website is a honeypot. The submission form hides it from people, so only spam bots fill it in.
+	Website string `json:"website,omitempty" extensions:"x-order=8"`
+}
+
+type GetSubmissions struct {
+	// Status is one of pending, approving, approved or rejected, all submissions are returned when it is empty
+	Status string `query:"status" validate:"regexp=^(pending|approving|approved|rejected)?$"`
+}
+
+type RejectSubmission struct {
+	// Reason is kept with the submission to explain the decision to the other admins
+	Reason string `json:"reason" validate:"nonzero,min=2,max=500" extensions:"x-order=0"`
+}
@@ -0,0 +1,23 @@
+package response
+
+type Achievement struct {
+	ID        string `json:"id" extensions:"x-order=0"`
+	GroupID   string `json:"groupID" extensions:"x-order=1"`
+	EventName string `json:"eventName" extensions:"x-order=2"`
+	Year      uint16 `json:"year" extensions:"x-order=3"`
+	Level     string `json:"level" enums:"village,regency,national" extensions:"x-order=4"`
+	Rank      uint8  `json:"rank" extensions:"x-order=5"`
+	// Certificate is the scanned certificate of the achievement, null when none was uploaded
+	Certificate *Attachment `json:"certificate" extensions:"x-order=6"`
+}
+
+type DistrictRanking struct {
+	// Position is the 1-based place of the district in the medal table
+	Position     int    `json:"position" extensions:"x-order=0"`
+	DistrictID   string `json:"districtID" extensions:"x-order=1"`
+	DistrictName string `json:"districtName" extensions:"x-order=2"`
+	Gold         int    `json:"gold" extensions:"x-order=3"`
+	Silver       int    `json:"silver" extensions:"x-order=4"`
+	Bronze       int    `json:"bronze" extensions:"x-order=5"`
+	Total        int    `json:"total" extensions:"x-order=6"`
+}
@@ -0,0 +1,10 @@
+package response
+
+type Attachment struct {
+	ID           string `json:"id" extensions:"x-order=0"`
+	FileName     string `json:"fileName" extensions:"x-order=1"`
+	ContentType  string `json:"contentType" extensions:"x-order=2"`
+	Size         int64  `json:"size" extensions:"x-order=3"`
+	URL          string `json:"url" extensions:"x-order=4"`
+	ThumbnailURL string `json:"thumbnailURL,omitempty" extensions:"x-order=5"`
+}
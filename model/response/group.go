@@ -1,11 +1,39 @@
 package response
 
 type Group struct {
-	ID         string     `json:"id" extensions:"x-order=0"`
-	Name       string     `json:"name" extensions:"x-order=1"`
-	Leader     string     `json:"leader" extensions:"x-order=2"`
-	Address    Address    `json:"address" extensions:"x-order=3"`
-	Properties []Property `json:"properties" extensions:"x-order=4"`
+	ID           string       `json:"id" extensions:"x-order=0"`
+	Name         string       `json:"name" extensions:"x-order=1"`
+	Leader       string       `json:"leader" extensions:"x-order=2"`
+	Address      Address      `json:"address" extensions:"x-order=3"`
+	Properties   []Property   `json:"properties" extensions:"x-order=4"`
+	MemberCounts MemberCounts `json:"memberCounts" extensions:"x-order=5"`
+	Attachments  []Attachment `json:"attachments" extensions:"x-order=6"`
+	// RegistrationNumber is the sequential number officials register the group under
+	RegistrationNumber string        `json:"registrationNumber" extensions:"x-order=7"`
+	Status             string        `json:"status" enums:"active,dormant,suspended,dissolved" extensions:"x-order=8"`
+	Achievements       []Achievement `json:"achievements" extensions:"x-order=9"`
+	Contacts           GroupContacts `json:"contacts" extensions:"x-order=10"`
+	// Version changes on every update of the group, it is part of the ETag sent back in the If-Match header
+	Version int `json:"version" extensions:"x-order=11"`
+}
+
+type NearbyGroup struct {
+	Group
+	// Distance is the distance from the given point to the group, in meters
+	Distance float64 `json:"distance" extensions:"x-order=12"`
+}
+
+// GroupContacts has phone numbers in the E.164 format, such as +6281234567890, and social media handles without their @.
+// Missing contacts are empty.
+type GroupContacts struct {
+	Phone     string `json:"phone" extensions:"x-order=0"`
+	WhatsApp  string `json:"whatsapp" extensions:"x-order=1"`
+	Email     string `json:"email" extensions:"x-order=2"`
+	Website   string `json:"website" extensions:"x-order=3"`
+	Instagram string `json:"instagram" extensions:"x-order=4"`
+	Facebook  string `json:"facebook" extensions:"x-order=5"`
+	YouTube   string `json:"youtube" extensions:"x-order=6"`
+	TikTok    string `json:"tiktok" extensions:"x-order=7"`
 }
 
 type Address struct {
@@ -19,11 +47,86 @@ type Address struct {
 	RegencyName  string `json:"regencyName" extensions:"x-order=6"`
 	ProvinceID   string `json:"provinceID" extensions:"x-order=7"`
 	ProvinceName string `json:"provinceName" extensions:"x-order=8"`
+	// Latitude and Longitude are null until the location of the group is known
+	Latitude  *float64 `json:"latitude" extensions:"x-order=9"`
+	Longitude *float64 `json:"longitude" extensions:"x-order=10"`
 }
 
 type Property struct {
-	ID          string `json:"id" extensions:"x-order=0"`
-	Name        string `json:"name" extensions:"x-order=1"`
-	Description string `json:"description" extensions:"x-order=2"`
-	Amount      uint16 `json:"amount" extensions:"x-order=3"`
+	ID          string       `json:"id" extensions:"x-order=0"`
+	Name        string       `json:"name" extensions:"x-order=1"`
+	Description string       `json:"description" extensions:"x-order=2"`
+	Amount      uint16       `json:"amount" extensions:"x-order=3"`
+	Attachments []Attachment `json:"attachments" extensions:"x-order=4"`
+	// Version is sent back quoted in the If-Match header to update or delete the property
+	Version int `json:"version" extensions:"x-order=5"`
+}
+
+type GroupStatusTransition struct {
+	ID            string `json:"id" extensions:"x-order=0"`
+	FromStatus    string `json:"fromStatus" enums:"active,dormant,suspended,dissolved" extensions:"x-order=1"`
+	ToStatus      string `json:"toStatus" enums:"active,dormant,suspended,dissolved" extensions:"x-order=2"`
+	Reason        string `json:"reason" extensions:"x-order=3"`
+	AdminID       string `json:"adminID" extensions:"x-order=4"`
+	AdminUsername string `json:"adminUsername" extensions:"x-order=5"`
+	// ChangedAt layout format: time.RFC822 (02 Jan 06 15:04 MST)
+	ChangedAt string `json:"changedAt" extensions:"x-order=6"`
+}
+
+type LeadershipChange struct {
+	ID             string `json:"id" extensions:"x-order=0"`
+	PreviousLeader string `json:"previousLeader" extensions:"x-order=1"`
+	NewLeader      string `json:"newLeader" extensions:"x-order=2"`
+	// EffectiveOn layout format: 2006-01-02
+	EffectiveOn   string `json:"effectiveOn" extensions:"x-order=3"`
+	Note          string `json:"note" extensions:"x-order=4"`
+	AdminID       string `json:"adminID" extensions:"x-order=5"`
+	AdminUsername string `json:"adminUsername" extensions:"x-order=6"`
+	// RecordedAt layout format: time.RFC822 (02 Jan 06 15:04 MST)
+	RecordedAt string `json:"recordedAt" extensions:"x-order=7"`
+}
+
+// DuplicateGroups is a pair of groups of the same village whose names and leaders are spelled alike.
+type DuplicateGroups struct {
+	VillageID   string           `json:"villageID" extensions:"x-order=0"`
+	VillageName string           `json:"villageName" extensions:"x-order=1"`
+	Groups      []DuplicateGroup `json:"groups" extensions:"x-order=2"`
+	// Similarity is the mean of NameSimilarity and LeaderSimilarity
+	Similarity       float64 `json:"similarity" extensions:"x-order=3"`
+	NameSimilarity   float64 `json:"nameSimilarity" extensions:"x-order=4"`
+	LeaderSimilarity float64 `json:"leaderSimilarity" extensions:"x-order=5"`
+}
+
+type DuplicateGroup struct {
+	ID            string `json:"id" extensions:"x-order=0"`
+	Name          string `json:"name" extensions:"x-order=1"`
+	Leader        string `json:"leader" extensions:"x-order=2"`
+	PropertyCount int    `json:"propertyCount" extensions:"x-order=3"`
+	// CreatedAt layout format: time.RFC822 (02 Jan 06 15:04 MST)
+	CreatedAt string `json:"createdAt" extensions:"x-order=4"`
+}
+
+// GroupDeletion lists the IDs of the records affected by the deletion of a group.
+type GroupDeletion struct {
+	GroupID string `json:"groupID" extensions:"x-order=0"`
+	// Deletable is false when some show schedules block the deletion, then nothing is deleted at all
+	Deletable    bool     `json:"deletable" extensions:"x-order=1"`
+	Addresses    []string `json:"addresses" extensions:"x-order=2"`
+	Properties   []string `json:"properties" extensions:"x-order=3"`
+	Members      []string `json:"members" extensions:"x-order=4"`
+	Achievements []string `json:"achievements" extensions:"x-order=5"`
+	// DeletedShowSchedules are deleted along with the group
+	DeletedShowSchedules []string `json:"deletedShowSchedules" extensions:"x-order=6"`
+	// DetachedShowSchedules are kept without a group
+	DetachedShowSchedules []string `json:"detachedShowSchedules" extensions:"x-order=7"`
+	// BlockingShowSchedules prevent the group from being deleted
+	BlockingShowSchedules []string `json:"blockingShowSchedules" extensions:"x-order=8"`
+}
+
+type ImportGroup struct {
+	// Row is the 1-based position of the group in the imported file, excluding the header
+	Row    int    `json:"row" extensions:"x-order=0"`
+	Status string `json:"status" enums:"created,valid,invalid,skipped,failed" extensions:"x-order=1"`
+	ID     string `json:"id,omitempty" extensions:"x-order=2"`
+	Error  string `json:"error,omitempty" extensions:"x-order=3"`
 }
@@ -0,0 +1,26 @@
+package response
+
+type Loan struct {
+	ID         string `json:"id" extensions:"x-order=0"`
+	PropertyID string `json:"propertyID" extensions:"x-order=1"`
+	// BorrowerGroupID is empty when the borrower is not a group
+	BorrowerGroupID string `json:"borrowerGroupID" extensions:"x-order=2"`
+	BorrowerName    string `json:"borrowerName" extensions:"x-order=3"`
+	Quantity        uint16 `json:"quantity" extensions:"x-order=4"`
+	// LoanedOn, DueOn and ReturnedOn layout format: 2006-01-02
+	LoanedOn string `json:"loanedOn" extensions:"x-order=5"`
+	DueOn    string `json:"dueOn" extensions:"x-order=6"`
+	// ReturnedOn is empty while the items are still out
+	ReturnedOn string `json:"returnedOn" extensions:"x-order=7"`
+	Overdue    bool   `json:"overdue" extensions:"x-order=8"`
+	Note       string `json:"note" extensions:"x-order=9"`
+}
+
+type PropertyLoans struct {
+	PropertyID string `json:"propertyID" extensions:"x-order=0"`
+	Amount     uint16 `json:"amount" extensions:"x-order=1"`
+	OnLoan     int    `json:"onLoan" extensions:"x-order=2"`
+	// Available is the amount minus the quantity on loan, it is what can still be lent
+	Available int    `json:"available" extensions:"x-order=3"`
+	Loans     []Loan `json:"loans" extensions:"x-order=4"`
+}
@@ -0,0 +1,27 @@
+package response
+
+type Maintenance struct {
+	ID                string `json:"id" extensions:"x-order=0"`
+	PropertyID        string `json:"propertyID" extensions:"x-order=1"`
+	Condition         string `json:"condition" enums:"good,fair,poor,broken" extensions:"x-order=2"`
+	InspectedOn       string `json:"inspectedOn" extensions:"x-order=3"`
+	DamageDescription string `json:"damageDescription" extensions:"x-order=4"`
+	// RepairedOn is empty while the damage is not repaired
+	RepairedOn string       `json:"repairedOn,omitempty" extensions:"x-order=5"`
+	Cost       uint32       `json:"cost" extensions:"x-order=6"`
+	Photos     []Attachment `json:"photos" extensions:"x-order=7"`
+}
+
+type PoorConditionItem struct {
+	PropertyID        string `json:"propertyID" extensions:"x-order=0"`
+	PropertyName      string `json:"propertyName" extensions:"x-order=1"`
+	Amount            uint16 `json:"amount" extensions:"x-order=2"`
+	GroupID           string `json:"groupID" extensions:"x-order=3"`
+	GroupName         string `json:"groupName" extensions:"x-order=4"`
+	DistrictID        string `json:"districtID" extensions:"x-order=5"`
+	DistrictName      string `json:"districtName" extensions:"x-order=6"`
+	MaintenanceID     string `json:"maintenanceID" extensions:"x-order=7"`
+	Condition         string `json:"condition" enums:"poor,broken" extensions:"x-order=8"`
+	InspectedOn       string `json:"inspectedOn" extensions:"x-order=9"`
+	DamageDescription string `json:"damageDescription" extensions:"x-order=10"`
+}
@@ -0,0 +1,23 @@
+package response
+
+type Member struct {
+	ID        string `json:"id" extensions:"x-order=0"`
+	GroupID   string `json:"groupID" extensions:"x-order=1"`
+	Name      string `json:"name" extensions:"x-order=2"`
+	Role      string `json:"role" extensions:"x-order=3"`
+	Gender    string `json:"gender" extensions:"x-order=4"`
+	BirthYear uint16 `json:"birthYear" extensions:"x-order=5"`
+	Phone     string `json:"phone" extensions:"x-order=6"`
+	// JoinedOn layout format: 2006-01-02
+	JoinedOn string `json:"joinedOn" extensions:"x-order=7"`
+}
+
+type MemberCounts struct {
+	Warok          int `json:"warok" extensions:"x-order=0"`
+	Jathil         int `json:"jathil" extensions:"x-order=1"`
+	BujangGanong   int `json:"bujangGanong" extensions:"x-order=2"`
+	KlonoSewandono int `json:"klonoSewandono" extensions:"x-order=3"`
+	Pembarong      int `json:"pembarong" extensions:"x-order=4"`
+	Musician       int `json:"musician" extensions:"x-order=5"`
+	Total          int `json:"total" extensions:"x-order=6"`
+}
@@ -0,0 +1,10 @@
+package response
+
+type Pagination struct {
+	Page       int    `json:"page" extensions:"x-order=0"`
+	Limit      int    `json:"limit" extensions:"x-order=1"`
+	TotalItems int64  `json:"totalItems" extensions:"x-order=2"`
+	TotalPages int    `json:"totalPages" extensions:"x-order=3"`
+	Next       string `json:"next,omitempty" extensions:"x-order=4"`
+	Previous   string `json:"previous,omitempty" extensions:"x-order=5"`
+}
@@ -0,0 +1,58 @@
+package response
+
+// PublicGroup is the view of a group given to everyone. It leaves out the leader, the street address, the exact
+// location and the phone numbers and email of the group, as they are often the leader's own.
+type PublicGroup struct {
+	ID           string `json:"id" extensions:"x-order=0"`
+	Name         string `json:"name" extensions:"x-order=1"`
+	VillageName  string `json:"villageName" extensions:"x-order=2"`
+	DistrictName string `json:"districtName" extensions:"x-order=3"`
+	Website      string `json:"website,omitempty" extensions:"x-order=4"`
+	Instagram    string `json:"instagram,omitempty" extensions:"x-order=5"`
+	Facebook     string `json:"facebook,omitempty" extensions:"x-order=6"`
+	YouTube      string `json:"youtube,omitempty" extensions:"x-order=7"`
+	TikTok       string `json:"tiktok,omitempty" extensions:"x-order=8"`
+}
+
+type PublicShowSchedule struct {
+	ID           string `json:"id" extensions:"x-order=0"`
+	GroupID      string `json:"groupID" extensions:"x-order=1"`
+	GroupName    string `json:"groupName" extensions:"x-order=2"`
+	VillageName  string `json:"villageName" extensions:"x-order=3"`
+	DistrictName string `json:"districtName" extensions:"x-order=4"`
+	Place        string `json:"place" extensions:"x-order=5"`
+	// StartOn layout format: time.RFC822 (02 Jan 06 15:04 MST)
+	StartOn string `json:"startOn" extensions:"x-order=6"`
+	// FinishOn layout format: time.RFC822 (02 Jan 06 15:04 MST)
+	FinishOn  string   `json:"finishOn" extensions:"x-order=7"`
+	Latitude  *float64 `json:"latitude" extensions:"x-order=8"`
+	Longitude *float64 `json:"longitude" extensions:"x-order=9"`
+}
+
+type PublicProperty struct {
+	ID          string `json:"id" extensions:"x-order=0"`
+	Name        string `json:"name" extensions:"x-order=1"`
+	Description string `json:"description" extensions:"x-order=2"`
+	Amount      uint16 `json:"amount" extensions:"x-order=3"`
+}
+
+// ScanResult is what a scanned QR code stands for. Group is the scanned group, or the group owning the scanned
+// property.
+type ScanResult struct {
+	Type     string          `json:"type" enums:"group,property" extensions:"x-order=0"`
+	Group    PublicGroup     `json:"group" extensions:"x-order=1"`
+	Property *PublicProperty `json:"property,omitempty" extensions:"x-order=2"`
+	// Verified is false for the bare IDs encoded in the QR codes printed before the codes were signed.
+	Verified bool `json:"verified" extensions:"x-order=3"`
+}
+
+type CodeVerification struct {
+	KeyID       string `json:"keyID" extensions:"x-order=0"`
+	Type        string `json:"type" enums:"group,property" extensions:"x-order=1"`
+	ID          string `json:"id" extensions:"x-order=2"`
+	GroupID     string `json:"groupID" extensions:"x-order=3"`
+	GroupName   string `json:"groupName" extensions:"x-order=4"`
+	GroupStatus string `json:"groupStatus" extensions:"x-order=5"`
+	// IssuedOn layout format: ISO 8601 date (2006-01-02)
+	IssuedOn string `json:"issuedOn" extensions:"x-order=6"`
+}
@@ -0,0 +1,13 @@
+package response
+
+type SearchResult struct {
+	// Type is what matched the query: a group by its name or leader, the address of a group, a property or a show schedule
+	Type      string `json:"type" enums:"group,address,property,show_schedule" extensions:"x-order=0"`
+	ID        string `json:"id" extensions:"x-order=1"`
+	GroupID   string `json:"groupID" extensions:"x-order=2"`
+	GroupName string `json:"groupName" extensions:"x-order=3"`
+	Title     string `json:"title" extensions:"x-order=4"`
+	// Snippet is the HTML escaped matching text, with the matched words wrapped in <mark> tags
+	Snippet string  `json:"snippet" extensions:"x-order=5"`
+	Rank    float64 `json:"rank" extensions:"x-order=6"`
+}
@@ -7,7 +7,10 @@ type ShowSchedule struct {
 	// StartOn layout format: time.RFC822 (02 Jan 06 15:04 MST)
 	StartOn string `json:"startOn" extensions:"x-order=3"`
 	// FinishOn layout format: time.RFC822 (02 Jan 06 15:04 MST)
-	FinishOn string `json:"finishOn" extensions:"x-order=4"`
+	FinishOn  string   `json:"finishOn" extensions:"x-order=4"`
+	Latitude  *float64 `json:"latitude" extensions:"x-order=5"`
+	Longitude *float64 `json:"longitude" extensions:"x-order=6"`
+	Version   int      `json:"version" extensions:"x-order=7"`
 }
 
 type ShowScheduleDetails struct {
@@ -18,5 +21,8 @@ type ShowScheduleDetails struct {
 	// StartOn layout format: time.RFC822 (02 Jan 06 15:04 MST)
 	StartOn string `json:"startOn" extensions:"x-order=4"`
 	// FinishOn layout format: time.RFC822 (02 Jan 06 15:04 MST)
-	FinishOn string `json:"finishOn" extensions:"x-order=5"`
+	FinishOn  string   `json:"finishOn" extensions:"x-order=5"`
+	Latitude  *float64 `json:"latitude" extensions:"x-order=6"`
+	Longitude *float64 `json:"longitude" extensions:"x-order=7"`
+	Version   int      `json:"version" extensions:"x-order=8"`
 }
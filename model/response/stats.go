@@ -0,0 +1,22 @@
+package response
+
+type DistrictStatsReport struct {
+	// From and To are the first and last days of the show schedules counted, in the 2006-01-02 layout
+	From      string          `json:"from" extensions:"x-order=0"`
+	To        string          `json:"to" extensions:"x-order=1"`
+	Districts []DistrictStats `json:"districts" extensions:"x-order=2"`
+}
+
+type DistrictStats struct {
+	DistrictID   string `json:"districtID" extensions:"x-order=0"`
+	DistrictName string `json:"districtName" extensions:"x-order=1"`
+	ActiveGroups int    `json:"activeGroups" extensions:"x-order=2"`
+	// Properties are the total amounts of the properties of the groups by name, in alphabetical order
+	Properties    []PropertyTotal `json:"properties" extensions:"x-order=3"`
+	ShowSchedules int             `json:"showSchedules" extensions:"x-order=4"`
+}
+
+type PropertyTotal struct {
+	Name   string `json:"name" extensions:"x-order=0"`
+	Amount int    `json:"amount" extensions:"x-order=1"`
+}
@@ -0,0 +1,23 @@
+package response
+
+type Submission struct {
+	ID           string   `json:"id" extensions:"x-order=0"`
+	Name         string   `json:"name" extensions:"x-order=1"`
+	Leader       string   `json:"leader" extensions:"x-order=2"`
+	Address      string   `json:"address" extensions:"x-order=3"`
+	VillageID    string   `json:"villageID" extensions:"x-order=4"`
+	Latitude     *float64 `json:"latitude,omitempty" extensions:"x-order=5"`
+	Longitude    *float64 `json:"longitude,omitempty" extensions:"x-order=6"`
+	ContactPhone string   `json:"contactPhone" extensions:"x-order=7"`
+	ContactEmail string   `json:"contactEmail,omitempty" extensions:"x-order=8"`
+	SubmitterIP  string   `json:"submitterIP" extensions:"x-order=9"`
+	Status       string   `json:"status" enums:"pending,approving,approved,rejected" extensions:"x-order=10"`
+	// GroupID is the group created when the submission was approved
+	GroupID         string `json:"groupID,omitempty" extensions:"x-order=11"`
+	RejectionReason string `json:"rejectionReason,omitempty" extensions:"x-order=12"`
+	ReviewedBy      string `json:"reviewedBy,omitempty" extensions:"x-order=13"`
+	// ReviewedAt layout format: time.RFC822 (02 Jan 06 15:04 MST)
+	ReviewedAt string `json:"reviewedAt,omitempty" extensions:"x-order=14"`
+	// SubmittedAt layout format: time.RFC822 (02 Jan 06 15:04 MST)
+	SubmittedAt string `json:"submittedAt" extensions:"x-order=15"`
+}
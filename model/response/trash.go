@@ -0,0 +1,33 @@
+package response
+
+type DeletedGroup struct {
+	ID     string `json:"id" extensions:"x-order=0"`
+	Name   string `json:"name" extensions:"x-order=1"`
+	Leader string `json:"leader" extensions:"x-order=2"`
+	// DeletedAt layout format: time.RFC822 (02 Jan 06 15:04 MST)
+	DeletedAt string `json:"deletedAt" extensions:"x-order=3"`
+	// MergedIntoID is the ID of the group this one was merged into, empty when it was deleted
+	MergedIntoID string `json:"mergedIntoID,omitempty" extensions:"x-order=4"`
+}
+
+type DeletedProperty struct {
+	ID          string `json:"id" extensions:"x-order=0"`
+	GroupID     string `json:"groupID" extensions:"x-order=1"`
+	Name        string `json:"name" extensions:"x-order=2"`
+	Description string `json:"description" extensions:"x-order=3"`
+	Amount      uint16 `json:"amount" extensions:"x-order=4"`
+	// DeletedAt layout format: time.RFC822 (02 Jan 06 15:04 MST)
+	DeletedAt string `json:"deletedAt" extensions:"x-order=5"`
+}
+
+type DeletedShowSchedule struct {
+	ID      string `json:"id" extensions:"x-order=0"`
+	GroupID string `json:"groupID" extensions:"x-order=1"`
+	Place   string `json:"place" extensions:"x-order=2"`
+	// StartOn layout format: time.RFC822 (02 Jan 06 15:04 MST)
+	StartOn string `json:"startOn" extensions:"x-order=3"`
+	// FinishOn layout format: time.RFC822 (02 Jan 06 15:04 MST)
+	FinishOn string `json:"finishOn" extensions:"x-order=4"`
+	// DeletedAt layout format: time.RFC822 (02 Jan 06 15:04 MST)
+	DeletedAt string `json:"deletedAt" extensions:"x-order=5"`
+}
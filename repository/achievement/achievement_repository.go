@@ -0,0 +1,31 @@
+package achievement
+
+import (
+	"context"
+
+	"github.com/erikrios/reog-apps-apis/entity"
+)
+
+type AchievementRepository interface {
+	Insert(ctx context.Context, achievement entity.Achievement) (err error)
+	FindByGroupID(ctx context.Context, groupID string) (achievements []entity.Achievement, err error)
+	FindByID(ctx context.Context, groupID, id string) (achievement entity.Achievement, err error)
+	Update(ctx context.Context, groupID, id string, achievement entity.Achievement) (err error)
+	Delete(ctx context.Context, groupID, id string) (err error)
+	FindDistrictMedals(ctx context.Context, filter RankingFilter) (medals []DistrictMedals, err error)
+}
+
+// RankingFilter narrows down the achievements counted by FindDistrictMedals. Zero values count every achievement.
+type RankingFilter struct {
+	Level string
+	Year  uint16
+}
+
+// DistrictMedals counts the first, second and third places won by the groups of a district.
+type DistrictMedals struct {
+	DistrictID   string
+	DistrictName string
+	Gold         int
+	Silver       int
+	Bronze       int
+}
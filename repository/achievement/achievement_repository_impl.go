@@ -0,0 +1,142 @@
+package achievement
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"github.com/erikrios/reog-apps-apis/entity"
+	"github.com/erikrios/reog-apps-apis/repository"
+	"github.com/erikrios/reog-apps-apis/utils/logging"
+	"github.com/jackc/pgconn"
+	"gorm.io/gorm"
+)
+
+type achievementRepositoryImpl struct {
+	db     *gorm.DB
+	logger logging.Logging
+}
+
+func NewAchievementRepositoryImpl(db *gorm.DB, logger logging.Logging) *achievementRepositoryImpl {
+	return &achievementRepositoryImpl{db: db, logger: logger}
+}
+
+func (a *achievementRepositoryImpl) Insert(ctx context.Context, achievement entity.Achievement) (err error) {
+	if dbErr := a.db.WithContext(ctx).Create(&achievement).Error; dbErr != nil {
+		var pqErr *pgconn.PgError
+		if ok := errors.As(dbErr, &pqErr); ok && pqErr.Code == "23505" {
+			err = repository.ErrRecordAlreadyExists
+			return
+		}
+
+		go func(logger logging.Logging, message string) {
+			logger.Error(message)
+		}(a.logger, dbErr.Error())
+
+		log.Println(dbErr)
+		err = repository.ErrDatabase
+	}
+	return
+}
+
+func (a *achievementRepositoryImpl) FindByGroupID(ctx context.Context, groupID string) (achievements []entity.Achievement, err error) {
+	if dbErr := a.db.WithContext(ctx).
+		Preload("Certificate").
+		Where("group_id = ?", groupID).
+		Order("year DESC, event_name").
+		Find(&achievements).Error; dbErr != nil {
+		go func(logger logging.Logging, message string) {
+			logger.Error(message)
+		}(a.logger, dbErr.Error())
+
+		log.Println(dbErr)
+		err = repository.ErrDatabase
+	}
+	return
+}
+
+func (a *achievementRepositoryImpl) FindByID(ctx context.Context, groupID, id string) (achievement entity.Achievement, err error) {
+	if dbErr := a.db.WithContext(ctx).Preload("Certificate").First(&achievement, "id = ? AND group_id = ?", id, groupID).Error; dbErr != nil {
+		if errors.Is(dbErr, gorm.ErrRecordNotFound) {
+			err = repository.ErrRecordNotFound
+			return
+		}
+
+		go func(logger logging.Logging, message string) {
+			logger.Error(message)
+		}(a.logger, dbErr.Error())
+
+		log.Println(dbErr)
+		err = repository.ErrDatabase
+	}
+	return
+}
+
+func (a *achievementRepositoryImpl) Update(ctx context.Context, groupID, id string, achievement entity.Achievement) (err error) {
+	if result := a.db.WithContext(ctx).
+		Select("event_name", "year", "level", "rank").
+		Where("id = ? AND group_id = ?", id, groupID).
+		UpdateColumns(&achievement); result.Error != nil {
+		go func(logger logging.Logging, message string) {
+			logger.Error(message)
+		}(a.logger, result.Error.Error())
+
+		log.Println(result.Error)
+		err = repository.ErrDatabase
+	} else {
+		if result.RowsAffected < 1 {
+			err = repository.ErrRecordNotFound
+		}
+	}
+	return
+}
+
+func (a *achievementRepositoryImpl) Delete(ctx context.Context, groupID, id string) (err error) {
+	if result := a.db.WithContext(ctx).Delete(&entity.Achievement{}, "id = ? AND group_id = ?", id, groupID); result.Error != nil {
+		go func(logger logging.Logging, message string) {
+			logger.Error(message)
+		}(a.logger, result.Error.Error())
+
+		log.Println(result.Error)
+		err = repository.ErrDatabase
+	} else {
+		if result.RowsAffected < 1 {
+			err = repository.ErrRecordNotFound
+		}
+	}
+	return
+}
+
+// FindDistrictMedals ranks the districts by their gold, then silver, then bronze medals, as in a medal table.
+// Districts without any medal are left out.
+func (a *achievementRepositoryImpl) FindDistrictMedals(ctx context.Context, filter RankingFilter) (medals []DistrictMedals, err error) {
+	query := a.db.WithContext(ctx).
+		Model(&entity.Achievement{}).
+		Select(`addresses.district_id, addresses.district_name,
+			COUNT(*) FILTER (WHERE achievements.rank = 1) AS gold,
+			COUNT(*) FILTER (WHERE achievements.rank = 2) AS silver,
+			COUNT(*) FILTER (WHERE achievements.rank = 3) AS bronze`).
+		Joins("JOIN groups ON groups.id = achievements.group_id AND groups.deleted_at IS NULL").
+		Joins("JOIN addresses ON addresses.id = groups.id AND addresses.deleted_at IS NULL").
+		Where("achievements.rank BETWEEN 1 AND 3")
+
+	if filter.Level != "" {
+		query = query.Where("achievements.level = ?", filter.Level)
+	}
+	if filter.Year != 0 {
+		query = query.Where("achievements.year = ?", filter.Year)
+	}
+
+	if dbErr := query.
+		Group("addresses.district_id, addresses.district_name").
+		Order("gold DESC, silver DESC, bronze DESC, addresses.district_name").
+		Scan(&medals).Error; dbErr != nil {
+		go func(logger logging.Logging, message string) {
+			logger.Error(message)
+		}(a.logger, dbErr.Error())
+
+		log.Println(dbErr)
+		err = repository.ErrDatabase
+	}
+	return
+}
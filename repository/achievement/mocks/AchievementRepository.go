@@ -0,0 +1,125 @@
+// Code generated by mockery v2.10.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	entity "github.com/erikrios/reog-apps-apis/entity"
+	achievement "github.com/erikrios/reog-apps-apis/repository/achievement"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// AchievementRepository is an autogenerated mock type for the AchievementRepository type
+type AchievementRepository struct {
+	mock.Mock
+}
+
+// Delete provides a mock function with given fields: ctx, groupID, id
+func (_m *AchievementRepository) Delete(ctx context.Context, groupID string, id string) error {
+	ret := _m.Called(ctx, groupID, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, groupID, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FindByGroupID provides a mock function with given fields: ctx, groupID
+func (_m *AchievementRepository) FindByGroupID(ctx context.Context, groupID string) ([]entity.Achievement, error) {
+	ret := _m.Called(ctx, groupID)
+
+	var r0 []entity.Achievement
+	if rf, ok := ret.Get(0).(func(context.Context, string) []entity.Achievement); ok {
+		r0 = rf(ctx, groupID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Achievement)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, groupID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindByID provides a mock function with given fields: ctx, groupID, id
+func (_m *AchievementRepository) FindByID(ctx context.Context, groupID string, id string) (entity.Achievement, error) {
+	ret := _m.Called(ctx, groupID, id)
+
+	var r0 entity.Achievement
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) entity.Achievement); ok {
+		r0 = rf(ctx, groupID, id)
+	} else {
+		r0 = ret.Get(0).(entity.Achievement)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, groupID, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindDistrictMedals provides a mock function with given fields: ctx, filter
+func (_m *AchievementRepository) FindDistrictMedals(ctx context.Context, filter achievement.RankingFilter) ([]achievement.DistrictMedals, error) {
+	ret := _m.Called(ctx, filter)
+
+	var r0 []achievement.DistrictMedals
+	if rf, ok := ret.Get(0).(func(context.Context, achievement.RankingFilter) []achievement.DistrictMedals); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]achievement.DistrictMedals)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, achievement.RankingFilter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Insert provides a mock function with given fields: ctx, _a1
+func (_m *AchievementRepository) Insert(ctx context.Context, _a1 entity.Achievement) error {
+	ret := _m.Called(ctx, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.Achievement) error); ok {
+		r0 = rf(ctx, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Update provides a mock function with given fields: ctx, groupID, id, _a3
+func (_m *AchievementRepository) Update(ctx context.Context, groupID string, id string, _a3 entity.Achievement) error {
+	ret := _m.Called(ctx, groupID, id, _a3)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, entity.Achievement) error); ok {
+		r0 = rf(ctx, groupID, id, _a3)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
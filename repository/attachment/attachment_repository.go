@@ -0,0 +1,13 @@
+package attachment
+
+import (
+	"context"
+
+	"github.com/erikrios/reog-apps-apis/entity"
+)
+
+type AttachmentRepository interface {
+	Insert(ctx context.Context, attachment entity.Attachment) (err error)
+	FindByID(ctx context.Context, ownerType, ownerID, id string) (attachment entity.Attachment, err error)
+	Delete(ctx context.Context, ownerType, ownerID, id string) (err error)
+}
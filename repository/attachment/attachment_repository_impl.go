@@ -0,0 +1,73 @@
+package attachment
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"github.com/erikrios/reog-apps-apis/entity"
+	"github.com/erikrios/reog-apps-apis/repository"
+	"github.com/erikrios/reog-apps-apis/utils/logging"
+	"github.com/jackc/pgconn"
+	"gorm.io/gorm"
+)
+
+type attachmentRepositoryImpl struct {
+	db     *gorm.DB
+	logger logging.Logging
+}
+
+func NewAttachmentRepositoryImpl(db *gorm.DB, logger logging.Logging) *attachmentRepositoryImpl {
+	return &attachmentRepositoryImpl{db: db, logger: logger}
+}
+
+func (a *attachmentRepositoryImpl) Insert(ctx context.Context, attachment entity.Attachment) (err error) {
+	if dbErr := a.db.WithContext(ctx).Create(&attachment).Error; dbErr != nil {
+		var pqErr *pgconn.PgError
+		if ok := errors.As(dbErr, &pqErr); ok && pqErr.Code == "23505" {
+			err = repository.ErrRecordAlreadyExists
+			return
+		}
+
+		go func(logger logging.Logging, message string) {
+			logger.Error(message)
+		}(a.logger, dbErr.Error())
+
+		log.Println(dbErr)
+		err = repository.ErrDatabase
+	}
+	return
+}
+
+func (a *attachmentRepositoryImpl) FindByID(ctx context.Context, ownerType, ownerID, id string) (attachment entity.Attachment, err error) {
+	if dbErr := a.db.WithContext(ctx).First(&attachment, "id = ? AND owner_type = ? AND owner_id = ?", id, ownerType, ownerID).Error; dbErr != nil {
+		if errors.Is(dbErr, gorm.ErrRecordNotFound) {
+			err = repository.ErrRecordNotFound
+			return
+		}
+
+		go func(logger logging.Logging, message string) {
+			logger.Error(message)
+		}(a.logger, dbErr.Error())
+
+		log.Println(dbErr)
+		err = repository.ErrDatabase
+	}
+	return
+}
+
+func (a *attachmentRepositoryImpl) Delete(ctx context.Context, ownerType, ownerID, id string) (err error) {
+	if result := a.db.WithContext(ctx).Unscoped().Delete(&entity.Attachment{}, "id = ? AND owner_type = ? AND owner_id = ?", id, ownerType, ownerID); result.Error != nil {
+		go func(logger logging.Logging, message string) {
+			logger.Error(message)
+		}(a.logger, result.Error.Error())
+
+		log.Println(result.Error)
+		err = repository.ErrDatabase
+	} else {
+		if result.RowsAffected < 1 {
+			err = repository.ErrRecordNotFound
+		}
+	}
+	return
+}
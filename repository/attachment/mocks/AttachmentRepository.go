@@ -0,0 +1,64 @@
+// Code generated by mockery v2.10.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	entity "github.com/erikrios/reog-apps-apis/entity"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// AttachmentRepository is an autogenerated mock type for the AttachmentRepository type
+type AttachmentRepository struct {
+	mock.Mock
+}
+
+// Delete provides a mock function with given fields: ctx, ownerType, ownerID, id
+func (_m *AttachmentRepository) Delete(ctx context.Context, ownerType string, ownerID string, id string) error {
+	ret := _m.Called(ctx, ownerType, ownerID, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) error); ok {
+		r0 = rf(ctx, ownerType, ownerID, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FindByID provides a mock function with given fields: ctx, ownerType, ownerID, id
+func (_m *AttachmentRepository) FindByID(ctx context.Context, ownerType string, ownerID string, id string) (entity.Attachment, error) {
+	ret := _m.Called(ctx, ownerType, ownerID, id)
+
+	var r0 entity.Attachment
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) entity.Attachment); ok {
+		r0 = rf(ctx, ownerType, ownerID, id)
+	} else {
+		r0 = ret.Get(0).(entity.Attachment)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = rf(ctx, ownerType, ownerID, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Insert provides a mock function with given fields: ctx, _a1
+func (_m *AttachmentRepository) Insert(ctx context.Context, _a1 entity.Attachment) error {
+	ret := _m.Called(ctx, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.Attachment) error); ok {
+		r0 = rf(ctx, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
@@ -2,15 +2,86 @@ package group
 
 import (
 	"context"
+	"time"
 
 	"github.com/erikrios/reog-apps-apis/entity"
+	"github.com/erikrios/reog-apps-apis/utils/geo"
 )
 
 type GroupRepository interface {
-	Insert(ctx context.Context, group entity.Group) (err error)
-	InsertAll(ctx context.Context, groups []entity.Group) (err error)
-	FindAll(ctx context.Context) (groups []entity.Group, err error)
+	Insert(ctx context.Context, group entity.Group, registration Registration) (err error)
+	InsertAll(ctx context.Context, groups []entity.Group, registrations []Registration) (err error)
+	FindAll(ctx context.Context, filter Filter) (groups []entity.Group, total int64, err error)
 	FindByID(ctx context.Context, id string) (group entity.Group, err error)
-	Update(ctx context.Context, id string, group entity.Group) (err error)
-	Delete(ctx context.Context, id string) (err error)
+	FindUnregistered(ctx context.Context) (groups []entity.Group, err error)
+	NextRegistrationNumber(ctx context.Context, scope string) (number int, err error)
+	Update(ctx context.Context, id string, version int, group entity.Group) (err error)
+	UpdateContacts(ctx context.Context, id string, version int, contacts entity.GroupContacts) (err error)
+	UpdateStatus(ctx context.Context, version int, transition entity.GroupStatusTransition) (err error)
+	FindStatusTransitions(ctx context.Context, groupID string) (transitions []entity.GroupStatusTransition, err error)
+	UpdateLeader(ctx context.Context, version int, group entity.Group, change entity.LeadershipChange) (err error)
+	FindLeadershipChanges(ctx context.Context, groupID string) (changes []entity.LeadershipChange, err error)
+	FindDeletionImpact(ctx context.Context, id string, now time.Time) (impact DeletionImpact, err error)
+	Delete(ctx context.Context, id string, version int, options DeleteOptions) (err error)
+	Merge(ctx context.Context, sourceID string, sourceVersion int, targetID string, targetVersion int) (err error)
+}
+
+// Registration tells Insert how to give a group its registration number: the next running number of Scope, rendered
+// by Number.
+type Registration struct {
+	Scope  string
+	Number func(number int) string
+}
+
+// Filter narrows down and orders the groups returned by FindAll.
+// A zero Limit means that all of the matching groups are returned.
+type Filter struct {
+	DistrictID string
+	VillageID  string
+	Name       string
+	Status     string
+	// Within keeps only the groups located inside the bounds, when it is not nil
+	Within     *geo.Bounds
+	SortBy     SortKey
+	Descending bool
+	Offset     int
+	Limit      int
+}
+
+type SortKey string
+
+const (
+	SortByName          SortKey = "name"
+	SortByCreatedAt     SortKey = "created_at"
+	SortByPropertyCount SortKey = "property_count"
+)
+
+// ShowScheduleAction tells Delete what to do with the show schedules of the deleted group.
+type ShowScheduleAction string
+
+const (
+	// ShowScheduleCascade soft-deletes the show schedules along with the group
+	ShowScheduleCascade ShowScheduleAction = "cascade"
+	// ShowScheduleBlock refuses to delete the group when it has any of the show schedules
+	ShowScheduleBlock ShowScheduleAction = "block"
+	// ShowScheduleDetach keeps the show schedules, without a group
+	ShowScheduleDetach ShowScheduleAction = "detach"
+)
+
+// DeleteOptions tell Delete what to do with the show schedules of the group. The past show schedules are those
+// finished before Now, the future ones are still going on or yet to come.
+type DeleteOptions struct {
+	Now         time.Time
+	FutureShows ShowScheduleAction
+	PastShows   ShowScheduleAction
+}
+
+// DeletionImpact holds the IDs of the records belonging to a group, split by the way Delete handles them.
+type DeletionImpact struct {
+	AddressIDs            []string
+	PropertyIDs           []string
+	MemberIDs             []string
+	AchievementIDs        []string
+	FutureShowScheduleIDs []string
+	PastShowScheduleIDs   []string
 }
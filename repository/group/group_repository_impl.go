@@ -4,6 +4,8 @@ import (
 	"context"
 	"errors"
 	"log"
+	"strings"
+	"time"
 
 	"github.com/erikrios/reog-apps-apis/entity"
 	"github.com/erikrios/reog-apps-apis/repository"
@@ -21,8 +23,35 @@ func NewGroupRepositoryImpl(db *gorm.DB, logger logging.Logging) *groupRepositor
 	return &groupRepositoryImpl{db: db, logger: logger}
 }
 
-func (g *groupRepositoryImpl) Insert(ctx context.Context, group entity.Group) (err error) {
-	if dbErr := g.db.WithContext(ctx).Create(&group).Error; dbErr != nil {
+// Insert takes the registration number of the group within the transaction inserting it, so a failed insert gives
+// the number back.
+func (g *groupRepositoryImpl) Insert(ctx context.Context, group entity.Group, registration Registration) (err error) {
+	err = g.db.WithContext(ctx).Transaction(func(tx *gorm.DB) (err error) {
+		if group.RegistrationNumber, err = g.nextRegistrationNumber(tx, registration); err != nil {
+			return
+		}
+
+		return g.create(tx, &group)
+	})
+	return
+}
+
+// InsertAll inserts all of the groups, each numbered by the registration at the same index, or none of them.
+func (g *groupRepositoryImpl) InsertAll(ctx context.Context, groups []entity.Group, registrations []Registration) (err error) {
+	err = g.db.WithContext(ctx).Transaction(func(tx *gorm.DB) (err error) {
+		for i := range groups {
+			if groups[i].RegistrationNumber, err = g.nextRegistrationNumber(tx, registrations[i]); err != nil {
+				return
+			}
+		}
+
+		return g.create(tx, &groups)
+	})
+	return
+}
+
+func (g *groupRepositoryImpl) create(db *gorm.DB, value any) (err error) {
+	if dbErr := db.Create(value).Error; dbErr != nil {
 		var pqErr *pgconn.PgError
 		if ok := errors.As(dbErr, &pqErr); ok && pqErr.Code == "23505" {
 			err = repository.ErrRecordAlreadyExists
@@ -39,26 +68,27 @@ func (g *groupRepositoryImpl) Insert(ctx context.Context, group entity.Group) (e
 	return
 }
 
-func (g *groupRepositoryImpl) InsertAll(ctx context.Context, groups []entity.Group) (err error) {
-	if dbErr := g.db.WithContext(ctx).Create(&groups).Error; dbErr != nil {
-		var pqErr *pgconn.PgError
-		if ok := errors.As(dbErr, &pqErr); ok && pqErr.Code == "23505" {
-			err = repository.ErrRecordAlreadyExists
-			return
-		}
+// FindAll returns the groups matching the filter with the total of them. Only their address, properties and member
+// roles are loaded; the attachments and achievements are left to FindByID, as a list has no use for them.
+func (g *groupRepositoryImpl) FindAll(ctx context.Context, filter Filter) (groups []entity.Group, total int64, err error) {
+	query := g.db.WithContext(ctx).Model(&entity.Group{}).Scopes(filterScope(filter))
 
+	if dbErr := query.Count(&total).Error; dbErr != nil {
 		go func(logger logging.Logging, message string) {
 			logger.Error(message)
 		}(g.logger, dbErr.Error())
 
 		log.Println(dbErr)
 		err = repository.ErrDatabase
+		return
 	}
-	return
-}
 
-func (g *groupRepositoryImpl) FindAll(ctx context.Context) (groups []entity.Group, err error) {
-	if dbErr := g.db.WithContext(ctx).Preload("Address").Preload("Properties").Find(&groups).Error; dbErr != nil {
+	query = query.Scopes(sortScope(filter))
+	if filter.Limit > 0 {
+		query = query.Offset(filter.Offset).Limit(filter.Limit)
+	}
+
+	if dbErr := query.Preload("Address").Preload("Properties").Preload("Members", selectMemberRoles).Find(&groups).Error; dbErr != nil {
 		go func(logger logging.Logging, message string) {
 			logger.Error(message)
 		}(g.logger, dbErr.Error())
@@ -71,7 +101,7 @@ func (g *groupRepositoryImpl) FindAll(ctx context.Context) (groups []entity.Grou
 }
 
 func (g *groupRepositoryImpl) FindByID(ctx context.Context, id string) (group entity.Group, err error) {
-	if dbErr := g.db.WithContext(ctx).Preload("Address").Preload("Properties").First(&group, "id = ?", id).Error; dbErr != nil {
+	if dbErr := g.db.WithContext(ctx).Preload("Address").Preload("Properties").Preload("Properties.Attachments").Preload("Attachments").Preload("Achievements", orderAchievements).Preload("Achievements.Certificate").Preload("Members", selectMemberRoles).First(&group, "id = ?", id).Error; dbErr != nil {
 		if errors.Is(dbErr, gorm.ErrRecordNotFound) {
 			err = repository.ErrRecordNotFound
 			return
@@ -86,8 +116,63 @@ func (g *groupRepositoryImpl) FindByID(ctx context.Context, id string) (group en
 	return
 }
 
-func (g *groupRepositoryImpl) Update(ctx context.Context, id string, group entity.Group) (err error) {
-	if result := g.db.WithContext(ctx).Where("id = ?", id).UpdateColumns(&group); result.Error != nil {
+func (g *groupRepositoryImpl) FindUnregistered(ctx context.Context) (groups []entity.Group, err error) {
+	if dbErr := g.db.WithContext(ctx).
+		Preload("Address").
+		Where("registration_number IS NULL OR registration_number = ''").
+		Order("created_at, id").
+		Find(&groups).Error; dbErr != nil {
+		go func(logger logging.Logging, message string) {
+			logger.Error(message)
+		}(g.logger, dbErr.Error())
+
+		log.Println(dbErr)
+		err = repository.ErrDatabase
+	}
+	return
+}
+
+// NextRegistrationNumber increments the running number of the scope in a single statement, so concurrent
+// registrations never receive the same number. Numbers of registrations that fail afterwards are not reused.
+func (g *groupRepositoryImpl) NextRegistrationNumber(ctx context.Context, scope string) (number int, err error) {
+	if dbErr := g.db.WithContext(ctx).Raw(nextRegistrationNumberQuery, scope).Scan(&number).Error; dbErr != nil {
+		go func(logger logging.Logging, message string) {
+			logger.Error(message)
+		}(g.logger, dbErr.Error())
+
+		log.Println(dbErr)
+		err = repository.ErrDatabase
+	}
+	return
+}
+
+// nextRegistrationNumber renders the next running number of the registration scope, within tx. The counter stays
+// locked until tx ends, and is rolled back with it.
+func (g *groupRepositoryImpl) nextRegistrationNumber(tx *gorm.DB, registration Registration) (registrationNumber string, err error) {
+	var number int
+	if dbErr := tx.Raw(nextRegistrationNumberQuery, registration.Scope).Scan(&number).Error; dbErr != nil {
+		go func(logger logging.Logging, message string) {
+			logger.Error(message)
+		}(g.logger, dbErr.Error())
+
+		log.Println(dbErr)
+		err = repository.ErrDatabase
+		return
+	}
+
+	registrationNumber = registration.Number(number)
+	return
+}
+
+const nextRegistrationNumberQuery = `INSERT INTO registration_counters (scope, last_number) VALUES (?, 1)
+		ON CONFLICT (scope) DO UPDATE SET last_number = registration_counters.last_number + 1
+		RETURNING last_number`
+
+// Update only updates the group while it still has the given version, and increments its version.
+func (g *groupRepositoryImpl) Update(ctx context.Context, id string, version int, group entity.Group) (err error) {
+	group.Version = version + 1
+
+	if result := g.db.WithContext(ctx).Where("id = ? AND version = ?", id, version).UpdateColumns(&group); result.Error != nil {
 		go func(logger logging.Logging, message string) {
 			logger.Error(message)
 		}(g.logger, result.Error.Error())
@@ -96,17 +181,194 @@ func (g *groupRepositoryImpl) Update(ctx context.Context, id string, group entit
 		err = repository.ErrDatabase
 	} else {
 		if result.RowsAffected < 1 {
-			err = repository.ErrRecordNotFound
+			err = g.notFoundOrModified(g.db.WithContext(ctx), id)
+		}
+	}
+	return
+}
+
+// UpdateContacts replaces all of the contacts of the group, including the empty ones, if it still has the given
+// version.
+func (g *groupRepositoryImpl) UpdateContacts(ctx context.Context, id string, version int, contacts entity.GroupContacts) (err error) {
+	if result := g.db.WithContext(ctx).
+		Model(&entity.Group{}).
+		Where("id = ? AND version = ?", id, version).
+		Updates(map[string]any{
+			"contact_phone":     contacts.Phone,
+			"contact_whatsapp":  contacts.WhatsApp,
+			"contact_email":     contacts.Email,
+			"contact_website":   contacts.Website,
+			"contact_instagram": contacts.Instagram,
+			"contact_facebook":  contacts.Facebook,
+			"contact_youtube":   contacts.YouTube,
+			"contact_tiktok":    contacts.TikTok,
+			"version":           gorm.Expr("version + 1"),
+		}); result.Error != nil {
+		go func(logger logging.Logging, message string) {
+			logger.Error(message)
+		}(g.logger, result.Error.Error())
+
+		log.Println(result.Error)
+		err = repository.ErrDatabase
+	} else {
+		if result.RowsAffected < 1 {
+			err = g.notFoundOrModified(g.db.WithContext(ctx), id)
+		}
+	}
+	return
+}
+
+// UpdateStatus changes the status of the group and records the transition in the same transaction. The status is
+// only changed while the group still has the given version and the transition's FromStatus, so concurrent changes
+// cannot go unrecorded.
+func (g *groupRepositoryImpl) UpdateStatus(ctx context.Context, version int, transition entity.GroupStatusTransition) (err error) {
+	err = g.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if result := tx.WithContext(ctx).
+			Model(&entity.Group{}).
+			Where("id = ? AND status = ? AND version = ?", transition.GroupID, transition.FromStatus, version).
+			Updates(map[string]any{"status": transition.ToStatus, "version": gorm.Expr("version + 1")}); result.Error == nil {
+			if result.RowsAffected < 1 {
+				return g.notFoundOrModified(tx.WithContext(ctx), transition.GroupID)
+			}
+		} else {
+			go func(logger logging.Logging, message string) {
+				logger.Error(message)
+			}(g.logger, result.Error.Error())
+
+			log.Println(result.Error)
+			return repository.ErrDatabase
+		}
+
+		if dbErr := tx.WithContext(ctx).Create(&transition).Error; dbErr != nil {
+			go func(logger logging.Logging, message string) {
+				logger.Error(message)
+			}(g.logger, dbErr.Error())
+
+			log.Println(dbErr)
+			return repository.ErrDatabase
+		}
+
+		return nil
+	})
+
+	return
+}
+
+func (g *groupRepositoryImpl) FindStatusTransitions(ctx context.Context, groupID string) (transitions []entity.GroupStatusTransition, err error) {
+	if dbErr := g.db.WithContext(ctx).Where("group_id = ?", groupID).Order("created_at, id").Find(&transitions).Error; dbErr != nil {
+		go func(logger logging.Logging, message string) {
+			logger.Error(message)
+		}(g.logger, dbErr.Error())
+
+		log.Println(dbErr)
+		err = repository.ErrDatabase
+	}
+	return
+}
+
+// UpdateLeader updates the group like Update does and records the leadership change in the same transaction. The
+// group is only updated while it is still led by the change's PreviousLeader, so concurrent changes cannot go
+// unrecorded.
+func (g *groupRepositoryImpl) UpdateLeader(ctx context.Context, version int, group entity.Group, change entity.LeadershipChange) (err error) {
+	group.Version = version + 1
+
+	err = g.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if result := tx.WithContext(ctx).
+			Where("id = ? AND leader = ? AND version = ?", change.GroupID, change.PreviousLeader, version).
+			UpdateColumns(&group); result.Error == nil {
+			if result.RowsAffected < 1 {
+				return g.notFoundOrModified(tx.WithContext(ctx), change.GroupID)
+			}
+		} else {
+			go func(logger logging.Logging, message string) {
+				logger.Error(message)
+			}(g.logger, result.Error.Error())
+
+			log.Println(result.Error)
+			return repository.ErrDatabase
+		}
+
+		if dbErr := tx.WithContext(ctx).Create(&change).Error; dbErr != nil {
+			go func(logger logging.Logging, message string) {
+				logger.Error(message)
+			}(g.logger, dbErr.Error())
+
+			log.Println(dbErr)
+			return repository.ErrDatabase
+		}
+
+		return nil
+	})
+
+	return
+}
+
+func (g *groupRepositoryImpl) FindLeadershipChanges(ctx context.Context, groupID string) (changes []entity.LeadershipChange, err error) {
+	if dbErr := g.db.WithContext(ctx).Where("group_id = ?", groupID).Order("effective_on, created_at, id").Find(&changes).Error; dbErr != nil {
+		go func(logger logging.Logging, message string) {
+			logger.Error(message)
+		}(g.logger, dbErr.Error())
+
+		log.Println(dbErr)
+		err = repository.ErrDatabase
+	}
+	return
+}
+
+// FindDeletionImpact finds the records that Delete soft-deletes or detaches along with the group.
+func (g *groupRepositoryImpl) FindDeletionImpact(ctx context.Context, id string, now time.Time) (impact DeletionImpact, err error) {
+	var count int64
+	if dbErr := g.db.WithContext(ctx).Model(&entity.Group{}).Where("id = ?", id).Count(&count).Error; dbErr != nil {
+		go func(logger logging.Logging, message string) {
+			logger.Error(message)
+		}(g.logger, dbErr.Error())
+
+		log.Println(dbErr)
+		err = repository.ErrDatabase
+		return
+	}
+
+	if count < 1 {
+		err = repository.ErrRecordNotFound
+		return
+	}
+
+	children := []struct {
+		model any
+		query string
+		args  []any
+		ids   *[]string
+	}{
+		{&entity.Address{}, "id = ?", []any{id}, &impact.AddressIDs},
+		{&entity.Property{}, "group_id = ?", []any{id}, &impact.PropertyIDs},
+		{&entity.Member{}, "group_id = ?", []any{id}, &impact.MemberIDs},
+		{&entity.Achievement{}, "group_id = ?", []any{id}, &impact.AchievementIDs},
+		{&entity.ShowSchedule{}, "group_id = ? AND finish_on >= ?", []any{id, now}, &impact.FutureShowScheduleIDs},
+		{&entity.ShowSchedule{}, "group_id = ? AND finish_on < ?", []any{id, now}, &impact.PastShowScheduleIDs},
+	}
+
+	for _, child := range children {
+		if dbErr := g.db.WithContext(ctx).Model(child.model).Where(child.query, child.args...).Order("id").Pluck("id", child.ids).Error; dbErr != nil {
+			go func(logger logging.Logging, message string) {
+				logger.Error(message)
+			}(g.logger, dbErr.Error())
+
+			log.Println(dbErr)
+			err = repository.ErrDatabase
+			return
 		}
 	}
 	return
 }
 
-func (g *groupRepositoryImpl) Delete(ctx context.Context, id string) (err error) {
+// Delete soft-deletes the group with its address, properties, members and achievements, and handles its show
+// schedules as told by the options. The group is only deleted while it still has the given version. When a show
+// schedule blocks the deletion, nothing is deleted and ErrRecordReferenced is returned.
+func (g *groupRepositoryImpl) Delete(ctx context.Context, id string, version int, options DeleteOptions) (err error) {
 	err = g.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
-		if result := tx.WithContext(ctx).Delete(&entity.Group{}, "id = ?", id); result.Error == nil {
+		if result := tx.WithContext(ctx).Delete(&entity.Group{}, "id = ? AND version = ?", id, version); result.Error == nil {
 			if result.RowsAffected < 1 {
-				return repository.ErrRecordNotFound
+				return g.notFoundOrModified(tx.WithContext(ctx), id)
 			}
 		} else {
 			go func(logger logging.Logging, message string) {
@@ -135,8 +397,244 @@ func (g *groupRepositoryImpl) Delete(ctx context.Context, id string) (err error)
 			return repository.ErrDatabase
 		}
 
+		if dbErr := tx.WithContext(ctx).Delete(&entity.Member{}, "group_id = ?", id).Error; dbErr != nil {
+			go func(logger logging.Logging, message string) {
+				logger.Error(message)
+			}(g.logger, dbErr.Error())
+
+			log.Println(dbErr)
+			return repository.ErrDatabase
+		}
+
+		if dbErr := tx.WithContext(ctx).Delete(&entity.Achievement{}, "group_id = ?", id).Error; dbErr != nil {
+			go func(logger logging.Logging, message string) {
+				logger.Error(message)
+			}(g.logger, dbErr.Error())
+
+			log.Println(dbErr)
+			return repository.ErrDatabase
+		}
+
+		showSchedules := []struct {
+			query  string
+			action ShowScheduleAction
+		}{
+			{"group_id = ? AND finish_on >= ?", options.FutureShows},
+			{"group_id = ? AND finish_on < ?", options.PastShows},
+		}
+
+		for _, showSchedule := range showSchedules {
+			query := tx.WithContext(ctx).Model(&entity.ShowSchedule{}).Where(showSchedule.query, id, options.Now)
+
+			var dbErr error
+			switch showSchedule.action {
+			case ShowScheduleBlock:
+				var count int64
+				if dbErr = query.Count(&count).Error; dbErr == nil && count > 0 {
+					return repository.ErrRecordReferenced
+				}
+			case ShowScheduleDetach:
+				dbErr = query.Update("group_id", nil).Error
+			default:
+				dbErr = query.Delete(&entity.ShowSchedule{}).Error
+			}
+
+			if dbErr != nil {
+				go func(logger logging.Logging, message string) {
+					logger.Error(message)
+				}(g.logger, dbErr.Error())
+
+				log.Println(dbErr)
+				return repository.ErrDatabase
+			}
+		}
+
 		return nil
 	})
 
 	return
 }
+
+// notFoundOrModified tells why a write guarded by the version of the group affected no row: either the group does
+// not exist, or it has been modified since the version was read.
+func (g *groupRepositoryImpl) notFoundOrModified(db *gorm.DB, id string) (err error) {
+	var count int64
+	if dbErr := db.Model(&entity.Group{}).Where("id = ?", id).Count(&count).Error; dbErr != nil {
+		go func(logger logging.Logging, message string) {
+			logger.Error(message)
+		}(g.logger, dbErr.Error())
+
+		log.Println(dbErr)
+		err = repository.ErrDatabase
+		return
+	}
+
+	if count < 1 {
+		err = repository.ErrRecordNotFound
+	} else {
+		err = repository.ErrRecordModified
+	}
+	return
+}
+
+// selectMemberRoles loads only the columns needed to count the members of a group per role.
+func selectMemberRoles(db *gorm.DB) *gorm.DB {
+	return db.Select("id", "group_id", "role")
+}
+
+// orderAchievements lists the achievements of a group from the most recent one.
+func orderAchievements(db *gorm.DB) *gorm.DB {
+	return db.Order("year DESC, event_name")
+}
+
+// Merge moves the properties, show schedules, members, achievements and attachments of the source group to the
+// target group, then soft-deletes the source group and its address, leaving MergedIntoID pointing to the target.
+// Both groups must still have the given versions, and the version of the target is bumped.
+//
+// The address is deliberately not moved: a group has a single address sharing its ID, so the target keeps its own
+// and only takes the location of the source address when it has none. The source address stays with the soft-deleted
+// source group, so that it comes back along with it.
+func (g *groupRepositoryImpl) Merge(ctx context.Context, sourceID string, sourceVersion int, targetID string, targetVersion int) (err error) {
+	err = g.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		guards := []struct {
+			id      string
+			version int
+			updates map[string]any
+		}{
+			{sourceID, sourceVersion, map[string]any{"merged_into_id": targetID, "version": gorm.Expr("version + 1")}},
+			{targetID, targetVersion, map[string]any{"version": gorm.Expr("version + 1")}},
+		}
+
+		for _, guard := range guards {
+			if result := tx.WithContext(ctx).
+				Model(&entity.Group{}).
+				Where("id = ? AND version = ?", guard.id, guard.version).
+				Updates(guard.updates); result.Error == nil {
+				if result.RowsAffected < 1 {
+					return g.notFoundOrModified(tx.WithContext(ctx), guard.id)
+				}
+			} else {
+				go func(logger logging.Logging, message string) {
+					logger.Error(message)
+				}(g.logger, result.Error.Error())
+
+				log.Println(result.Error)
+				return repository.ErrDatabase
+			}
+		}
+
+		moves := []struct {
+			model  any
+			query  string
+			args   []any
+			column string
+		}{
+			{&entity.Property{}, "group_id = ?", []any{sourceID}, "group_id"},
+			{&entity.ShowSchedule{}, "group_id = ?", []any{sourceID}, "group_id"},
+			{&entity.Member{}, "group_id = ?", []any{sourceID}, "group_id"},
+			{&entity.Achievement{}, "group_id = ?", []any{sourceID}, "group_id"},
+			{&entity.Attachment{}, "owner_type = ? AND owner_id = ?", []any{entity.AttachmentOwnerGroup, sourceID}, "owner_id"},
+		}
+
+		for _, move := range moves {
+			if dbErr := tx.WithContext(ctx).Model(move.model).Where(move.query, move.args...).Update(move.column, targetID).Error; dbErr != nil {
+				go func(logger logging.Logging, message string) {
+					logger.Error(message)
+				}(g.logger, dbErr.Error())
+
+				log.Println(dbErr)
+				return repository.ErrDatabase
+			}
+		}
+
+		if dbErr := tx.WithContext(ctx).Exec(
+			`UPDATE addresses SET latitude = source.latitude, longitude = source.longitude
+			FROM addresses AS source
+			WHERE addresses.id = ? AND addresses.latitude IS NULL AND source.id = ? AND source.latitude IS NOT NULL`,
+			targetID, sourceID,
+		).Error; dbErr != nil {
+			go func(logger logging.Logging, message string) {
+				logger.Error(message)
+			}(g.logger, dbErr.Error())
+
+			log.Println(dbErr)
+			return repository.ErrDatabase
+		}
+
+		if dbErr := tx.WithContext(ctx).Delete(&entity.Address{}, "id = ?", sourceID).Error; dbErr != nil {
+			go func(logger logging.Logging, message string) {
+				logger.Error(message)
+			}(g.logger, dbErr.Error())
+
+			log.Println(dbErr)
+			return repository.ErrDatabase
+		}
+
+		if dbErr := tx.WithContext(ctx).Delete(&entity.Group{}, "id = ?", sourceID).Error; dbErr != nil {
+			go func(logger logging.Logging, message string) {
+				logger.Error(message)
+			}(g.logger, dbErr.Error())
+
+			log.Println(dbErr)
+			return repository.ErrDatabase
+		}
+
+		return nil
+	})
+
+	return
+}
+
+func filterScope(filter Filter) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if filter.DistrictID != "" || filter.VillageID != "" || filter.Within != nil {
+			db = db.Joins("JOIN addresses ON addresses.id = groups.id AND addresses.deleted_at IS NULL")
+		}
+		if filter.DistrictID != "" {
+			db = db.Where("addresses.district_id = ?", filter.DistrictID)
+		}
+		if filter.VillageID != "" {
+			db = db.Where("addresses.village_id = ?", filter.VillageID)
+		}
+		if filter.Within != nil {
+			db = db.Where(
+				"addresses.latitude BETWEEN ? AND ? AND addresses.longitude BETWEEN ? AND ?",
+				filter.Within.Min.Latitude, filter.Within.Max.Latitude, filter.Within.Min.Longitude, filter.Within.Max.Longitude,
+			)
+		}
+		if filter.Name != "" {
+			db = db.Where("groups.name ILIKE ?", "%"+escapeLike(filter.Name)+"%")
+		}
+		if filter.Status != "" {
+			db = db.Where("groups.status = ?", filter.Status)
+		}
+		return db
+	}
+}
+
+func sortScope(filter Filter) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		var column string
+		switch filter.SortBy {
+		case SortByName:
+			column = "groups.name"
+		case SortByCreatedAt:
+			column = "groups.created_at"
+		case SortByPropertyCount:
+			column = "(SELECT COUNT(*) FROM properties WHERE properties.group_id = groups.id AND properties.deleted_at IS NULL)"
+		default:
+			column = "groups.created_at"
+		}
+
+		direction := "ASC"
+		if filter.Descending {
+			direction = "DESC"
+		}
+
+		return db.Order(column + " " + direction).Order("groups.id ASC")
+	}
+}
+
+func escapeLike(value string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(value)
+}
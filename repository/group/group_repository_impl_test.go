@@ -2,11 +2,15 @@ package group
 
 import (
 	"context"
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/erikrios/reog-apps-apis/entity"
 	"github.com/erikrios/reog-apps-apis/repository"
+	"github.com/erikrios/reog-apps-apis/utils/geo"
+	"github.com/jackc/pgconn"
 	"github.com/stretchr/testify/assert"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
@@ -33,19 +37,39 @@ func TestInsert(t *testing.T) {
 	mockDB, err := gorm.Open(dialector, &gorm.Config{})
 	var repo GroupRepository = NewGroupRepositoryImpl(mockDB, &mockLog{})
 
+	registration := Registration{
+		Scope: "3502/3502030/{number}/2022",
+		Number: func(number int) string {
+			return fmt.Sprintf("3502/3502030/%04d/2022", number)
+		},
+	}
+
 	testCases := []struct {
 		name          string
-		inputGroup    entity.Group
 		expectedError error
 		mockBehaviour func()
 	}{
 		{
 			name:          "it should return nil error, when successfully insert the data to database",
-			inputGroup:    entity.Group{},
 			expectedError: nil,
 			mockBehaviour: func() {
 				mock.ExpectBegin()
-				mock.ExpectExec(".*").WithArgs(
+				mock.ExpectQuery("INSERT INTO registration_counters").
+					WithArgs("3502/3502030/{number}/2022").
+					WillReturnRows(sqlmock.NewRows([]string{"last_number"}).AddRow(8))
+				mock.ExpectExec("INSERT INTO \"groups\"").WithArgs(
+					sqlmock.AnyArg(),
+					sqlmock.AnyArg(),
+					sqlmock.AnyArg(),
+					"3502/3502030/0008/2022",
+					sqlmock.AnyArg(),
+					sqlmock.AnyArg(),
+					sqlmock.AnyArg(),
+					sqlmock.AnyArg(),
+					sqlmock.AnyArg(),
+					sqlmock.AnyArg(),
+					sqlmock.AnyArg(),
+					sqlmock.AnyArg(),
 					sqlmock.AnyArg(),
 					sqlmock.AnyArg(),
 					sqlmock.AnyArg(),
@@ -57,12 +81,26 @@ func TestInsert(t *testing.T) {
 			},
 		},
 		{
-			name:          "it should return ErrDatabase, when database return an error",
-			inputGroup:    entity.Group{},
+			name:          "it should return ErrDatabase and roll the running number back, when database fails to insert the group",
 			expectedError: repository.ErrDatabase,
 			mockBehaviour: func() {
 				mock.ExpectBegin()
-				mock.ExpectExec(".*").WithArgs(
+				mock.ExpectQuery("INSERT INTO registration_counters").
+					WithArgs("3502/3502030/{number}/2022").
+					WillReturnRows(sqlmock.NewRows([]string{"last_number"}).AddRow(8))
+				mock.ExpectExec("INSERT INTO \"groups\"").WithArgs(
+					sqlmock.AnyArg(),
+					sqlmock.AnyArg(),
+					sqlmock.AnyArg(),
+					"3502/3502030/0008/2022",
+					sqlmock.AnyArg(),
+					sqlmock.AnyArg(),
+					sqlmock.AnyArg(),
+					sqlmock.AnyArg(),
+					sqlmock.AnyArg(),
+					sqlmock.AnyArg(),
+					sqlmock.AnyArg(),
+					sqlmock.AnyArg(),
 					sqlmock.AnyArg(),
 					sqlmock.AnyArg(),
 					sqlmock.AnyArg(),
@@ -70,30 +108,37 @@ func TestInsert(t *testing.T) {
 					sqlmock.AnyArg(),
 					sqlmock.AnyArg(),
 				).WillReturnError(gorm.ErrInvalidDB)
+				mock.ExpectRollback()
+			},
+		},
+		{
+			name:          "it should return ErrRecordAlreadyExists and roll the running number back, when generated id is already exists in the database",
+			expectedError: repository.ErrRecordAlreadyExists,
+			mockBehaviour: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery("INSERT INTO registration_counters").
+					WithArgs("3502/3502030/{number}/2022").
+					WillReturnRows(sqlmock.NewRows([]string{"last_number"}).AddRow(8))
+				mock.ExpectExec("INSERT INTO \"groups\"").WillReturnError(&pgconn.PgError{Code: "23505"})
+				mock.ExpectRollback()
+			},
+		},
+		{
+			name:          "it should return ErrDatabase, when database fails to increment the running number",
+			expectedError: repository.ErrDatabase,
+			mockBehaviour: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery("INSERT INTO registration_counters").WillReturnError(gorm.ErrInvalidDB)
+				mock.ExpectRollback()
 			},
 		},
-		// {
-		// 	name:          "it should return ErrRecordAlreadyExists, when generated id is already exists in the database",
-		// 	expectedError: repository.ErrRecordAlreadyExists,
-		// 	mockBehaviour: func() {
-		// 		mock.ExpectBegin()
-		// 		mock.ExpectExec(".*").WithArgs(
-		// 			sqlmock.AnyArg(),
-		// 			sqlmock.AnyArg(),
-		// 			sqlmock.AnyArg(),
-		// 			sqlmock.AnyArg(),
-		// 			sqlmock.AnyArg(),
-		// 			sqlmock.AnyArg(),
-		// 		).WillReturnError(&pgconn.PgError{Code: "23505"})
-		// 	},
-		// },
 	}
 
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
 			testCase.mockBehaviour()
 
-			gotError := repo.Insert(context.Background(), testCase.inputGroup)
+			gotError := repo.Insert(context.Background(), entity.Group{}, registration)
 
 			if err := mock.ExpectationsWereMet(); err != nil {
 				t.Fatal(err)
@@ -125,36 +170,70 @@ func TestInsertAll(t *testing.T) {
 	mockDB, err := gorm.Open(dialector, &gorm.Config{})
 	var repo GroupRepository = NewGroupRepositoryImpl(mockDB, &mockLog{})
 
+	registration := Registration{
+		Scope: "3502/3502030/{number}/2022",
+		Number: func(number int) string {
+			return fmt.Sprintf("3502/3502030/%04d/2022", number)
+		},
+	}
+
 	testCases := []struct {
 		name          string
-		inputGroups   []entity.Group
 		expectedError error
 		mockBehaviour func()
 	}{
 		{
 			name:          "it should return nil error, when successfully insert the data to database",
-			inputGroups:   []entity.Group{{}},
 			expectedError: nil,
 			mockBehaviour: func() {
 				mock.ExpectBegin()
-				mock.ExpectExec(".*").WithArgs(
+				mock.ExpectQuery("INSERT INTO registration_counters").
+					WithArgs("3502/3502030/{number}/2022").
+					WillReturnRows(sqlmock.NewRows([]string{"last_number"}).AddRow(8))
+				mock.ExpectExec("INSERT INTO \"groups\"").WithArgs(
+					sqlmock.AnyArg(),
+					sqlmock.AnyArg(),
+					sqlmock.AnyArg(),
+					"3502/3502030/0008/2022",
+					sqlmock.AnyArg(),
+					sqlmock.AnyArg(),
+					sqlmock.AnyArg(),
+					sqlmock.AnyArg(),
+					sqlmock.AnyArg(),
 					sqlmock.AnyArg(),
 					sqlmock.AnyArg(),
 					sqlmock.AnyArg(),
 					sqlmock.AnyArg(),
 					sqlmock.AnyArg(),
 					sqlmock.AnyArg(),
-				).WillReturnResult(sqlmock.NewResult(1, 0))
+					sqlmock.AnyArg(),
+					sqlmock.AnyArg(),
+					sqlmock.AnyArg(),
+				).WillReturnResult(sqlmock.NewResult(1, 1))
 				mock.ExpectCommit()
 			},
 		},
 		{
-			name:          "it should return ErrDatabase, when database return an error",
-			inputGroups:   []entity.Group{{}},
+			name:          "it should return ErrDatabase and roll the running number back, when database fails to insert the group",
 			expectedError: repository.ErrDatabase,
 			mockBehaviour: func() {
 				mock.ExpectBegin()
-				mock.ExpectExec(".*").WithArgs(
+				mock.ExpectQuery("INSERT INTO registration_counters").
+					WithArgs("3502/3502030/{number}/2022").
+					WillReturnRows(sqlmock.NewRows([]string{"last_number"}).AddRow(8))
+				mock.ExpectExec("INSERT INTO \"groups\"").WithArgs(
+					sqlmock.AnyArg(),
+					sqlmock.AnyArg(),
+					sqlmock.AnyArg(),
+					"3502/3502030/0008/2022",
+					sqlmock.AnyArg(),
+					sqlmock.AnyArg(),
+					sqlmock.AnyArg(),
+					sqlmock.AnyArg(),
+					sqlmock.AnyArg(),
+					sqlmock.AnyArg(),
+					sqlmock.AnyArg(),
+					sqlmock.AnyArg(),
 					sqlmock.AnyArg(),
 					sqlmock.AnyArg(),
 					sqlmock.AnyArg(),
@@ -162,6 +241,28 @@ func TestInsertAll(t *testing.T) {
 					sqlmock.AnyArg(),
 					sqlmock.AnyArg(),
 				).WillReturnError(gorm.ErrInvalidDB)
+				mock.ExpectRollback()
+			},
+		},
+		{
+			name:          "it should return ErrRecordAlreadyExists and roll the running number back, when generated id is already exists in the database",
+			expectedError: repository.ErrRecordAlreadyExists,
+			mockBehaviour: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery("INSERT INTO registration_counters").
+					WithArgs("3502/3502030/{number}/2022").
+					WillReturnRows(sqlmock.NewRows([]string{"last_number"}).AddRow(8))
+				mock.ExpectExec("INSERT INTO \"groups\"").WillReturnError(&pgconn.PgError{Code: "23505"})
+				mock.ExpectRollback()
+			},
+		},
+		{
+			name:          "it should return ErrDatabase, when database fails to increment the running number",
+			expectedError: repository.ErrDatabase,
+			mockBehaviour: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery("INSERT INTO registration_counters").WillReturnError(gorm.ErrInvalidDB)
+				mock.ExpectRollback()
 			},
 		},
 	}
@@ -170,7 +271,7 @@ func TestInsertAll(t *testing.T) {
 		t.Run(testCase.name, func(t *testing.T) {
 			testCase.mockBehaviour()
 
-			gotError := repo.InsertAll(context.Background(), testCase.inputGroups)
+			gotError := repo.InsertAll(context.Background(), []entity.Group{{}}, []Registration{registration})
 
 			if err := mock.ExpectationsWereMet(); err != nil {
 				t.Fatal(err)
@@ -204,7 +305,9 @@ func TestFindAll(t *testing.T) {
 
 	testCases := []struct {
 		name           string
+		inputFilter    Filter
 		expectedGroups []entity.Group
+		expectedTotal  int64
 		expectedError  error
 		mockBehaviour  func()
 	}{
@@ -217,8 +320,10 @@ func TestFindAll(t *testing.T) {
 					Leader: "Erik",
 				},
 			},
+			expectedTotal: 1,
 			expectedError: nil,
 			mockBehaviour: func() {
+				mock.ExpectQuery("SELECT count").WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
 				returnedRows := sqlmock.NewRows([]string{"id", "name", "leader", "created_at", "updated_at", "deleted_at"})
 				returnedRows.AddRow(
 					"g-xyz",
@@ -229,12 +334,54 @@ func TestFindAll(t *testing.T) {
 					nil,
 				)
 				mock.ExpectQuery(".*").WillReturnRows(returnedRows)
-				mock.ExpectQuery(".*").
+				mock.ExpectQuery("SELECT \\* FROM \"addresses\"").
 					WillReturnRows(sqlmock.NewRows([]string{"id", "address", "village_id", "villlage_name", "district_id", "district_name", "regency_id", "regency_name, province_id", "province_name", "created_at", "updated_at", "deleted_at"}))
-				mock.ExpectQuery(".*").
+				mock.ExpectQuery("SELECT \"id\",\"group_id\",\"role\" FROM \"members\"").
+					WillReturnRows(sqlmock.NewRows([]string{"id", "group_id", "role"}))
+				mock.ExpectQuery("SELECT \\* FROM \"properties\"").
 					WillReturnRows(sqlmock.NewRows([]string{"id", "name", "description", "amount", "group_id", "created_at", "updated_at", "deleted_at"}))
 			},
 		},
+		{
+			name: "it should return valid groups, when filtered by district and sorted by property count",
+			inputFilter: Filter{
+				DistrictID: "3502030",
+				Name:       "Reog",
+				SortBy:     SortByPropertyCount,
+				Descending: true,
+				Offset:     10,
+				Limit:      10,
+			},
+			expectedGroups: []entity.Group{},
+			expectedTotal:  0,
+			expectedError:  nil,
+			mockBehaviour: func() {
+				mock.ExpectQuery("SELECT count.*JOIN addresses.*addresses.district_id = .*groups.name ILIKE").
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+				mock.ExpectQuery("ORDER BY \\(SELECT COUNT\\(\\*\\) FROM properties.*DESC.*LIMIT 10 OFFSET 10").
+					WillReturnRows(sqlmock.NewRows([]string{"id", "name", "leader", "created_at", "updated_at", "deleted_at"}))
+			},
+		},
+		{
+			name: "it should return valid groups, when filtered by location bounds",
+			inputFilter: Filter{
+				Within: &geo.Bounds{
+					Min: geo.Point{Latitude: -7.9, Longitude: 111.4},
+					Max: geo.Point{Latitude: -7.8, Longitude: 111.5},
+				},
+			},
+			expectedGroups: []entity.Group{},
+			expectedTotal:  0,
+			expectedError:  nil,
+			mockBehaviour: func() {
+				mock.ExpectQuery("SELECT count.*JOIN addresses.*addresses.latitude BETWEEN .* AND .* AND addresses.longitude BETWEEN .* AND .*").
+					WithArgs(-7.9, -7.8, 111.4, 111.5).
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+				mock.ExpectQuery("addresses.latitude BETWEEN").
+					WithArgs(-7.9, -7.8, 111.4, 111.5).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "name", "leader", "created_at", "updated_at", "deleted_at"}))
+			},
+		},
 		{
 			name:           "it should return ErrDatabase, when database return an error",
 			expectedGroups: []entity.Group{},
@@ -249,7 +396,7 @@ func TestFindAll(t *testing.T) {
 		t.Run(testCase.name, func(t *testing.T) {
 			testCase.mockBehaviour()
 
-			gotEntity, gotError := repo.FindAll(context.Background())
+			gotEntity, gotTotal, gotError := repo.FindAll(context.Background(), testCase.inputFilter)
 
 			if err := mock.ExpectationsWereMet(); err != nil {
 				t.Fatal(err)
@@ -259,6 +406,7 @@ func TestFindAll(t *testing.T) {
 				assert.Equal(t, testCase.expectedError, gotError)
 			} else {
 				assert.NoError(t, gotError)
+				assert.Equal(t, testCase.expectedTotal, gotTotal)
 				assert.Equal(t, len(testCase.expectedGroups), len(gotEntity))
 				for i, group := range testCase.expectedGroups {
 					assert.Equal(t, group.ID, gotEntity[i].ID)
@@ -296,11 +444,14 @@ func TestFindByID(t *testing.T) {
 		{
 			name: "it should return valid groups, when database successfully return the data",
 			expectedGroup: entity.Group{
-				ID:         "g-xyz",
-				Name:       "Paguyuban Reog",
-				Leader:     "Erik",
-				Address:    entity.Address{},
-				Properties: []entity.Property{},
+				ID:           "g-xyz",
+				Name:         "Paguyuban Reog",
+				Leader:       "Erik",
+				Address:      entity.Address{},
+				Properties:   []entity.Property{},
+				Members:      []entity.Member{},
+				Attachments:  []entity.Attachment{},
+				Achievements: []entity.Achievement{},
 			},
 			expectedError: nil,
 			mockBehaviour: func() {
@@ -314,8 +465,14 @@ func TestFindByID(t *testing.T) {
 					nil,
 				)
 				mock.ExpectQuery(".*").WillReturnRows(returnedRows)
+				mock.ExpectQuery(".*").
+					WillReturnRows(sqlmock.NewRows([]string{"id", "group_id", "event_name", "year", "level", "rank", "created_at", "updated_at", "deleted_at"}))
 				mock.ExpectQuery(".*").
 					WillReturnRows(sqlmock.NewRows([]string{"id", "address", "village_id", "villlage_name", "district_id", "district_name", "regency_id", "regency_name, province_id", "province_name", "created_at", "updated_at", "deleted_at"}))
+				mock.ExpectQuery(".*").
+					WillReturnRows(sqlmock.NewRows([]string{"id", "owner_id", "owner_type", "file_name", "content_type", "size", "key", "url", "thumbnail_key", "thumbnail_url", "created_at", "updated_at"}))
+				mock.ExpectQuery(".*").
+					WillReturnRows(sqlmock.NewRows([]string{"id", "group_id", "role"}))
 				mock.ExpectQuery(".*").
 					WillReturnRows(sqlmock.NewRows([]string{"id", "name", "description", "amount", "group_id", "created_at", "updated_at", "deleted_at"}))
 			},
@@ -357,3 +514,760 @@ func TestFindByID(t *testing.T) {
 		})
 	}
 }
+
+func TestNextRegistrationNumber(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer db.Close()
+
+	dialector := postgres.New(postgres.Config{
+		DriverName:           "postgres",
+		DSN:                  "sqlmock_db_0",
+		PreferSimpleProtocol: true,
+		Conn:                 db,
+	})
+	mockDB, err := gorm.Open(dialector, &gorm.Config{})
+	var repo GroupRepository = NewGroupRepositoryImpl(mockDB, &mockLog{})
+
+	testCases := []struct {
+		name           string
+		expectedNumber int
+		expectedError  error
+		mockBehaviour  func()
+	}{
+		{
+			name:           "it should return the incremented number, when database successfully return the data",
+			expectedNumber: 8,
+			expectedError:  nil,
+			mockBehaviour: func() {
+				mock.ExpectQuery("INSERT INTO registration_counters").
+					WithArgs("3502/3502030/{number}/2022").
+					WillReturnRows(sqlmock.NewRows([]string{"last_number"}).AddRow(8))
+			},
+		},
+		{
+			name:           "it should return ErrDatabase, when database return an error",
+			expectedNumber: 0,
+			expectedError:  repository.ErrDatabase,
+			mockBehaviour: func() {
+				mock.ExpectQuery("INSERT INTO registration_counters").WillReturnError(gorm.ErrInvalidDB)
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.mockBehaviour()
+
+			gotNumber, gotError := repo.NextRegistrationNumber(context.Background(), "3502/3502030/{number}/2022")
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Fatal(err)
+			}
+
+			if testCase.expectedError != nil {
+				assert.Equal(t, testCase.expectedError, gotError)
+			} else {
+				assert.NoError(t, gotError)
+				assert.Equal(t, testCase.expectedNumber, gotNumber)
+			}
+		})
+	}
+}
+
+func TestUpdateContacts(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer db.Close()
+
+	dialector := postgres.New(postgres.Config{
+		DriverName:           "postgres",
+		DSN:                  "sqlmock_db_0",
+		PreferSimpleProtocol: true,
+		Conn:                 db,
+	})
+	mockDB, err := gorm.Open(dialector, &gorm.Config{})
+	var repo GroupRepository = NewGroupRepositoryImpl(mockDB, &mockLog{})
+
+	testCases := []struct {
+		name          string
+		expectedError error
+		mockBehaviour func()
+	}{
+		{
+			name:          "it should return nil error, when the group still has the given version",
+			expectedError: nil,
+			mockBehaviour: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec("UPDATE \"groups\" SET .* WHERE \\(id = .* AND version = .*\\)").
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name:          "it should return ErrRecordNotFound, when group id not exists",
+			expectedError: repository.ErrRecordNotFound,
+			mockBehaviour: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec("UPDATE \"groups\" SET").WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectCommit()
+				mock.ExpectQuery("SELECT count").WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+			},
+		},
+		{
+			name:          "it should return ErrRecordModified, when the group has changed since it was read",
+			expectedError: repository.ErrRecordModified,
+			mockBehaviour: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec("UPDATE \"groups\" SET").WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectCommit()
+				mock.ExpectQuery("SELECT count").WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.mockBehaviour()
+
+			gotError := repo.UpdateContacts(context.Background(), "g-xyz", 3, entity.GroupContacts{Phone: "+6281234567890"})
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Fatal(err)
+			}
+
+			if testCase.expectedError != nil {
+				assert.Equal(t, testCase.expectedError, gotError)
+			} else {
+				assert.NoError(t, gotError)
+			}
+		})
+	}
+}
+
+func TestUpdateStatus(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer db.Close()
+
+	dialector := postgres.New(postgres.Config{
+		DriverName:           "postgres",
+		DSN:                  "sqlmock_db_0",
+		PreferSimpleProtocol: true,
+		Conn:                 db,
+	})
+	mockDB, err := gorm.Open(dialector, &gorm.Config{})
+	var repo GroupRepository = NewGroupRepositoryImpl(mockDB, &mockLog{})
+
+	inputTransition := entity.GroupStatusTransition{
+		ID:            "t-Ay8LmNI",
+		GroupID:       "g-xyz",
+		FromStatus:    entity.GroupStatusActive,
+		ToStatus:      entity.GroupStatusSuspended,
+		Reason:        "Unpaid membership fee",
+		AdminID:       "a-XU",
+		AdminUsername: "erikrios",
+	}
+
+	testCases := []struct {
+		name          string
+		expectedError error
+		mockBehaviour func()
+	}{
+		{
+			name:          "it should return nil error, when successfully update the status and record the transition",
+			expectedError: nil,
+			mockBehaviour: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec("UPDATE \"groups\" SET").
+					WithArgs(entity.GroupStatusSuspended, sqlmock.AnyArg(), "g-xyz", entity.GroupStatusActive, 3).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectExec("INSERT INTO \"group_status_transitions\"").
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name:          "it should return ErrRecordNotFound, when group id not exists",
+			expectedError: repository.ErrRecordNotFound,
+			mockBehaviour: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec("UPDATE \"groups\" SET").WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectQuery("SELECT count").WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+				mock.ExpectRollback()
+			},
+		},
+		{
+			name:          "it should return ErrRecordModified, when the group has changed since it was read",
+			expectedError: repository.ErrRecordModified,
+			mockBehaviour: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec("UPDATE \"groups\" SET").WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectQuery("SELECT count").WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+				mock.ExpectRollback()
+			},
+		},
+		{
+			name:          "it should return ErrDatabase, when database return an error",
+			expectedError: repository.ErrDatabase,
+			mockBehaviour: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec("UPDATE \"groups\" SET").WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectExec("INSERT INTO \"group_status_transitions\"").WillReturnError(gorm.ErrInvalidDB)
+				mock.ExpectRollback()
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.mockBehaviour()
+
+			gotError := repo.UpdateStatus(context.Background(), 3, inputTransition)
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Fatal(err)
+			}
+
+			if testCase.expectedError != nil {
+				assert.Equal(t, testCase.expectedError, gotError)
+			} else {
+				assert.NoError(t, gotError)
+			}
+		})
+	}
+}
+
+func TestUpdateLeader(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer db.Close()
+
+	dialector := postgres.New(postgres.Config{
+		DriverName:           "postgres",
+		DSN:                  "sqlmock_db_0",
+		PreferSimpleProtocol: true,
+		Conn:                 db,
+	})
+	mockDB, err := gorm.Open(dialector, &gorm.Config{})
+	var repo GroupRepository = NewGroupRepositoryImpl(mockDB, &mockLog{})
+
+	inputGroup := entity.Group{
+		ID:     "g-xyz",
+		Name:   "Paguyuban Reog",
+		Leader: "Rio S",
+	}
+
+	inputChange := entity.LeadershipChange{
+		ID:             "l-Ay8LmNI",
+		GroupID:        "g-xyz",
+		PreviousLeader: "Erik R",
+		NewLeader:      "Rio S",
+		Note:           "Elected at the annual meeting",
+		AdminID:        "a-XU",
+		AdminUsername:  "erikrios",
+	}
+
+	testCases := []struct {
+		name          string
+		expectedError error
+		mockBehaviour func()
+	}{
+		{
+			name:          "it should return nil error, when successfully update the group and record the leadership change",
+			expectedError: nil,
+			mockBehaviour: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec("UPDATE \"groups\" SET .*\"version\"=\\$\\d WHERE \\(id = \\$\\d AND leader = \\$\\d AND version = \\$\\d\\)").
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectExec("INSERT INTO \"leadership_changes\"").
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name:          "it should return ErrRecordNotFound, when group id not exists",
+			expectedError: repository.ErrRecordNotFound,
+			mockBehaviour: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec("UPDATE \"groups\" SET").WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectQuery("SELECT count\\(\\*\\) FROM \"groups\"").
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+				mock.ExpectRollback()
+			},
+		},
+		{
+			name:          "it should return ErrRecordModified, when the group has been modified since the given version",
+			expectedError: repository.ErrRecordModified,
+			mockBehaviour: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec("UPDATE \"groups\" SET").WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectQuery("SELECT count\\(\\*\\) FROM \"groups\"").
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+				mock.ExpectRollback()
+			},
+		},
+		{
+			name:          "it should return ErrDatabase, when database return an error",
+			expectedError: repository.ErrDatabase,
+			mockBehaviour: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec("UPDATE \"groups\" SET").WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectExec("INSERT INTO \"leadership_changes\"").WillReturnError(gorm.ErrInvalidDB)
+				mock.ExpectRollback()
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.mockBehaviour()
+
+			gotError := repo.UpdateLeader(context.Background(), 2, inputGroup, inputChange)
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Fatal(err)
+			}
+
+			if testCase.expectedError != nil {
+				assert.Equal(t, testCase.expectedError, gotError)
+			} else {
+				assert.NoError(t, gotError)
+			}
+		})
+	}
+}
+
+func TestFindLeadershipChanges(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer db.Close()
+
+	dialector := postgres.New(postgres.Config{
+		DriverName:           "postgres",
+		DSN:                  "sqlmock_db_0",
+		PreferSimpleProtocol: true,
+		Conn:                 db,
+	})
+	mockDB, err := gorm.Open(dialector, &gorm.Config{})
+	var repo GroupRepository = NewGroupRepositoryImpl(mockDB, &mockLog{})
+
+	testCases := []struct {
+		name            string
+		expectedChanges []entity.LeadershipChange
+		expectedError   error
+		mockBehaviour   func()
+	}{
+		{
+			name: "it should return the leadership changes ordered by effective date, when database successfully return the data",
+			expectedChanges: []entity.LeadershipChange{
+				{ID: "l-Ay8LmNI", GroupID: "g-xyz", PreviousLeader: "Erik R", NewLeader: "Rio S"},
+			},
+			expectedError: nil,
+			mockBehaviour: func() {
+				mock.ExpectQuery("SELECT \\* FROM \"leadership_changes\" WHERE group_id = \\$1 ORDER BY effective_on, created_at, id").
+					WithArgs("g-xyz").
+					WillReturnRows(sqlmock.NewRows([]string{"id", "group_id", "previous_leader", "new_leader"}).
+						AddRow("l-Ay8LmNI", "g-xyz", "Erik R", "Rio S"))
+			},
+		},
+		{
+			name:          "it should return ErrDatabase, when database return an error",
+			expectedError: repository.ErrDatabase,
+			mockBehaviour: func() {
+				mock.ExpectQuery(".*").WillReturnError(gorm.ErrInvalidDB)
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.mockBehaviour()
+
+			gotChanges, gotError := repo.FindLeadershipChanges(context.Background(), "g-xyz")
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Fatal(err)
+			}
+
+			if testCase.expectedError != nil {
+				assert.Equal(t, testCase.expectedError, gotError)
+			} else {
+				assert.NoError(t, gotError)
+				assert.Equal(t, testCase.expectedChanges, gotChanges)
+			}
+		})
+	}
+}
+
+func TestMerge(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer db.Close()
+
+	dialector := postgres.New(postgres.Config{
+		DriverName:           "postgres",
+		DSN:                  "sqlmock_db_0",
+		PreferSimpleProtocol: true,
+		Conn:                 db,
+	})
+	mockDB, err := gorm.Open(dialector, &gorm.Config{})
+	var repo GroupRepository = NewGroupRepositoryImpl(mockDB, &mockLog{})
+
+	testCases := []struct {
+		name          string
+		expectedError error
+		mockBehaviour func()
+	}{
+		{
+			name:          "it should return nil error, when successfully move the records and soft-delete the merged group",
+			expectedError: nil,
+			mockBehaviour: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec("UPDATE \"groups\" SET \"merged_into_id\"=\\$1,\"version\"=version \\+ 1,\"updated_at\"=\\$2 WHERE \\(id = \\$3 AND version = \\$4\\)").
+					WithArgs("g-abc", sqlmock.AnyArg(), "g-def", 3).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectExec("UPDATE \"groups\" SET \"version\"=version \\+ 1,\"updated_at\"=\\$1 WHERE \\(id = \\$2 AND version = \\$3\\)").
+					WithArgs(sqlmock.AnyArg(), "g-abc", 5).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectExec("UPDATE \"properties\" SET \"group_id\"").
+					WithArgs("g-abc", sqlmock.AnyArg(), "g-def").
+					WillReturnResult(sqlmock.NewResult(2, 2))
+				mock.ExpectExec("UPDATE \"show_schedules\" SET \"group_id\"").
+					WithArgs("g-abc", sqlmock.AnyArg(), "g-def").
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectExec("UPDATE \"members\" SET \"group_id\"").
+					WithArgs("g-abc", sqlmock.AnyArg(), "g-def").
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectExec("UPDATE \"achievements\" SET \"group_id\"").
+					WithArgs("g-abc", sqlmock.AnyArg(), "g-def").
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectExec("UPDATE \"attachments\" SET \"owner_id\"").
+					WithArgs("g-abc", sqlmock.AnyArg(), "groups", "g-def").
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectExec("UPDATE addresses SET latitude = source.latitude").
+					WithArgs("g-abc", "g-def").
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectExec("UPDATE \"addresses\" SET \"deleted_at\"").
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectExec("UPDATE \"groups\" SET \"deleted_at\"").
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name:          "it should return ErrRecordNotFound, when the merged group not exists",
+			expectedError: repository.ErrRecordNotFound,
+			mockBehaviour: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec("UPDATE \"groups\" SET \"merged_into_id\"").WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectQuery("SELECT count").WithArgs("g-def").WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+				mock.ExpectRollback()
+			},
+		},
+		{
+			name:          "it should return ErrRecordModified, when the merged group has changed since it was read",
+			expectedError: repository.ErrRecordModified,
+			mockBehaviour: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec("UPDATE \"groups\" SET \"merged_into_id\"").WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectQuery("SELECT count").WithArgs("g-def").WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+				mock.ExpectRollback()
+			},
+		},
+		{
+			name:          "it should return ErrRecordModified, when the target group has changed since it was read",
+			expectedError: repository.ErrRecordModified,
+			mockBehaviour: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec("UPDATE \"groups\" SET \"merged_into_id\"").WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectExec("UPDATE \"groups\" SET \"version\"").WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectQuery("SELECT count").WithArgs("g-abc").WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+				mock.ExpectRollback()
+			},
+		},
+		{
+			name:          "it should return ErrDatabase, when database return an error",
+			expectedError: repository.ErrDatabase,
+			mockBehaviour: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec("UPDATE \"groups\" SET \"merged_into_id\"").WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectExec("UPDATE \"groups\" SET \"version\"").WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectExec("UPDATE \"properties\" SET \"group_id\"").WillReturnError(gorm.ErrInvalidDB)
+				mock.ExpectRollback()
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.mockBehaviour()
+
+			gotError := repo.Merge(context.Background(), "g-def", 3, "g-abc", 5)
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Fatal(err)
+			}
+
+			if testCase.expectedError != nil {
+				assert.Equal(t, testCase.expectedError, gotError)
+			} else {
+				assert.NoError(t, gotError)
+			}
+		})
+	}
+}
+
+func TestFindDeletionImpact(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer db.Close()
+
+	dialector := postgres.New(postgres.Config{
+		DriverName:           "postgres",
+		DSN:                  "sqlmock_db_0",
+		PreferSimpleProtocol: true,
+		Conn:                 db,
+	})
+	mockDB, err := gorm.Open(dialector, &gorm.Config{})
+	var repo GroupRepository = NewGroupRepositoryImpl(mockDB, &mockLog{})
+
+	now := time.Date(2022, 8, 17, 0, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name           string
+		expectedImpact DeletionImpact
+		expectedError  error
+		mockBehaviour  func()
+	}{
+		{
+			name: "it should return the address along with the other records, when the group exists",
+			expectedImpact: DeletionImpact{
+				AddressIDs:            []string{"g-xyz"},
+				PropertyIDs:           []string{"p-Ay8LmNI"},
+				MemberIDs:             []string{},
+				AchievementIDs:        []string{},
+				FutureShowScheduleIDs: []string{"s-EuKgD1O"},
+				PastShowScheduleIDs:   []string{},
+			},
+			expectedError: nil,
+			mockBehaviour: func() {
+				mock.ExpectQuery("SELECT count\\(\\*\\) FROM \"groups\"").
+					WithArgs("g-xyz").
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+				mock.ExpectQuery("SELECT \"id\" FROM \"addresses\" WHERE id = \\$1").
+					WithArgs("g-xyz").
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow("g-xyz"))
+				mock.ExpectQuery("SELECT \"id\" FROM \"properties\"").
+					WithArgs("g-xyz").
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow("p-Ay8LmNI"))
+				mock.ExpectQuery("SELECT \"id\" FROM \"members\"").
+					WithArgs("g-xyz").
+					WillReturnRows(sqlmock.NewRows([]string{"id"}))
+				mock.ExpectQuery("SELECT \"id\" FROM \"achievements\"").
+					WithArgs("g-xyz").
+					WillReturnRows(sqlmock.NewRows([]string{"id"}))
+				mock.ExpectQuery("SELECT \"id\" FROM \"show_schedules\" WHERE \\(group_id = \\$1 AND finish_on >= \\$2\\)").
+					WithArgs("g-xyz", now).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow("s-EuKgD1O"))
+				mock.ExpectQuery("SELECT \"id\" FROM \"show_schedules\" WHERE \\(group_id = \\$1 AND finish_on < \\$2\\)").
+					WithArgs("g-xyz", now).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}))
+			},
+		},
+		{
+			name:          "it should return ErrRecordNotFound, when the group does not exist",
+			expectedError: repository.ErrRecordNotFound,
+			mockBehaviour: func() {
+				mock.ExpectQuery("SELECT count\\(\\*\\) FROM \"groups\"").
+					WithArgs("g-xyz").
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+			},
+		},
+		{
+			name:          "it should return ErrDatabase, when database return an error",
+			expectedError: repository.ErrDatabase,
+			mockBehaviour: func() {
+				mock.ExpectQuery(".*").WillReturnError(gorm.ErrInvalidDB)
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.mockBehaviour()
+
+			gotImpact, gotError := repo.FindDeletionImpact(context.Background(), "g-xyz", now)
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Fatal(err)
+			}
+
+			if testCase.expectedError != nil {
+				assert.Equal(t, testCase.expectedError, gotError)
+			} else {
+				assert.NoError(t, gotError)
+				assert.Equal(t, testCase.expectedImpact, gotImpact)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer db.Close()
+
+	dialector := postgres.New(postgres.Config{
+		DriverName:           "postgres",
+		DSN:                  "sqlmock_db_0",
+		PreferSimpleProtocol: true,
+		Conn:                 db,
+	})
+	mockDB, err := gorm.Open(dialector, &gorm.Config{})
+	var repo GroupRepository = NewGroupRepositoryImpl(mockDB, &mockLog{})
+
+	now := time.Now()
+
+	expectChildrenDeleted := func() {
+		mock.ExpectExec("UPDATE \"groups\" SET \"deleted_at\"").WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec("UPDATE \"addresses\" SET \"deleted_at\"").WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec("UPDATE \"properties\" SET \"deleted_at\"").WillReturnResult(sqlmock.NewResult(2, 2))
+		mock.ExpectExec("UPDATE \"members\" SET \"deleted_at\"").WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec("UPDATE \"achievements\" SET \"deleted_at\"").WillReturnResult(sqlmock.NewResult(0, 0))
+	}
+
+	testCases := []struct {
+		name          string
+		inputOptions  DeleteOptions
+		expectedError error
+		mockBehaviour func()
+	}{
+		{
+			name:          "it should return nil error, when the show schedules are deleted along with the group",
+			inputOptions:  DeleteOptions{Now: now, FutureShows: ShowScheduleCascade, PastShows: ShowScheduleCascade},
+			expectedError: nil,
+			mockBehaviour: func() {
+				mock.ExpectBegin()
+				expectChildrenDeleted()
+				mock.ExpectExec("UPDATE \"show_schedules\" SET \"deleted_at\"").
+					WithArgs(sqlmock.AnyArg(), "g-xyz", now).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectExec("UPDATE \"show_schedules\" SET \"deleted_at\"").
+					WithArgs(sqlmock.AnyArg(), "g-xyz", now).
+					WillReturnResult(sqlmock.NewResult(3, 3))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name:          "it should return nil error, when the past show schedules are detached from the group",
+			inputOptions:  DeleteOptions{Now: now, FutureShows: ShowScheduleBlock, PastShows: ShowScheduleDetach},
+			expectedError: nil,
+			mockBehaviour: func() {
+				mock.ExpectBegin()
+				expectChildrenDeleted()
+				mock.ExpectQuery("SELECT count\\(\\*\\) FROM \"show_schedules\"").
+					WithArgs("g-xyz", now).
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+				mock.ExpectExec("UPDATE \"show_schedules\" SET \"group_id\"").
+					WithArgs(nil, sqlmock.AnyArg(), "g-xyz", now).
+					WillReturnResult(sqlmock.NewResult(3, 3))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name:          "it should return ErrRecordReferenced, when a future show schedule blocks the deletion",
+			inputOptions:  DeleteOptions{Now: now, FutureShows: ShowScheduleBlock, PastShows: ShowScheduleCascade},
+			expectedError: repository.ErrRecordReferenced,
+			mockBehaviour: func() {
+				mock.ExpectBegin()
+				expectChildrenDeleted()
+				mock.ExpectQuery("SELECT count\\(\\*\\) FROM \"show_schedules\"").
+					WithArgs("g-xyz", now).
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+				mock.ExpectRollback()
+			},
+		},
+		{
+			name:          "it should return ErrRecordNotFound, when the group not exists",
+			inputOptions:  DeleteOptions{Now: now, FutureShows: ShowScheduleCascade, PastShows: ShowScheduleCascade},
+			expectedError: repository.ErrRecordNotFound,
+			mockBehaviour: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec("UPDATE \"groups\" SET \"deleted_at\"").WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectQuery("SELECT count\\(\\*\\) FROM \"groups\"").
+					WithArgs("g-xyz").
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+				mock.ExpectRollback()
+			},
+		},
+		{
+			name:          "it should return ErrRecordModified, when the group has been modified since the given version",
+			inputOptions:  DeleteOptions{Now: now, FutureShows: ShowScheduleCascade, PastShows: ShowScheduleCascade},
+			expectedError: repository.ErrRecordModified,
+			mockBehaviour: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec("UPDATE \"groups\" SET \"deleted_at\"").
+					WithArgs(sqlmock.AnyArg(), "g-xyz", 3).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectQuery("SELECT count\\(\\*\\) FROM \"groups\"").
+					WithArgs("g-xyz").
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+				mock.ExpectRollback()
+			},
+		},
+		{
+			name:          "it should return ErrDatabase, when database return an error",
+			inputOptions:  DeleteOptions{Now: now, FutureShows: ShowScheduleDetach, PastShows: ShowScheduleCascade},
+			expectedError: repository.ErrDatabase,
+			mockBehaviour: func() {
+				mock.ExpectBegin()
+				expectChildrenDeleted()
+				mock.ExpectExec("UPDATE \"show_schedules\" SET \"group_id\"").WillReturnError(gorm.ErrInvalidDB)
+				mock.ExpectRollback()
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.mockBehaviour()
+
+			gotError := repo.Delete(context.Background(), "g-xyz", 3, testCase.inputOptions)
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Fatal(err)
+			}
+
+			if testCase.expectedError != nil {
+				assert.Equal(t, testCase.expectedError, gotError)
+			} else {
+				assert.NoError(t, gotError)
+			}
+		})
+	}
+}
@@ -4,9 +4,10 @@ package mocks
 
 import (
 	context "context"
+	time "time"
 
 	entity "github.com/erikrios/reog-apps-apis/entity"
-
+	group "github.com/erikrios/reog-apps-apis/repository/group"
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -15,13 +16,13 @@ type GroupRepository struct {
 	mock.Mock
 }
 
-// Delete provides a mock function with given fields: ctx, id
-func (_m *GroupRepository) Delete(ctx context.Context, id string) error {
-	ret := _m.Called(ctx, id)
+// Delete provides a mock function with given fields: ctx, id, version, options
+func (_m *GroupRepository) Delete(ctx context.Context, id string, version int, options group.DeleteOptions) error {
+	ret := _m.Called(ctx, id, version, options)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
-		r0 = rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, group.DeleteOptions) error); ok {
+		r0 = rf(ctx, id, version, options)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -29,27 +30,34 @@ func (_m *GroupRepository) Delete(ctx context.Context, id string) error {
 	return r0
 }
 
-// FindAll provides a mock function with given fields: ctx
-func (_m *GroupRepository) FindAll(ctx context.Context) ([]entity.Group, error) {
-	ret := _m.Called(ctx)
+// FindAll provides a mock function with given fields: ctx, filter
+func (_m *GroupRepository) FindAll(ctx context.Context, filter group.Filter) ([]entity.Group, int64, error) {
+	ret := _m.Called(ctx, filter)
 
 	var r0 []entity.Group
-	if rf, ok := ret.Get(0).(func(context.Context) []entity.Group); ok {
-		r0 = rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, group.Filter) []entity.Group); ok {
+		r0 = rf(ctx, filter)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]entity.Group)
 		}
 	}
 
-	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = rf(ctx)
+	var r1 int64
+	if rf, ok := ret.Get(1).(func(context.Context, group.Filter) int64); ok {
+		r1 = rf(ctx, filter)
 	} else {
-		r1 = ret.Error(1)
+		r1 = ret.Get(1).(int64)
 	}
 
-	return r0, r1
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, group.Filter) error); ok {
+		r2 = rf(ctx, filter)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
 }
 
 // FindByID provides a mock function with given fields: ctx, id
@@ -73,13 +81,180 @@ func (_m *GroupRepository) FindByID(ctx context.Context, id string) (entity.Grou
 	return r0, r1
 }
 
-// Insert provides a mock function with given fields: ctx, _a1
-func (_m *GroupRepository) Insert(ctx context.Context, _a1 entity.Group) error {
-	ret := _m.Called(ctx, _a1)
+// FindDeletionImpact provides a mock function with given fields: ctx, id, now
+func (_m *GroupRepository) FindDeletionImpact(ctx context.Context, id string, now time.Time) (group.DeletionImpact, error) {
+	ret := _m.Called(ctx, id, now)
+
+	var r0 group.DeletionImpact
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time) group.DeletionImpact); ok {
+		r0 = rf(ctx, id, now)
+	} else {
+		r0 = ret.Get(0).(group.DeletionImpact)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, time.Time) error); ok {
+		r1 = rf(ctx, id, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindLeadershipChanges provides a mock function with given fields: ctx, groupID
+func (_m *GroupRepository) FindLeadershipChanges(ctx context.Context, groupID string) ([]entity.LeadershipChange, error) {
+	ret := _m.Called(ctx, groupID)
+
+	var r0 []entity.LeadershipChange
+	if rf, ok := ret.Get(0).(func(context.Context, string) []entity.LeadershipChange); ok {
+		r0 = rf(ctx, groupID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.LeadershipChange)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, groupID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindStatusTransitions provides a mock function with given fields: ctx, groupID
+func (_m *GroupRepository) FindStatusTransitions(ctx context.Context, groupID string) ([]entity.GroupStatusTransition, error) {
+	ret := _m.Called(ctx, groupID)
+
+	var r0 []entity.GroupStatusTransition
+	if rf, ok := ret.Get(0).(func(context.Context, string) []entity.GroupStatusTransition); ok {
+		r0 = rf(ctx, groupID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.GroupStatusTransition)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, groupID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindUnregistered provides a mock function with given fields: ctx
+func (_m *GroupRepository) FindUnregistered(ctx context.Context) ([]entity.Group, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []entity.Group
+	if rf, ok := ret.Get(0).(func(context.Context) []entity.Group); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Group)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Insert provides a mock function with given fields: ctx, _a1, registration
+func (_m *GroupRepository) Insert(ctx context.Context, _a1 entity.Group, registration group.Registration) error {
+	ret := _m.Called(ctx, _a1, registration)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.Group, group.Registration) error); ok {
+		r0 = rf(ctx, _a1, registration)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// InsertAll provides a mock function with given fields: ctx, groups, registrations
+func (_m *GroupRepository) InsertAll(ctx context.Context, groups []entity.Group, registrations []group.Registration) error {
+	ret := _m.Called(ctx, groups, registrations)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []entity.Group, []group.Registration) error); ok {
+		r0 = rf(ctx, groups, registrations)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Merge provides a mock function with given fields: ctx, sourceID, sourceVersion, targetID, targetVersion
+func (_m *GroupRepository) Merge(ctx context.Context, sourceID string, sourceVersion int, targetID string, targetVersion int) error {
+	ret := _m.Called(ctx, sourceID, sourceVersion, targetID, targetVersion)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, string, int) error); ok {
+		r0 = rf(ctx, sourceID, sourceVersion, targetID, targetVersion)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NextRegistrationNumber provides a mock function with given fields: ctx, scope
+func (_m *GroupRepository) NextRegistrationNumber(ctx context.Context, scope string) (int, error) {
+	ret := _m.Called(ctx, scope)
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func(context.Context, string) int); ok {
+		r0 = rf(ctx, scope)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, scope)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Update provides a mock function with given fields: ctx, id, version, _a3
+func (_m *GroupRepository) Update(ctx context.Context, id string, version int, _a3 entity.Group) error {
+	ret := _m.Called(ctx, id, version, _a3)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, entity.Group) error); ok {
+		r0 = rf(ctx, id, version, _a3)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateContacts provides a mock function with given fields: ctx, id, version, contacts
+func (_m *GroupRepository) UpdateContacts(ctx context.Context, id string, version int, contacts entity.GroupContacts) error {
+	ret := _m.Called(ctx, id, version, contacts)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, entity.Group) error); ok {
-		r0 = rf(ctx, _a1)
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, entity.GroupContacts) error); ok {
+		r0 = rf(ctx, id, version, contacts)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -87,13 +262,13 @@ func (_m *GroupRepository) Insert(ctx context.Context, _a1 entity.Group) error {
 	return r0
 }
 
-// InsertAll provides a mock function with given fields: ctx, groups
-func (_m *GroupRepository) InsertAll(ctx context.Context, groups []entity.Group) error {
-	ret := _m.Called(ctx, groups)
+// UpdateLeader provides a mock function with given fields: ctx, version, _a2, change
+func (_m *GroupRepository) UpdateLeader(ctx context.Context, version int, _a2 entity.Group, change entity.LeadershipChange) error {
+	ret := _m.Called(ctx, version, _a2, change)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, []entity.Group) error); ok {
-		r0 = rf(ctx, groups)
+	if rf, ok := ret.Get(0).(func(context.Context, int, entity.Group, entity.LeadershipChange) error); ok {
+		r0 = rf(ctx, version, _a2, change)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -101,13 +276,13 @@ func (_m *GroupRepository) InsertAll(ctx context.Context, groups []entity.Group)
 	return r0
 }
 
-// Update provides a mock function with given fields: ctx, id, _a2
-func (_m *GroupRepository) Update(ctx context.Context, id string, _a2 entity.Group) error {
-	ret := _m.Called(ctx, id, _a2)
+// UpdateStatus provides a mock function with given fields: ctx, version, transition
+func (_m *GroupRepository) UpdateStatus(ctx context.Context, version int, transition entity.GroupStatusTransition) error {
+	ret := _m.Called(ctx, version, transition)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, entity.Group) error); ok {
-		r0 = rf(ctx, id, _a2)
+	if rf, ok := ret.Get(0).(func(context.Context, int, entity.GroupStatusTransition) error); ok {
+		r0 = rf(ctx, version, transition)
 	} else {
 		r0 = ret.Error(0)
 	}
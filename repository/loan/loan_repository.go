@@ -0,0 +1,15 @@
+package loan
+
+import (
+	"context"
+	"time"
+
+	"github.com/erikrios/reog-apps-apis/entity"
+)
+
+type LoanRepository interface {
+	Insert(ctx context.Context, loan entity.Loan) (err error)
+	FindByPropertyID(ctx context.Context, propertyID string) (loans []entity.Loan, err error)
+	FindByID(ctx context.Context, propertyID, id string) (loan entity.Loan, err error)
+	Return(ctx context.Context, propertyID, id string, returnedOn time.Time) (err error)
+}
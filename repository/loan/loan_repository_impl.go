@@ -0,0 +1,126 @@
+package loan
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/erikrios/reog-apps-apis/entity"
+	"github.com/erikrios/reog-apps-apis/repository"
+	"github.com/erikrios/reog-apps-apis/utils/logging"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type loanRepositoryImpl struct {
+	db     *gorm.DB
+	logger logging.Logging
+}
+
+func NewLoanRepositoryImpl(db *gorm.DB, logger logging.Logging) *loanRepositoryImpl {
+	return &loanRepositoryImpl{db: db, logger: logger}
+}
+
+// Insert lends out the items only if enough of them are left. The property is locked until the loan is inserted, so
+// that two loans of the same property cannot both take the last items.
+func (l *loanRepositoryImpl) Insert(ctx context.Context, loan entity.Loan) (err error) {
+	err = l.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var property entity.Property
+		if dbErr := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Select("id", "amount").
+			First(&property, "id = ?", loan.PropertyID).Error; dbErr != nil {
+			if errors.Is(dbErr, gorm.ErrRecordNotFound) {
+				return repository.ErrRecordNotFound
+			}
+
+			go func(logger logging.Logging, message string) {
+				logger.Error(message)
+			}(l.logger, dbErr.Error())
+
+			log.Println(dbErr)
+			return repository.ErrDatabase
+		}
+
+		var onLoan int
+		if dbErr := tx.Model(&entity.Loan{}).
+			Select("COALESCE(SUM(quantity), 0)").
+			Where("property_id = ? AND returned_on IS NULL", loan.PropertyID).
+			Scan(&onLoan).Error; dbErr != nil {
+			go func(logger logging.Logging, message string) {
+				logger.Error(message)
+			}(l.logger, dbErr.Error())
+
+			log.Println(dbErr)
+			return repository.ErrDatabase
+		}
+
+		if onLoan+int(loan.Quantity) > int(property.Amount) {
+			return repository.ErrNotEnoughQuantity
+		}
+
+		if dbErr := tx.Create(&loan).Error; dbErr != nil {
+			go func(logger logging.Logging, message string) {
+				logger.Error(message)
+			}(l.logger, dbErr.Error())
+
+			log.Println(dbErr)
+			return repository.ErrDatabase
+		}
+		return nil
+	})
+	return
+}
+
+// FindByPropertyID finds the loans of the property, the ones still out first, then the most recent ones.
+func (l *loanRepositoryImpl) FindByPropertyID(ctx context.Context, propertyID string) (loans []entity.Loan, err error) {
+	if dbErr := l.db.WithContext(ctx).
+		Where("property_id = ?", propertyID).
+		Order("returned_on IS NOT NULL, loaned_on DESC, id").
+		Find(&loans).Error; dbErr != nil {
+		go func(logger logging.Logging, message string) {
+			logger.Error(message)
+		}(l.logger, dbErr.Error())
+
+		log.Println(dbErr)
+		err = repository.ErrDatabase
+	}
+	return
+}
+
+func (l *loanRepositoryImpl) FindByID(ctx context.Context, propertyID, id string) (loan entity.Loan, err error) {
+	if dbErr := l.db.WithContext(ctx).First(&loan, "id = ? AND property_id = ?", id, propertyID).Error; dbErr != nil {
+		if errors.Is(dbErr, gorm.ErrRecordNotFound) {
+			err = repository.ErrRecordNotFound
+			return
+		}
+
+		go func(logger logging.Logging, message string) {
+			logger.Error(message)
+		}(l.logger, dbErr.Error())
+
+		log.Println(dbErr)
+		err = repository.ErrDatabase
+	}
+	return
+}
+
+// Return only applies to a loan still out, a loan returned in the meantime is reported as not found.
+func (l *loanRepositoryImpl) Return(ctx context.Context, propertyID, id string, returnedOn time.Time) (err error) {
+	if result := l.db.WithContext(ctx).
+		Model(&entity.Loan{}).
+		Where("id = ? AND property_id = ? AND returned_on IS NULL", id, propertyID).
+		Update("returned_on", returnedOn); result.Error != nil {
+		go func(logger logging.Logging, message string) {
+			logger.Error(message)
+		}(l.logger, result.Error.Error())
+
+		log.Println(result.Error)
+		err = repository.ErrDatabase
+	} else {
+		if result.RowsAffected < 1 {
+			err = repository.ErrRecordNotFound
+		}
+	}
+	return
+}
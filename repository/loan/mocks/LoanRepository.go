@@ -0,0 +1,90 @@
+// Code generated by mockery v2.10.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	entity "github.com/erikrios/reog-apps-apis/entity"
+
+	time "time"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// LoanRepository is an autogenerated mock type for the LoanRepository type
+type LoanRepository struct {
+	mock.Mock
+}
+
+// FindByID provides a mock function with given fields: ctx, propertyID, id
+func (_m *LoanRepository) FindByID(ctx context.Context, propertyID string, id string) (entity.Loan, error) {
+	ret := _m.Called(ctx, propertyID, id)
+
+	var r0 entity.Loan
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) entity.Loan); ok {
+		r0 = rf(ctx, propertyID, id)
+	} else {
+		r0 = ret.Get(0).(entity.Loan)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, propertyID, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindByPropertyID provides a mock function with given fields: ctx, propertyID
+func (_m *LoanRepository) FindByPropertyID(ctx context.Context, propertyID string) ([]entity.Loan, error) {
+	ret := _m.Called(ctx, propertyID)
+
+	var r0 []entity.Loan
+	if rf, ok := ret.Get(0).(func(context.Context, string) []entity.Loan); ok {
+		r0 = rf(ctx, propertyID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Loan)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, propertyID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Insert provides a mock function with given fields: ctx, _a1
+func (_m *LoanRepository) Insert(ctx context.Context, _a1 entity.Loan) error {
+	ret := _m.Called(ctx, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.Loan) error); ok {
+		r0 = rf(ctx, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Return provides a mock function with given fields: ctx, propertyID, id, returnedOn
+func (_m *LoanRepository) Return(ctx context.Context, propertyID string, id string, returnedOn time.Time) error {
+	ret := _m.Called(ctx, propertyID, id, returnedOn)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, time.Time) error); ok {
+		r0 = rf(ctx, propertyID, id, returnedOn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
@@ -0,0 +1,38 @@
+package maintenance
+
+import (
+	"context"
+	"time"
+
+	"github.com/erikrios/reog-apps-apis/entity"
+)
+
+type MaintenanceRepository interface {
+	Insert(ctx context.Context, maintenance entity.Maintenance) (err error)
+	FindByPropertyID(ctx context.Context, propertyID string) (maintenances []entity.Maintenance, err error)
+	FindByID(ctx context.Context, propertyID, id string) (maintenance entity.Maintenance, err error)
+	Update(ctx context.Context, propertyID, id string, maintenance entity.Maintenance) (err error)
+	Delete(ctx context.Context, propertyID, id string) (err error)
+	FindPoorCondition(ctx context.Context, filter ReportFilter) (items []PoorConditionItem, err error)
+}
+
+// ReportFilter narrows down the items returned by FindPoorCondition. A zero DistrictID reports the whole regency.
+type ReportFilter struct {
+	DistrictID string
+}
+
+// PoorConditionItem is a property whose latest inspection found it in poor condition or broken, with the damage
+// not repaired yet.
+type PoorConditionItem struct {
+	PropertyID        string
+	PropertyName      string
+	Amount            uint16
+	GroupID           string
+	GroupName         string
+	DistrictID        string
+	DistrictName      string
+	MaintenanceID     string
+	Condition         string
+	InspectedOn       time.Time
+	DamageDescription string
+}
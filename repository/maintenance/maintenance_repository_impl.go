@@ -0,0 +1,144 @@
+package maintenance
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"github.com/erikrios/reog-apps-apis/entity"
+	"github.com/erikrios/reog-apps-apis/repository"
+	"github.com/erikrios/reog-apps-apis/utils/logging"
+	"github.com/jackc/pgconn"
+	"gorm.io/gorm"
+)
+
+type maintenanceRepositoryImpl struct {
+	db     *gorm.DB
+	logger logging.Logging
+}
+
+func NewMaintenanceRepositoryImpl(db *gorm.DB, logger logging.Logging) *maintenanceRepositoryImpl {
+	return &maintenanceRepositoryImpl{db: db, logger: logger}
+}
+
+func (m *maintenanceRepositoryImpl) Insert(ctx context.Context, maintenance entity.Maintenance) (err error) {
+	if dbErr := m.db.WithContext(ctx).Create(&maintenance).Error; dbErr != nil {
+		var pqErr *pgconn.PgError
+		if ok := errors.As(dbErr, &pqErr); ok && pqErr.Code == "23505" {
+			err = repository.ErrRecordAlreadyExists
+			return
+		}
+
+		go func(logger logging.Logging, message string) {
+			logger.Error(message)
+		}(m.logger, dbErr.Error())
+
+		log.Println(dbErr)
+		err = repository.ErrDatabase
+	}
+	return
+}
+
+func (m *maintenanceRepositoryImpl) FindByPropertyID(ctx context.Context, propertyID string) (maintenances []entity.Maintenance, err error) {
+	if dbErr := m.db.WithContext(ctx).
+		Preload("Photos").
+		Where("property_id = ?", propertyID).
+		Order("inspected_on DESC, created_at DESC").
+		Find(&maintenances).Error; dbErr != nil {
+		go func(logger logging.Logging, message string) {
+			logger.Error(message)
+		}(m.logger, dbErr.Error())
+
+		log.Println(dbErr)
+		err = repository.ErrDatabase
+	}
+	return
+}
+
+func (m *maintenanceRepositoryImpl) FindByID(ctx context.Context, propertyID, id string) (maintenance entity.Maintenance, err error) {
+	if dbErr := m.db.WithContext(ctx).Preload("Photos").First(&maintenance, "id = ? AND property_id = ?", id, propertyID).Error; dbErr != nil {
+		if errors.Is(dbErr, gorm.ErrRecordNotFound) {
+			err = repository.ErrRecordNotFound
+			return
+		}
+
+		go func(logger logging.Logging, message string) {
+			logger.Error(message)
+		}(m.logger, dbErr.Error())
+
+		log.Println(dbErr)
+		err = repository.ErrDatabase
+	}
+	return
+}
+
+// Update replaces every column of the record, so a nil RepairedOn clears the repair date.
+func (m *maintenanceRepositoryImpl) Update(ctx context.Context, propertyID, id string, maintenance entity.Maintenance) (err error) {
+	if result := m.db.WithContext(ctx).
+		Select("condition", "inspected_on", "damage_description", "repaired_on", "cost").
+		Where("id = ? AND property_id = ?", id, propertyID).
+		UpdateColumns(&maintenance); result.Error != nil {
+		go func(logger logging.Logging, message string) {
+			logger.Error(message)
+		}(m.logger, result.Error.Error())
+
+		log.Println(result.Error)
+		err = repository.ErrDatabase
+	} else {
+		if result.RowsAffected < 1 {
+			err = repository.ErrRecordNotFound
+		}
+	}
+	return
+}
+
+func (m *maintenanceRepositoryImpl) Delete(ctx context.Context, propertyID, id string) (err error) {
+	if result := m.db.WithContext(ctx).Delete(&entity.Maintenance{}, "id = ? AND property_id = ?", id, propertyID); result.Error != nil {
+		go func(logger logging.Logging, message string) {
+			logger.Error(message)
+		}(m.logger, result.Error.Error())
+
+		log.Println(result.Error)
+		err = repository.ErrDatabase
+	} else {
+		if result.RowsAffected < 1 {
+			err = repository.ErrRecordNotFound
+		}
+	}
+	return
+}
+
+// FindPoorCondition lists the properties whose latest inspection found them in poor condition or broken, and
+// which have not been repaired since, ordered by district, group and property.
+func (m *maintenanceRepositoryImpl) FindPoorCondition(ctx context.Context, filter ReportFilter) (items []PoorConditionItem, err error) {
+	latest := m.db.
+		Model(&entity.Maintenance{}).
+		Select("DISTINCT ON (property_id) id, property_id, condition, inspected_on, damage_description, repaired_on").
+		Order("property_id, inspected_on DESC, created_at DESC")
+
+	query := m.db.WithContext(ctx).
+		Table("(?) AS latest", latest).
+		Select(`properties.id AS property_id, properties.name AS property_name, properties.amount,
+			groups.id AS group_id, groups.name AS group_name, addresses.district_id, addresses.district_name,
+			latest.id AS maintenance_id, latest.condition, latest.inspected_on, latest.damage_description`).
+		Joins("JOIN properties ON properties.id = latest.property_id AND properties.deleted_at IS NULL").
+		Joins("JOIN groups ON groups.id = properties.group_id AND groups.deleted_at IS NULL").
+		Joins("JOIN addresses ON addresses.id = groups.id AND addresses.deleted_at IS NULL").
+		Where("latest.condition IN ? AND latest.repaired_on IS NULL", []string{entity.ConditionPoor, entity.ConditionBroken})
+
+	if filter.DistrictID != "" {
+		query = query.Where("addresses.district_id = ?", filter.DistrictID)
+	}
+
+	if dbErr := query.
+		Order("addresses.district_name, groups.name, properties.name").
+		Scan(&items).Error; dbErr != nil {
+		go func(logger logging.Logging, message string) {
+			logger.Error(message)
+		}(m.logger, dbErr.Error())
+
+		log.Println(dbErr)
+		err = repository.ErrDatabase
+	}
+	return
+}
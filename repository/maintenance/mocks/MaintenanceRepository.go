@@ -0,0 +1,125 @@
+// Code generated by mockery v2.10.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	entity "github.com/erikrios/reog-apps-apis/entity"
+	maintenance "github.com/erikrios/reog-apps-apis/repository/maintenance"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MaintenanceRepository is an autogenerated mock type for the MaintenanceRepository type
+type MaintenanceRepository struct {
+	mock.Mock
+}
+
+// Delete provides a mock function with given fields: ctx, propertyID, id
+func (_m *MaintenanceRepository) Delete(ctx context.Context, propertyID string, id string) error {
+	ret := _m.Called(ctx, propertyID, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, propertyID, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FindByID provides a mock function with given fields: ctx, propertyID, id
+func (_m *MaintenanceRepository) FindByID(ctx context.Context, propertyID string, id string) (entity.Maintenance, error) {
+	ret := _m.Called(ctx, propertyID, id)
+
+	var r0 entity.Maintenance
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) entity.Maintenance); ok {
+		r0 = rf(ctx, propertyID, id)
+	} else {
+		r0 = ret.Get(0).(entity.Maintenance)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, propertyID, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindByPropertyID provides a mock function with given fields: ctx, propertyID
+func (_m *MaintenanceRepository) FindByPropertyID(ctx context.Context, propertyID string) ([]entity.Maintenance, error) {
+	ret := _m.Called(ctx, propertyID)
+
+	var r0 []entity.Maintenance
+	if rf, ok := ret.Get(0).(func(context.Context, string) []entity.Maintenance); ok {
+		r0 = rf(ctx, propertyID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Maintenance)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, propertyID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindPoorCondition provides a mock function with given fields: ctx, filter
+func (_m *MaintenanceRepository) FindPoorCondition(ctx context.Context, filter maintenance.ReportFilter) ([]maintenance.PoorConditionItem, error) {
+	ret := _m.Called(ctx, filter)
+
+	var r0 []maintenance.PoorConditionItem
+	if rf, ok := ret.Get(0).(func(context.Context, maintenance.ReportFilter) []maintenance.PoorConditionItem); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]maintenance.PoorConditionItem)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, maintenance.ReportFilter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Insert provides a mock function with given fields: ctx, _a1
+func (_m *MaintenanceRepository) Insert(ctx context.Context, _a1 entity.Maintenance) error {
+	ret := _m.Called(ctx, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.Maintenance) error); ok {
+		r0 = rf(ctx, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Update provides a mock function with given fields: ctx, propertyID, id, _a3
+func (_m *MaintenanceRepository) Update(ctx context.Context, propertyID string, id string, _a3 entity.Maintenance) error {
+	ret := _m.Called(ctx, propertyID, id, _a3)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, entity.Maintenance) error); ok {
+		r0 = rf(ctx, propertyID, id, _a3)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
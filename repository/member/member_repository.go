@@ -0,0 +1,15 @@
+package member
+
+import (
+	"context"
+
+	"github.com/erikrios/reog-apps-apis/entity"
+)
+
+type MemberRepository interface {
+	Insert(ctx context.Context, member entity.Member) (err error)
+	FindByGroupID(ctx context.Context, groupID string) (members []entity.Member, err error)
+	FindByID(ctx context.Context, groupID, id string) (member entity.Member, err error)
+	Update(ctx context.Context, groupID, id string, member entity.Member) (err error)
+	Delete(ctx context.Context, groupID, id string) (err error)
+}
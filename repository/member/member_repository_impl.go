@@ -0,0 +1,104 @@
+package member
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"github.com/erikrios/reog-apps-apis/entity"
+	"github.com/erikrios/reog-apps-apis/repository"
+	"github.com/erikrios/reog-apps-apis/utils/logging"
+	"github.com/jackc/pgconn"
+	"gorm.io/gorm"
+)
+
+type memberRepositoryImpl struct {
+	db     *gorm.DB
+	logger logging.Logging
+}
+
+func NewMemberRepositoryImpl(db *gorm.DB, logger logging.Logging) *memberRepositoryImpl {
+	return &memberRepositoryImpl{db: db, logger: logger}
+}
+
+func (m *memberRepositoryImpl) Insert(ctx context.Context, member entity.Member) (err error) {
+	if dbErr := m.db.WithContext(ctx).Create(&member).Error; dbErr != nil {
+		var pqErr *pgconn.PgError
+		if ok := errors.As(dbErr, &pqErr); ok && pqErr.Code == "23505" {
+			err = repository.ErrRecordAlreadyExists
+			return
+		}
+
+		go func(logger logging.Logging, message string) {
+			logger.Error(message)
+		}(m.logger, dbErr.Error())
+
+		log.Println(dbErr)
+		err = repository.ErrDatabase
+	}
+	return
+}
+
+func (m *memberRepositoryImpl) FindByGroupID(ctx context.Context, groupID string) (members []entity.Member, err error) {
+	if dbErr := m.db.WithContext(ctx).Where("group_id = ?", groupID).Order("name").Find(&members).Error; dbErr != nil {
+		go func(logger logging.Logging, message string) {
+			logger.Error(message)
+		}(m.logger, dbErr.Error())
+
+		log.Println(dbErr)
+		err = repository.ErrDatabase
+	}
+	return
+}
+
+func (m *memberRepositoryImpl) FindByID(ctx context.Context, groupID, id string) (member entity.Member, err error) {
+	if dbErr := m.db.WithContext(ctx).First(&member, "id = ? AND group_id = ?", id, groupID).Error; dbErr != nil {
+		if errors.Is(dbErr, gorm.ErrRecordNotFound) {
+			err = repository.ErrRecordNotFound
+			return
+		}
+
+		go func(logger logging.Logging, message string) {
+			logger.Error(message)
+		}(m.logger, dbErr.Error())
+
+		log.Println(dbErr)
+		err = repository.ErrDatabase
+	}
+	return
+}
+
+func (m *memberRepositoryImpl) Update(ctx context.Context, groupID, id string, member entity.Member) (err error) {
+	if result := m.db.WithContext(ctx).
+		Select("name", "role", "gender", "birth_year", "phone", "joined_on").
+		Where("id = ? AND group_id = ?", id, groupID).
+		UpdateColumns(&member); result.Error != nil {
+		go func(logger logging.Logging, message string) {
+			logger.Error(message)
+		}(m.logger, result.Error.Error())
+
+		log.Println(result.Error)
+		err = repository.ErrDatabase
+	} else {
+		if result.RowsAffected < 1 {
+			err = repository.ErrRecordNotFound
+		}
+	}
+	return
+}
+
+func (m *memberRepositoryImpl) Delete(ctx context.Context, groupID, id string) (err error) {
+	if result := m.db.WithContext(ctx).Delete(&entity.Member{}, "id = ? AND group_id = ?", id, groupID); result.Error != nil {
+		go func(logger logging.Logging, message string) {
+			logger.Error(message)
+		}(m.logger, result.Error.Error())
+
+		log.Println(result.Error)
+		err = repository.ErrDatabase
+	} else {
+		if result.RowsAffected < 1 {
+			err = repository.ErrRecordNotFound
+		}
+	}
+	return
+}
@@ -0,0 +1,102 @@
+// Code generated by mockery v2.10.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	entity "github.com/erikrios/reog-apps-apis/entity"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MemberRepository is an autogenerated mock type for the MemberRepository type
+type MemberRepository struct {
+	mock.Mock
+}
+
+// Delete provides a mock function with given fields: ctx, groupID, id
+func (_m *MemberRepository) Delete(ctx context.Context, groupID string, id string) error {
+	ret := _m.Called(ctx, groupID, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, groupID, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FindByGroupID provides a mock function with given fields: ctx, groupID
+func (_m *MemberRepository) FindByGroupID(ctx context.Context, groupID string) ([]entity.Member, error) {
+	ret := _m.Called(ctx, groupID)
+
+	var r0 []entity.Member
+	if rf, ok := ret.Get(0).(func(context.Context, string) []entity.Member); ok {
+		r0 = rf(ctx, groupID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Member)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, groupID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindByID provides a mock function with given fields: ctx, groupID, id
+func (_m *MemberRepository) FindByID(ctx context.Context, groupID string, id string) (entity.Member, error) {
+	ret := _m.Called(ctx, groupID, id)
+
+	var r0 entity.Member
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) entity.Member); ok {
+		r0 = rf(ctx, groupID, id)
+	} else {
+		r0 = ret.Get(0).(entity.Member)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, groupID, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Insert provides a mock function with given fields: ctx, _a1
+func (_m *MemberRepository) Insert(ctx context.Context, _a1 entity.Member) error {
+	ret := _m.Called(ctx, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.Member) error); ok {
+		r0 = rf(ctx, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Update provides a mock function with given fields: ctx, groupID, id, _a3
+func (_m *MemberRepository) Update(ctx context.Context, groupID string, id string, _a3 entity.Member) error {
+	ret := _m.Called(ctx, groupID, id, _a3)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, entity.Member) error); ok {
+		r0 = rf(ctx, groupID, id, _a3)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
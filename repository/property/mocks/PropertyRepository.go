@@ -14,13 +14,13 @@ type PropertyRepository struct {
 	mock.Mock
 }
 
-// Delete provides a mock function with given fields: ctx, id
-func (_m *PropertyRepository) Delete(ctx context.Context, id string) error {
-	ret := _m.Called(ctx, id)
+// Delete provides a mock function with given fields: ctx, id, version
+func (_m *PropertyRepository) Delete(ctx context.Context, id string, version int) error {
+	ret := _m.Called(ctx, id, version)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
-		r0 = rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) error); ok {
+		r0 = rf(ctx, id, version)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -28,6 +28,27 @@ func (_m *PropertyRepository) Delete(ctx context.Context, id string) error {
 	return r0
 }
 
+// FindByID provides a mock function with given fields: ctx, id
+func (_m *PropertyRepository) FindByID(ctx context.Context, id string) (entity.Property, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 entity.Property
+	if rf, ok := ret.Get(0).(func(context.Context, string) entity.Property); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(entity.Property)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Insert provides a mock function with given fields: ctx, _a1
 func (_m *PropertyRepository) Insert(ctx context.Context, _a1 entity.Property) error {
 	ret := _m.Called(ctx, _a1)
@@ -42,13 +63,13 @@ func (_m *PropertyRepository) Insert(ctx context.Context, _a1 entity.Property) e
 	return r0
 }
 
-// Update provides a mock function with given fields: ctx, id, _a2
-func (_m *PropertyRepository) Update(ctx context.Context, id string, _a2 entity.Property) error {
-	ret := _m.Called(ctx, id, _a2)
+// Update provides a mock function with given fields: ctx, id, version, _a3
+func (_m *PropertyRepository) Update(ctx context.Context, id string, version int, _a3 entity.Property) error {
+	ret := _m.Called(ctx, id, version, _a3)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, entity.Property) error); ok {
-		r0 = rf(ctx, id, _a2)
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, entity.Property) error); ok {
+		r0 = rf(ctx, id, version, _a3)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -8,6 +8,7 @@ import (
 
 type PropertyRepository interface {
 	Insert(ctx context.Context, property entity.Property) (err error)
-	Update(ctx context.Context, id string, property entity.Property) (err error)
-	Delete(ctx context.Context, id string) (err error)
+	FindByID(ctx context.Context, id string) (property entity.Property, err error)
+	Update(ctx context.Context, id string, version int, property entity.Property) (err error)
+	Delete(ctx context.Context, id string, version int) (err error)
 }
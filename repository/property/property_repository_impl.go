@@ -10,6 +10,7 @@ import (
 	"github.com/erikrios/reog-apps-apis/utils/logging"
 	"github.com/jackc/pgconn"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type propertyRepositoryImpl struct {
@@ -39,35 +40,136 @@ func (p *propertyRepositoryImpl) Insert(ctx context.Context, property entity.Pro
 	return
 }
 
-func (p *propertyRepositoryImpl) Update(ctx context.Context, id string, property entity.Property) (err error) {
-	if result := p.db.WithContext(ctx).Where("id = ?", id).UpdateColumns(&property); result.Error != nil {
+func (p *propertyRepositoryImpl) FindByID(ctx context.Context, id string) (property entity.Property, err error) {
+	if dbErr := p.db.WithContext(ctx).First(&property, "id = ?", id).Error; dbErr != nil {
+		if errors.Is(dbErr, gorm.ErrRecordNotFound) {
+			err = repository.ErrRecordNotFound
+			return
+		}
+
 		go func(logger logging.Logging, message string) {
 			logger.Error(message)
-		}(p.logger, result.Error.Error())
-
-		log.Println(result.Error)
+		}(p.logger, dbErr.Error())
 
+		log.Println(dbErr)
 		err = repository.ErrDatabase
-	} else {
-		if result.RowsAffected < 1 {
+	}
+	return
+}
+
+// Update only updates the property while it still has the given version, and increments its version. A new amount
+// is checked against the items still out on loan, with the property locked as by loanRepositoryImpl.Insert.
+func (p *propertyRepositoryImpl) Update(ctx context.Context, id string, version int, property entity.Property) (err error) {
+	property.Version = version + 1
+
+	err = p.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if property.Amount > 0 {
+			onLoan, lockErr := p.lockOnLoan(tx, id)
+			if lockErr != nil {
+				return lockErr
+			}
+
+			if int(property.Amount) < onLoan {
+				return repository.ErrNotEnoughQuantity
+			}
+		}
+
+		if result := tx.Where("id = ? AND version = ?", id, version).UpdateColumns(&property); result.Error != nil {
+			go func(logger logging.Logging, message string) {
+				logger.Error(message)
+			}(p.logger, result.Error.Error())
+
+			log.Println(result.Error)
+			return repository.ErrDatabase
+		} else if result.RowsAffected < 1 {
+			return p.notFoundOrModified(ctx, id)
+		}
+		return nil
+	})
+	return
+}
+
+// Delete only deletes the property while it still has the given version, and none of its items are out on loan.
+// Otherwise, ErrRecordReferenced is returned.
+func (p *propertyRepositoryImpl) Delete(ctx context.Context, id string, version int) (err error) {
+	err = p.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		onLoan, lockErr := p.lockOnLoan(tx, id)
+		if lockErr != nil {
+			return lockErr
+		}
+
+		if onLoan > 0 {
+			return repository.ErrRecordReferenced
+		}
+
+		if result := tx.Delete(&entity.Property{}, "id = ? AND version = ?", id, version); result.Error != nil {
+			go func(logger logging.Logging, message string) {
+				logger.Error(message)
+			}(p.logger, result.Error.Error())
+
+			log.Println(result.Error)
+			return repository.ErrDatabase
+		} else if result.RowsAffected < 1 {
+			return p.notFoundOrModified(ctx, id)
+		}
+		return nil
+	})
+	return
+}
+
+// lockOnLoan locks the property until tx ends, so no loan can be inserted in the meantime, and returns the quantity
+// of its items still out on loan.
+func (p *propertyRepositoryImpl) lockOnLoan(tx *gorm.DB, id string) (onLoan int, err error) {
+	var property entity.Property
+	if dbErr := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Select("id").
+		First(&property, "id = ?", id).Error; dbErr != nil {
+		if errors.Is(dbErr, gorm.ErrRecordNotFound) {
 			err = repository.ErrRecordNotFound
+			return
 		}
+
+		go func(logger logging.Logging, message string) {
+			logger.Error(message)
+		}(p.logger, dbErr.Error())
+
+		log.Println(dbErr)
+		err = repository.ErrDatabase
+		return
+	}
+
+	if dbErr := tx.Model(&entity.Loan{}).
+		Select("COALESCE(SUM(quantity), 0)").
+		Where("property_id = ? AND returned_on IS NULL", id).
+		Scan(&onLoan).Error; dbErr != nil {
+		go func(logger logging.Logging, message string) {
+			logger.Error(message)
+		}(p.logger, dbErr.Error())
+
+		log.Println(dbErr)
+		err = repository.ErrDatabase
 	}
 	return
 }
 
-func (p *propertyRepositoryImpl) Delete(ctx context.Context, id string) (err error) {
-	if result := p.db.WithContext(ctx).Delete(&entity.Property{}, "id = ?", id); result.Error != nil {
+// notFoundOrModified tells why a write guarded by the version of the property affected no row: either the property
+// does not exist, or it has been modified since the version was read.
+func (p *propertyRepositoryImpl) notFoundOrModified(ctx context.Context, id string) (err error) {
+	var count int64
+	if dbErr := p.db.WithContext(ctx).Model(&entity.Property{}).Where("id = ?", id).Count(&count).Error; dbErr != nil {
 		go func(logger logging.Logging, message string) {
 			logger.Error(message)
-		}(p.logger, result.Error.Error())
+		}(p.logger, dbErr.Error())
 
-		log.Println(result.Error)
+		log.Println(dbErr)
 		err = repository.ErrDatabase
+		return
+	}
+
+	if count < 1 {
+		err = repository.ErrRecordNotFound
 	} else {
-		if result.RowsAffected < 1 {
-			err = repository.ErrRecordNotFound
-		}
+		err = repository.ErrRecordModified
 	}
 	return
 }
@@ -6,4 +6,8 @@ var (
 	ErrRecordNotFound      = errors.New("repository: record with given params not found")
 	ErrDatabase            = errors.New("repository: something wrong with the database")
 	ErrRecordAlreadyExists = errors.New("repository: record already exists")
+	ErrRecordReferenced    = errors.New("repository: record is still referenced by other records")
+	ErrRecordModified      = errors.New("repository: record has been modified since it was read")
+	ErrNotEnoughQuantity   = errors.New("repository: not enough quantity left")
+	ErrQuotaExceeded       = errors.New("repository: quota exceeded")
 )
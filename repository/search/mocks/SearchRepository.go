@@ -0,0 +1,38 @@
+// Code generated by mockery v2.10.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	search "github.com/erikrios/reog-apps-apis/repository/search"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// SearchRepository is an autogenerated mock type for the SearchRepository type
+type SearchRepository struct {
+	mock.Mock
+}
+
+// Search provides a mock function with given fields: ctx, query, limit
+func (_m *SearchRepository) Search(ctx context.Context, query string, limit int) ([]search.Result, error) {
+	ret := _m.Called(ctx, query, limit)
+
+	var r0 []search.Result
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) []search.Result); ok {
+		r0 = rf(ctx, query, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]search.Result)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, int) error); ok {
+		r1 = rf(ctx, query, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
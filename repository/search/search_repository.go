@@ -0,0 +1,26 @@
+package search
+
+import "context"
+
+type SearchRepository interface {
+	Search(ctx context.Context, query string, limit int) (results []Result, err error)
+}
+
+const (
+	ResultTypeGroup        = "group"
+	ResultTypeAddress      = "address"
+	ResultTypeProperty     = "property"
+	ResultTypeShowSchedule = "show_schedule"
+)
+
+// Result is a record matching the search query. GroupID is the ID of the record itself for groups and addresses.
+// Snippet is the HTML escaped matching text with the matched words wrapped in <mark> tags.
+type Result struct {
+	Type      string
+	ID        string
+	GroupID   string
+	GroupName string
+	Title     string
+	Snippet   string
+	Rank      float64
+}
@@ -0,0 +1,91 @@
+package search
+
+import (
+	"context"
+	"html"
+	"log"
+	"strings"
+
+	"github.com/erikrios/reog-apps-apis/repository"
+	"github.com/erikrios/reog-apps-apis/utils/logging"
+	"gorm.io/gorm"
+)
+
+// The documents below are indexed by config.MigratePostgreSQLDatabase, any change has to be made there as well.
+const (
+	groupDocument        = "groups.name || ' ' || groups.leader"
+	addressDocument      = "addresses.address || ' ' || addresses.village_name || ' ' || addresses.district_name"
+	propertyDocument     = "properties.name || ' ' || properties.description"
+	showScheduleDocument = "show_schedules.place"
+)
+
+// The matched words are delimited with control characters, removed from the text beforehand, so that the text can
+// be HTML escaped before the delimiters are replaced with <mark> tags.
+const (
+	startSel        = "\x02"
+	stopSel         = "\x03"
+	headlineOptions = "StartSel=" + startSel + ", StopSel=" + stopSel + ", MaxWords=20, MinWords=5, MaxFragments=2"
+)
+
+var highlighter = strings.NewReplacer(startSel, "<mark>", stopSel, "</mark>")
+
+const searchQuery = `
+WITH q AS (SELECT websearch_to_tsquery('simple', @query) AS query)
+SELECT 'group' AS type, groups.id, groups.id AS group_id, groups.name AS group_name, groups.name AS title,
+	ts_headline('simple', translate(` + groupDocument + `, @delimiters, ''), q.query, @options) AS snippet,
+	ts_rank(to_tsvector('simple', ` + groupDocument + `), q.query) AS rank
+FROM groups, q
+WHERE groups.deleted_at IS NULL AND to_tsvector('simple', ` + groupDocument + `) @@ q.query
+UNION ALL
+SELECT 'address', addresses.id, groups.id, groups.name, groups.name,
+	ts_headline('simple', translate(` + addressDocument + `, @delimiters, ''), q.query, @options),
+	ts_rank(to_tsvector('simple', ` + addressDocument + `), q.query)
+FROM addresses JOIN groups ON groups.id = addresses.id AND groups.deleted_at IS NULL, q
+WHERE addresses.deleted_at IS NULL AND to_tsvector('simple', ` + addressDocument + `) @@ q.query
+UNION ALL
+SELECT 'property', properties.id, groups.id, groups.name, properties.name,
+	ts_headline('simple', translate(` + propertyDocument + `, @delimiters, ''), q.query, @options),
+	ts_rank(to_tsvector('simple', ` + propertyDocument + `), q.query)
+FROM properties JOIN groups ON groups.id = properties.group_id AND groups.deleted_at IS NULL, q
+WHERE properties.deleted_at IS NULL AND to_tsvector('simple', ` + propertyDocument + `) @@ q.query
+UNION ALL
+SELECT 'show_schedule', show_schedules.id, groups.id, groups.name, show_schedules.place,
+	ts_headline('simple', translate(` + showScheduleDocument + `, @delimiters, ''), q.query, @options),
+	ts_rank(to_tsvector('simple', ` + showScheduleDocument + `), q.query)
+FROM show_schedules JOIN groups ON groups.id = show_schedules.group_id AND groups.deleted_at IS NULL, q
+WHERE show_schedules.deleted_at IS NULL AND to_tsvector('simple', ` + showScheduleDocument + `) @@ q.query
+ORDER BY rank DESC, type, id
+LIMIT @limit`
+
+type searchRepositoryImpl struct {
+	db     *gorm.DB
+	logger logging.Logging
+}
+
+func NewSearchRepositoryImpl(db *gorm.DB, logger logging.Logging) *searchRepositoryImpl {
+	return &searchRepositoryImpl{db: db, logger: logger}
+}
+
+// Search matches the query, written in the web search syntax of PostgreSQL, against the full-text indexes of groups,
+// addresses, properties and show schedules. Records of deleted groups are left out.
+func (s *searchRepositoryImpl) Search(ctx context.Context, query string, limit int) (results []Result, err error) {
+	if dbErr := s.db.WithContext(ctx).Raw(searchQuery, map[string]any{
+		"query":      query,
+		"options":    headlineOptions,
+		"delimiters": startSel + stopSel,
+		"limit":      limit,
+	}).Scan(&results).Error; dbErr != nil {
+		go func(logger logging.Logging, message string) {
+			logger.Error(message)
+		}(s.logger, dbErr.Error())
+
+		log.Println(dbErr)
+		err = repository.ErrDatabase
+		return
+	}
+
+	for i := range results {
+		results[i].Snippet = highlighter.Replace(html.EscapeString(results[i].Snippet))
+	}
+	return
+}
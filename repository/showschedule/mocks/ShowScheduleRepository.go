@@ -6,6 +6,7 @@ import (
 	context "context"
 
 	entity "github.com/erikrios/reog-apps-apis/entity"
+	showschedule "github.com/erikrios/reog-apps-apis/repository/showschedule"
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -14,13 +15,13 @@ type ShowScheduleRepository struct {
 	mock.Mock
 }
 
-// Delete provides a mock function with given fields: ctx, id
-func (_m *ShowScheduleRepository) Delete(ctx context.Context, id string) error {
-	ret := _m.Called(ctx, id)
+// Delete provides a mock function with given fields: ctx, id, version
+func (_m *ShowScheduleRepository) Delete(ctx context.Context, id string, version int) error {
+	ret := _m.Called(ctx, id, version)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
-		r0 = rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) error); ok {
+		r0 = rf(ctx, id, version)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -95,6 +96,29 @@ func (_m *ShowScheduleRepository) FindByID(ctx context.Context, id string) (enti
 	return r0, r1
 }
 
+// FindUpcoming provides a mock function with given fields: ctx, filter
+func (_m *ShowScheduleRepository) FindUpcoming(ctx context.Context, filter showschedule.UpcomingFilter) ([]showschedule.UpcomingShowSchedule, error) {
+	ret := _m.Called(ctx, filter)
+
+	var r0 []showschedule.UpcomingShowSchedule
+	if rf, ok := ret.Get(0).(func(context.Context, showschedule.UpcomingFilter) []showschedule.UpcomingShowSchedule); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]showschedule.UpcomingShowSchedule)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, showschedule.UpcomingFilter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Insert provides a mock function with given fields: ctx, showSchedule
 func (_m *ShowScheduleRepository) Insert(ctx context.Context, showSchedule entity.ShowSchedule) error {
 	ret := _m.Called(ctx, showSchedule)
@@ -109,13 +133,13 @@ func (_m *ShowScheduleRepository) Insert(ctx context.Context, showSchedule entit
 	return r0
 }
 
-// Update provides a mock function with given fields: ctx, id, showSchedule
-func (_m *ShowScheduleRepository) Update(ctx context.Context, id string, showSchedule entity.ShowSchedule) error {
-	ret := _m.Called(ctx, id, showSchedule)
+// Update provides a mock function with given fields: ctx, id, version, showSchedule
+func (_m *ShowScheduleRepository) Update(ctx context.Context, id string, version int, showSchedule entity.ShowSchedule) error {
+	ret := _m.Called(ctx, id, version, showSchedule)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, entity.ShowSchedule) error); ok {
-		r0 = rf(ctx, id, showSchedule)
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, entity.ShowSchedule) error); ok {
+		r0 = rf(ctx, id, version, showSchedule)
 	} else {
 		r0 = ret.Error(0)
 	}
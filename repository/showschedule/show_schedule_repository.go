@@ -2,6 +2,7 @@ package showschedule
 
 import (
 	"context"
+	"time"
 
 	"github.com/erikrios/reog-apps-apis/entity"
 )
@@ -11,6 +12,24 @@ type ShowScheduleRepository interface {
 	FindAll(ctx context.Context) (showSchedules []entity.ShowSchedule, err error)
 	FindByID(ctx context.Context, id string) (showSchedule entity.ShowSchedule, err error)
 	FindByGroupID(ctx context.Context, groupID string) (showSchedules []entity.ShowSchedule, err error)
-	Update(ctx context.Context, id string, showSchedule entity.ShowSchedule) (err error)
-	Delete(ctx context.Context, id string) (err error)
+	FindUpcoming(ctx context.Context, filter UpcomingFilter) (showSchedules []UpcomingShowSchedule, err error)
+	Update(ctx context.Context, id string, version int, showSchedule entity.ShowSchedule) (err error)
+	Delete(ctx context.Context, id string, version int) (err error)
+}
+
+// UpcomingFilter narrows down the show schedules returned by FindUpcoming. Empty IDs and a zero Limit don't filter.
+type UpcomingFilter struct {
+	// From excludes the show schedules finished before it
+	From       time.Time
+	GroupID    string
+	DistrictID string
+	Limit      int
+}
+
+// UpcomingShowSchedule is a show schedule with the name and location of its group.
+type UpcomingShowSchedule struct {
+	entity.ShowSchedule `gorm:"embedded"`
+	GroupName           string
+	VillageName         string
+	DistrictName        string
 }
@@ -83,8 +83,42 @@ func (s *showScheduleRepositoryImpl) FindByGroupID(ctx context.Context, groupID
 	return
 }
 
-func (s *showScheduleRepositoryImpl) Update(ctx context.Context, id string, showSchedule entity.ShowSchedule) (err error) {
-	if result := s.db.WithContext(ctx).Where("id = ?", id).UpdateColumns(&showSchedule); result.Error != nil {
+// FindUpcoming finds the show schedules of the active groups that are not deleted, from the earliest one. The shows
+// still going on at filter.From are included.
+func (s *showScheduleRepositoryImpl) FindUpcoming(ctx context.Context, filter UpcomingFilter) (showSchedules []UpcomingShowSchedule, err error) {
+	query := s.db.WithContext(ctx).
+		Model(&entity.ShowSchedule{}).
+		Select("show_schedules.*, groups.name AS group_name, addresses.village_name, addresses.district_name").
+		Joins("JOIN groups ON groups.id = show_schedules.group_id AND groups.deleted_at IS NULL AND groups.status = ?", entity.GroupStatusActive).
+		Joins("JOIN addresses ON addresses.id = groups.id AND addresses.deleted_at IS NULL").
+		Where("show_schedules.finish_on >= ?", filter.From)
+
+	if filter.GroupID != "" {
+		query = query.Where("show_schedules.group_id = ?", filter.GroupID)
+	}
+	if filter.DistrictID != "" {
+		query = query.Where("addresses.district_id = ?", filter.DistrictID)
+	}
+	if filter.Limit > 0 {
+		query = query.Limit(filter.Limit)
+	}
+
+	if dbErr := query.Order("show_schedules.start_on, show_schedules.id").Scan(&showSchedules).Error; dbErr != nil {
+		go func(logger logging.Logging, message string) {
+			logger.Error(message)
+		}(s.logger, dbErr.Error())
+
+		log.Println(dbErr)
+		err = repository.ErrDatabase
+	}
+	return
+}
+
+// Update only updates the show schedule while it still has the given version, and increments its version.
+func (s *showScheduleRepositoryImpl) Update(ctx context.Context, id string, version int, showSchedule entity.ShowSchedule) (err error) {
+	showSchedule.Version = version + 1
+
+	if result := s.db.WithContext(ctx).Where("id = ? AND version = ?", id, version).UpdateColumns(&showSchedule); result.Error != nil {
 		go func(logger logging.Logging, message string) {
 			logger.Error(message)
 		}(s.logger, result.Error.Error())
@@ -93,14 +127,15 @@ func (s *showScheduleRepositoryImpl) Update(ctx context.Context, id string, show
 		err = repository.ErrDatabase
 	} else {
 		if result.RowsAffected < 1 {
-			err = repository.ErrRecordNotFound
+			err = s.notFoundOrModified(ctx, id)
 		}
 	}
 	return
 }
 
-func (s *showScheduleRepositoryImpl) Delete(ctx context.Context, id string) (err error) {
-	if result := s.db.WithContext(ctx).Delete(&entity.ShowSchedule{}, "id = ?", id); result.Error != nil {
+// Delete only deletes the show schedule while it still has the given version.
+func (s *showScheduleRepositoryImpl) Delete(ctx context.Context, id string, version int) (err error) {
+	if result := s.db.WithContext(ctx).Delete(&entity.ShowSchedule{}, "id = ? AND version = ?", id, version); result.Error != nil {
 		go func(logger logging.Logging, message string) {
 			logger.Error(message)
 		}(s.logger, result.Error.Error())
@@ -109,8 +144,30 @@ func (s *showScheduleRepositoryImpl) Delete(ctx context.Context, id string) (err
 		err = repository.ErrDatabase
 	} else {
 		if result.RowsAffected < 1 {
-			err = repository.ErrRecordNotFound
+			err = s.notFoundOrModified(ctx, id)
 		}
 	}
 	return
 }
+
+// notFoundOrModified tells why a write guarded by the version of the show schedule affected no row: either the show schedule
+// does not exist, or it has been modified since the version was read.
+func (s *showScheduleRepositoryImpl) notFoundOrModified(ctx context.Context, id string) (err error) {
+	var count int64
+	if dbErr := s.db.WithContext(ctx).Model(&entity.ShowSchedule{}).Where("id = ?", id).Count(&count).Error; dbErr != nil {
+		go func(logger logging.Logging, message string) {
+			logger.Error(message)
+		}(s.logger, dbErr.Error())
+
+		log.Println(dbErr)
+		err = repository.ErrDatabase
+		return
+	}
+
+	if count < 1 {
+		err = repository.ErrRecordNotFound
+	} else {
+		err = repository.ErrRecordModified
+	}
+	return
+}
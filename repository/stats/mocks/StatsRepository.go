@@ -0,0 +1,85 @@
+// Code generated by mockery v2.10.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	time "time"
+
+	stats "github.com/erikrios/reog-apps-apis/repository/stats"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// StatsRepository is an autogenerated mock type for the StatsRepository type
+type StatsRepository struct {
+	mock.Mock
+}
+
+// CountActiveGroupsByDistrict provides a mock function with given fields: ctx
+func (_m *StatsRepository) CountActiveGroupsByDistrict(ctx context.Context) ([]stats.DistrictCount, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []stats.DistrictCount
+	if rf, ok := ret.Get(0).(func(context.Context) []stats.DistrictCount); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]stats.DistrictCount)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CountShowSchedulesByDistrict provides a mock function with given fields: ctx, from, to
+func (_m *StatsRepository) CountShowSchedulesByDistrict(ctx context.Context, from time.Time, to time.Time) ([]stats.DistrictCount, error) {
+	ret := _m.Called(ctx, from, to)
+
+	var r0 []stats.DistrictCount
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, time.Time) []stats.DistrictCount); ok {
+		r0 = rf(ctx, from, to)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]stats.DistrictCount)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, time.Time) error); ok {
+		r1 = rf(ctx, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SumPropertyAmountsByDistrict provides a mock function with given fields: ctx
+func (_m *StatsRepository) SumPropertyAmountsByDistrict(ctx context.Context) ([]stats.DistrictPropertyAmount, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []stats.DistrictPropertyAmount
+	if rf, ok := ret.Get(0).(func(context.Context) []stats.DistrictPropertyAmount); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]stats.DistrictPropertyAmount)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
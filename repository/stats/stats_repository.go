@@ -0,0 +1,26 @@
+package stats
+
+import (
+	"context"
+	"time"
+)
+
+type StatsRepository interface {
+	CountActiveGroupsByDistrict(ctx context.Context) (counts []DistrictCount, err error)
+	SumPropertyAmountsByDistrict(ctx context.Context) (amounts []DistrictPropertyAmount, err error)
+	CountShowSchedulesByDistrict(ctx context.Context, from, to time.Time) (counts []DistrictCount, err error)
+}
+
+type DistrictCount struct {
+	DistrictID   string
+	DistrictName string
+	Count        int
+}
+
+// DistrictPropertyAmount is the total amount of the properties with the same name in a district.
+type DistrictPropertyAmount struct {
+	DistrictID   string
+	DistrictName string
+	Name         string
+	Amount       int
+}
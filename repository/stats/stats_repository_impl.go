@@ -0,0 +1,80 @@
+package stats
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/erikrios/reog-apps-apis/entity"
+	"github.com/erikrios/reog-apps-apis/repository"
+	"github.com/erikrios/reog-apps-apis/utils/logging"
+	"gorm.io/gorm"
+)
+
+type statsRepositoryImpl struct {
+	db     *gorm.DB
+	logger logging.Logging
+}
+
+func NewStatsRepositoryImpl(db *gorm.DB, logger logging.Logging) *statsRepositoryImpl {
+	return &statsRepositoryImpl{db: db, logger: logger}
+}
+
+func (s *statsRepositoryImpl) CountActiveGroupsByDistrict(ctx context.Context) (counts []DistrictCount, err error) {
+	if dbErr := s.db.WithContext(ctx).
+		Model(&entity.Address{}).
+		Select("addresses.district_id, addresses.district_name, COUNT(*) AS count").
+		Joins("JOIN groups ON groups.id = addresses.id AND groups.deleted_at IS NULL").
+		Where("groups.status = ?", entity.GroupStatusActive).
+		Group("addresses.district_id, addresses.district_name").
+		Scan(&counts).Error; dbErr != nil {
+		go func(logger logging.Logging, message string) {
+			logger.Error(message)
+		}(s.logger, dbErr.Error())
+
+		log.Println(dbErr)
+		err = repository.ErrDatabase
+	}
+	return
+}
+
+// SumPropertyAmountsByDistrict sums the property amounts of the groups by district and property name. The names are
+// compared case-insensitively, ignoring the surrounding spaces, as they are typed in by hand.
+func (s *statsRepositoryImpl) SumPropertyAmountsByDistrict(ctx context.Context) (amounts []DistrictPropertyAmount, err error) {
+	if dbErr := s.db.WithContext(ctx).
+		Model(&entity.Property{}).
+		Select("addresses.district_id, addresses.district_name, MIN(TRIM(properties.name)) AS name, SUM(properties.amount) AS amount").
+		Joins("JOIN groups ON groups.id = properties.group_id AND groups.deleted_at IS NULL").
+		Joins("JOIN addresses ON addresses.id = groups.id AND addresses.deleted_at IS NULL").
+		Group("addresses.district_id, addresses.district_name, LOWER(TRIM(properties.name))").
+		Order("MIN(TRIM(properties.name))").
+		Scan(&amounts).Error; dbErr != nil {
+		go func(logger logging.Logging, message string) {
+			logger.Error(message)
+		}(s.logger, dbErr.Error())
+
+		log.Println(dbErr)
+		err = repository.ErrDatabase
+	}
+	return
+}
+
+// CountShowSchedulesByDistrict counts the show schedules starting from from and before to.
+func (s *statsRepositoryImpl) CountShowSchedulesByDistrict(ctx context.Context, from, to time.Time) (counts []DistrictCount, err error) {
+	if dbErr := s.db.WithContext(ctx).
+		Model(&entity.ShowSchedule{}).
+		Select("addresses.district_id, addresses.district_name, COUNT(*) AS count").
+		Joins("JOIN groups ON groups.id = show_schedules.group_id AND groups.deleted_at IS NULL").
+		Joins("JOIN addresses ON addresses.id = groups.id AND addresses.deleted_at IS NULL").
+		Where("show_schedules.start_on >= ? AND show_schedules.start_on < ?", from, to).
+		Group("addresses.district_id, addresses.district_name").
+		Scan(&counts).Error; dbErr != nil {
+		go func(logger logging.Logging, message string) {
+			logger.Error(message)
+		}(s.logger, dbErr.Error())
+
+		log.Println(dbErr)
+		err = repository.ErrDatabase
+	}
+	return
+}
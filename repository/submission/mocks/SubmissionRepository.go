@@ -0,0 +1,103 @@
+// Code generated by mockery v2.10.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	entity "github.com/erikrios/reog-apps-apis/entity"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// SubmissionRepository is an autogenerated mock type for the SubmissionRepository type
+type SubmissionRepository struct {
+	mock.Mock
+}
+
+// Claim provides a mock function with given fields: ctx, id
+func (_m *SubmissionRepository) Claim(ctx context.Context, id string) error {
+	ret := _m.Called(ctx, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FindAll provides a mock function with given fields: ctx, status
+func (_m *SubmissionRepository) FindAll(ctx context.Context, status string) ([]entity.GroupSubmission, error) {
+	ret := _m.Called(ctx, status)
+
+	var r0 []entity.GroupSubmission
+	if rf, ok := ret.Get(0).(func(context.Context, string) []entity.GroupSubmission); ok {
+		r0 = rf(ctx, status)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.GroupSubmission)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, status)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindByID provides a mock function with given fields: ctx, id
+func (_m *SubmissionRepository) FindByID(ctx context.Context, id string) (entity.GroupSubmission, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 entity.GroupSubmission
+	if rf, ok := ret.Get(0).(func(context.Context, string) entity.GroupSubmission); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(entity.GroupSubmission)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Insert provides a mock function with given fields: ctx, _a1, quota, since
+func (_m *SubmissionRepository) Insert(ctx context.Context, _a1 entity.GroupSubmission, quota int64, since time.Time) error {
+	ret := _m.Called(ctx, _a1, quota, since)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.GroupSubmission, int64, time.Time) error); ok {
+		r0 = rf(ctx, _a1, quota, since)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Review provides a mock function with given fields: ctx, _a1, from
+func (_m *SubmissionRepository) Review(ctx context.Context, _a1 entity.GroupSubmission, from string) error {
+	ret := _m.Called(ctx, _a1, from)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.GroupSubmission, string) error); ok {
+		r0 = rf(ctx, _a1, from)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
@@ -0,0 +1,16 @@
+package submission
+
+import (
+	"context"
+	"time"
+
+	"github.com/erikrios/reog-apps-apis/entity"
+)
+
+type SubmissionRepository interface {
+	Insert(ctx context.Context, submission entity.GroupSubmission, quota int64, since time.Time) (err error)
+	FindAll(ctx context.Context, status string) (submissions []entity.GroupSubmission, err error)
+	FindByID(ctx context.Context, id string) (submission entity.GroupSubmission, err error)
+	Claim(ctx context.Context, id string) (err error)
+	Review(ctx context.Context, submission entity.GroupSubmission, from string) (err error)
+}
@@ -0,0 +1,148 @@
+package submission
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/erikrios/reog-apps-apis/entity"
+	"github.com/erikrios/reog-apps-apis/repository"
+	"github.com/erikrios/reog-apps-apis/utils/logging"
+	"github.com/jackc/pgconn"
+	"gorm.io/gorm"
+)
+
+type submissionRepositoryImpl struct {
+	db     *gorm.DB
+	logger logging.Logging
+}
+
+func NewSubmissionRepositoryImpl(db *gorm.DB, logger logging.Logging) *submissionRepositoryImpl {
+	return &submissionRepositoryImpl{db: db, logger: logger}
+}
+
+// Insert inserts the submission unless its IP address already sent quota submissions since the given time. The
+// submissions of an IP address are counted and inserted under a lock on that address, so concurrent submissions
+// can't go over the quota together.
+func (s *submissionRepositoryImpl) Insert(ctx context.Context, submission entity.GroupSubmission, quota int64, since time.Time) (err error) {
+	err = s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if dbErr := tx.Exec("SELECT pg_advisory_xact_lock(hashtext(?))", submission.SubmitterIP).Error; dbErr != nil {
+			go func(logger logging.Logging, message string) {
+				logger.Error(message)
+			}(s.logger, dbErr.Error())
+
+			log.Println(dbErr)
+			return repository.ErrDatabase
+		}
+
+		var count int64
+		if dbErr := tx.Model(&entity.GroupSubmission{}).
+			Where("submitter_ip = ? AND created_at >= ?", submission.SubmitterIP, since).
+			Count(&count).Error; dbErr != nil {
+			go func(logger logging.Logging, message string) {
+				logger.Error(message)
+			}(s.logger, dbErr.Error())
+
+			log.Println(dbErr)
+			return repository.ErrDatabase
+		}
+
+		if count >= quota {
+			return repository.ErrQuotaExceeded
+		}
+
+		if dbErr := tx.Create(&submission).Error; dbErr != nil {
+			var pqErr *pgconn.PgError
+			if ok := errors.As(dbErr, &pqErr); ok && pqErr.Code == "23505" {
+				return repository.ErrRecordAlreadyExists
+			}
+
+			go func(logger logging.Logging, message string) {
+				logger.Error(message)
+			}(s.logger, dbErr.Error())
+
+			log.Println(dbErr)
+			return repository.ErrDatabase
+		}
+		return nil
+	})
+	return
+}
+
+// FindAll finds the submissions with the given status, or all of them when status is empty, the oldest first.
+func (s *submissionRepositoryImpl) FindAll(ctx context.Context, status string) (submissions []entity.GroupSubmission, err error) {
+	query := s.db.WithContext(ctx)
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+
+	if dbErr := query.Order("created_at, id").Find(&submissions).Error; dbErr != nil {
+		go func(logger logging.Logging, message string) {
+			logger.Error(message)
+		}(s.logger, dbErr.Error())
+
+		log.Println(dbErr)
+		err = repository.ErrDatabase
+	}
+	return
+}
+
+func (s *submissionRepositoryImpl) FindByID(ctx context.Context, id string) (submission entity.GroupSubmission, err error) {
+	if dbErr := s.db.WithContext(ctx).First(&submission, "id = ?", id).Error; dbErr != nil {
+		if errors.Is(dbErr, gorm.ErrRecordNotFound) {
+			err = repository.ErrRecordNotFound
+			return
+		}
+
+		go func(logger logging.Logging, message string) {
+			logger.Error(message)
+		}(s.logger, dbErr.Error())
+
+		log.Println(dbErr)
+		err = repository.ErrDatabase
+	}
+	return
+}
+
+// Claim marks a pending submission as being approved, so that no other admin can approve or reject it meanwhile.
+// A submission that is no longer pending is reported as not found.
+func (s *submissionRepositoryImpl) Claim(ctx context.Context, id string) (err error) {
+	if result := s.db.WithContext(ctx).
+		Model(&entity.GroupSubmission{}).
+		Where("id = ? AND status = ?", id, entity.SubmissionStatusPending).
+		UpdateColumn("status", entity.SubmissionStatusApproving); result.Error != nil {
+		go func(logger logging.Logging, message string) {
+			logger.Error(message)
+		}(s.logger, result.Error.Error())
+
+		log.Println(result.Error)
+		err = repository.ErrDatabase
+	} else {
+		if result.RowsAffected < 1 {
+			err = repository.ErrRecordNotFound
+		}
+	}
+	return
+}
+
+// Review records the decision on a submission. It only updates a submission still in the from status, so that a
+// submission reviewed concurrently by another admin is reported as not found.
+func (s *submissionRepositoryImpl) Review(ctx context.Context, submission entity.GroupSubmission, from string) (err error) {
+	if result := s.db.WithContext(ctx).
+		Select("status", "group_id", "rejection_reason", "reviewed_by", "reviewed_at").
+		Where("id = ? AND status = ?", submission.ID, from).
+		UpdateColumns(&submission); result.Error != nil {
+		go func(logger logging.Logging, message string) {
+			logger.Error(message)
+		}(s.logger, result.Error.Error())
+
+		log.Println(result.Error)
+		err = repository.ErrDatabase
+	} else {
+		if result.RowsAffected < 1 {
+			err = repository.ErrRecordNotFound
+		}
+	}
+	return
+}
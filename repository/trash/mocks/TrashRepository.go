@@ -0,0 +1,231 @@
+// Code generated by mockery v2.10.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	entity "github.com/erikrios/reog-apps-apis/entity"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// TrashRepository is an autogenerated mock type for the TrashRepository type
+type TrashRepository struct {
+	mock.Mock
+}
+
+// FindDeletedGroupByID provides a mock function with given fields: ctx, id
+func (_m *TrashRepository) FindDeletedGroupByID(ctx context.Context, id string) (entity.Group, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 entity.Group
+	if rf, ok := ret.Get(0).(func(context.Context, string) entity.Group); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(entity.Group)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindDeletedGroups provides a mock function with given fields: ctx
+func (_m *TrashRepository) FindDeletedGroups(ctx context.Context) ([]entity.Group, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []entity.Group
+	if rf, ok := ret.Get(0).(func(context.Context) []entity.Group); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Group)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindDeletedProperties provides a mock function with given fields: ctx
+func (_m *TrashRepository) FindDeletedProperties(ctx context.Context) ([]entity.Property, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []entity.Property
+	if rf, ok := ret.Get(0).(func(context.Context) []entity.Property); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Property)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindDeletedPropertyByID provides a mock function with given fields: ctx, id
+func (_m *TrashRepository) FindDeletedPropertyByID(ctx context.Context, id string) (entity.Property, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 entity.Property
+	if rf, ok := ret.Get(0).(func(context.Context, string) entity.Property); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(entity.Property)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindDeletedShowScheduleByID provides a mock function with given fields: ctx, id
+func (_m *TrashRepository) FindDeletedShowScheduleByID(ctx context.Context, id string) (entity.ShowSchedule, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 entity.ShowSchedule
+	if rf, ok := ret.Get(0).(func(context.Context, string) entity.ShowSchedule); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(entity.ShowSchedule)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindDeletedShowSchedules provides a mock function with given fields: ctx
+func (_m *TrashRepository) FindDeletedShowSchedules(ctx context.Context) ([]entity.ShowSchedule, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []entity.ShowSchedule
+	if rf, ok := ret.Get(0).(func(context.Context) []entity.ShowSchedule); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.ShowSchedule)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PurgeGroup provides a mock function with given fields: ctx, id
+func (_m *TrashRepository) PurgeGroup(ctx context.Context, id string) error {
+	ret := _m.Called(ctx, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PurgeProperty provides a mock function with given fields: ctx, id
+func (_m *TrashRepository) PurgeProperty(ctx context.Context, id string) error {
+	ret := _m.Called(ctx, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PurgeShowSchedule provides a mock function with given fields: ctx, id
+func (_m *TrashRepository) PurgeShowSchedule(ctx context.Context, id string) error {
+	ret := _m.Called(ctx, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RestoreGroup provides a mock function with given fields: ctx, id
+func (_m *TrashRepository) RestoreGroup(ctx context.Context, id string) error {
+	ret := _m.Called(ctx, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RestoreProperty provides a mock function with given fields: ctx, id
+func (_m *TrashRepository) RestoreProperty(ctx context.Context, id string) error {
+	ret := _m.Called(ctx, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RestoreShowSchedule provides a mock function with given fields: ctx, id
+func (_m *TrashRepository) RestoreShowSchedule(ctx context.Context, id string) error {
+	ret := _m.Called(ctx, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
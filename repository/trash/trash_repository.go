@@ -0,0 +1,23 @@
+package trash
+
+import (
+	"context"
+
+	"github.com/erikrios/reog-apps-apis/entity"
+)
+
+// TrashRepository reaches the soft-deleted groups, properties and show schedules.
+type TrashRepository interface {
+	FindDeletedGroups(ctx context.Context) (groups []entity.Group, err error)
+	FindDeletedGroupByID(ctx context.Context, id string) (group entity.Group, err error)
+	FindDeletedProperties(ctx context.Context) (properties []entity.Property, err error)
+	FindDeletedPropertyByID(ctx context.Context, id string) (property entity.Property, err error)
+	FindDeletedShowSchedules(ctx context.Context) (showSchedules []entity.ShowSchedule, err error)
+	FindDeletedShowScheduleByID(ctx context.Context, id string) (showSchedule entity.ShowSchedule, err error)
+	RestoreGroup(ctx context.Context, id string) (err error)
+	RestoreProperty(ctx context.Context, id string) (err error)
+	RestoreShowSchedule(ctx context.Context, id string) (err error)
+	PurgeGroup(ctx context.Context, id string) (err error)
+	PurgeProperty(ctx context.Context, id string) (err error)
+	PurgeShowSchedule(ctx context.Context, id string) (err error)
+}
@@ -0,0 +1,354 @@
+package trash
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"github.com/erikrios/reog-apps-apis/entity"
+	"github.com/erikrios/reog-apps-apis/repository"
+	"github.com/erikrios/reog-apps-apis/utils/logging"
+	"gorm.io/gorm"
+)
+
+type trashRepositoryImpl struct {
+	db     *gorm.DB
+	logger logging.Logging
+}
+
+func NewTrashRepositoryImpl(db *gorm.DB, logger logging.Logging) *trashRepositoryImpl {
+	return &trashRepositoryImpl{db: db, logger: logger}
+}
+
+func (t *trashRepositoryImpl) FindDeletedGroups(ctx context.Context) (groups []entity.Group, err error) {
+	if dbErr := t.deletedGroups(ctx).Order("groups.deleted_at DESC").Find(&groups).Error; dbErr != nil {
+		go func(logger logging.Logging, message string) {
+			logger.Error(message)
+		}(t.logger, dbErr.Error())
+
+		log.Println(dbErr)
+		err = repository.ErrDatabase
+	}
+	return
+}
+
+func (t *trashRepositoryImpl) FindDeletedGroupByID(ctx context.Context, id string) (group entity.Group, err error) {
+	if dbErr := t.deletedGroups(ctx).First(&group, "groups.id = ?", id).Error; dbErr != nil {
+		if errors.Is(dbErr, gorm.ErrRecordNotFound) {
+			err = repository.ErrRecordNotFound
+			return
+		}
+
+		go func(logger logging.Logging, message string) {
+			logger.Error(message)
+		}(t.logger, dbErr.Error())
+
+		log.Println(dbErr)
+		err = repository.ErrDatabase
+	}
+	return
+}
+
+func (t *trashRepositoryImpl) FindDeletedProperties(ctx context.Context) (properties []entity.Property, err error) {
+	if dbErr := t.deletedProperties(ctx).Order("properties.deleted_at DESC").Find(&properties).Error; dbErr != nil {
+		go func(logger logging.Logging, message string) {
+			logger.Error(message)
+		}(t.logger, dbErr.Error())
+
+		log.Println(dbErr)
+		err = repository.ErrDatabase
+	}
+	return
+}
+
+func (t *trashRepositoryImpl) FindDeletedPropertyByID(ctx context.Context, id string) (property entity.Property, err error) {
+	if dbErr := t.deletedProperties(ctx).First(&property, "properties.id = ?", id).Error; dbErr != nil {
+		if errors.Is(dbErr, gorm.ErrRecordNotFound) {
+			err = repository.ErrRecordNotFound
+			return
+		}
+
+		go func(logger logging.Logging, message string) {
+			logger.Error(message)
+		}(t.logger, dbErr.Error())
+
+		log.Println(dbErr)
+		err = repository.ErrDatabase
+	}
+	return
+}
+
+func (t *trashRepositoryImpl) FindDeletedShowSchedules(ctx context.Context) (showSchedules []entity.ShowSchedule, err error) {
+	if dbErr := t.db.WithContext(ctx).
+		Unscoped().
+		Where("deleted_at IS NOT NULL").
+		Order("deleted_at DESC").
+		Find(&showSchedules).Error; dbErr != nil {
+		go func(logger logging.Logging, message string) {
+			logger.Error(message)
+		}(t.logger, dbErr.Error())
+
+		log.Println(dbErr)
+		err = repository.ErrDatabase
+	}
+	return
+}
+
+func (t *trashRepositoryImpl) FindDeletedShowScheduleByID(ctx context.Context, id string) (showSchedule entity.ShowSchedule, err error) {
+	if dbErr := t.db.WithContext(ctx).
+		Unscoped().
+		Where("deleted_at IS NOT NULL").
+		First(&showSchedule, "id = ?", id).Error; dbErr != nil {
+		if errors.Is(dbErr, gorm.ErrRecordNotFound) {
+			err = repository.ErrRecordNotFound
+			return
+		}
+
+		go func(logger logging.Logging, message string) {
+			logger.Error(message)
+		}(t.logger, dbErr.Error())
+
+		log.Println(dbErr)
+		err = repository.ErrDatabase
+	}
+	return
+}
+
+// RestoreGroup restores the group together with the address, properties, members, achievements and show schedules
+// that were deleted with it. The show schedules detached from it stay detached.
+// The children are soft-deleted right after the group in the same transaction, so those deleted at or after
+// the group are the cascaded ones, while those deleted on their own before stay in the trash.
+func (t *trashRepositoryImpl) RestoreGroup(ctx context.Context, id string) (err error) {
+	err = t.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var group entity.Group
+		if dbErr := tx.Unscoped().Where("deleted_at IS NOT NULL").First(&group, "id = ?", id).Error; dbErr != nil {
+			if errors.Is(dbErr, gorm.ErrRecordNotFound) {
+				return repository.ErrRecordNotFound
+			}
+
+			go func(logger logging.Logging, message string) {
+				logger.Error(message)
+			}(t.logger, dbErr.Error())
+
+			log.Println(dbErr)
+			return repository.ErrDatabase
+		}
+
+		if dbErr := tx.Unscoped().Model(&entity.Group{}).Where("id = ?", id).Updates(map[string]any{"deleted_at": nil, "merged_into_id": nil}).Error; dbErr != nil {
+			go func(logger logging.Logging, message string) {
+				logger.Error(message)
+			}(t.logger, dbErr.Error())
+
+			log.Println(dbErr)
+			return repository.ErrDatabase
+		}
+
+		children := []struct {
+			model  any
+			column string
+		}{
+			{&entity.Address{}, "id"},
+			{&entity.Property{}, "group_id"},
+			{&entity.Member{}, "group_id"},
+			{&entity.Achievement{}, "group_id"},
+			{&entity.ShowSchedule{}, "group_id"},
+		}
+
+		for _, child := range children {
+			if dbErr := tx.Unscoped().
+				Model(child.model).
+				Where(child.column+" = ? AND deleted_at >= ?", id, group.DeletedAt).
+				Update("deleted_at", nil).Error; dbErr != nil {
+				go func(logger logging.Logging, message string) {
+					logger.Error(message)
+				}(t.logger, dbErr.Error())
+
+				log.Println(dbErr)
+				return repository.ErrDatabase
+			}
+		}
+
+		return nil
+	})
+
+	return
+}
+
+func (t *trashRepositoryImpl) RestoreProperty(ctx context.Context, id string) (err error) {
+	err = t.restore(ctx, &entity.Property{}, id)
+	return
+}
+
+func (t *trashRepositoryImpl) RestoreShowSchedule(ctx context.Context, id string) (err error) {
+	err = t.restore(ctx, &entity.ShowSchedule{}, id)
+	return
+}
+
+// PurgeGroup permanently deletes the group in the trash with everything that belongs to it.
+func (t *trashRepositoryImpl) PurgeGroup(ctx context.Context, id string) (err error) {
+	err = t.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var group entity.Group
+		if dbErr := tx.Unscoped().Where("deleted_at IS NOT NULL").First(&group, "id = ?", id).Error; dbErr != nil {
+			if errors.Is(dbErr, gorm.ErrRecordNotFound) {
+				return repository.ErrRecordNotFound
+			}
+
+			go func(logger logging.Logging, message string) {
+				logger.Error(message)
+			}(t.logger, dbErr.Error())
+
+			log.Println(dbErr)
+			return repository.ErrDatabase
+		}
+
+		propertyIDs := tx.Unscoped().Model(&entity.Property{}).Select("id").Where("group_id = ?", id)
+		achievementIDs := tx.Unscoped().Model(&entity.Achievement{}).Select("id").Where("group_id = ?", id)
+		maintenanceIDs := tx.Unscoped().Model(&entity.Maintenance{}).Select("id").Where("property_id IN (?)", propertyIDs)
+
+		deletions := []struct {
+			model any
+			query string
+			args  []any
+		}{
+			{&entity.Attachment{}, "owner_type = ? AND owner_id IN (?)", []any{entity.AttachmentOwnerProperty, propertyIDs}},
+			{&entity.Attachment{}, "owner_type = ? AND owner_id IN (?)", []any{entity.AttachmentOwnerAchievement, achievementIDs}},
+			{&entity.Attachment{}, "owner_type = ? AND owner_id = ?", []any{entity.AttachmentOwnerGroup, id}},
+			{&entity.Attachment{}, "owner_type = ? AND owner_id IN (?)", []any{entity.AttachmentOwnerMaintenance, maintenanceIDs}},
+			{&entity.Maintenance{}, "property_id IN (?)", []any{propertyIDs}},
+			{&entity.Loan{}, "property_id IN (?)", []any{propertyIDs}},
+			{&entity.Property{}, "group_id = ?", []any{id}},
+			{&entity.Address{}, "id = ?", []any{id}},
+			{&entity.Member{}, "group_id = ?", []any{id}},
+			{&entity.Achievement{}, "group_id = ?", []any{id}},
+			{&entity.ShowSchedule{}, "group_id = ?", []any{id}},
+			{&entity.GroupStatusTransition{}, "group_id = ?", []any{id}},
+			{&entity.LeadershipChange{}, "group_id = ?", []any{id}},
+			{&entity.Group{}, "id = ?", []any{id}},
+		}
+
+		for _, deletion := range deletions {
+			if dbErr := tx.Unscoped().Where(deletion.query, deletion.args...).Delete(deletion.model).Error; dbErr != nil {
+				go func(logger logging.Logging, message string) {
+					logger.Error(message)
+				}(t.logger, dbErr.Error())
+
+				log.Println(dbErr)
+				return repository.ErrDatabase
+			}
+		}
+
+		return nil
+	})
+
+	return
+}
+
+// PurgeProperty permanently deletes the property in the trash with its attachments, maintenance records and loans.
+func (t *trashRepositoryImpl) PurgeProperty(ctx context.Context, id string) (err error) {
+	err = t.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		maintenanceIDs := tx.Unscoped().Model(&entity.Maintenance{}).Select("id").Where("property_id = ?", id)
+
+		// The records pointing to the property go first, as the maintenance records reference it with a foreign key
+		deletions := []struct {
+			model any
+			query string
+			args  []any
+		}{
+			{&entity.Attachment{}, "owner_type = ? AND owner_id = ?", []any{entity.AttachmentOwnerProperty, id}},
+			{&entity.Attachment{}, "owner_type = ? AND owner_id IN (?)", []any{entity.AttachmentOwnerMaintenance, maintenanceIDs}},
+			{&entity.Maintenance{}, "property_id = ?", []any{id}},
+			{&entity.Loan{}, "property_id = ?", []any{id}},
+		}
+
+		for _, deletion := range deletions {
+			if dbErr := tx.Unscoped().Where(deletion.query, deletion.args...).Delete(deletion.model).Error; dbErr != nil {
+				go func(logger logging.Logging, message string) {
+					logger.Error(message)
+				}(t.logger, dbErr.Error())
+
+				log.Println(dbErr)
+				return repository.ErrDatabase
+			}
+		}
+
+		if result := tx.Unscoped().Where("id = ? AND deleted_at IS NOT NULL", id).Delete(&entity.Property{}); result.Error != nil {
+			go func(logger logging.Logging, message string) {
+				logger.Error(message)
+			}(t.logger, result.Error.Error())
+
+			log.Println(result.Error)
+			return repository.ErrDatabase
+		} else if result.RowsAffected < 1 {
+			return repository.ErrRecordNotFound
+		}
+
+		return nil
+	})
+
+	return
+}
+
+func (t *trashRepositoryImpl) PurgeShowSchedule(ctx context.Context, id string) (err error) {
+	if result := t.db.WithContext(ctx).
+		Unscoped().
+		Where("id = ? AND deleted_at IS NOT NULL", id).
+		Delete(&entity.ShowSchedule{}); result.Error != nil {
+		go func(logger logging.Logging, message string) {
+			logger.Error(message)
+		}(t.logger, result.Error.Error())
+
+		log.Println(result.Error)
+		err = repository.ErrDatabase
+	} else if result.RowsAffected < 1 {
+		err = repository.ErrRecordNotFound
+	}
+	return
+}
+
+// deletedGroups selects the groups in the trash with their deleted address and properties, and the attachments
+// and maintenance photos whose files have to be removed when the group is purged.
+func (t *trashRepositoryImpl) deletedGroups(ctx context.Context) *gorm.DB {
+	return t.db.WithContext(ctx).
+		Unscoped().
+		Where("groups.deleted_at IS NOT NULL").
+		Preload("Address", unscoped).
+		Preload("Attachments").
+		Preload("Properties", unscoped).
+		Preload("Properties.Attachments").
+		Preload("Properties.Maintenances", unscoped).
+		Preload("Properties.Maintenances.Photos").
+		Preload("Achievements", unscoped).
+		Preload("Achievements.Certificate")
+}
+
+func (t *trashRepositoryImpl) deletedProperties(ctx context.Context) *gorm.DB {
+	return t.db.WithContext(ctx).
+		Unscoped().
+		Where("properties.deleted_at IS NOT NULL").
+		Preload("Attachments").
+		Preload("Maintenances", unscoped).
+		Preload("Maintenances.Photos").
+		Preload("Loans", "returned_on IS NULL")
+}
+
+func (t *trashRepositoryImpl) restore(ctx context.Context, model any, id string) (err error) {
+	if result := t.db.WithContext(ctx).
+		Unscoped().
+		Model(model).
+		Where("id = ? AND deleted_at IS NOT NULL", id).
+		Update("deleted_at", nil); result.Error != nil {
+		go func(logger logging.Logging, message string) {
+			logger.Error(message)
+		}(t.logger, result.Error.Error())
+
+		log.Println(result.Error)
+		err = repository.ErrDatabase
+	} else if result.RowsAffected < 1 {
+		err = repository.ErrRecordNotFound
+	}
+	return
+}
+
+func unscoped(db *gorm.DB) *gorm.DB {
+	return db.Unscoped()
+}
@@ -0,0 +1,17 @@
+package achievement
+
+import (
+	"context"
+
+	"github.com/erikrios/reog-apps-apis/model/payload"
+	"github.com/erikrios/reog-apps-apis/model/response"
+)
+
+type AchievementService interface {
+	Create(ctx context.Context, groupID string, p payload.CreateAchievement) (id string, err error)
+	GetByGroupID(ctx context.Context, groupID string) (responses []response.Achievement, err error)
+	GetByID(ctx context.Context, groupID, id string) (response response.Achievement, err error)
+	Update(ctx context.Context, groupID, id string, p payload.UpdateAchievement) (err error)
+	Delete(ctx context.Context, groupID, id string) (err error)
+	GetRanking(ctx context.Context, p payload.GetAchievementRanking) (responses []response.DistrictRanking, err error)
+}
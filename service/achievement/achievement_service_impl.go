@@ -0,0 +1,184 @@
+package achievement
+
+import (
+	"context"
+	"time"
+
+	"github.com/erikrios/reog-apps-apis/entity"
+	"github.com/erikrios/reog-apps-apis/model/payload"
+	"github.com/erikrios/reog-apps-apis/model/response"
+	"github.com/erikrios/reog-apps-apis/repository/achievement"
+	"github.com/erikrios/reog-apps-apis/repository/group"
+	"github.com/erikrios/reog-apps-apis/service"
+	"github.com/erikrios/reog-apps-apis/utils/generator"
+	"gopkg.in/validator.v2"
+)
+
+type achievementServiceImpl struct {
+	achievementRepository achievement.AchievementRepository
+	groupRepository       group.GroupRepository
+	idGenerator           generator.IDGenerator
+}
+
+func NewAchievementServiceImpl(
+	achievementRepository achievement.AchievementRepository,
+	groupRepository group.GroupRepository,
+	idGenerator generator.IDGenerator,
+) *achievementServiceImpl {
+	return &achievementServiceImpl{
+		achievementRepository: achievementRepository,
+		groupRepository:       groupRepository,
+		idGenerator:           idGenerator,
+	}
+}
+
+func (a *achievementServiceImpl) Create(ctx context.Context, groupID string, p payload.CreateAchievement) (id string, err error) {
+	if validateErr := validator.Validate(p); validateErr != nil || int(p.Year) > time.Now().Year() {
+		err = service.ErrInvalidPayload
+		return
+	}
+
+	if _, repoErr := a.groupRepository.FindByID(ctx, groupID); repoErr != nil {
+		err = service.MapError(repoErr)
+		return
+	}
+
+	id, genErr := a.idGenerator.GenerateAchievementID()
+	if genErr != nil {
+		err = service.MapError(genErr)
+		return
+	}
+
+	achievement := entity.Achievement{
+		ID:        id,
+		GroupID:   groupID,
+		EventName: p.EventName,
+		Year:      p.Year,
+		Level:     p.Level,
+		Rank:      p.Rank,
+	}
+
+	if repoErr := a.achievementRepository.Insert(ctx, achievement); repoErr != nil {
+		err = service.MapError(repoErr)
+	}
+	return
+}
+
+func (a *achievementServiceImpl) GetByGroupID(ctx context.Context, groupID string) (responses []response.Achievement, err error) {
+	if _, repoErr := a.groupRepository.FindByID(ctx, groupID); repoErr != nil {
+		err = service.MapError(repoErr)
+		return
+	}
+
+	achievements, repoErr := a.achievementRepository.FindByGroupID(ctx, groupID)
+	if repoErr != nil {
+		err = service.MapError(repoErr)
+		return
+	}
+
+	responses = make([]response.Achievement, len(achievements))
+	for i, achievement := range achievements {
+		responses[i] = mapToModel(achievement)
+	}
+	return
+}
+
+func (a *achievementServiceImpl) GetByID(ctx context.Context, groupID, id string) (response response.Achievement, err error) {
+	achievement, repoErr := a.achievementRepository.FindByID(ctx, groupID, id)
+	if repoErr != nil {
+		err = service.MapError(repoErr)
+		return
+	}
+
+	response = mapToModel(achievement)
+	return
+}
+
+func (a *achievementServiceImpl) Update(ctx context.Context, groupID, id string, p payload.UpdateAchievement) (err error) {
+	if validateErr := validator.Validate(p); validateErr != nil || int(p.Year) > time.Now().Year() {
+		err = service.ErrInvalidPayload
+		return
+	}
+
+	achievement := entity.Achievement{
+		EventName: p.EventName,
+		Year:      p.Year,
+		Level:     p.Level,
+		Rank:      p.Rank,
+	}
+
+	if repoErr := a.achievementRepository.Update(ctx, groupID, id, achievement); repoErr != nil {
+		err = service.MapError(repoErr)
+	}
+	return
+}
+
+func (a *achievementServiceImpl) Delete(ctx context.Context, groupID, id string) (err error) {
+	if repoErr := a.achievementRepository.Delete(ctx, groupID, id); repoErr != nil {
+		err = service.MapError(repoErr)
+	}
+	return
+}
+
+// GetRanking returns the medal table of the districts. Districts with the same medals share the same position,
+// and the next district skips the positions taken by the tie.
+func (a *achievementServiceImpl) GetRanking(ctx context.Context, p payload.GetAchievementRanking) (responses []response.DistrictRanking, err error) {
+	if validateErr := validator.Validate(p); validateErr != nil || int(p.Year) > time.Now().Year() {
+		err = service.ErrInvalidPayload
+		return
+	}
+
+	medals, repoErr := a.achievementRepository.FindDistrictMedals(ctx, achievement.RankingFilter{
+		Level: p.Level,
+		Year:  p.Year,
+	})
+	if repoErr != nil {
+		err = service.MapError(repoErr)
+		return
+	}
+
+	responses = make([]response.DistrictRanking, len(medals))
+	for i, medal := range medals {
+		position := i + 1
+		if i > 0 {
+			previous := medals[i-1]
+			if previous.Gold == medal.Gold && previous.Silver == medal.Silver && previous.Bronze == medal.Bronze {
+				position = responses[i-1].Position
+			}
+		}
+
+		responses[i] = response.DistrictRanking{
+			Position:     position,
+			DistrictID:   medal.DistrictID,
+			DistrictName: medal.DistrictName,
+			Gold:         medal.Gold,
+			Silver:       medal.Silver,
+			Bronze:       medal.Bronze,
+			Total:        medal.Gold + medal.Silver + medal.Bronze,
+		}
+	}
+	return
+}
+
+func mapToModel(e entity.Achievement) response.Achievement {
+	achievement := response.Achievement{
+		ID:        e.ID,
+		GroupID:   e.GroupID,
+		EventName: e.EventName,
+		Year:      e.Year,
+		Level:     e.Level,
+		Rank:      e.Rank,
+	}
+
+	if e.Certificate != nil {
+		achievement.Certificate = &response.Attachment{
+			ID:           e.Certificate.ID,
+			FileName:     e.Certificate.FileName,
+			ContentType:  e.Certificate.ContentType,
+			Size:         e.Certificate.Size,
+			URL:          e.Certificate.URL,
+			ThumbnailURL: e.Certificate.ThumbnailURL,
+		}
+	}
+	return achievement
+}
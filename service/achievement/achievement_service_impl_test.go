@@ -0,0 +1,654 @@
+package achievement
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/erikrios/reog-apps-apis/entity"
+	"github.com/erikrios/reog-apps-apis/model/payload"
+	"github.com/erikrios/reog-apps-apis/model/response"
+	"github.com/erikrios/reog-apps-apis/repository"
+	"github.com/erikrios/reog-apps-apis/repository/achievement"
+	mar "github.com/erikrios/reog-apps-apis/repository/achievement/mocks"
+	mgr "github.com/erikrios/reog-apps-apis/repository/group/mocks"
+	"github.com/erikrios/reog-apps-apis/service"
+	mig "github.com/erikrios/reog-apps-apis/utils/generator/mocks"
+	_ "github.com/erikrios/reog-apps-apis/validation"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCreate(t *testing.T) {
+	mockAchievementRepo := &mar.AchievementRepository{}
+	mockGroupRepo := &mgr.GroupRepository{}
+	mockIDGen := &mig.IDGenerator{}
+
+	var achievementService AchievementService = NewAchievementServiceImpl(
+		mockAchievementRepo,
+		mockGroupRepo,
+		mockIDGen,
+	)
+
+	validPayload := payload.CreateAchievement{
+		EventName: "Festival Reog Nasional",
+		Year:      2021,
+		Level:     "national",
+		Rank:      1,
+	}
+
+	testCases := []struct {
+		name                   string
+		inputGroupID           string
+		inputCreateAchievement payload.CreateAchievement
+		expectedID             string
+		expectedError          error
+		mockBehaviours         func()
+	}{
+		{
+			name:         "it should return service.ErrInvalidPayload error, when level is invalid",
+			inputGroupID: "g-xyz",
+			inputCreateAchievement: payload.CreateAchievement{
+				EventName: "Festival Reog Nasional",
+				Year:      2021,
+				Level:     "international",
+				Rank:      1,
+			},
+			expectedError:  service.ErrInvalidPayload,
+			mockBehaviours: func() {},
+		},
+		{
+			name:         "it should return service.ErrInvalidPayload error, when year is in the future",
+			inputGroupID: "g-xyz",
+			inputCreateAchievement: payload.CreateAchievement{
+				EventName: "Festival Reog Nasional",
+				Year:      uint16(time.Now().Year() + 1),
+				Level:     "national",
+				Rank:      1,
+			},
+			expectedError:  service.ErrInvalidPayload,
+			mockBehaviours: func() {},
+		},
+		{
+			name:         "it should return service.ErrInvalidPayload error, when rank is zero",
+			inputGroupID: "g-xyz",
+			inputCreateAchievement: payload.CreateAchievement{
+				EventName: "Festival Reog Nasional",
+				Year:      2021,
+				Level:     "national",
+			},
+			expectedError:  service.ErrInvalidPayload,
+			mockBehaviours: func() {},
+		},
+		{
+			name:                   "it should return service.ErrDataNotFound error, when group repository return an error",
+			inputGroupID:           "g-xyz",
+			inputCreateAchievement: validPayload,
+			expectedError:          service.ErrDataNotFound,
+			mockBehaviours: func() {
+				mockGroupRepo.On(
+					"FindByID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, id string) entity.Group {
+						return entity.Group{}
+					},
+					func(ctx context.Context, id string) error {
+						return repository.ErrRecordNotFound
+					},
+				).Once()
+			},
+		},
+		{
+			name:                   "it should return service.ErrRepository error, when id generator return an error",
+			inputGroupID:           "g-xyz",
+			inputCreateAchievement: validPayload,
+			expectedError:          service.ErrRepository,
+			mockBehaviours: func() {
+				mockGroupRepo.On(
+					"FindByID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, id string) entity.Group {
+						return entity.Group{}
+					},
+					func(ctx context.Context, id string) error {
+						return nil
+					},
+				).Once()
+
+				mockIDGen.On("GenerateAchievementID").Return(
+					func() string {
+						return ""
+					},
+					func() error {
+						return errors.New("error generate achievement id")
+					},
+				).Once()
+			},
+		},
+		{
+			name:                   "it should return a valid ID, when no error is returned",
+			inputGroupID:           "g-xyz",
+			inputCreateAchievement: validPayload,
+			expectedID:             "c-aBcdEfG",
+			expectedError:          nil,
+			mockBehaviours: func() {
+				mockGroupRepo.On(
+					"FindByID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, id string) entity.Group {
+						return entity.Group{}
+					},
+					func(ctx context.Context, id string) error {
+						return nil
+					},
+				).Once()
+
+				mockIDGen.On("GenerateAchievementID").Return(
+					func() string {
+						return "c-aBcdEfG"
+					},
+					func() error {
+						return nil
+					},
+				).Once()
+
+				mockAchievementRepo.On(
+					"Insert",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.MatchedBy(func(achievement entity.Achievement) bool {
+						return achievement.ID == "c-aBcdEfG" && achievement.GroupID == "g-xyz" && achievement.Level == entity.AchievementLevelNational
+					}),
+				).Return(
+					func(ctx context.Context, achievement entity.Achievement) error {
+						return nil
+					},
+				).Once()
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.mockBehaviours()
+			gotID, gotErr := achievementService.Create(context.Background(), testCase.inputGroupID, testCase.inputCreateAchievement)
+
+			if testCase.expectedError != nil {
+				assert.ErrorIs(t, gotErr, testCase.expectedError)
+			} else {
+				assert.NoError(t, gotErr)
+				assert.Equal(t, testCase.expectedID, gotID)
+			}
+		})
+	}
+}
+
+func TestGetByGroupID(t *testing.T) {
+	mockAchievementRepo := &mar.AchievementRepository{}
+	mockGroupRepo := &mgr.GroupRepository{}
+	mockIDGen := &mig.IDGenerator{}
+
+	var achievementService AchievementService = NewAchievementServiceImpl(
+		mockAchievementRepo,
+		mockGroupRepo,
+		mockIDGen,
+	)
+
+	testCases := []struct {
+		name                 string
+		expectedAchievements []response.Achievement
+		expectedError        error
+		mockBehaviours       func()
+	}{
+		{
+			name:          "it should return service.ErrDataNotFound error, when group repository return an error",
+			expectedError: service.ErrDataNotFound,
+			mockBehaviours: func() {
+				mockGroupRepo.On(
+					"FindByID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, id string) entity.Group {
+						return entity.Group{}
+					},
+					func(ctx context.Context, id string) error {
+						return repository.ErrRecordNotFound
+					},
+				).Once()
+			},
+		},
+		{
+			name: "it should return valid achievements, when no error is returned",
+			expectedAchievements: []response.Achievement{
+				{
+					ID:        "c-aBcdEfG",
+					GroupID:   "g-xyz",
+					EventName: "Festival Reog Nasional",
+					Year:      2021,
+					Level:     "national",
+					Rank:      1,
+					Certificate: &response.Attachment{
+						ID:          "f-hIjkLmN",
+						FileName:    "piagam.pdf",
+						ContentType: "application/pdf",
+						Size:        51200,
+						URL:         "/uploads/achievements/c-aBcdEfG/f-hIjkLmN.pdf",
+					},
+				},
+				{
+					ID:        "c-oPqrStU",
+					GroupID:   "g-xyz",
+					EventName: "Lomba Reog Desa",
+					Year:      2019,
+					Level:     "village",
+					Rank:      4,
+				},
+			},
+			expectedError: nil,
+			mockBehaviours: func() {
+				mockGroupRepo.On(
+					"FindByID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, id string) entity.Group {
+						return entity.Group{}
+					},
+					func(ctx context.Context, id string) error {
+						return nil
+					},
+				).Once()
+
+				mockAchievementRepo.On(
+					"FindByGroupID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, groupID string) []entity.Achievement {
+						return []entity.Achievement{
+							{
+								ID:        "c-aBcdEfG",
+								GroupID:   "g-xyz",
+								EventName: "Festival Reog Nasional",
+								Year:      2021,
+								Level:     "national",
+								Rank:      1,
+								Certificate: &entity.Attachment{
+									ID:          "f-hIjkLmN",
+									OwnerID:     "c-aBcdEfG",
+									OwnerType:   entity.AttachmentOwnerAchievement,
+									FileName:    "piagam.pdf",
+									ContentType: "application/pdf",
+									Size:        51200,
+									Key:         "achievements/c-aBcdEfG/f-hIjkLmN.pdf",
+									URL:         "/uploads/achievements/c-aBcdEfG/f-hIjkLmN.pdf",
+								},
+							},
+							{
+								ID:        "c-oPqrStU",
+								GroupID:   "g-xyz",
+								EventName: "Lomba Reog Desa",
+								Year:      2019,
+								Level:     "village",
+								Rank:      4,
+							},
+						}
+					},
+					func(ctx context.Context, groupID string) error {
+						return nil
+					},
+				).Once()
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.mockBehaviours()
+			gotAchievements, gotErr := achievementService.GetByGroupID(context.Background(), "g-xyz")
+
+			if testCase.expectedError != nil {
+				assert.ErrorIs(t, gotErr, testCase.expectedError)
+			} else {
+				assert.NoError(t, gotErr)
+				assert.Equal(t, testCase.expectedAchievements, gotAchievements)
+			}
+		})
+	}
+}
+
+func TestGetByID(t *testing.T) {
+	mockAchievementRepo := &mar.AchievementRepository{}
+	mockGroupRepo := &mgr.GroupRepository{}
+	mockIDGen := &mig.IDGenerator{}
+
+	var achievementService AchievementService = NewAchievementServiceImpl(
+		mockAchievementRepo,
+		mockGroupRepo,
+		mockIDGen,
+	)
+
+	testCases := []struct {
+		name                string
+		expectedAchievement response.Achievement
+		expectedError       error
+		mockBehaviours      func()
+	}{
+		{
+			name:          "it should return service.ErrDataNotFound error, when achievement repository return an error",
+			expectedError: service.ErrDataNotFound,
+			mockBehaviours: func() {
+				mockAchievementRepo.On(
+					"FindByID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, groupID string, id string) entity.Achievement {
+						return entity.Achievement{}
+					},
+					func(ctx context.Context, groupID string, id string) error {
+						return repository.ErrRecordNotFound
+					},
+				).Once()
+			},
+		},
+		{
+			name: "it should return a valid achievement, when no error is returned",
+			expectedAchievement: response.Achievement{
+				ID:        "c-aBcdEfG",
+				GroupID:   "g-xyz",
+				EventName: "Parade Reog Kabupaten",
+				Year:      2020,
+				Level:     "regency",
+				Rank:      2,
+			},
+			expectedError: nil,
+			mockBehaviours: func() {
+				mockAchievementRepo.On(
+					"FindByID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, groupID string, id string) entity.Achievement {
+						return entity.Achievement{
+							ID:        "c-aBcdEfG",
+							GroupID:   "g-xyz",
+							EventName: "Parade Reog Kabupaten",
+							Year:      2020,
+							Level:     "regency",
+							Rank:      2,
+						}
+					},
+					func(ctx context.Context, groupID string, id string) error {
+						return nil
+					},
+				).Once()
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.mockBehaviours()
+			gotAchievement, gotErr := achievementService.GetByID(context.Background(), "g-xyz", "c-aBcdEfG")
+
+			if testCase.expectedError != nil {
+				assert.ErrorIs(t, gotErr, testCase.expectedError)
+			} else {
+				assert.NoError(t, gotErr)
+				assert.Equal(t, testCase.expectedAchievement, gotAchievement)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	mockAchievementRepo := &mar.AchievementRepository{}
+	mockGroupRepo := &mgr.GroupRepository{}
+	mockIDGen := &mig.IDGenerator{}
+
+	var achievementService AchievementService = NewAchievementServiceImpl(
+		mockAchievementRepo,
+		mockGroupRepo,
+		mockIDGen,
+	)
+
+	validPayload := payload.UpdateAchievement{
+		EventName: "Parade Reog Kabupaten",
+		Year:      2020,
+		Level:     "regency",
+		Rank:      2,
+	}
+
+	testCases := []struct {
+		name                   string
+		inputUpdateAchievement payload.UpdateAchievement
+		expectedError          error
+		mockBehaviours         func()
+	}{
+		{
+			name: "it should return service.ErrInvalidPayload error, when year is before 1900",
+			inputUpdateAchievement: payload.UpdateAchievement{
+				EventName: "Parade Reog Kabupaten",
+				Year:      1899,
+				Level:     "regency",
+				Rank:      2,
+			},
+			expectedError:  service.ErrInvalidPayload,
+			mockBehaviours: func() {},
+		},
+		{
+			name:                   "it should return service.ErrDataNotFound error, when achievement repository return an error",
+			inputUpdateAchievement: validPayload,
+			expectedError:          service.ErrDataNotFound,
+			mockBehaviours: func() {
+				mockAchievementRepo.On(
+					"Update",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", entity.Achievement{})),
+				).Return(
+					func(ctx context.Context, groupID string, id string, achievement entity.Achievement) error {
+						return repository.ErrRecordNotFound
+					},
+				).Once()
+			},
+		},
+		{
+			name:                   "it should return nil error, when no error is returned",
+			inputUpdateAchievement: validPayload,
+			expectedError:          nil,
+			mockBehaviours: func() {
+				mockAchievementRepo.On(
+					"Update",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", entity.Achievement{})),
+				).Return(
+					func(ctx context.Context, groupID string, id string, achievement entity.Achievement) error {
+						return nil
+					},
+				).Once()
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.mockBehaviours()
+			gotErr := achievementService.Update(context.Background(), "g-xyz", "c-aBcdEfG", testCase.inputUpdateAchievement)
+
+			if testCase.expectedError != nil {
+				assert.ErrorIs(t, gotErr, testCase.expectedError)
+			} else {
+				assert.NoError(t, gotErr)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	mockAchievementRepo := &mar.AchievementRepository{}
+	mockGroupRepo := &mgr.GroupRepository{}
+	mockIDGen := &mig.IDGenerator{}
+
+	var achievementService AchievementService = NewAchievementServiceImpl(
+		mockAchievementRepo,
+		mockGroupRepo,
+		mockIDGen,
+	)
+
+	testCases := []struct {
+		name           string
+		expectedError  error
+		mockBehaviours func()
+	}{
+		{
+			name:          "it should return service.ErrRepository error, when achievement repository return an error",
+			expectedError: service.ErrRepository,
+			mockBehaviours: func() {
+				mockAchievementRepo.On(
+					"Delete",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, groupID string, id string) error {
+						return repository.ErrDatabase
+					},
+				).Once()
+			},
+		},
+		{
+			name:          "it should return nil error, when no error is returned",
+			expectedError: nil,
+			mockBehaviours: func() {
+				mockAchievementRepo.On(
+					"Delete",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, groupID string, id string) error {
+						return nil
+					},
+				).Once()
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.mockBehaviours()
+			gotErr := achievementService.Delete(context.Background(), "g-xyz", "c-aBcdEfG")
+
+			if testCase.expectedError != nil {
+				assert.ErrorIs(t, gotErr, testCase.expectedError)
+			} else {
+				assert.NoError(t, gotErr)
+			}
+		})
+	}
+}
+
+func TestGetRanking(t *testing.T) {
+	mockAchievementRepo := &mar.AchievementRepository{}
+	mockGroupRepo := &mgr.GroupRepository{}
+	mockIDGen := &mig.IDGenerator{}
+
+	var achievementService AchievementService = NewAchievementServiceImpl(
+		mockAchievementRepo,
+		mockGroupRepo,
+		mockIDGen,
+	)
+
+	testCases := []struct {
+		name            string
+		inputRanking    payload.GetAchievementRanking
+		expectedRanking []response.DistrictRanking
+		expectedError   error
+		mockBehaviours  func()
+	}{
+		{
+			name:           "it should return service.ErrInvalidPayload error, when level is invalid",
+			inputRanking:   payload.GetAchievementRanking{Level: "province"},
+			expectedError:  service.ErrInvalidPayload,
+			mockBehaviours: func() {},
+		},
+		{
+			name:          "it should return service.ErrRepository error, when achievement repository return an error",
+			inputRanking:  payload.GetAchievementRanking{},
+			expectedError: service.ErrRepository,
+			mockBehaviours: func() {
+				mockAchievementRepo.On(
+					"FindDistrictMedals",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", achievement.RankingFilter{})),
+				).Return(
+					func(ctx context.Context, filter achievement.RankingFilter) []achievement.DistrictMedals {
+						return nil
+					},
+					func(ctx context.Context, filter achievement.RankingFilter) error {
+						return repository.ErrDatabase
+					},
+				).Once()
+			},
+		},
+		{
+			name:         "it should return a ranking sharing the position of tied districts, when no error is returned",
+			inputRanking: payload.GetAchievementRanking{Level: "regency", Year: 2021},
+			expectedRanking: []response.DistrictRanking{
+				{Position: 1, DistrictID: "3502030", DistrictName: "Bungkal", Gold: 2, Silver: 0, Bronze: 1, Total: 3},
+				{Position: 2, DistrictID: "3502010", DistrictName: "Ngrayun", Gold: 1, Silver: 1, Bronze: 0, Total: 2},
+				{Position: 2, DistrictID: "3502020", DistrictName: "Slahung", Gold: 1, Silver: 1, Bronze: 0, Total: 2},
+				{Position: 4, DistrictID: "3502040", DistrictName: "Sambit", Gold: 0, Silver: 0, Bronze: 3, Total: 3},
+			},
+			expectedError: nil,
+			mockBehaviours: func() {
+				mockAchievementRepo.On(
+					"FindDistrictMedals",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					achievement.RankingFilter{Level: "regency", Year: 2021},
+				).Return(
+					func(ctx context.Context, filter achievement.RankingFilter) []achievement.DistrictMedals {
+						return []achievement.DistrictMedals{
+							{DistrictID: "3502030", DistrictName: "Bungkal", Gold: 2, Silver: 0, Bronze: 1},
+							{DistrictID: "3502010", DistrictName: "Ngrayun", Gold: 1, Silver: 1, Bronze: 0},
+							{DistrictID: "3502020", DistrictName: "Slahung", Gold: 1, Silver: 1, Bronze: 0},
+							{DistrictID: "3502040", DistrictName: "Sambit", Gold: 0, Silver: 0, Bronze: 3},
+						}
+					},
+					func(ctx context.Context, filter achievement.RankingFilter) error {
+						return nil
+					},
+				).Once()
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.mockBehaviours()
+			gotRanking, gotErr := achievementService.GetRanking(context.Background(), testCase.inputRanking)
+
+			if testCase.expectedError != nil {
+				assert.ErrorIs(t, gotErr, testCase.expectedError)
+			} else {
+				assert.NoError(t, gotErr)
+				assert.Equal(t, testCase.expectedRanking, gotRanking)
+			}
+		})
+	}
+}
@@ -0,0 +1,132 @@
+// Code generated by mockery v2.10.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	payload "github.com/erikrios/reog-apps-apis/model/payload"
+	response "github.com/erikrios/reog-apps-apis/model/response"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// AchievementService is an autogenerated mock type for the AchievementService type
+type AchievementService struct {
+	mock.Mock
+}
+
+// Create provides a mock function with given fields: ctx, groupID, p
+func (_m *AchievementService) Create(ctx context.Context, groupID string, p payload.CreateAchievement) (string, error) {
+	ret := _m.Called(ctx, groupID, p)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(context.Context, string, payload.CreateAchievement) string); ok {
+		r0 = rf(ctx, groupID, p)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, payload.CreateAchievement) error); ok {
+		r1 = rf(ctx, groupID, p)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Delete provides a mock function with given fields: ctx, groupID, id
+func (_m *AchievementService) Delete(ctx context.Context, groupID string, id string) error {
+	ret := _m.Called(ctx, groupID, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, groupID, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetByGroupID provides a mock function with given fields: ctx, groupID
+func (_m *AchievementService) GetByGroupID(ctx context.Context, groupID string) ([]response.Achievement, error) {
+	ret := _m.Called(ctx, groupID)
+
+	var r0 []response.Achievement
+	if rf, ok := ret.Get(0).(func(context.Context, string) []response.Achievement); ok {
+		r0 = rf(ctx, groupID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]response.Achievement)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, groupID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetByID provides a mock function with given fields: ctx, groupID, id
+func (_m *AchievementService) GetByID(ctx context.Context, groupID string, id string) (response.Achievement, error) {
+	ret := _m.Called(ctx, groupID, id)
+
+	var r0 response.Achievement
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) response.Achievement); ok {
+		r0 = rf(ctx, groupID, id)
+	} else {
+		r0 = ret.Get(0).(response.Achievement)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, groupID, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetRanking provides a mock function with given fields: ctx, p
+func (_m *AchievementService) GetRanking(ctx context.Context, p payload.GetAchievementRanking) ([]response.DistrictRanking, error) {
+	ret := _m.Called(ctx, p)
+
+	var r0 []response.DistrictRanking
+	if rf, ok := ret.Get(0).(func(context.Context, payload.GetAchievementRanking) []response.DistrictRanking); ok {
+		r0 = rf(ctx, p)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]response.DistrictRanking)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, payload.GetAchievementRanking) error); ok {
+		r1 = rf(ctx, p)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Update provides a mock function with given fields: ctx, groupID, id, p
+func (_m *AchievementService) Update(ctx context.Context, groupID string, id string, p payload.UpdateAchievement) error {
+	ret := _m.Called(ctx, groupID, id, p)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, payload.UpdateAchievement) error); ok {
+		r0 = rf(ctx, groupID, id, p)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
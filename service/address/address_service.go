@@ -8,4 +8,5 @@ import (
 
 type AddressService interface {
 	Update(ctx context.Context, id string, p payload.UpdateAddress) (err error)
+	Patch(ctx context.Context, id string, p payload.PatchAddress) (err error)
 }
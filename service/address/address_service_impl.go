@@ -8,6 +8,7 @@ import (
 	"github.com/erikrios/reog-apps-apis/repository/address"
 	"github.com/erikrios/reog-apps-apis/repository/village"
 	"github.com/erikrios/reog-apps-apis/service"
+	"github.com/erikrios/reog-apps-apis/utils/geo"
 	"gopkg.in/validator.v2"
 )
 
@@ -27,7 +28,7 @@ func NewAddressServiceImpl(
 }
 
 func (a *addressServiceImpl) Update(ctx context.Context, id string, p payload.UpdateAddress) (err error) {
-	if validateErr := validator.Validate(p); validateErr != nil {
+	if validateErr := validator.Validate(p); validateErr != nil || !geo.ValidLocation(p.Latitude, p.Longitude) {
 		err = service.ErrInvalidPayload
 		return
 	}
@@ -49,6 +50,50 @@ func (a *addressServiceImpl) Update(ctx context.Context, id string, p payload.Up
 		RegencyName:  village.District.Regency.Name,
 		ProvinceID:   village.District.Regency.Province.ID,
 		ProvinceName: village.District.Regency.Province.Name,
+		Latitude:     p.Latitude,
+		Longitude:    p.Longitude,
+	}
+
+	repoErr := a.addressRepository.Update(ctx, id, address)
+	if repoErr != nil {
+		err = service.MapError(repoErr)
+	}
+	return
+}
+
+// Patch applies a merge patch to the address. The village is only looked up when the patch moves the address to
+// another one.
+func (a *addressServiceImpl) Patch(ctx context.Context, id string, p payload.PatchAddress) (err error) {
+	if validateErr := validator.Validate(p); validateErr != nil || !geo.ValidLocation(p.Latitude, p.Longitude) {
+		err = service.ErrInvalidPayload
+		return
+	}
+
+	address := entity.Address{
+		ID:        id,
+		Latitude:  p.Latitude,
+		Longitude: p.Longitude,
+	}
+
+	if p.Address != nil {
+		address.Address = *p.Address
+	}
+
+	if p.VillageID != nil {
+		village, villageErr := a.villageRepository.FindByID(*p.VillageID)
+		if villageErr != nil {
+			err = service.MapError(villageErr)
+			return
+		}
+
+		address.VillageID = village.ID
+		address.VillageName = village.Name
+		address.DistrictID = village.District.ID
+		address.DistrictName = village.District.Name
+		address.RegencyID = village.District.Regency.ID
+		address.RegencyName = village.District.Regency.Name
+		address.ProvinceID = village.District.Regency.Province.ID
+		address.ProvinceName = village.District.Regency.Province.Name
 	}
 
 	repoErr := a.addressRepository.Update(ctx, id, address)
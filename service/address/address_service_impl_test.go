@@ -24,6 +24,9 @@ func TestUpdate(t *testing.T) {
 		mockVillageRepo,
 	)
 
+	latitude, longitude := -7.87, 111.46
+	outsideLatitude, outsideLongitude := -6.2, 106.8
+
 	testCases := []struct {
 		name               string
 		inputID            string
@@ -41,6 +44,29 @@ func TestUpdate(t *testing.T) {
 			expectedError:  service.ErrInvalidPayload,
 			mockBehaviours: func() {},
 		},
+		{
+			name:    "it should return service.ErrInvalidPayload error, when location is outside Ponorogo",
+			inputID: "g-xyz",
+			inputUpdateAddress: payload.UpdateAddress{
+				Address:   "RT 01 RW 01 Dukuh Bibis",
+				VillageID: "3502030007",
+				Latitude:  &outsideLatitude,
+				Longitude: &outsideLongitude,
+			},
+			expectedError:  service.ErrInvalidPayload,
+			mockBehaviours: func() {},
+		},
+		{
+			name:    "it should return service.ErrInvalidPayload error, when only longitude is given",
+			inputID: "g-xyz",
+			inputUpdateAddress: payload.UpdateAddress{
+				Address:   "RT 01 RW 01 Dukuh Bibis",
+				VillageID: "3502030007",
+				Longitude: &longitude,
+			},
+			expectedError:  service.ErrInvalidPayload,
+			mockBehaviours: func() {},
+		},
 		{
 			name:    "it should return service.ErrDataNotFound error, when village repository return an error",
 			inputID: "g-xyz",
@@ -126,6 +152,8 @@ func TestUpdate(t *testing.T) {
 			inputUpdateAddress: payload.UpdateAddress{
 				Address:   "RT 01 RW 01 Dukuh Bibis",
 				VillageID: "3502030007",
+				Latitude:  &latitude,
+				Longitude: &longitude,
 			},
 			expectedError: nil,
 			mockBehaviours: func() {
@@ -142,7 +170,9 @@ func TestUpdate(t *testing.T) {
 					"Update",
 					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
 					mock.AnythingOfType(fmt.Sprintf("%T", "")),
-					mock.AnythingOfType(fmt.Sprintf("%T", entity.Address{})),
+					mock.MatchedBy(func(address entity.Address) bool {
+						return address.Latitude == &latitude && address.Longitude == &longitude
+					}),
 				).Return(
 					func(ctx context.Context, id string, group entity.Address) error {
 						return nil
@@ -165,3 +195,170 @@ func TestUpdate(t *testing.T) {
 		})
 	}
 }
+
+func TestPatch(t *testing.T) {
+	mockAddressRepo := &mar.AddressRepository{}
+	mockVillageRepo := &mvr.VillageRepository{}
+
+	var addressService AddressService = NewAddressServiceImpl(
+		mockAddressRepo,
+		mockVillageRepo,
+	)
+
+	latitude := -7.87
+	outsideLatitude, outsideLongitude := -6.2, 106.8
+
+	testCases := []struct {
+		name              string
+		inputID           string
+		inputPatchAddress payload.PatchAddress
+		expectedError     error
+		mockBehaviours    func()
+	}{
+		{
+			name:              "it should return service.ErrInvalidPayload error, when a present field is empty",
+			inputID:           "g-xyz",
+			inputPatchAddress: payload.PatchAddress{Address: stringPtr("")},
+			expectedError:     service.ErrInvalidPayload,
+			mockBehaviours:    func() {},
+		},
+		{
+			name:    "it should return service.ErrInvalidPayload error, when location is outside Ponorogo",
+			inputID: "g-xyz",
+			inputPatchAddress: payload.PatchAddress{
+				Latitude:  &outsideLatitude,
+				Longitude: &outsideLongitude,
+			},
+			expectedError:  service.ErrInvalidPayload,
+			mockBehaviours: func() {},
+		},
+		{
+			name:              "it should return service.ErrInvalidPayload error, when only latitude is given",
+			inputID:           "g-xyz",
+			inputPatchAddress: payload.PatchAddress{Latitude: &latitude},
+			expectedError:     service.ErrInvalidPayload,
+			mockBehaviours:    func() {},
+		},
+		{
+			name:              "it should return service.ErrDataNotFound error, when village repository return an error",
+			inputID:           "g-xyz",
+			inputPatchAddress: payload.PatchAddress{VillageID: stringPtr("3502031117")},
+			expectedError:     service.ErrDataNotFound,
+			mockBehaviours: func() {
+				mockVillageRepo.On("FindByID", "3502031117").Return(
+					func(id string) entity.Village {
+						return entity.Village{}
+					},
+					func(id string) error {
+						return repository.ErrRecordNotFound
+					},
+				).Once()
+			},
+		},
+		{
+			name:              "it should return service.ErrRepository error, when addresss repository return an error",
+			inputID:           "g-xyz",
+			inputPatchAddress: payload.PatchAddress{Address: stringPtr("RT 01 RW 01 Dukuh Bibis")},
+			expectedError:     service.ErrRepository,
+			mockBehaviours: func() {
+				mockAddressRepo.On(
+					"Update",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", entity.Address{})),
+				).Return(
+					func(ctx context.Context, id string, address entity.Address) error {
+						return repository.ErrDatabase
+					},
+				).Once()
+			},
+		},
+		{
+			name:              "it should only write the address, when the village is absent from the patch",
+			inputID:           "g-xyz",
+			inputPatchAddress: payload.PatchAddress{Address: stringPtr("RT 02 RW 01 Dukuh Bibis")},
+			expectedError:     nil,
+			mockBehaviours: func() {
+				mockAddressRepo.On(
+					"Update",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					"g-xyz",
+					entity.Address{ID: "g-xyz", Address: "RT 02 RW 01 Dukuh Bibis"},
+				).Return(
+					func(ctx context.Context, id string, address entity.Address) error {
+						return nil
+					},
+				).Once()
+			},
+		},
+		{
+			name:              "it should write the village with its district, when the village is patched",
+			inputID:           "g-xyz",
+			inputPatchAddress: payload.PatchAddress{VillageID: stringPtr("3502030007")},
+			expectedError:     nil,
+			mockBehaviours: func() {
+				mockVillageRepo.On("FindByID", "3502030007").Return(
+					func(id string) entity.Village {
+						return entity.Village{
+							ID:   id,
+							Name: "Bungkal",
+							District: entity.District{
+								ID:   "3502030",
+								Name: "Bungkal",
+								Regency: entity.Regency{
+									ID:   "3502",
+									Name: "Kabupaten Ponorogo",
+									Province: entity.Province{
+										ID:   "35",
+										Name: "Jawa Timur",
+									},
+								},
+							},
+						}
+					},
+					func(id string) error {
+						return nil
+					},
+				).Once()
+
+				mockAddressRepo.On(
+					"Update",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					"g-xyz",
+					entity.Address{
+						ID:           "g-xyz",
+						VillageID:    "3502030007",
+						VillageName:  "Bungkal",
+						DistrictID:   "3502030",
+						DistrictName: "Bungkal",
+						RegencyID:    "3502",
+						RegencyName:  "Kabupaten Ponorogo",
+						ProvinceID:   "35",
+						ProvinceName: "Jawa Timur",
+					},
+				).Return(
+					func(ctx context.Context, id string, address entity.Address) error {
+						return nil
+					},
+				).Once()
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.mockBehaviours()
+			gotErr := addressService.Patch(context.Background(), testCase.inputID, testCase.inputPatchAddress)
+
+			if testCase.expectedError != nil {
+				assert.ErrorIs(t, gotErr, testCase.expectedError)
+			} else {
+				assert.NoError(t, gotErr)
+			}
+		})
+	}
+}
+
+func stringPtr(s string) *string {
+	return &s
+}
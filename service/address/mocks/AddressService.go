@@ -14,6 +14,20 @@ type AddressService struct {
 	mock.Mock
 }
 
+// Patch provides a mock function with given fields: ctx, id, p
+func (_m *AddressService) Patch(ctx context.Context, id string, p payload.PatchAddress) error {
+	ret := _m.Called(ctx, id, p)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, payload.PatchAddress) error); ok {
+		r0 = rf(ctx, id, p)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Update provides a mock function with given fields: ctx, id, p
 func (_m *AddressService) Update(ctx context.Context, id string, p payload.UpdateAddress) error {
 	ret := _m.Called(ctx, id, p)
@@ -0,0 +1,20 @@
+package attachment
+
+import (
+	"context"
+
+	"github.com/erikrios/reog-apps-apis/model/payload"
+)
+
+type AttachmentService interface {
+	CreateForGroup(ctx context.Context, groupID string, p payload.CreateAttachment) (id string, err error)
+	CreateForProperty(ctx context.Context, groupID, propertyID string, p payload.CreateAttachment) (id string, err error)
+	// CreateForAchievement uploads the certificate scan of an achievement, which holds at most one.
+	CreateForAchievement(ctx context.Context, groupID, achievementID string, p payload.CreateAttachment) (id string, err error)
+	// CreateForMaintenance uploads a photo of the condition of a property, which has to be an image.
+	CreateForMaintenance(ctx context.Context, groupID, propertyID, maintenanceID string, p payload.CreateAttachment) (id string, err error)
+	DeleteFromGroup(ctx context.Context, groupID, id string) (err error)
+	DeleteFromProperty(ctx context.Context, groupID, propertyID, id string) (err error)
+	DeleteFromAchievement(ctx context.Context, groupID, achievementID string) (err error)
+	DeleteFromMaintenance(ctx context.Context, groupID, propertyID, maintenanceID, id string) (err error)
+}
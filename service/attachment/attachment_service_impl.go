@@ -0,0 +1,306 @@
+package attachment
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"path"
+	"strings"
+
+	"github.com/erikrios/reog-apps-apis/entity"
+	"github.com/erikrios/reog-apps-apis/model/payload"
+	"github.com/erikrios/reog-apps-apis/repository/achievement"
+	"github.com/erikrios/reog-apps-apis/repository/attachment"
+	"github.com/erikrios/reog-apps-apis/repository/group"
+	"github.com/erikrios/reog-apps-apis/repository/maintenance"
+	"github.com/erikrios/reog-apps-apis/repository/property"
+	"github.com/erikrios/reog-apps-apis/service"
+	"github.com/erikrios/reog-apps-apis/utils/generator"
+	"github.com/erikrios/reog-apps-apis/utils/storage"
+	"gopkg.in/validator.v2"
+)
+
+// MaxFileSize is the largest attachment accepted, in bytes.
+const MaxFileSize = 10 << 20
+
+const (
+	thumbnailSize        = 320
+	thumbnailContentType = "image/jpeg"
+)
+
+// fileExtensions lists the accepted content types, as sniffed from the file content, with the extension they are stored under.
+var fileExtensions = map[string]string{
+	"image/jpeg":      ".jpg",
+	"image/png":       ".png",
+	"image/gif":       ".gif",
+	"image/webp":      ".webp",
+	"application/pdf": ".pdf",
+}
+
+type attachmentServiceImpl struct {
+	attachmentRepository  attachment.AttachmentRepository
+	groupRepository       group.GroupRepository
+	propertyRepository    property.PropertyRepository
+	achievementRepository achievement.AchievementRepository
+	maintenanceRepository maintenance.MaintenanceRepository
+	idGenerator           generator.IDGenerator
+	thumbnailGenerator    generator.ThumbnailGenerator
+	storage               storage.Storage
+}
+
+func NewAttachmentServiceImpl(
+	attachmentRepository attachment.AttachmentRepository,
+	groupRepository group.GroupRepository,
+	propertyRepository property.PropertyRepository,
+	achievementRepository achievement.AchievementRepository,
+	maintenanceRepository maintenance.MaintenanceRepository,
+	idGenerator generator.IDGenerator,
+	thumbnailGenerator generator.ThumbnailGenerator,
+	storage storage.Storage,
+) *attachmentServiceImpl {
+	return &attachmentServiceImpl{
+		attachmentRepository:  attachmentRepository,
+		groupRepository:       groupRepository,
+		propertyRepository:    propertyRepository,
+		achievementRepository: achievementRepository,
+		maintenanceRepository: maintenanceRepository,
+		idGenerator:           idGenerator,
+		thumbnailGenerator:    thumbnailGenerator,
+		storage:               storage,
+	}
+}
+
+func (a *attachmentServiceImpl) CreateForGroup(ctx context.Context, groupID string, p payload.CreateAttachment) (id string, err error) {
+	if validateErr := validator.Validate(p); validateErr != nil {
+		err = service.ErrInvalidPayload
+		return
+	}
+
+	if _, repoErr := a.groupRepository.FindByID(ctx, groupID); repoErr != nil {
+		err = service.MapError(repoErr)
+		return
+	}
+
+	id, err = a.create(ctx, entity.AttachmentOwnerGroup, groupID, p)
+	return
+}
+
+func (a *attachmentServiceImpl) CreateForProperty(ctx context.Context, groupID, propertyID string, p payload.CreateAttachment) (id string, err error) {
+	if validateErr := validator.Validate(p); validateErr != nil {
+		err = service.ErrInvalidPayload
+		return
+	}
+
+	if err = a.findProperty(ctx, groupID, propertyID); err != nil {
+		return
+	}
+
+	id, err = a.create(ctx, entity.AttachmentOwnerProperty, propertyID, p)
+	return
+}
+
+func (a *attachmentServiceImpl) CreateForAchievement(ctx context.Context, groupID, achievementID string, p payload.CreateAttachment) (id string, err error) {
+	if validateErr := validator.Validate(p); validateErr != nil {
+		err = service.ErrInvalidPayload
+		return
+	}
+
+	achievement, repoErr := a.achievementRepository.FindByID(ctx, groupID, achievementID)
+	if repoErr != nil {
+		err = service.MapError(repoErr)
+		return
+	}
+
+	if achievement.Certificate != nil {
+		err = service.ErrDataAlreadyExists
+		return
+	}
+
+	id, err = a.create(ctx, entity.AttachmentOwnerAchievement, achievementID, p)
+	return
+}
+
+func (a *attachmentServiceImpl) CreateForMaintenance(ctx context.Context, groupID, propertyID, maintenanceID string, p payload.CreateAttachment) (id string, err error) {
+	if validateErr := validator.Validate(p); validateErr != nil {
+		err = service.ErrInvalidPayload
+		return
+	}
+
+	if !strings.HasPrefix(http.DetectContentType(p.Content), "image/") {
+		err = service.ErrUnsupportedFile
+		return
+	}
+
+	if err = a.findMaintenance(ctx, groupID, propertyID, maintenanceID); err != nil {
+		return
+	}
+
+	id, err = a.create(ctx, entity.AttachmentOwnerMaintenance, maintenanceID, p)
+	return
+}
+
+func (a *attachmentServiceImpl) DeleteFromGroup(ctx context.Context, groupID, id string) (err error) {
+	err = a.delete(ctx, entity.AttachmentOwnerGroup, groupID, id)
+	return
+}
+
+func (a *attachmentServiceImpl) DeleteFromProperty(ctx context.Context, groupID, propertyID, id string) (err error) {
+	if err = a.findProperty(ctx, groupID, propertyID); err != nil {
+		return
+	}
+
+	err = a.delete(ctx, entity.AttachmentOwnerProperty, propertyID, id)
+	return
+}
+
+func (a *attachmentServiceImpl) DeleteFromAchievement(ctx context.Context, groupID, achievementID string) (err error) {
+	achievement, repoErr := a.achievementRepository.FindByID(ctx, groupID, achievementID)
+	if repoErr != nil {
+		err = service.MapError(repoErr)
+		return
+	}
+
+	if achievement.Certificate == nil {
+		err = service.ErrDataNotFound
+		return
+	}
+
+	err = a.delete(ctx, entity.AttachmentOwnerAchievement, achievementID, achievement.Certificate.ID)
+	return
+}
+
+func (a *attachmentServiceImpl) DeleteFromMaintenance(ctx context.Context, groupID, propertyID, maintenanceID, id string) (err error) {
+	if err = a.findMaintenance(ctx, groupID, propertyID, maintenanceID); err != nil {
+		return
+	}
+
+	err = a.delete(ctx, entity.AttachmentOwnerMaintenance, maintenanceID, id)
+	return
+}
+
+func (a *attachmentServiceImpl) findProperty(ctx context.Context, groupID, propertyID string) (err error) {
+	property, repoErr := a.propertyRepository.FindByID(ctx, propertyID)
+	if repoErr != nil {
+		err = service.MapError(repoErr)
+		return
+	}
+
+	if property.GroupID != groupID {
+		err = service.ErrDataNotFound
+	}
+	return
+}
+
+func (a *attachmentServiceImpl) findMaintenance(ctx context.Context, groupID, propertyID, maintenanceID string) (err error) {
+	if err = a.findProperty(ctx, groupID, propertyID); err != nil {
+		return
+	}
+
+	if _, repoErr := a.maintenanceRepository.FindByID(ctx, propertyID, maintenanceID); repoErr != nil {
+		err = service.MapError(repoErr)
+	}
+	return
+}
+
+func (a *attachmentServiceImpl) create(ctx context.Context, ownerType, ownerID string, p payload.CreateAttachment) (id string, err error) {
+	if len(p.Content) > MaxFileSize {
+		err = service.ErrFileTooLarge
+		return
+	}
+
+	contentType := http.DetectContentType(p.Content)
+	extension, ok := fileExtensions[contentType]
+	if !ok {
+		err = service.ErrUnsupportedFile
+		return
+	}
+
+	var thumbnail []byte
+	if strings.HasPrefix(contentType, "image/") {
+		var genErr error
+		if thumbnail, genErr = a.thumbnailGenerator.GenerateThumbnail(p.Content, thumbnailSize); genErr != nil {
+			if errors.Is(genErr, generator.ErrImageTooLarge) {
+				err = service.ErrFileTooLarge
+				return
+			}
+
+			err = service.ErrUnsupportedFile
+			return
+		}
+	}
+
+	id, genErr := a.idGenerator.GenerateAttachmentID()
+	if genErr != nil {
+		err = service.MapError(genErr)
+		return
+	}
+
+	attachment := entity.Attachment{
+		ID:          id,
+		OwnerID:     ownerID,
+		OwnerType:   ownerType,
+		FileName:    p.FileName,
+		ContentType: contentType,
+		Size:        int64(len(p.Content)),
+		Key:         path.Join(ownerType, ownerID, id+extension),
+	}
+	attachment.URL = a.storage.URL(attachment.Key)
+
+	if storageErr := a.storage.Put(ctx, attachment.Key, bytes.NewReader(p.Content), attachment.Size, contentType); storageErr != nil {
+		err = service.MapError(storageErr)
+		return
+	}
+
+	if thumbnail != nil {
+		attachment.ThumbnailKey = path.Join(ownerType, ownerID, id+"_thumbnail.jpg")
+		attachment.ThumbnailURL = a.storage.URL(attachment.ThumbnailKey)
+
+		if storageErr := a.storage.Put(ctx, attachment.ThumbnailKey, bytes.NewReader(thumbnail), int64(len(thumbnail)), thumbnailContentType); storageErr != nil {
+			a.removeFiles(ctx, attachment.Key)
+			err = service.MapError(storageErr)
+			return
+		}
+	}
+
+	if repoErr := a.attachmentRepository.Insert(ctx, attachment); repoErr != nil {
+		a.removeFiles(ctx, attachment.Key, attachment.ThumbnailKey)
+		err = service.MapError(repoErr)
+	}
+	return
+}
+
+func (a *attachmentServiceImpl) delete(ctx context.Context, ownerType, ownerID, id string) (err error) {
+	attachment, repoErr := a.attachmentRepository.FindByID(ctx, ownerType, ownerID, id)
+	if repoErr != nil {
+		err = service.MapError(repoErr)
+		return
+	}
+
+	// The files go first, so a failure leaves the attachment listed and the deletion can be retried.
+	for _, key := range []string{attachment.Key, attachment.ThumbnailKey} {
+		if key == "" {
+			continue
+		}
+
+		if storageErr := a.storage.Delete(ctx, key); storageErr != nil {
+			err = service.MapError(storageErr)
+			return
+		}
+	}
+
+	if repoErr := a.attachmentRepository.Delete(ctx, ownerType, ownerID, id); repoErr != nil {
+		err = service.MapError(repoErr)
+	}
+	return
+}
+
+// removeFiles cleans up the files of an attachment that could not be saved. Failures are ignored, as the
+// original error is the one worth reporting.
+func (a *attachmentServiceImpl) removeFiles(ctx context.Context, keys ...string) {
+	for _, key := range keys {
+		if key != "" {
+			_ = a.storage.Delete(ctx, key)
+		}
+	}
+}
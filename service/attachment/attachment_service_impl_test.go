@@ -0,0 +1,1086 @@
+package attachment
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"image"
+	"image/png"
+	"io"
+	"testing"
+
+	"github.com/erikrios/reog-apps-apis/entity"
+	"github.com/erikrios/reog-apps-apis/model/payload"
+	"github.com/erikrios/reog-apps-apis/repository"
+	mcr "github.com/erikrios/reog-apps-apis/repository/achievement/mocks"
+	mar "github.com/erikrios/reog-apps-apis/repository/attachment/mocks"
+	mgr "github.com/erikrios/reog-apps-apis/repository/group/mocks"
+	mmr "github.com/erikrios/reog-apps-apis/repository/maintenance/mocks"
+	mpr "github.com/erikrios/reog-apps-apis/repository/property/mocks"
+	"github.com/erikrios/reog-apps-apis/service"
+	"github.com/erikrios/reog-apps-apis/utils/generator"
+	mig "github.com/erikrios/reog-apps-apis/utils/generator/mocks"
+	mst "github.com/erikrios/reog-apps-apis/utils/storage/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func newPNG(t *testing.T) []byte {
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, image.NewRGBA(image.Rect(0, 0, 4, 4))); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestCreateForGroup(t *testing.T) {
+	mockAttachmentRepo := &mar.AttachmentRepository{}
+	mockGroupRepo := &mgr.GroupRepository{}
+	mockPropertyRepo := &mpr.PropertyRepository{}
+	mockAchievementRepo := &mcr.AchievementRepository{}
+	mockMaintenanceRepo := &mmr.MaintenanceRepository{}
+	mockIDGen := &mig.IDGenerator{}
+	mockThumbnailGen := &mig.ThumbnailGenerator{}
+	mockStorage := &mst.Storage{}
+
+	var attachmentService AttachmentService = NewAttachmentServiceImpl(
+		mockAttachmentRepo,
+		mockGroupRepo,
+		mockPropertyRepo,
+		mockAchievementRepo,
+		mockMaintenanceRepo,
+		mockIDGen,
+		mockThumbnailGen,
+		mockStorage,
+	)
+
+	mockStorage.On("URL", mock.AnythingOfType(fmt.Sprintf("%T", ""))).Return(
+		func(key string) string {
+			return "/uploads/" + key
+		},
+	)
+
+	onFindGroup := func(err error) {
+		mockGroupRepo.On(
+			"FindByID",
+			mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+			mock.AnythingOfType(fmt.Sprintf("%T", "")),
+		).Return(
+			func(ctx context.Context, id string) entity.Group {
+				return entity.Group{ID: id}
+			},
+			func(ctx context.Context, id string) error {
+				return err
+			},
+		).Once()
+	}
+
+	onGenerateID := func() {
+		mockIDGen.On("GenerateAttachmentID").Return(
+			func() string {
+				return "f-aBcdEfG"
+			},
+			func() error {
+				return nil
+			},
+		).Once()
+	}
+
+	onPut := func(key string, err error) {
+		mockStorage.On(
+			"Put",
+			mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+			key,
+			mock.AnythingOfType(fmt.Sprintf("%T", &bytes.Reader{})),
+			mock.AnythingOfType(fmt.Sprintf("%T", int64(0))),
+			mock.AnythingOfType(fmt.Sprintf("%T", "")),
+		).Return(
+			func(ctx context.Context, key string, r io.Reader, size int64, contentType string) error {
+				return err
+			},
+		).Once()
+	}
+
+	pngContent := newPNG(t)
+	pdfContent := []byte("%PDF-1.4\n1 0 obj\n<<>>\nendobj\ntrailer\n<<>>\n%%EOF\n")
+
+	testCases := []struct {
+		name                  string
+		inputCreateAttachment payload.CreateAttachment
+		expectedID            string
+		expectedError         error
+		mockBehaviours        func()
+	}{
+		{
+			name:                  "it should return service.ErrInvalidPayload error, when content is empty",
+			inputCreateAttachment: payload.CreateAttachment{FileName: "empty.jpg"},
+			expectedError:         service.ErrInvalidPayload,
+			mockBehaviours:        func() {},
+		},
+		{
+			name:                  "it should return service.ErrDataNotFound error, when group repository return an error",
+			inputCreateAttachment: payload.CreateAttachment{FileName: "merak.png", Content: pngContent},
+			expectedError:         service.ErrDataNotFound,
+			mockBehaviours: func() {
+				onFindGroup(repository.ErrRecordNotFound)
+			},
+		},
+		{
+			name:                  "it should return service.ErrFileTooLarge error, when content exceeds the maximum size",
+			inputCreateAttachment: payload.CreateAttachment{FileName: "big.pdf", Content: append(pdfContent, make([]byte, MaxFileSize)...)},
+			expectedError:         service.ErrFileTooLarge,
+			mockBehaviours: func() {
+				onFindGroup(nil)
+			},
+		},
+		{
+			name:                  "it should return service.ErrUnsupportedFile error, when content type is not allowed",
+			inputCreateAttachment: payload.CreateAttachment{FileName: "notes.txt", Content: []byte("just some notes")},
+			expectedError:         service.ErrUnsupportedFile,
+			mockBehaviours: func() {
+				onFindGroup(nil)
+			},
+		},
+		{
+			name:                  "it should return service.ErrUnsupportedFile error, when image can not be decoded",
+			inputCreateAttachment: payload.CreateAttachment{FileName: "broken.png", Content: pngContent[:16]},
+			expectedError:         service.ErrUnsupportedFile,
+			mockBehaviours: func() {
+				onFindGroup(nil)
+
+				mockThumbnailGen.On(
+					"GenerateThumbnail",
+					mock.AnythingOfType(fmt.Sprintf("%T", []byte{})),
+					mock.AnythingOfType(fmt.Sprintf("%T", 0)),
+				).Return(
+					func(content []byte, size int) []byte {
+						return nil
+					},
+					func(content []byte, size int) error {
+						return errors.New("png: invalid format")
+					},
+				).Once()
+			},
+		},
+		{
+			name:                  "it should return service.ErrFileTooLarge error, when image has too many pixels",
+			inputCreateAttachment: payload.CreateAttachment{FileName: "huge.png", Content: pngContent},
+			expectedError:         service.ErrFileTooLarge,
+			mockBehaviours: func() {
+				onFindGroup(nil)
+
+				mockThumbnailGen.On(
+					"GenerateThumbnail",
+					mock.AnythingOfType(fmt.Sprintf("%T", []byte{})),
+					mock.AnythingOfType(fmt.Sprintf("%T", 0)),
+				).Return(
+					func(content []byte, size int) []byte {
+						return nil
+					},
+					func(content []byte, size int) error {
+						return generator.ErrImageTooLarge
+					},
+				).Once()
+			},
+		},
+		{
+			name:                  "it should return service.ErrRepository error, when storage return an error",
+			inputCreateAttachment: payload.CreateAttachment{FileName: "surat.pdf", Content: pdfContent},
+			expectedError:         service.ErrRepository,
+			mockBehaviours: func() {
+				onFindGroup(nil)
+				onGenerateID()
+				onPut("groups/g-xyz/f-aBcdEfG.pdf", errors.New("storage unavailable"))
+			},
+		},
+		{
+			name:                  "it should return service.ErrRepository error and remove the files, when attachment repository return an error",
+			inputCreateAttachment: payload.CreateAttachment{FileName: "surat.pdf", Content: pdfContent},
+			expectedError:         service.ErrRepository,
+			mockBehaviours: func() {
+				onFindGroup(nil)
+				onGenerateID()
+				onPut("groups/g-xyz/f-aBcdEfG.pdf", nil)
+
+				mockAttachmentRepo.On(
+					"Insert",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", entity.Attachment{})),
+				).Return(
+					func(ctx context.Context, attachment entity.Attachment) error {
+						return repository.ErrDatabase
+					},
+				).Once()
+
+				mockStorage.On(
+					"Delete",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					"groups/g-xyz/f-aBcdEfG.pdf",
+				).Return(
+					func(ctx context.Context, key string) error {
+						return nil
+					},
+				).Once()
+			},
+		},
+		{
+			name:                  "it should return a valid ID without a thumbnail, when a document is uploaded",
+			inputCreateAttachment: payload.CreateAttachment{FileName: "surat.pdf", Content: pdfContent},
+			expectedID:            "f-aBcdEfG",
+			expectedError:         nil,
+			mockBehaviours: func() {
+				onFindGroup(nil)
+				onGenerateID()
+				onPut("groups/g-xyz/f-aBcdEfG.pdf", nil)
+
+				mockAttachmentRepo.On(
+					"Insert",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					entity.Attachment{
+						ID:          "f-aBcdEfG",
+						OwnerID:     "g-xyz",
+						OwnerType:   entity.AttachmentOwnerGroup,
+						FileName:    "surat.pdf",
+						ContentType: "application/pdf",
+						Size:        int64(len(pdfContent)),
+						Key:         "groups/g-xyz/f-aBcdEfG.pdf",
+						URL:         "/uploads/groups/g-xyz/f-aBcdEfG.pdf",
+					},
+				).Return(
+					func(ctx context.Context, attachment entity.Attachment) error {
+						return nil
+					},
+				).Once()
+			},
+		},
+		{
+			name:                  "it should return a valid ID with a thumbnail, when an image is uploaded",
+			inputCreateAttachment: payload.CreateAttachment{FileName: "merak.png", Content: pngContent},
+			expectedID:            "f-aBcdEfG",
+			expectedError:         nil,
+			mockBehaviours: func() {
+				onFindGroup(nil)
+
+				mockThumbnailGen.On(
+					"GenerateThumbnail",
+					mock.AnythingOfType(fmt.Sprintf("%T", []byte{})),
+					thumbnailSize,
+				).Return(
+					func(content []byte, size int) []byte {
+						return []byte("thumbnail")
+					},
+					func(content []byte, size int) error {
+						return nil
+					},
+				).Once()
+
+				onGenerateID()
+				onPut("groups/g-xyz/f-aBcdEfG.png", nil)
+				onPut("groups/g-xyz/f-aBcdEfG_thumbnail.jpg", nil)
+
+				mockAttachmentRepo.On(
+					"Insert",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					entity.Attachment{
+						ID:           "f-aBcdEfG",
+						OwnerID:      "g-xyz",
+						OwnerType:    entity.AttachmentOwnerGroup,
+						FileName:     "merak.png",
+						ContentType:  "image/png",
+						Size:         int64(len(pngContent)),
+						Key:          "groups/g-xyz/f-aBcdEfG.png",
+						URL:          "/uploads/groups/g-xyz/f-aBcdEfG.png",
+						ThumbnailKey: "groups/g-xyz/f-aBcdEfG_thumbnail.jpg",
+						ThumbnailURL: "/uploads/groups/g-xyz/f-aBcdEfG_thumbnail.jpg",
+					},
+				).Return(
+					func(ctx context.Context, attachment entity.Attachment) error {
+						return nil
+					},
+				).Once()
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.mockBehaviours()
+			gotID, gotErr := attachmentService.CreateForGroup(context.Background(), "g-xyz", testCase.inputCreateAttachment)
+
+			if testCase.expectedError != nil {
+				assert.ErrorIs(t, gotErr, testCase.expectedError)
+			} else {
+				assert.NoError(t, gotErr)
+				assert.Equal(t, testCase.expectedID, gotID)
+			}
+		})
+	}
+
+	mockStorage.AssertExpectations(t)
+	mockAttachmentRepo.AssertExpectations(t)
+}
+
+func TestCreateForProperty(t *testing.T) {
+	mockAttachmentRepo := &mar.AttachmentRepository{}
+	mockGroupRepo := &mgr.GroupRepository{}
+	mockPropertyRepo := &mpr.PropertyRepository{}
+	mockAchievementRepo := &mcr.AchievementRepository{}
+	mockMaintenanceRepo := &mmr.MaintenanceRepository{}
+	mockIDGen := &mig.IDGenerator{}
+	mockThumbnailGen := &mig.ThumbnailGenerator{}
+	mockStorage := &mst.Storage{}
+
+	var attachmentService AttachmentService = NewAttachmentServiceImpl(
+		mockAttachmentRepo,
+		mockGroupRepo,
+		mockPropertyRepo,
+		mockAchievementRepo,
+		mockMaintenanceRepo,
+		mockIDGen,
+		mockThumbnailGen,
+		mockStorage,
+	)
+
+	mockStorage.On("URL", mock.AnythingOfType(fmt.Sprintf("%T", ""))).Return(
+		func(key string) string {
+			return "/uploads/" + key
+		},
+	)
+
+	onFindProperty := func(groupID string, err error) {
+		mockPropertyRepo.On(
+			"FindByID",
+			mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+			mock.AnythingOfType(fmt.Sprintf("%T", "")),
+		).Return(
+			func(ctx context.Context, id string) entity.Property {
+				return entity.Property{ID: id, GroupID: groupID}
+			},
+			func(ctx context.Context, id string) error {
+				return err
+			},
+		).Once()
+	}
+
+	pdfContent := []byte("%PDF-1.4\n1 0 obj\n<<>>\nendobj\ntrailer\n<<>>\n%%EOF\n")
+
+	testCases := []struct {
+		name           string
+		expectedID     string
+		expectedError  error
+		mockBehaviours func()
+	}{
+		{
+			name:          "it should return service.ErrDataNotFound error, when property repository return an error",
+			expectedError: service.ErrDataNotFound,
+			mockBehaviours: func() {
+				onFindProperty("", repository.ErrRecordNotFound)
+			},
+		},
+		{
+			name:          "it should return service.ErrDataNotFound error, when property belongs to another group",
+			expectedError: service.ErrDataNotFound,
+			mockBehaviours: func() {
+				onFindProperty("g-abc", nil)
+			},
+		},
+		{
+			name:          "it should return a valid ID, when no error is returned",
+			expectedID:    "f-aBcdEfG",
+			expectedError: nil,
+			mockBehaviours: func() {
+				onFindProperty("g-xyz", nil)
+
+				mockIDGen.On("GenerateAttachmentID").Return(
+					func() string {
+						return "f-aBcdEfG"
+					},
+					func() error {
+						return nil
+					},
+				).Once()
+
+				mockStorage.On(
+					"Put",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					"properties/p-YIhpPgp/f-aBcdEfG.pdf",
+					mock.AnythingOfType(fmt.Sprintf("%T", &bytes.Reader{})),
+					int64(len(pdfContent)),
+					"application/pdf",
+				).Return(
+					func(ctx context.Context, key string, r io.Reader, size int64, contentType string) error {
+						return nil
+					},
+				).Once()
+
+				mockAttachmentRepo.On(
+					"Insert",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.MatchedBy(func(attachment entity.Attachment) bool {
+						return attachment.OwnerType == entity.AttachmentOwnerProperty && attachment.OwnerID == "p-YIhpPgp"
+					}),
+				).Return(
+					func(ctx context.Context, attachment entity.Attachment) error {
+						return nil
+					},
+				).Once()
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.mockBehaviours()
+			gotID, gotErr := attachmentService.CreateForProperty(
+				context.Background(),
+				"g-xyz",
+				"p-YIhpPgp",
+				payload.CreateAttachment{FileName: "nota.pdf", Content: pdfContent},
+			)
+
+			if testCase.expectedError != nil {
+				assert.ErrorIs(t, gotErr, testCase.expectedError)
+			} else {
+				assert.NoError(t, gotErr)
+				assert.Equal(t, testCase.expectedID, gotID)
+			}
+		})
+	}
+}
+
+func TestDeleteFromGroup(t *testing.T) {
+	mockAttachmentRepo := &mar.AttachmentRepository{}
+	mockGroupRepo := &mgr.GroupRepository{}
+	mockPropertyRepo := &mpr.PropertyRepository{}
+	mockAchievementRepo := &mcr.AchievementRepository{}
+	mockMaintenanceRepo := &mmr.MaintenanceRepository{}
+	mockIDGen := &mig.IDGenerator{}
+	mockThumbnailGen := &mig.ThumbnailGenerator{}
+	mockStorage := &mst.Storage{}
+
+	var attachmentService AttachmentService = NewAttachmentServiceImpl(
+		mockAttachmentRepo,
+		mockGroupRepo,
+		mockPropertyRepo,
+		mockAchievementRepo,
+		mockMaintenanceRepo,
+		mockIDGen,
+		mockThumbnailGen,
+		mockStorage,
+	)
+
+	dummyAttachment := entity.Attachment{
+		ID:           "f-aBcdEfG",
+		OwnerID:      "g-xyz",
+		OwnerType:    entity.AttachmentOwnerGroup,
+		Key:          "groups/g-xyz/f-aBcdEfG.png",
+		ThumbnailKey: "groups/g-xyz/f-aBcdEfG_thumbnail.jpg",
+	}
+
+	onFindAttachment := func(err error) {
+		mockAttachmentRepo.On(
+			"FindByID",
+			mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+			entity.AttachmentOwnerGroup,
+			"g-xyz",
+			"f-aBcdEfG",
+		).Return(
+			func(ctx context.Context, ownerType string, ownerID string, id string) entity.Attachment {
+				return dummyAttachment
+			},
+			func(ctx context.Context, ownerType string, ownerID string, id string) error {
+				return err
+			},
+		).Once()
+	}
+
+	onDeleteFile := func(key string, err error) {
+		mockStorage.On(
+			"Delete",
+			mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+			key,
+		).Return(
+			func(ctx context.Context, key string) error {
+				return err
+			},
+		).Once()
+	}
+
+	testCases := []struct {
+		name           string
+		expectedError  error
+		mockBehaviours func()
+	}{
+		{
+			name:          "it should return service.ErrDataNotFound error, when attachment repository return an error",
+			expectedError: service.ErrDataNotFound,
+			mockBehaviours: func() {
+				onFindAttachment(repository.ErrRecordNotFound)
+			},
+		},
+		{
+			name:          "it should return service.ErrRepository error, when storage return an error",
+			expectedError: service.ErrRepository,
+			mockBehaviours: func() {
+				onFindAttachment(nil)
+				onDeleteFile(dummyAttachment.Key, errors.New("storage unavailable"))
+			},
+		},
+		{
+			name:          "it should return nil error, when no error is returned",
+			expectedError: nil,
+			mockBehaviours: func() {
+				onFindAttachment(nil)
+				onDeleteFile(dummyAttachment.Key, nil)
+				onDeleteFile(dummyAttachment.ThumbnailKey, nil)
+
+				mockAttachmentRepo.On(
+					"Delete",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					entity.AttachmentOwnerGroup,
+					"g-xyz",
+					"f-aBcdEfG",
+				).Return(
+					func(ctx context.Context, ownerType string, ownerID string, id string) error {
+						return nil
+					},
+				).Once()
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.mockBehaviours()
+			gotErr := attachmentService.DeleteFromGroup(context.Background(), "g-xyz", "f-aBcdEfG")
+
+			if testCase.expectedError != nil {
+				assert.ErrorIs(t, gotErr, testCase.expectedError)
+			} else {
+				assert.NoError(t, gotErr)
+			}
+		})
+	}
+
+	mockStorage.AssertExpectations(t)
+	mockAttachmentRepo.AssertExpectations(t)
+}
+
+func TestDeleteFromProperty(t *testing.T) {
+	mockAttachmentRepo := &mar.AttachmentRepository{}
+	mockGroupRepo := &mgr.GroupRepository{}
+	mockPropertyRepo := &mpr.PropertyRepository{}
+	mockAchievementRepo := &mcr.AchievementRepository{}
+	mockMaintenanceRepo := &mmr.MaintenanceRepository{}
+	mockIDGen := &mig.IDGenerator{}
+	mockThumbnailGen := &mig.ThumbnailGenerator{}
+	mockStorage := &mst.Storage{}
+
+	var attachmentService AttachmentService = NewAttachmentServiceImpl(
+		mockAttachmentRepo,
+		mockGroupRepo,
+		mockPropertyRepo,
+		mockAchievementRepo,
+		mockMaintenanceRepo,
+		mockIDGen,
+		mockThumbnailGen,
+		mockStorage,
+	)
+
+	testCases := []struct {
+		name           string
+		expectedError  error
+		mockBehaviours func()
+	}{
+		{
+			name:          "it should return service.ErrDataNotFound error, when property belongs to another group",
+			expectedError: service.ErrDataNotFound,
+			mockBehaviours: func() {
+				mockPropertyRepo.On(
+					"FindByID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, id string) entity.Property {
+						return entity.Property{ID: id, GroupID: "g-abc"}
+					},
+					func(ctx context.Context, id string) error {
+						return nil
+					},
+				).Once()
+			},
+		},
+		{
+			name:          "it should return nil error, when no error is returned",
+			expectedError: nil,
+			mockBehaviours: func() {
+				mockPropertyRepo.On(
+					"FindByID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, id string) entity.Property {
+						return entity.Property{ID: id, GroupID: "g-xyz"}
+					},
+					func(ctx context.Context, id string) error {
+						return nil
+					},
+				).Once()
+
+				mockAttachmentRepo.On(
+					"FindByID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					entity.AttachmentOwnerProperty,
+					"p-YIhpPgp",
+					"f-aBcdEfG",
+				).Return(
+					func(ctx context.Context, ownerType string, ownerID string, id string) entity.Attachment {
+						return entity.Attachment{ID: id, Key: "properties/p-YIhpPgp/f-aBcdEfG.pdf"}
+					},
+					func(ctx context.Context, ownerType string, ownerID string, id string) error {
+						return nil
+					},
+				).Once()
+
+				mockStorage.On(
+					"Delete",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					"properties/p-YIhpPgp/f-aBcdEfG.pdf",
+				).Return(
+					func(ctx context.Context, key string) error {
+						return nil
+					},
+				).Once()
+
+				mockAttachmentRepo.On(
+					"Delete",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					entity.AttachmentOwnerProperty,
+					"p-YIhpPgp",
+					"f-aBcdEfG",
+				).Return(
+					func(ctx context.Context, ownerType string, ownerID string, id string) error {
+						return nil
+					},
+				).Once()
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.mockBehaviours()
+			gotErr := attachmentService.DeleteFromProperty(context.Background(), "g-xyz", "p-YIhpPgp", "f-aBcdEfG")
+
+			if testCase.expectedError != nil {
+				assert.ErrorIs(t, gotErr, testCase.expectedError)
+			} else {
+				assert.NoError(t, gotErr)
+			}
+		})
+	}
+}
+
+func TestCreateForAchievement(t *testing.T) {
+	mockAttachmentRepo := &mar.AttachmentRepository{}
+	mockGroupRepo := &mgr.GroupRepository{}
+	mockPropertyRepo := &mpr.PropertyRepository{}
+	mockAchievementRepo := &mcr.AchievementRepository{}
+	mockMaintenanceRepo := &mmr.MaintenanceRepository{}
+	mockIDGen := &mig.IDGenerator{}
+	mockThumbnailGen := &mig.ThumbnailGenerator{}
+	mockStorage := &mst.Storage{}
+
+	var attachmentService AttachmentService = NewAttachmentServiceImpl(
+		mockAttachmentRepo,
+		mockGroupRepo,
+		mockPropertyRepo,
+		mockAchievementRepo,
+		mockMaintenanceRepo,
+		mockIDGen,
+		mockThumbnailGen,
+		mockStorage,
+	)
+
+	mockStorage.On("URL", mock.AnythingOfType(fmt.Sprintf("%T", ""))).Return(
+		func(key string) string {
+			return "/uploads/" + key
+		},
+	)
+
+	onFindAchievement := func(certificate *entity.Attachment, err error) {
+		mockAchievementRepo.On(
+			"FindByID",
+			mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+			"g-xyz",
+			"c-oPqrStU",
+		).Return(
+			func(ctx context.Context, groupID string, id string) entity.Achievement {
+				return entity.Achievement{ID: id, GroupID: groupID, Certificate: certificate}
+			},
+			func(ctx context.Context, groupID string, id string) error {
+				return err
+			},
+		).Once()
+	}
+
+	pdfContent := []byte("%PDF-1.4\n1 0 obj\n<<>>\nendobj\ntrailer\n<<>>\n%%EOF\n")
+
+	testCases := []struct {
+		name           string
+		expectedID     string
+		expectedError  error
+		mockBehaviours func()
+	}{
+		{
+			name:          "it should return service.ErrDataNotFound error, when achievement repository return an error",
+			expectedError: service.ErrDataNotFound,
+			mockBehaviours: func() {
+				onFindAchievement(nil, repository.ErrRecordNotFound)
+			},
+		},
+		{
+			name:          "it should return service.ErrDataAlreadyExists error, when achievement already has a certificate",
+			expectedError: service.ErrDataAlreadyExists,
+			mockBehaviours: func() {
+				onFindAchievement(&entity.Attachment{ID: "f-hIjkLmN"}, nil)
+			},
+		},
+		{
+			name:          "it should return a valid ID, when no error is returned",
+			expectedID:    "f-aBcdEfG",
+			expectedError: nil,
+			mockBehaviours: func() {
+				onFindAchievement(nil, nil)
+
+				mockIDGen.On("GenerateAttachmentID").Return(
+					func() string {
+						return "f-aBcdEfG"
+					},
+					func() error {
+						return nil
+					},
+				).Once()
+
+				mockStorage.On(
+					"Put",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					"achievements/c-oPqrStU/f-aBcdEfG.pdf",
+					mock.AnythingOfType(fmt.Sprintf("%T", &bytes.Reader{})),
+					int64(len(pdfContent)),
+					"application/pdf",
+				).Return(
+					func(ctx context.Context, key string, r io.Reader, size int64, contentType string) error {
+						return nil
+					},
+				).Once()
+
+				mockAttachmentRepo.On(
+					"Insert",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.MatchedBy(func(attachment entity.Attachment) bool {
+						return attachment.OwnerType == entity.AttachmentOwnerAchievement && attachment.OwnerID == "c-oPqrStU"
+					}),
+				).Return(
+					func(ctx context.Context, attachment entity.Attachment) error {
+						return nil
+					},
+				).Once()
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.mockBehaviours()
+			gotID, gotErr := attachmentService.CreateForAchievement(
+				context.Background(),
+				"g-xyz",
+				"c-oPqrStU",
+				payload.CreateAttachment{FileName: "piagam.pdf", Content: pdfContent},
+			)
+
+			if testCase.expectedError != nil {
+				assert.ErrorIs(t, gotErr, testCase.expectedError)
+			} else {
+				assert.NoError(t, gotErr)
+				assert.Equal(t, testCase.expectedID, gotID)
+			}
+		})
+	}
+}
+
+func TestDeleteFromAchievement(t *testing.T) {
+	mockAttachmentRepo := &mar.AttachmentRepository{}
+	mockGroupRepo := &mgr.GroupRepository{}
+	mockPropertyRepo := &mpr.PropertyRepository{}
+	mockAchievementRepo := &mcr.AchievementRepository{}
+	mockMaintenanceRepo := &mmr.MaintenanceRepository{}
+	mockIDGen := &mig.IDGenerator{}
+	mockThumbnailGen := &mig.ThumbnailGenerator{}
+	mockStorage := &mst.Storage{}
+
+	var attachmentService AttachmentService = NewAttachmentServiceImpl(
+		mockAttachmentRepo,
+		mockGroupRepo,
+		mockPropertyRepo,
+		mockAchievementRepo,
+		mockMaintenanceRepo,
+		mockIDGen,
+		mockThumbnailGen,
+		mockStorage,
+	)
+
+	onFindAchievement := func(certificate *entity.Attachment) {
+		mockAchievementRepo.On(
+			"FindByID",
+			mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+			"g-xyz",
+			"c-oPqrStU",
+		).Return(
+			func(ctx context.Context, groupID string, id string) entity.Achievement {
+				return entity.Achievement{ID: id, GroupID: groupID, Certificate: certificate}
+			},
+			func(ctx context.Context, groupID string, id string) error {
+				return nil
+			},
+		).Once()
+	}
+
+	testCases := []struct {
+		name           string
+		expectedError  error
+		mockBehaviours func()
+	}{
+		{
+			name:          "it should return service.ErrDataNotFound error, when achievement has no certificate",
+			expectedError: service.ErrDataNotFound,
+			mockBehaviours: func() {
+				onFindAchievement(nil)
+			},
+		},
+		{
+			name:          "it should return nil error, when no error is returned",
+			expectedError: nil,
+			mockBehaviours: func() {
+				onFindAchievement(&entity.Attachment{ID: "f-aBcdEfG"})
+
+				mockAttachmentRepo.On(
+					"FindByID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					entity.AttachmentOwnerAchievement,
+					"c-oPqrStU",
+					"f-aBcdEfG",
+				).Return(
+					func(ctx context.Context, ownerType string, ownerID string, id string) entity.Attachment {
+						return entity.Attachment{ID: id, Key: "achievements/c-oPqrStU/f-aBcdEfG.pdf"}
+					},
+					func(ctx context.Context, ownerType string, ownerID string, id string) error {
+						return nil
+					},
+				).Once()
+
+				mockStorage.On(
+					"Delete",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					"achievements/c-oPqrStU/f-aBcdEfG.pdf",
+				).Return(
+					func(ctx context.Context, key string) error {
+						return nil
+					},
+				).Once()
+
+				mockAttachmentRepo.On(
+					"Delete",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					entity.AttachmentOwnerAchievement,
+					"c-oPqrStU",
+					"f-aBcdEfG",
+				).Return(
+					func(ctx context.Context, ownerType string, ownerID string, id string) error {
+						return nil
+					},
+				).Once()
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.mockBehaviours()
+			gotErr := attachmentService.DeleteFromAchievement(context.Background(), "g-xyz", "c-oPqrStU")
+
+			if testCase.expectedError != nil {
+				assert.ErrorIs(t, gotErr, testCase.expectedError)
+			} else {
+				assert.NoError(t, gotErr)
+			}
+		})
+	}
+}
+
+func TestCreateForMaintenance(t *testing.T) {
+	mockAttachmentRepo := &mar.AttachmentRepository{}
+	mockGroupRepo := &mgr.GroupRepository{}
+	mockPropertyRepo := &mpr.PropertyRepository{}
+	mockAchievementRepo := &mcr.AchievementRepository{}
+	mockMaintenanceRepo := &mmr.MaintenanceRepository{}
+	mockIDGen := &mig.IDGenerator{}
+	mockThumbnailGen := &mig.ThumbnailGenerator{}
+	mockStorage := &mst.Storage{}
+
+	var attachmentService AttachmentService = NewAttachmentServiceImpl(
+		mockAttachmentRepo,
+		mockGroupRepo,
+		mockPropertyRepo,
+		mockAchievementRepo,
+		mockMaintenanceRepo,
+		mockIDGen,
+		mockThumbnailGen,
+		mockStorage,
+	)
+
+	mockStorage.On("URL", mock.AnythingOfType(fmt.Sprintf("%T", ""))).Return(
+		func(key string) string {
+			return "/uploads/" + key
+		},
+	)
+
+	onFindProperty := func() {
+		mockPropertyRepo.On(
+			"FindByID",
+			mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+			"p-xyz",
+		).Return(
+			func(ctx context.Context, id string) entity.Property {
+				return entity.Property{ID: id, GroupID: "g-xyz"}
+			},
+			func(ctx context.Context, id string) error {
+				return nil
+			},
+		).Once()
+	}
+
+	onFindMaintenance := func(err error) {
+		mockMaintenanceRepo.On(
+			"FindByID",
+			mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+			"p-xyz",
+			"i-oPqrStU",
+		).Return(
+			func(ctx context.Context, propertyID string, id string) entity.Maintenance {
+				return entity.Maintenance{ID: id, PropertyID: propertyID}
+			},
+			func(ctx context.Context, propertyID string, id string) error {
+				return err
+			},
+		).Once()
+	}
+
+	pngContent := newPNG(t)
+	pdfContent := []byte("%PDF-1.4\n1 0 obj\n<<>>\nendobj\ntrailer\n<<>>\n%%EOF\n")
+
+	testCases := []struct {
+		name           string
+		inputContent   []byte
+		expectedID     string
+		expectedError  error
+		mockBehaviours func()
+	}{
+		{
+			name:           "it should return service.ErrUnsupportedFile error, when the photo is not an image",
+			inputContent:   pdfContent,
+			expectedError:  service.ErrUnsupportedFile,
+			mockBehaviours: func() {},
+		},
+		{
+			name:          "it should return service.ErrDataNotFound error, when maintenance repository return an error",
+			inputContent:  pngContent,
+			expectedError: service.ErrDataNotFound,
+			mockBehaviours: func() {
+				onFindProperty()
+				onFindMaintenance(repository.ErrRecordNotFound)
+			},
+		},
+		{
+			name:          "it should return a valid ID, when no error is returned",
+			inputContent:  pngContent,
+			expectedID:    "f-aBcdEfG",
+			expectedError: nil,
+			mockBehaviours: func() {
+				onFindProperty()
+				onFindMaintenance(nil)
+
+				mockThumbnailGen.On(
+					"GenerateThumbnail",
+					mock.AnythingOfType(fmt.Sprintf("%T", []byte{})),
+					mock.AnythingOfType(fmt.Sprintf("%T", 0)),
+				).Return(
+					func(content []byte, size int) []byte {
+						return []byte("thumbnail")
+					},
+					func(content []byte, size int) error {
+						return nil
+					},
+				).Once()
+
+				mockIDGen.On("GenerateAttachmentID").Return(
+					func() string {
+						return "f-aBcdEfG"
+					},
+					func() error {
+						return nil
+					},
+				).Once()
+
+				mockStorage.On(
+					"Put",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", &bytes.Reader{})),
+					mock.AnythingOfType(fmt.Sprintf("%T", int64(0))),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, key string, r io.Reader, size int64, contentType string) error {
+						return nil
+					},
+				).Twice()
+
+				mockAttachmentRepo.On(
+					"Insert",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.MatchedBy(func(attachment entity.Attachment) bool {
+						return attachment.OwnerType == entity.AttachmentOwnerMaintenance &&
+							attachment.OwnerID == "i-oPqrStU" &&
+							attachment.Key == "maintenances/i-oPqrStU/f-aBcdEfG.png"
+					}),
+				).Return(
+					func(ctx context.Context, attachment entity.Attachment) error {
+						return nil
+					},
+				).Once()
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.mockBehaviours()
+			gotID, gotErr := attachmentService.CreateForMaintenance(
+				context.Background(),
+				"g-xyz",
+				"p-xyz",
+				"i-oPqrStU",
+				payload.CreateAttachment{FileName: "sobek.png", Content: testCase.inputContent},
+			)
+
+			if testCase.expectedError != nil {
+				assert.ErrorIs(t, gotErr, testCase.expectedError)
+			} else {
+				assert.NoError(t, gotErr)
+				assert.Equal(t, testCase.expectedID, gotID)
+			}
+		})
+	}
+}
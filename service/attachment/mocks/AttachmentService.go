@@ -0,0 +1,155 @@
+// Code generated by mockery v2.10.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	payload "github.com/erikrios/reog-apps-apis/model/payload"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// AttachmentService is an autogenerated mock type for the AttachmentService type
+type AttachmentService struct {
+	mock.Mock
+}
+
+// CreateForAchievement provides a mock function with given fields: ctx, groupID, achievementID, p
+func (_m *AttachmentService) CreateForAchievement(ctx context.Context, groupID string, achievementID string, p payload.CreateAttachment) (string, error) {
+	ret := _m.Called(ctx, groupID, achievementID, p)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, payload.CreateAttachment) string); ok {
+		r0 = rf(ctx, groupID, achievementID, p)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, payload.CreateAttachment) error); ok {
+		r1 = rf(ctx, groupID, achievementID, p)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateForGroup provides a mock function with given fields: ctx, groupID, p
+func (_m *AttachmentService) CreateForGroup(ctx context.Context, groupID string, p payload.CreateAttachment) (string, error) {
+	ret := _m.Called(ctx, groupID, p)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(context.Context, string, payload.CreateAttachment) string); ok {
+		r0 = rf(ctx, groupID, p)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, payload.CreateAttachment) error); ok {
+		r1 = rf(ctx, groupID, p)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateForMaintenance provides a mock function with given fields: ctx, groupID, propertyID, maintenanceID, p
+func (_m *AttachmentService) CreateForMaintenance(ctx context.Context, groupID string, propertyID string, maintenanceID string, p payload.CreateAttachment) (string, error) {
+	ret := _m.Called(ctx, groupID, propertyID, maintenanceID, p)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, payload.CreateAttachment) string); ok {
+		r0 = rf(ctx, groupID, propertyID, maintenanceID, p)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, payload.CreateAttachment) error); ok {
+		r1 = rf(ctx, groupID, propertyID, maintenanceID, p)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateForProperty provides a mock function with given fields: ctx, groupID, propertyID, p
+func (_m *AttachmentService) CreateForProperty(ctx context.Context, groupID string, propertyID string, p payload.CreateAttachment) (string, error) {
+	ret := _m.Called(ctx, groupID, propertyID, p)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, payload.CreateAttachment) string); ok {
+		r0 = rf(ctx, groupID, propertyID, p)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, payload.CreateAttachment) error); ok {
+		r1 = rf(ctx, groupID, propertyID, p)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteFromAchievement provides a mock function with given fields: ctx, groupID, achievementID
+func (_m *AttachmentService) DeleteFromAchievement(ctx context.Context, groupID string, achievementID string) error {
+	ret := _m.Called(ctx, groupID, achievementID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, groupID, achievementID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteFromGroup provides a mock function with given fields: ctx, groupID, id
+func (_m *AttachmentService) DeleteFromGroup(ctx context.Context, groupID string, id string) error {
+	ret := _m.Called(ctx, groupID, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, groupID, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteFromMaintenance provides a mock function with given fields: ctx, groupID, propertyID, maintenanceID, id
+func (_m *AttachmentService) DeleteFromMaintenance(ctx context.Context, groupID string, propertyID string, maintenanceID string, id string) error {
+	ret := _m.Called(ctx, groupID, propertyID, maintenanceID, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string) error); ok {
+		r0 = rf(ctx, groupID, propertyID, maintenanceID, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteFromProperty provides a mock function with given fields: ctx, groupID, propertyID, id
+func (_m *AttachmentService) DeleteFromProperty(ctx context.Context, groupID string, propertyID string, id string) error {
+	ret := _m.Called(ctx, groupID, propertyID, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) error); ok {
+		r0 = rf(ctx, groupID, propertyID, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
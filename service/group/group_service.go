@@ -9,9 +9,24 @@ import (
 
 type GroupService interface {
 	Create(ctx context.Context, p payload.CreateGroup) (id string, err error)
-	GetAll(ctx context.Context) (responses []response.Group, err error)
+	CreateWithContacts(ctx context.Context, p payload.CreateGroup, c payload.UpdateGroupContacts) (id string, err error)
+	Import(ctx context.Context, rows []payload.CreateGroup, p payload.ImportGroups) (responses []response.ImportGroup, err error)
+	GetAll(ctx context.Context, p payload.GetGroups) (responses []response.Group, pagination response.Pagination, err error)
+	Export(ctx context.Context, p payload.GetGroups, write func(groups []response.Group) error) (err error)
+	GetNearby(ctx context.Context, p payload.GetNearbyGroups) (responses []response.NearbyGroup, err error)
 	GetByID(ctx context.Context, id string) (response response.Group, err error)
-	Update(ctx context.Context, id string, p payload.UpdateGroup) (err error)
-	Delete(ctx context.Context, id string) (err error)
+	Update(ctx context.Context, id string, version int, adminID, adminUsername string, p payload.UpdateGroup) (err error)
+	Patch(ctx context.Context, id string, version int, adminID, adminUsername string, p payload.PatchGroup) (err error)
+	UpdateContacts(ctx context.Context, id string, version int, p payload.UpdateGroupContacts) (err error)
+	Delete(ctx context.Context, id string, version int, p payload.DeleteGroup) (err error)
+	PreviewDelete(ctx context.Context, id string, p payload.DeleteGroup) (response response.GroupDeletion, err error)
+	GetDuplicates(ctx context.Context, p payload.GetDuplicateGroups) (responses []response.DuplicateGroups, err error)
+	Merge(ctx context.Context, id string, version, targetVersion int, p payload.MergeGroup) (err error)
+	UpdateStatus(ctx context.Context, id string, version int, adminID, adminUsername string, p payload.UpdateGroupStatus) (err error)
+	GetStatusHistory(ctx context.Context, id string) (responses []response.GroupStatusTransition, err error)
+	GetLeadershipHistory(ctx context.Context, id string) (responses []response.LeadershipChange, err error)
+	AssignRegistrationNumbers(ctx context.Context) (err error)
+	GenerateCertificate(ctx context.Context, id string) (file []byte, err error)
 	GenerateQRCode(ctx context.Context, id string) (file []byte, err error)
+	GenerateVCard(ctx context.Context, id string) (file []byte, err error)
 }
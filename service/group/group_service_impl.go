@@ -2,23 +2,58 @@ package group
 
 import (
 	"context"
+	"errors"
+	"math"
+	"sort"
+	"strings"
+	"time"
 
 	"github.com/erikrios/reog-apps-apis/entity"
 	"github.com/erikrios/reog-apps-apis/model/payload"
 	"github.com/erikrios/reog-apps-apis/model/response"
+	"github.com/erikrios/reog-apps-apis/repository"
 	"github.com/erikrios/reog-apps-apis/repository/group"
 	"github.com/erikrios/reog-apps-apis/repository/village"
 	"github.com/erikrios/reog-apps-apis/service"
+	"github.com/erikrios/reog-apps-apis/utils/contact"
+	"github.com/erikrios/reog-apps-apis/utils/fuzzy"
 	"github.com/erikrios/reog-apps-apis/utils/generator"
+	"github.com/erikrios/reog-apps-apis/utils/geo"
+	"github.com/erikrios/reog-apps-apis/utils/qrsign"
 	"github.com/skip2/go-qrcode"
 	"gopkg.in/validator.v2"
 )
 
+const (
+	defaultLimit  = 20
+	maxImportRows = 1000
+	// exportPageSize is the number of groups Export reads from the database at a time
+	exportPageSize = 500
+	// dateLayout is the layout of the dates of leadership changes
+	dateLayout = "2006-01-02"
+	// defaultMinSimilarity is the lowest similarity of the reported duplicates when none is given
+	defaultMinSimilarity = 0.8
+	// defaultRadius is the radius of the nearby search when none is given, in meters
+	defaultRadius = 5000
+)
+
+const (
+	importStatusCreated = "created"
+	importStatusValid   = "valid"
+	importStatusInvalid = "invalid"
+	importStatusSkipped = "skipped"
+	importStatusFailed  = "failed"
+)
+
 type groupServiceImpl struct {
-	groupRepository   group.GroupRepository
-	villageRepository village.VillageRepository
-	idGenerator       generator.IDGenerator
-	qrCodeGenerator   generator.QRCodeGenerator
+	groupRepository             group.GroupRepository
+	villageRepository           village.VillageRepository
+	idGenerator                 generator.IDGenerator
+	qrCodeGenerator             generator.QRCodeGenerator
+	scanLinkGenerator           generator.ScanLinkGenerator
+	codeSigner                  generator.CodeSigner
+	registrationNumberGenerator generator.RegistrationNumberGenerator
+	certificateGenerator        generator.CertificateGenerator
 }
 
 func NewGroupServiceImpl(
@@ -26,17 +61,42 @@ func NewGroupServiceImpl(
 	villageRepository village.VillageRepository,
 	idGenerator generator.IDGenerator,
 	qrCodeGenerator generator.QRCodeGenerator,
+	scanLinkGenerator generator.ScanLinkGenerator,
+	codeSigner generator.CodeSigner,
+	registrationNumberGenerator generator.RegistrationNumberGenerator,
+	certificateGenerator generator.CertificateGenerator,
 ) *groupServiceImpl {
 	return &groupServiceImpl{
-		groupRepository:   groupRepository,
-		villageRepository: villageRepository,
-		idGenerator:       idGenerator,
-		qrCodeGenerator:   qrCodeGenerator,
+		groupRepository:             groupRepository,
+		villageRepository:           villageRepository,
+		idGenerator:                 idGenerator,
+		qrCodeGenerator:             qrCodeGenerator,
+		scanLinkGenerator:           scanLinkGenerator,
+		codeSigner:                  codeSigner,
+		registrationNumberGenerator: registrationNumberGenerator,
+		certificateGenerator:        certificateGenerator,
 	}
 }
 
 func (g *groupServiceImpl) Create(ctx context.Context, p payload.CreateGroup) (id string, err error) {
-	if validateErr := validator.Validate(p); validateErr != nil {
+	id, err = g.CreateWithContacts(ctx, p, payload.UpdateGroupContacts{})
+	return
+}
+
+// CreateWithContacts creates the group with its contacts at once, as for an approved submission.
+func (g *groupServiceImpl) CreateWithContacts(ctx context.Context, p payload.CreateGroup, c payload.UpdateGroupContacts) (id string, err error) {
+	if validateErr := validator.Validate(p); validateErr != nil || !geo.ValidLocation(p.Latitude, p.Longitude) {
+		err = service.ErrInvalidPayload
+		return
+	}
+
+	if validateErr := validator.Validate(c); validateErr != nil {
+		err = service.ErrInvalidPayload
+		return
+	}
+
+	contacts, ok := mapToContacts(c)
+	if !ok {
 		err = service.ErrInvalidPayload
 		return
 	}
@@ -53,25 +113,10 @@ func (g *groupServiceImpl) Create(ctx context.Context, p payload.CreateGroup) (i
 		return
 	}
 
-	group := entity.Group{
-		ID:     id,
-		Name:   p.Name,
-		Leader: p.Leader,
-		Address: entity.Address{
-			ID:           id,
-			Address:      p.Address,
-			VillageID:    village.ID,
-			VillageName:  village.Name,
-			DistrictID:   village.District.ID,
-			DistrictName: village.District.Name,
-			RegencyID:    village.District.Regency.ID,
-			RegencyName:  village.District.Regency.Name,
-			ProvinceID:   village.District.Regency.Province.ID,
-			ProvinceName: village.District.Regency.Province.Name,
-		},
-	}
+	group := mapToEntity(id, p, village)
+	group.Contacts = contacts
 
-	if repoErr := g.groupRepository.Insert(ctx, group); repoErr != nil {
+	if repoErr := g.groupRepository.Insert(ctx, group, g.registration(group.Address, time.Now().Year())); repoErr != nil {
 		err = service.MapError(repoErr)
 		return
 	}
@@ -79,14 +124,226 @@ func (g *groupServiceImpl) Create(ctx context.Context, p payload.CreateGroup) (i
 	return
 }
 
-func (g *groupServiceImpl) GetAll(ctx context.Context) (responses []response.Group, err error) {
-	groups, repoErr := g.groupRepository.FindAll(ctx)
+func (g *groupServiceImpl) Import(ctx context.Context, rows []payload.CreateGroup, p payload.ImportGroups) (responses []response.ImportGroup, err error) {
+	if len(rows) == 0 || len(rows) > maxImportRows {
+		err = service.ErrInvalidPayload
+		return
+	}
+
+	responses = make([]response.ImportGroup, len(rows))
+	groups := make([]entity.Group, 0, len(rows))
+	indexes := make([]int, 0, len(rows))
+	villages := make(map[string]entity.Village)
+	ids := make(map[string]bool)
+	hasInvalid := false
+
+	for i, row := range rows {
+		responses[i].Row = i + 1
+
+		if validateErr := validator.Validate(row); validateErr != nil {
+			responses[i].Status = importStatusInvalid
+			responses[i].Error = "invalid payload: " + validateErr.Error()
+			hasInvalid = true
+			continue
+		}
+
+		if !geo.ValidLocation(row.Latitude, row.Longitude) {
+			responses[i].Status = importStatusInvalid
+			responses[i].Error = "invalid payload: latitude and longitude must be given together and lie in Ponorogo"
+			hasInvalid = true
+			continue
+		}
+
+		village, ok := villages[row.VillageID]
+		if !ok {
+			var villageErr error
+			village, villageErr = g.villageRepository.FindByID(row.VillageID)
+			if villageErr != nil {
+				if errors.Is(service.MapError(villageErr), service.ErrDataNotFound) {
+					responses[i].Status = importStatusInvalid
+					responses[i].Error = "village with ID " + row.VillageID + " not found"
+					hasInvalid = true
+					continue
+				}
+
+				err = service.MapError(villageErr)
+				return
+			}
+			villages[row.VillageID] = village
+		}
+
+		responses[i].Status = importStatusValid
+		if p.DryRun {
+			continue
+		}
+
+		id, genErr := g.idGenerator.GenerateGroupID()
+		for genErr == nil && ids[id] {
+			id, genErr = g.idGenerator.GenerateGroupID()
+		}
+		if genErr != nil {
+			err = service.MapError(genErr)
+			return
+		}
+		ids[id] = true
+
+		groups = append(groups, mapToEntity(id, row, village))
+		indexes = append(indexes, i)
+	}
+
+	if p.DryRun || len(groups) == 0 {
+		return
+	}
+
+	if p.Atomic && hasInvalid {
+		for _, i := range indexes {
+			responses[i].Status = importStatusSkipped
+		}
+		return
+	}
+
+	year := time.Now().Year()
+	if p.Atomic {
+		registrations := make([]group.Registration, len(groups))
+		for j := range groups {
+			registrations[j] = g.registration(groups[j].Address, year)
+		}
+
+		if repoErr := g.groupRepository.InsertAll(ctx, groups, registrations); repoErr != nil {
+			err = service.MapError(repoErr)
+			return
+		}
+
+		for j, i := range indexes {
+			responses[i].Status = importStatusCreated
+			responses[i].ID = groups[j].ID
+		}
+		return
+	}
+
+	for j, i := range indexes {
+		if repoErr := g.groupRepository.Insert(ctx, groups[j], g.registration(groups[j].Address, year)); repoErr != nil {
+			responses[i].Status = importStatusFailed
+			if errors.Is(service.MapError(repoErr), service.ErrDataAlreadyExists) {
+				responses[i].Error = "group already exists"
+			} else {
+				responses[i].Error = "group could not be saved, import the row again later"
+			}
+			continue
+		}
+
+		responses[i].Status = importStatusCreated
+		responses[i].ID = groups[j].ID
+	}
+	return
+}
+
+func (g *groupServiceImpl) GetAll(ctx context.Context, p payload.GetGroups) (responses []response.Group, pagination response.Pagination, err error) {
+	if validateErr := validator.Validate(p); validateErr != nil {
+		err = service.ErrInvalidPayload
+		return
+	}
+
+	if p.Page == 0 {
+		p.Page = 1
+	}
+
+	if p.Limit == 0 {
+		p.Limit = defaultLimit
+	}
+
+	filter := mapToFilter(p)
+	filter.Offset = (p.Page - 1) * p.Limit
+	filter.Limit = p.Limit
+
+	groups, total, repoErr := g.groupRepository.FindAll(ctx, filter)
 	if repoErr != nil {
 		err = service.MapError(repoErr)
 		return
 	}
 
 	responses = mapToModels(groups)
+	pagination = response.Pagination{
+		Page:       p.Page,
+		Limit:      p.Limit,
+		TotalItems: total,
+		TotalPages: int((total + int64(p.Limit) - 1) / int64(p.Limit)),
+	}
+	return
+}
+
+// Export pages through the groups matching the filters and hands every page to write as soon as it is read, so that
+// the groups are never all held at once. write is called at least once, with an empty page when no group matches.
+func (g *groupServiceImpl) Export(ctx context.Context, p payload.GetGroups, write func(groups []response.Group) error) (err error) {
+	if validateErr := validator.Validate(p); validateErr != nil {
+		err = service.ErrInvalidPayload
+		return
+	}
+
+	filter := mapToFilter(p)
+	filter.Limit = exportPageSize
+
+	for {
+		groups, _, repoErr := g.groupRepository.FindAll(ctx, filter)
+		if repoErr != nil {
+			err = service.MapError(repoErr)
+			return
+		}
+
+		if err = write(mapToModels(groups)); err != nil || len(groups) < filter.Limit {
+			return
+		}
+
+		filter.Offset += filter.Limit
+	}
+}
+
+// GetNearby returns the groups within the radius of the given point, from the closest one. The database only narrows
+// the groups down to the bounds of the circle, the distances are calculated here.
+func (g *groupServiceImpl) GetNearby(ctx context.Context, p payload.GetNearbyGroups) (responses []response.NearbyGroup, err error) {
+	if validateErr := validator.Validate(p); validateErr != nil {
+		err = service.ErrInvalidPayload
+		return
+	}
+
+	if p.Radius == 0 {
+		p.Radius = defaultRadius
+	}
+
+	if p.Limit == 0 {
+		p.Limit = defaultLimit
+	}
+
+	center := geo.Point{Latitude: *p.Latitude, Longitude: *p.Longitude}
+	bounds := geo.Around(center, p.Radius)
+
+	groups, _, repoErr := g.groupRepository.FindAll(ctx, group.Filter{Within: &bounds})
+	if repoErr != nil {
+		err = service.MapError(repoErr)
+		return
+	}
+
+	responses = make([]response.NearbyGroup, 0, len(groups))
+	for _, group := range groups {
+		if group.Address.Latitude == nil || group.Address.Longitude == nil {
+			continue
+		}
+
+		distance := geo.Distance(center, geo.Point{Latitude: *group.Address.Latitude, Longitude: *group.Address.Longitude})
+		if distance > p.Radius {
+			continue
+		}
+
+		responses = append(responses, response.NearbyGroup{Group: mapToModel(group), Distance: math.Round(distance)})
+	}
+
+	sort.SliceStable(responses, func(i, j int) bool {
+		return responses[i].Distance < responses[j].Distance
+	})
+
+	if len(responses) > p.Limit {
+		responses = responses[:p.Limit]
+	}
 	return
 }
 
@@ -101,27 +358,453 @@ func (g *groupServiceImpl) GetByID(ctx context.Context, id string) (response res
 	return
 }
 
-func (g *groupServiceImpl) Update(ctx context.Context, id string, p payload.UpdateGroup) (err error) {
+// Update updates the group if it still has the given version, the one the admin based the update on, recording a
+// leadership change when the leader is replaced.
+func (g *groupServiceImpl) Update(ctx context.Context, id string, version int, adminID, adminUsername string, p payload.UpdateGroup) (err error) {
 	if validateErr := validator.Validate(p); validateErr != nil {
 		err = service.ErrInvalidPayload
 		return
 	}
 
+	current, repoErr := g.groupRepository.FindByID(ctx, id)
+	if repoErr != nil {
+		err = service.MapError(repoErr)
+		return
+	}
+
+	if current.Version != version {
+		err = service.ErrVersionMismatch
+		return
+	}
+
+	err = g.update(ctx, current, adminID, adminUsername, p)
+	return
+}
+
+// Patch applies a merge patch to the group if it still has the given version. The members absent from the patch keep
+// their current values, which are not validated again.
+func (g *groupServiceImpl) Patch(ctx context.Context, id string, version int, adminID, adminUsername string, p payload.PatchGroup) (err error) {
+	if validateErr := validator.Validate(p); validateErr != nil {
+		err = service.ErrInvalidPayload
+		return
+	}
+
+	current, repoErr := g.groupRepository.FindByID(ctx, id)
+	if repoErr != nil {
+		err = service.MapError(repoErr)
+		return
+	}
+
+	if current.Version != version {
+		err = service.ErrVersionMismatch
+		return
+	}
+
+	update := payload.UpdateGroup{
+		Name:   current.Name,
+		Leader: current.Leader,
+	}
+	if p.Name != nil {
+		update.Name = *p.Name
+	}
+	if p.Leader != nil {
+		update.Leader = *p.Leader
+	}
+	if p.LeaderSince != nil {
+		update.LeaderSince = *p.LeaderSince
+	}
+	if p.LeaderNote != nil {
+		update.LeaderNote = *p.LeaderNote
+	}
+
+	err = g.update(ctx, current, adminID, adminUsername, update)
+	return
+}
+
+// update replaces the name and the leader of the current group, recording a leadership change when the leader is
+// replaced. The write only applies to the version of the current group.
+func (g *groupServiceImpl) update(ctx context.Context, current entity.Group, adminID, adminUsername string, p payload.UpdateGroup) (err error) {
+	id, version := current.ID, current.Version
+
 	group := entity.Group{
 		ID:     id,
 		Name:   p.Name,
 		Leader: p.Leader,
 	}
 
-	if repoErr := g.groupRepository.Update(ctx, id, group); repoErr != nil {
+	if current.Leader == p.Leader {
+		if repoErr := g.groupRepository.Update(ctx, id, version, group); repoErr != nil {
+			err = service.MapError(repoErr)
+		}
+		return
+	}
+
+	today, _ := time.Parse(dateLayout, time.Now().Format(dateLayout))
+	effectiveOn := today
+	if p.LeaderSince != "" {
+		leaderSince, parseErr := time.Parse(dateLayout, p.LeaderSince)
+		if parseErr != nil {
+			err = service.ErrDateParsing
+			return
+		}
+		if leaderSince.After(today) {
+			err = service.ErrInvalidPayload
+			return
+		}
+		effectiveOn = leaderSince
+	}
+
+	changeID, genErr := g.idGenerator.GenerateLeadershipChangeID()
+	if genErr != nil {
+		err = service.MapError(genErr)
+		return
+	}
+
+	change := entity.LeadershipChange{
+		ID:             changeID,
+		GroupID:        id,
+		PreviousLeader: current.Leader,
+		NewLeader:      p.Leader,
+		EffectiveOn:    effectiveOn,
+		Note:           strings.TrimSpace(p.LeaderNote),
+		AdminID:        adminID,
+		AdminUsername:  adminUsername,
+	}
+
+	if repoErr := g.groupRepository.UpdateLeader(ctx, version, group, change); repoErr != nil {
 		err = service.MapError(repoErr)
 	}
 	return
 }
 
-func (g *groupServiceImpl) Delete(ctx context.Context, id string) (err error) {
-	if repoErr := g.groupRepository.Delete(ctx, id); repoErr != nil {
+// UpdateContacts replaces the contacts of the group if it still has the given version.
+func (g *groupServiceImpl) UpdateContacts(ctx context.Context, id string, version int, p payload.UpdateGroupContacts) (err error) {
+	if validateErr := validator.Validate(p); validateErr != nil {
+		err = service.ErrInvalidPayload
+		return
+	}
+
+	contacts, ok := mapToContacts(p)
+	if !ok {
+		err = service.ErrInvalidPayload
+		return
+	}
+
+	if repoErr := g.groupRepository.UpdateContacts(ctx, id, version, contacts); repoErr != nil {
+		err = service.MapError(repoErr)
+		return
+	}
+
+	return
+}
+
+// Delete deletes the group with everything that belongs to it, if it still has the given version. Its show schedules
+// are deleted along, or block the deletion, or get detached from it, as told by the payload.
+func (g *groupServiceImpl) Delete(ctx context.Context, id string, version int, p payload.DeleteGroup) (err error) {
+	if validateErr := validator.Validate(p); validateErr != nil {
+		err = service.ErrInvalidPayload
+		return
+	}
+
+	if repoErr := g.groupRepository.Delete(ctx, id, version, mapToDeleteOptions(p)); repoErr != nil {
+		if errors.Is(repoErr, repository.ErrRecordReferenced) {
+			err = service.ErrGroupHasShows
+			return
+		}
+
+		err = service.MapError(repoErr)
+	}
+	return
+}
+
+// PreviewDelete reports the records that Delete would delete or detach with the same payload, without deleting
+// anything.
+func (g *groupServiceImpl) PreviewDelete(ctx context.Context, id string, p payload.DeleteGroup) (response response.GroupDeletion, err error) {
+	if validateErr := validator.Validate(p); validateErr != nil {
+		err = service.ErrInvalidPayload
+		return
+	}
+
+	options := mapToDeleteOptions(p)
+
+	impact, repoErr := g.groupRepository.FindDeletionImpact(ctx, id, options.Now)
+	if repoErr != nil {
 		err = service.MapError(repoErr)
+		return
+	}
+
+	response.GroupID = id
+	response.Addresses = append([]string{}, impact.AddressIDs...)
+	response.Properties = append([]string{}, impact.PropertyIDs...)
+	response.Members = append([]string{}, impact.MemberIDs...)
+	response.Achievements = append([]string{}, impact.AchievementIDs...)
+	response.DeletedShowSchedules = make([]string, 0)
+	response.DetachedShowSchedules = make([]string, 0)
+	response.BlockingShowSchedules = make([]string, 0)
+
+	showSchedules := []struct {
+		ids    []string
+		action group.ShowScheduleAction
+	}{
+		{impact.FutureShowScheduleIDs, options.FutureShows},
+		{impact.PastShowScheduleIDs, options.PastShows},
+	}
+
+	for _, showSchedule := range showSchedules {
+		switch showSchedule.action {
+		case group.ShowScheduleBlock:
+			response.BlockingShowSchedules = append(response.BlockingShowSchedules, showSchedule.ids...)
+		case group.ShowScheduleDetach:
+			response.DetachedShowSchedules = append(response.DetachedShowSchedules, showSchedule.ids...)
+		default:
+			response.DeletedShowSchedules = append(response.DeletedShowSchedules, showSchedule.ids...)
+		}
+	}
+
+	response.Deletable = len(response.BlockingShowSchedules) == 0
+	return
+}
+
+// GetDuplicates compares the names and leaders of the groups of every village with each other, and returns the pairs
+// that are spelled alike, from the most similar one.
+func (g *groupServiceImpl) GetDuplicates(ctx context.Context, p payload.GetDuplicateGroups) (responses []response.DuplicateGroups, err error) {
+	if validateErr := validator.Validate(p); validateErr != nil {
+		err = service.ErrInvalidPayload
+		return
+	}
+
+	if p.MinSimilarity == 0 {
+		p.MinSimilarity = defaultMinSimilarity
+	}
+
+	groups, _, repoErr := g.groupRepository.FindAll(ctx, group.Filter{DistrictID: p.DistrictID, VillageID: p.VillageID, SortBy: group.SortByCreatedAt})
+	if repoErr != nil {
+		err = service.MapError(repoErr)
+		return
+	}
+
+	villages := make(map[string][]entity.Group)
+	for _, group := range groups {
+		villages[group.Address.VillageID] = append(villages[group.Address.VillageID], group)
+	}
+
+	responses = make([]response.DuplicateGroups, 0)
+	for _, villageGroups := range villages {
+		for i, first := range villageGroups {
+			for _, second := range villageGroups[i+1:] {
+				nameSimilarity := fuzzy.Similarity(first.Name, second.Name)
+				leaderSimilarity := fuzzy.Similarity(first.Leader, second.Leader)
+				similarity := (nameSimilarity + leaderSimilarity) / 2
+				if similarity < p.MinSimilarity {
+					continue
+				}
+
+				responses = append(responses, response.DuplicateGroups{
+					VillageID:        first.Address.VillageID,
+					VillageName:      first.Address.VillageName,
+					Groups:           []response.DuplicateGroup{mapToDuplicateGroup(first), mapToDuplicateGroup(second)},
+					Similarity:       roundSimilarity(similarity),
+					NameSimilarity:   roundSimilarity(nameSimilarity),
+					LeaderSimilarity: roundSimilarity(leaderSimilarity),
+				})
+			}
+		}
+	}
+
+	sort.SliceStable(responses, func(i, j int) bool {
+		if responses[i].Similarity != responses[j].Similarity {
+			return responses[i].Similarity > responses[j].Similarity
+		}
+		if responses[i].Groups[0].ID != responses[j].Groups[0].ID {
+			return responses[i].Groups[0].ID < responses[j].Groups[0].ID
+		}
+		return responses[i].Groups[1].ID < responses[j].Groups[1].ID
+	})
+	return
+}
+
+// Merge merges the group into the target group, if both still have the given versions. The group is left
+// soft-deleted, pointing to the target.
+func (g *groupServiceImpl) Merge(ctx context.Context, id string, version, targetVersion int, p payload.MergeGroup) (err error) {
+	if validateErr := validator.Validate(p); validateErr != nil || p.TargetID == id {
+		err = service.ErrInvalidPayload
+		return
+	}
+
+	expectations := []struct {
+		id      string
+		version int
+	}{
+		{id, version},
+		{p.TargetID, targetVersion},
+	}
+
+	for _, expected := range expectations {
+		current, repoErr := g.groupRepository.FindByID(ctx, expected.id)
+		if repoErr != nil {
+			err = service.MapError(repoErr)
+			return
+		}
+
+		if current.Version != expected.version {
+			err = service.ErrVersionMismatch
+			return
+		}
+	}
+
+	if repoErr := g.groupRepository.Merge(ctx, id, version, p.TargetID, targetVersion); repoErr != nil {
+		err = service.MapError(repoErr)
+	}
+	return
+}
+
+// UpdateStatus changes the status of the group if it still has the given version.
+func (g *groupServiceImpl) UpdateStatus(ctx context.Context, id string, version int, adminID, adminUsername string, p payload.UpdateGroupStatus) (err error) {
+	if validateErr := validator.Validate(p); validateErr != nil {
+		err = service.ErrInvalidPayload
+		return
+	}
+
+	group, repoErr := g.groupRepository.FindByID(ctx, id)
+	if repoErr != nil {
+		err = service.MapError(repoErr)
+		return
+	}
+
+	if group.Version != version {
+		err = service.ErrVersionMismatch
+		return
+	}
+
+	if group.Status == p.Status {
+		err = service.ErrStatusUnchanged
+		return
+	}
+
+	transitionID, genErr := g.idGenerator.GenerateStatusTransitionID()
+	if genErr != nil {
+		err = service.MapError(genErr)
+		return
+	}
+
+	transition := entity.GroupStatusTransition{
+		ID:            transitionID,
+		GroupID:       id,
+		FromStatus:    group.Status,
+		ToStatus:      p.Status,
+		Reason:        strings.TrimSpace(p.Reason),
+		AdminID:       adminID,
+		AdminUsername: adminUsername,
+	}
+
+	if repoErr := g.groupRepository.UpdateStatus(ctx, version, transition); repoErr != nil {
+		err = service.MapError(repoErr)
+	}
+	return
+}
+
+func (g *groupServiceImpl) GetStatusHistory(ctx context.Context, id string) (responses []response.GroupStatusTransition, err error) {
+	if _, repoErr := g.groupRepository.FindByID(ctx, id); repoErr != nil {
+		err = service.MapError(repoErr)
+		return
+	}
+
+	transitions, repoErr := g.groupRepository.FindStatusTransitions(ctx, id)
+	if repoErr != nil {
+		err = service.MapError(repoErr)
+		return
+	}
+
+	responses = make([]response.GroupStatusTransition, len(transitions))
+
+	for i, transition := range transitions {
+		responses[i] = response.GroupStatusTransition{
+			ID:            transition.ID,
+			FromStatus:    transition.FromStatus,
+			ToStatus:      transition.ToStatus,
+			Reason:        transition.Reason,
+			AdminID:       transition.AdminID,
+			AdminUsername: transition.AdminUsername,
+			ChangedAt:     transition.CreatedAt.Format(time.RFC822),
+		}
+	}
+	return
+}
+
+// GetLeadershipHistory returns the leadership changes of a group, ordered by the date they took effect.
+func (g *groupServiceImpl) GetLeadershipHistory(ctx context.Context, id string) (responses []response.LeadershipChange, err error) {
+	if _, repoErr := g.groupRepository.FindByID(ctx, id); repoErr != nil {
+		err = service.MapError(repoErr)
+		return
+	}
+
+	changes, repoErr := g.groupRepository.FindLeadershipChanges(ctx, id)
+	if repoErr != nil {
+		err = service.MapError(repoErr)
+		return
+	}
+
+	responses = make([]response.LeadershipChange, len(changes))
+
+	for i, change := range changes {
+		responses[i] = response.LeadershipChange{
+			ID:             change.ID,
+			PreviousLeader: change.PreviousLeader,
+			NewLeader:      change.NewLeader,
+			EffectiveOn:    change.EffectiveOn.Format(dateLayout),
+			Note:           change.Note,
+			AdminID:        change.AdminID,
+			AdminUsername:  change.AdminUsername,
+			RecordedAt:     change.CreatedAt.Format(time.RFC822),
+		}
+	}
+	return
+}
+
+func (g *groupServiceImpl) AssignRegistrationNumbers(ctx context.Context) (err error) {
+	groups, repoErr := g.groupRepository.FindUnregistered(ctx)
+	if repoErr != nil {
+		err = service.MapError(repoErr)
+		return
+	}
+
+	for _, group := range groups {
+		if err = g.register(ctx, &group); err != nil {
+			return
+		}
+	}
+	return
+}
+
+// GenerateCertificate only reads the group: the registration numbers are given on creation, and to the older groups
+// by AssignRegistrationNumbers on startup, so a group without one is service.ErrNotRegistered.
+func (g *groupServiceImpl) GenerateCertificate(ctx context.Context, id string) (file []byte, err error) {
+	group, repoErr := g.groupRepository.FindByID(ctx, id)
+	if repoErr != nil {
+		err = service.MapError(repoErr)
+		return
+	}
+
+	if group.RegistrationNumber == "" {
+		err = service.ErrNotRegistered
+		return
+	}
+
+	code, genErr := g.codeSigner.SignCode(qrsign.TypeGroup, id, id)
+	if genErr != nil {
+		err = service.MapError(genErr)
+		return
+	}
+
+	qrCode, genErr := g.qrCodeGenerator.GenerateQRCode(g.scanLinkGenerator.GenerateScanLink(code), qrcode.Medium, 512)
+	if genErr != nil {
+		err = service.MapError(genErr)
+		return
+	}
+
+	file, genErr = g.certificateGenerator.GenerateCertificate(group, qrCode)
+	if genErr != nil {
+		err = service.MapError(genErr)
 	}
 	return
 }
@@ -132,13 +815,122 @@ func (g *groupServiceImpl) GenerateQRCode(ctx context.Context, id string) (file
 		return
 	}
 
-	file, genErr := g.qrCodeGenerator.GenerateQRCode(id, qrcode.Medium, 2048)
+	code, genErr := g.codeSigner.SignCode(qrsign.TypeGroup, id, id)
+	if genErr != nil {
+		err = service.MapError(genErr)
+		return
+	}
+
+	file, genErr = g.qrCodeGenerator.GenerateQRCode(g.scanLinkGenerator.GenerateScanLink(code), qrcode.Medium, 2048)
 	if genErr != nil {
 		err = service.MapError(genErr)
 	}
 	return
 }
 
+// GenerateVCard returns the contact of the group leader as a vCard, for the booking staff to import in their phones.
+func (g *groupServiceImpl) GenerateVCard(ctx context.Context, id string) (file []byte, err error) {
+	group, repoErr := g.groupRepository.FindByID(ctx, id)
+	if repoErr != nil {
+		err = service.MapError(repoErr)
+		return
+	}
+
+	file = mapToVCard(group).Encode()
+	return
+}
+
+// nextRegistrationNumber hands out the next registration number for a group registered at the address in the given year.
+func (g *groupServiceImpl) nextRegistrationNumber(ctx context.Context, address entity.Address, year int) (registrationNumber string, err error) {
+	scope := g.registrationNumberGenerator.GenerateScope(address, year)
+
+	number, repoErr := g.groupRepository.NextRegistrationNumber(ctx, scope)
+	if repoErr != nil {
+		err = service.MapError(repoErr)
+		return
+	}
+
+	registrationNumber = g.registrationNumberGenerator.GenerateRegistrationNumber(address, year, number)
+	return
+}
+
+// registration numbers a group registered at the address in the given year, when it is inserted.
+func (g *groupServiceImpl) registration(address entity.Address, year int) group.Registration {
+	return group.Registration{
+		Scope: g.registrationNumberGenerator.GenerateScope(address, year),
+		Number: func(number int) string {
+			return g.registrationNumberGenerator.GenerateRegistrationNumber(address, year, number)
+		},
+	}
+}
+
+// register gives an existing group without a registration number one for the year it was created in.
+func (g *groupServiceImpl) register(ctx context.Context, group *entity.Group) (err error) {
+	registrationNumber, err := g.nextRegistrationNumber(ctx, group.Address, group.CreatedAt.Year())
+	if err != nil {
+		return
+	}
+
+	if repoErr := g.groupRepository.Update(ctx, group.ID, group.Version, entity.Group{RegistrationNumber: registrationNumber}); repoErr != nil {
+		err = service.MapError(repoErr)
+		return
+	}
+
+	group.RegistrationNumber = registrationNumber
+	return
+}
+
+func mapToEntity(id string, p payload.CreateGroup, village entity.Village) entity.Group {
+	return entity.Group{
+		ID:     id,
+		Name:   p.Name,
+		Leader: p.Leader,
+		Status: entity.GroupStatusActive,
+		Address: entity.Address{
+			ID:           id,
+			Address:      p.Address,
+			VillageID:    village.ID,
+			VillageName:  village.Name,
+			DistrictID:   village.District.ID,
+			DistrictName: village.District.Name,
+			RegencyID:    village.District.Regency.ID,
+			RegencyName:  village.District.Regency.Name,
+			ProvinceID:   village.District.Regency.Province.ID,
+			ProvinceName: village.District.Regency.Province.Name,
+			Latitude:     p.Latitude,
+			Longitude:    p.Longitude,
+		},
+	}
+}
+
+func mapToFilter(p payload.GetGroups) group.Filter {
+	return group.Filter{
+		DistrictID: p.DistrictID,
+		VillageID:  p.VillageID,
+		Name:       strings.TrimSpace(p.Name),
+		Status:     p.Status,
+		SortBy:     group.SortKey(strings.TrimPrefix(p.Sort, "-")),
+		Descending: strings.HasPrefix(p.Sort, "-"),
+	}
+}
+
+// mapToDeleteOptions splits the show schedules at the current time, and cascades the deletion to them by default.
+func mapToDeleteOptions(p payload.DeleteGroup) group.DeleteOptions {
+	options := group.DeleteOptions{
+		Now:         time.Now(),
+		FutureShows: group.ShowScheduleAction(p.FutureShows),
+		PastShows:   group.ShowScheduleAction(p.PastShows),
+	}
+
+	if options.FutureShows == "" {
+		options.FutureShows = group.ShowScheduleCascade
+	}
+	if options.PastShows == "" {
+		options.PastShows = group.ShowScheduleCascade
+	}
+	return options
+}
+
 func mapToModel(e entity.Group) response.Group {
 	properties := make([]response.Property, len(e.Properties))
 
@@ -147,12 +939,16 @@ func mapToModel(e entity.Group) response.Group {
 		properties[i].Name = prop.Name
 		properties[i].Amount = prop.Amount
 		properties[i].Description = prop.Description
+		properties[i].Attachments = mapToAttachments(prop.Attachments)
+		properties[i].Version = prop.Version
 	}
 
 	return response.Group{
-		ID:     e.ID,
-		Name:   e.Name,
-		Leader: e.Leader,
+		ID:                 e.ID,
+		RegistrationNumber: e.RegistrationNumber,
+		Name:               e.Name,
+		Leader:             e.Leader,
+		Status:             e.Status,
 		Address: response.Address{
 			ID:           e.Address.ID,
 			Address:      e.Address.Address,
@@ -164,9 +960,162 @@ func mapToModel(e entity.Group) response.Group {
 			RegencyName:  e.Address.RegencyName,
 			ProvinceID:   e.Address.ProvinceID,
 			ProvinceName: e.Address.ProvinceName,
+			Latitude:     e.Address.Latitude,
+			Longitude:    e.Address.Longitude,
+		},
+		Properties:   properties,
+		MemberCounts: mapToMemberCounts(e.Members),
+		Attachments:  mapToAttachments(e.Attachments),
+		Achievements: mapToAchievements(e.Achievements),
+		Contacts: response.GroupContacts{
+			Phone:     e.Contacts.Phone,
+			WhatsApp:  e.Contacts.WhatsApp,
+			Email:     e.Contacts.Email,
+			Website:   e.Contacts.Website,
+			Instagram: e.Contacts.Instagram,
+			Facebook:  e.Contacts.Facebook,
+			YouTube:   e.Contacts.YouTube,
+			TikTok:    e.Contacts.TikTok,
+		},
+		Version: e.Version,
+	}
+}
+
+func mapToDuplicateGroup(e entity.Group) response.DuplicateGroup {
+	return response.DuplicateGroup{
+		ID:            e.ID,
+		Name:          e.Name,
+		Leader:        e.Leader,
+		PropertyCount: len(e.Properties),
+		CreatedAt:     e.CreatedAt.Format(time.RFC822),
+	}
+}
+
+// roundSimilarity rounds the similarity to two decimals.
+func roundSimilarity(similarity float64) float64 {
+	return math.Round(similarity*100) / 100
+}
+
+func mapToAchievements(achievements []entity.Achievement) []response.Achievement {
+	responses := make([]response.Achievement, len(achievements))
+
+	for i, achievement := range achievements {
+		responses[i] = response.Achievement{
+			ID:        achievement.ID,
+			GroupID:   achievement.GroupID,
+			EventName: achievement.EventName,
+			Year:      achievement.Year,
+			Level:     achievement.Level,
+			Rank:      achievement.Rank,
+		}
+		if achievement.Certificate != nil {
+			certificate := mapToAttachments([]entity.Attachment{*achievement.Certificate})[0]
+			responses[i].Certificate = &certificate
+		}
+	}
+
+	return responses
+}
+
+func mapToAttachments(attachments []entity.Attachment) []response.Attachment {
+	responses := make([]response.Attachment, len(attachments))
+
+	for i, attachment := range attachments {
+		responses[i] = response.Attachment{
+			ID:           attachment.ID,
+			FileName:     attachment.FileName,
+			ContentType:  attachment.ContentType,
+			Size:         attachment.Size,
+			URL:          attachment.URL,
+			ThumbnailURL: attachment.ThumbnailURL,
+		}
+	}
+
+	return responses
+}
+
+func mapToMemberCounts(members []entity.Member) (counts response.MemberCounts) {
+	for _, member := range members {
+		switch member.Role {
+		case entity.MemberRoleWarok:
+			counts.Warok++
+		case entity.MemberRoleJathil:
+			counts.Jathil++
+		case entity.MemberRoleBujangGanong:
+			counts.BujangGanong++
+		case entity.MemberRoleKlonoSewandono:
+			counts.KlonoSewandono++
+		case entity.MemberRolePembarong:
+			counts.Pembarong++
+		case entity.MemberRoleMusician:
+			counts.Musician++
+		}
+	}
+	counts.Total = len(members)
+	return
+}
+
+// mapToContacts normalizes the contacts, ok is false when one of them is invalid.
+func mapToContacts(p payload.UpdateGroupContacts) (contacts entity.GroupContacts, ok bool) {
+	ok = true
+	normalize := func(value string, normalizer func(string) (string, bool)) string {
+		if value == "" {
+			return ""
+		}
+		normalized, valid := normalizer(value)
+		ok = ok && valid
+		return normalized
+	}
+	validate := func(value string, isValid func(string) bool) string {
+		ok = ok && (value == "" || isValid(value))
+		return value
+	}
+
+	contacts = entity.GroupContacts{
+		Phone:     normalize(p.Phone, contact.NormalizePhone),
+		WhatsApp:  normalize(p.WhatsApp, contact.NormalizeMobilePhone),
+		Email:     validate(p.Email, contact.ValidEmail),
+		Website:   validate(p.Website, contact.ValidURL),
+		Instagram: normalize(p.Instagram, contact.Instagram.NormalizeHandle),
+		Facebook:  normalize(p.Facebook, contact.Facebook.NormalizeHandle),
+		YouTube:   normalize(p.YouTube, contact.YouTube.NormalizeHandle),
+		TikTok:    normalize(p.TikTok, contact.TikTok.NormalizeHandle),
+	}
+	return
+}
+
+func mapToVCard(e entity.Group) contact.VCard {
+	vCard := contact.VCard{
+		FormattedName: e.Leader,
+		Organization:  e.Name,
+		Title:         "Leader",
+		Phone:         e.Contacts.Phone,
+		Mobile:        e.Contacts.WhatsApp,
+		Email:         e.Contacts.Email,
+		URL:           e.Contacts.Website,
+		Address: contact.VCardAddress{
+			Street:   e.Address.Address,
+			Locality: e.Address.VillageName,
+			Region:   e.Address.RegencyName,
+			Country:  "Indonesia",
 		},
-		Properties: properties,
 	}
+
+	handles := []struct {
+		network contact.Network
+		handle  string
+	}{
+		{contact.Instagram, e.Contacts.Instagram},
+		{contact.Facebook, e.Contacts.Facebook},
+		{contact.YouTube, e.Contacts.YouTube},
+		{contact.TikTok, e.Contacts.TikTok},
+	}
+	for _, h := range handles {
+		if h.handle != "" {
+			vCard.SocialProfiles = append(vCard.SocialProfiles, contact.SocialProfile{Network: h.network.Name, URL: h.network.ProfileURL(h.handle)})
+		}
+	}
+	return vCard
 }
 
 func mapToModels(entities []entity.Group) []response.Group {
@@ -4,17 +4,22 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math"
 	"testing"
+	"time"
 
 	"github.com/erikrios/reog-apps-apis/entity"
 	"github.com/erikrios/reog-apps-apis/model/payload"
 	"github.com/erikrios/reog-apps-apis/model/response"
 	"github.com/erikrios/reog-apps-apis/repository"
+	"github.com/erikrios/reog-apps-apis/repository/group"
 	mgr "github.com/erikrios/reog-apps-apis/repository/group/mocks"
 	mvr "github.com/erikrios/reog-apps-apis/repository/village/mocks"
 	"github.com/erikrios/reog-apps-apis/service"
 	mig "github.com/erikrios/reog-apps-apis/utils/generator/mocks"
 	mqg "github.com/erikrios/reog-apps-apis/utils/generator/mocks"
+	"github.com/erikrios/reog-apps-apis/utils/geo"
+	"github.com/erikrios/reog-apps-apis/utils/qrsign"
 	_ "github.com/erikrios/reog-apps-apis/validation"
 	"github.com/skip2/go-qrcode"
 	"github.com/stretchr/testify/assert"
@@ -26,12 +31,41 @@ func TestCreate(t *testing.T) {
 	mockVillageRepo := &mvr.VillageRepository{}
 	mockIDGen := &mig.IDGenerator{}
 	mockQRGen := &mqg.QRCodeGenerator{}
+	mockScanLinkGen := &mqg.ScanLinkGenerator{}
+	mockCodeSigner := &mqg.CodeSigner{}
+	mockRegistrationNumberGen := &mig.RegistrationNumberGenerator{}
+	mockCertificateGen := &mig.CertificateGenerator{}
 
 	var groupService GroupService = NewGroupServiceImpl(
 		mockGroupRepo,
 		mockVillageRepo,
 		mockIDGen,
 		mockQRGen,
+		mockScanLinkGen,
+		mockCodeSigner,
+		mockRegistrationNumberGen,
+		mockCertificateGen,
+	)
+
+	mockRegistrationNumberGen.On(
+		"GenerateScope",
+		mock.AnythingOfType(fmt.Sprintf("%T", entity.Address{})),
+		mock.AnythingOfType(fmt.Sprintf("%T", 0)),
+	).Return(
+		func(address entity.Address, year int) string {
+			return "3502/3502030/{number}/2022"
+		},
+	)
+
+	mockRegistrationNumberGen.On(
+		"GenerateRegistrationNumber",
+		mock.AnythingOfType(fmt.Sprintf("%T", entity.Address{})),
+		mock.AnythingOfType(fmt.Sprintf("%T", 0)),
+		mock.AnythingOfType(fmt.Sprintf("%T", 0)),
+	).Return(
+		func(address entity.Address, year int, number int) string {
+			return fmt.Sprintf("3502/3502030/%04d/2022", number)
+		},
 	)
 
 	testCases := []struct {
@@ -53,6 +87,33 @@ func TestCreate(t *testing.T) {
 			expectedError:  service.ErrInvalidPayload,
 			mockBehaviours: func() {},
 		},
+		{
+			name: "it should return service.ErrInvalidPayload error, when location is outside Ponorogo",
+			inputCreateGroup: payload.CreateGroup{
+				Name:      "Paguyuban Reog",
+				Leader:    "Erik R",
+				Address:   "RT 01 RW 01 Dukuh Bibis",
+				VillageID: "3502031117",
+				Latitude:  floatPtr(-6.2),
+				Longitude: floatPtr(106.8),
+			},
+			expectedID:     "",
+			expectedError:  service.ErrInvalidPayload,
+			mockBehaviours: func() {},
+		},
+		{
+			name: "it should return service.ErrInvalidPayload error, when only latitude is given",
+			inputCreateGroup: payload.CreateGroup{
+				Name:      "Paguyuban Reog",
+				Leader:    "Erik R",
+				Address:   "RT 01 RW 01 Dukuh Bibis",
+				VillageID: "3502031117",
+				Latitude:  floatPtr(-7.87),
+			},
+			expectedID:     "",
+			expectedError:  service.ErrInvalidPayload,
+			mockBehaviours: func() {},
+		},
 		{
 			name: "it should return service.ErrDataNotFound error, when repository return an error",
 			inputCreateGroup: payload.CreateGroup{
@@ -136,8 +197,9 @@ func TestCreate(t *testing.T) {
 					"Insert",
 					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
 					mock.AnythingOfType(fmt.Sprintf("%T", entity.Group{})),
+					mock.AnythingOfType(fmt.Sprintf("%T", group.Registration{})),
 				).Return(
-					func(ctx context.Context, group entity.Group) error {
+					func(ctx context.Context, group entity.Group, registration group.Registration) error {
 						return repository.ErrDatabase
 					},
 				).Once()
@@ -175,8 +237,12 @@ func TestCreate(t *testing.T) {
 					"Insert",
 					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
 					mock.AnythingOfType(fmt.Sprintf("%T", entity.Group{})),
+					mock.MatchedBy(func(registration group.Registration) bool {
+						return registration.Scope == "3502/3502030/{number}/2022" &&
+							registration.Number(7) == "3502/3502030/0007/2022"
+					}),
 				).Return(
-					func(ctx context.Context, group entity.Group) error {
+					func(ctx context.Context, group entity.Group, registration group.Registration) error {
 						return nil
 					},
 				).Once()
@@ -199,105 +265,103 @@ func TestCreate(t *testing.T) {
 	}
 }
 
-func TestGetAll(t *testing.T) {
+func TestCreateWithContacts(t *testing.T) {
 	mockGroupRepo := &mgr.GroupRepository{}
 	mockVillageRepo := &mvr.VillageRepository{}
 	mockIDGen := &mig.IDGenerator{}
 	mockQRGen := &mqg.QRCodeGenerator{}
+	mockScanLinkGen := &mqg.ScanLinkGenerator{}
+	mockCodeSigner := &mqg.CodeSigner{}
+	mockRegistrationNumberGen := &mig.RegistrationNumberGenerator{}
+	mockCertificateGen := &mig.CertificateGenerator{}
 
 	var groupService GroupService = NewGroupServiceImpl(
 		mockGroupRepo,
 		mockVillageRepo,
 		mockIDGen,
 		mockQRGen,
+		mockScanLinkGen,
+		mockCodeSigner,
+		mockRegistrationNumberGen,
+		mockCertificateGen,
+	)
+
+	mockRegistrationNumberGen.On(
+		"GenerateScope",
+		mock.AnythingOfType(fmt.Sprintf("%T", entity.Address{})),
+		mock.AnythingOfType(fmt.Sprintf("%T", 0)),
+	).Return(
+		func(address entity.Address, year int) string {
+			return "3502/3502030/{number}/2022"
+		},
 	)
 
+	mockRegistrationNumberGen.On(
+		"GenerateRegistrationNumber",
+		mock.AnythingOfType(fmt.Sprintf("%T", entity.Address{})),
+		mock.AnythingOfType(fmt.Sprintf("%T", 0)),
+		mock.AnythingOfType(fmt.Sprintf("%T", 0)),
+	).Return(
+		func(address entity.Address, year int, number int) string {
+			return "3502/3502030/0007/2022"
+		},
+	)
+
+	validGroup := payload.CreateGroup{
+		Name:      "Paguyuban Reog",
+		Leader:    "Erik R",
+		Address:   "RT 01 RW 01 Dukuh Bibis",
+		VillageID: "3502030007",
+	}
+
 	testCases := []struct {
 		name           string
-		expectedGroups []response.Group
+		inputContacts  payload.UpdateGroupContacts
+		expectedID     string
 		expectedError  error
 		mockBehaviours func()
 	}{
 		{
-			name:           "it should return service.ErrRepository error, when group repository return an error",
-			expectedGroups: []response.Group{},
-			expectedError:  service.ErrRepository,
+			name:           "it should return service.ErrInvalidPayload error, when a contact is invalid",
+			inputContacts:  payload.UpdateGroupContacts{Phone: "call me maybe"},
+			expectedError:  service.ErrInvalidPayload,
+			mockBehaviours: func() {},
+		},
+		{
+			name:          "it should insert the group with its normalized contacts, when no error is returned",
+			inputContacts: payload.UpdateGroupContacts{Phone: "0812-3456-7890", Email: "erik@example.com"},
+			expectedID:    "g-xyz",
 			mockBehaviours: func() {
-				mockGroupRepo.On(
-					"FindAll",
-					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
-				).Return(
-					func(ctx context.Context) []entity.Group {
-						return []entity.Group{}
+				mockVillageRepo.On("FindByID", "3502030007").Return(
+					func(id string) entity.Village {
+						return entity.Village{ID: id}
 					},
-					func(ctx context.Context) error {
-						return repository.ErrDatabase
+					func(id string) error {
+						return nil
 					},
 				).Once()
-			},
-		},
-		{
-			name: "it should return a valid groups, when no error is returned",
-			expectedGroups: []response.Group{
-				{
-					ID:     "g-Nzo",
-					Name:   "Paguyuban Reog",
-					Leader: "Erik Rio Setiawan",
-					Address: response.Address{
-						ID:           "g-Nzo",
-						Address:      "RT 05 RW 01 Dukuh Tengah",
-						VillageID:    "3502030007",
-						VillageName:  "Pager",
-						DistrictID:   "3502030",
-						DistrictName: "Bungkal",
-						RegencyID:    "3502",
-						RegencyName:  "Kabupaten Ponorogo",
-						ProvinceID:   "35",
-						ProvinceName: "Jawa Timur",
+
+				mockIDGen.On("GenerateGroupID").Return(
+					func() string {
+						return "g-xyz"
 					},
-					Properties: []response.Property{
-						{
-							ID:          "p-YIhpPgp",
-							Name:        "Dadak Merak",
-							Description: "Ini adalah deskripsi dari dadak merak",
-							Amount:      1,
-						},
+					func() error {
+						return nil
 					},
-				},
-			},
-			expectedError: nil,
-			mockBehaviours: func() {
-				mockGroupRepo.On("FindAll", mock.AnythingOfType(fmt.Sprintf("%T", context.Background()))).Return(func(ctx context.Context) []entity.Group {
-					return []entity.Group{
-						{
-							ID:     "g-Nzo",
-							Name:   "Paguyuban Reog",
-							Leader: "Erik Rio Setiawan",
-							Address: entity.Address{
-								ID:           "g-Nzo",
-								Address:      "RT 05 RW 01 Dukuh Tengah",
-								VillageID:    "3502030007",
-								VillageName:  "Pager",
-								DistrictID:   "3502030",
-								DistrictName: "Bungkal",
-								RegencyID:    "3502",
-								RegencyName:  "Kabupaten Ponorogo",
-								ProvinceID:   "35",
-								ProvinceName: "Jawa Timur",
-							},
-							Properties: []entity.Property{
-								{
-									ID:          "p-YIhpPgp",
-									Name:        "Dadak Merak",
-									Description: "Ini adalah deskripsi dari dadak merak",
-									Amount:      1,
-								},
-							},
-						},
-					}
-				}, func(ctx context.Context) error {
-					return nil
-				}).Once()
+				).Once()
+
+				mockGroupRepo.On(
+					"Insert",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.MatchedBy(func(group entity.Group) bool {
+						return group.Contacts == entity.GroupContacts{Phone: "+6281234567890", Email: "erik@example.com"}
+					}),
+					mock.AnythingOfType(fmt.Sprintf("%T", group.Registration{})),
+				).Return(
+					func(ctx context.Context, group entity.Group, registration group.Registration) error {
+						return nil
+					},
+				).Once()
 			},
 		},
 	}
@@ -305,252 +369,3031 @@ func TestGetAll(t *testing.T) {
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
 			testCase.mockBehaviours()
-			gotGroups, gotErr := groupService.GetAll(context.Background())
+			gotID, gotErr := groupService.CreateWithContacts(context.Background(), validGroup, testCase.inputContacts)
 
 			if testCase.expectedError != nil {
 				assert.ErrorIs(t, gotErr, testCase.expectedError)
 			} else {
 				assert.NoError(t, gotErr)
-				assert.Equal(t, testCase.expectedGroups, gotGroups)
+				assert.Equal(t, testCase.expectedID, gotID)
 			}
 		})
 	}
+
+	mockGroupRepo.AssertExpectations(t)
 }
 
-func TestGetByID(t *testing.T) {
+func TestImport(t *testing.T) {
 	mockGroupRepo := &mgr.GroupRepository{}
 	mockVillageRepo := &mvr.VillageRepository{}
 	mockIDGen := &mig.IDGenerator{}
 	mockQRGen := &mqg.QRCodeGenerator{}
+	mockScanLinkGen := &mqg.ScanLinkGenerator{}
+	mockCodeSigner := &mqg.CodeSigner{}
+	mockRegistrationNumberGen := &mig.RegistrationNumberGenerator{}
+	mockCertificateGen := &mig.CertificateGenerator{}
 
 	var groupService GroupService = NewGroupServiceImpl(
 		mockGroupRepo,
 		mockVillageRepo,
 		mockIDGen,
 		mockQRGen,
+		mockScanLinkGen,
+		mockCodeSigner,
+		mockRegistrationNumberGen,
+		mockCertificateGen,
+	)
+
+	mockRegistrationNumberGen.On(
+		"GenerateScope",
+		mock.AnythingOfType(fmt.Sprintf("%T", entity.Address{})),
+		mock.AnythingOfType(fmt.Sprintf("%T", 0)),
+	).Return(
+		func(address entity.Address, year int) string {
+			return "3502/3502030/{number}/2022"
+		},
+	)
+
+	mockRegistrationNumberGen.On(
+		"GenerateRegistrationNumber",
+		mock.AnythingOfType(fmt.Sprintf("%T", entity.Address{})),
+		mock.AnythingOfType(fmt.Sprintf("%T", 0)),
+		mock.AnythingOfType(fmt.Sprintf("%T", 0)),
+	).Return(
+		func(address entity.Address, year int, number int) string {
+			return fmt.Sprintf("3502/3502030/%04d/2022", number)
+		},
 	)
 
+	validRow := payload.CreateGroup{
+		Name:      "Paguyuban Reog",
+		Leader:    "Erik R",
+		Address:   "RT 01 RW 01 Dukuh Bibis",
+		VillageID: "3502030007",
+	}
+
+	invalidRow := payload.CreateGroup{
+		Name:      "Paguyuban Reog",
+		Leader:    "E",
+		Address:   "RT 01 RW 01 Dukuh Bibis",
+		VillageID: "3502030007",
+	}
+
 	testCases := []struct {
-		name           string
-		inputID        string
-		expectedGroup  response.Group
-		expectedError  error
-		mockBehaviours func()
+		name              string
+		inputRows         []payload.CreateGroup
+		inputImportGroups payload.ImportGroups
+		expectedResponses []response.ImportGroup
+		expectedError     error
+		mockBehaviours    func()
 	}{
 		{
-			name:          "it should return service.ErrRepository error, when group repository return an error",
-			inputID:       "g-xyz",
-			expectedGroup: response.Group{},
+			name:           "it should return service.ErrInvalidPayload error, when there is no row",
+			inputRows:      []payload.CreateGroup{},
+			expectedError:  service.ErrInvalidPayload,
+			mockBehaviours: func() {},
+		},
+		{
+			name:          "it should return service.ErrRepository error, when village repository return an error",
+			inputRows:     []payload.CreateGroup{validRow},
 			expectedError: service.ErrRepository,
 			mockBehaviours: func() {
-				mockGroupRepo.On(
-					"FindByID",
-					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
-					mock.AnythingOfType(fmt.Sprintf("%T", "")),
-				).Return(
-					func(ctx context.Context, id string) entity.Group {
-						return entity.Group{}
+				mockVillageRepo.On("FindByID", mock.AnythingOfType("string")).Return(
+					func(id string) entity.Village {
+						return entity.Village{}
 					},
-					func(ctx context.Context, id string) error {
+					func(id string) error {
 						return repository.ErrDatabase
 					},
 				).Once()
 			},
 		},
 		{
-			name:          "it should return service.ErrDataNotFound error, when group repository return an error",
-			inputID:       "g-xyz",
-			expectedGroup: response.Group{},
-			expectedError: service.ErrDataNotFound,
+			name:              "it should return valid and invalid rows without creating groups, when in dry run mode",
+			inputRows:         []payload.CreateGroup{validRow, invalidRow, validRow},
+			inputImportGroups: payload.ImportGroups{DryRun: true},
+			expectedResponses: []response.ImportGroup{
+				{Row: 1, Status: "valid"},
+				{Row: 2, Status: "invalid", Error: "invalid payload: leader: less than min"},
+				{Row: 3, Status: "valid"},
+			},
 			mockBehaviours: func() {
-				mockGroupRepo.On(
-					"FindByID",
-					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
-					mock.AnythingOfType(fmt.Sprintf("%T", "")),
-				).Return(
-					func(ctx context.Context, id string) entity.Group {
-						return entity.Group{}
+				mockVillageRepo.On("FindByID", mock.AnythingOfType("string")).Return(
+					func(id string) entity.Village {
+						return entity.Village{ID: id}
 					},
-					func(ctx context.Context, id string) error {
-						return repository.ErrRecordNotFound
+					func(id string) error {
+						return nil
 					},
 				).Once()
 			},
 		},
 		{
-			name:    "it should return a valid group, when no error is returned",
-			inputID: "g-xyz",
-			expectedGroup: response.Group{
-				ID:     "g-Nzo",
-				Name:   "Paguyuban Reog",
-				Leader: "Erik Rio Setiawan",
-				Address: response.Address{
-					ID:           "g-Nzo",
-					Address:      "RT 05 RW 01 Dukuh Tengah",
-					VillageID:    "3502030007",
-					VillageName:  "Pager",
-					DistrictID:   "3502030",
-					DistrictName: "Bungkal",
-					RegencyID:    "3502",
-					RegencyName:  "Kabupaten Ponorogo",
-					ProvinceID:   "35",
-					ProvinceName: "Jawa Timur",
-				},
-				Properties: []response.Property{
-					{
-						ID:          "p-YIhpPgp",
-						Name:        "Dadak Merak",
-						Description: "Ini adalah deskripsi dari dadak merak",
-						Amount:      1,
-					},
-				},
+			name: "it should skip the valid rows, when in atomic mode and a village is not found",
+			inputRows: []payload.CreateGroup{validRow, {
+				Name:      "Paguyuban Reog",
+				Leader:    "Erik R",
+				Address:   "RT 01 RW 01 Dukuh Bibis",
+				VillageID: "3502031117",
+			}},
+			inputImportGroups: payload.ImportGroups{Atomic: true},
+			expectedResponses: []response.ImportGroup{
+				{Row: 1, Status: "skipped"},
+				{Row: 2, Status: "invalid", Error: "village with ID 3502031117 not found"},
 			},
-			expectedError: nil,
 			mockBehaviours: func() {
-				mockGroupRepo.On(
-					"FindByID",
-					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
-					mock.AnythingOfType(fmt.Sprintf("%T", "")),
-				).Return(
-					func(ctx context.Context, id string) entity.Group {
-						return entity.Group{
-							ID:     "g-Nzo",
-							Name:   "Paguyuban Reog",
-							Leader: "Erik Rio Setiawan",
-							Address: entity.Address{
-								ID:           "g-Nzo",
-								Address:      "RT 05 RW 01 Dukuh Tengah",
-								VillageID:    "3502030007",
-								VillageName:  "Pager",
-								DistrictID:   "3502030",
-								DistrictName: "Bungkal",
-								RegencyID:    "3502",
-								RegencyName:  "Kabupaten Ponorogo",
-								ProvinceID:   "35",
-								ProvinceName: "Jawa Timur",
-							},
-							Properties: []entity.Property{
-								{
-									ID:          "p-YIhpPgp",
-									Name:        "Dadak Merak",
-									Description: "Ini adalah deskripsi dari dadak merak",
-									Amount:      1,
-								},
-							},
-						}
+				mockVillageRepo.On("FindByID", "3502030007").Return(
+					func(id string) entity.Village {
+						return entity.Village{ID: id}
 					},
-					func(ctx context.Context, id string) error {
+					func(id string) error {
 						return nil
 					},
 				).Once()
-			},
-		},
-	}
-
+				mockVillageRepo.On("FindByID", "3502031117").Return(
+					func(id string) entity.Village {
+						return entity.Village{}
+					},
+					func(id string) error {
+						return repository.ErrRecordNotFound
+					},
+				).Once()
+				mockIDGen.On("GenerateGroupID").Return(
+					func() string {
+						return "g-xyz"
+					},
+					func() error {
+						return nil
+					},
+				).Once()
+			},
+		},
+		{
+			name:              "it should return service.ErrRepository error, when in atomic mode and group repository return an error",
+			inputRows:         []payload.CreateGroup{validRow},
+			inputImportGroups: payload.ImportGroups{Atomic: true},
+			expectedError:     service.ErrRepository,
+			mockBehaviours: func() {
+				mockVillageRepo.On("FindByID", mock.AnythingOfType("string")).Return(
+					func(id string) entity.Village {
+						return entity.Village{ID: id}
+					},
+					func(id string) error {
+						return nil
+					},
+				).Once()
+				mockIDGen.On("GenerateGroupID").Return(
+					func() string {
+						return "g-xyz"
+					},
+					func() error {
+						return nil
+					},
+				).Once()
+				mockGroupRepo.On(
+					"InsertAll",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", []entity.Group{})),
+					mock.AnythingOfType(fmt.Sprintf("%T", []group.Registration{})),
+				).Return(
+					func(ctx context.Context, groups []entity.Group, registrations []group.Registration) error {
+						return repository.ErrDatabase
+					},
+				).Once()
+			},
+		},
+		{
+			name:      "it should report the rows that cannot be saved and create the others, when not in atomic mode",
+			inputRows: []payload.CreateGroup{validRow, validRow, validRow},
+			expectedResponses: []response.ImportGroup{
+				{Row: 1, Status: "failed", Error: "group could not be saved, import the row again later"},
+				{Row: 2, Status: "failed", Error: "group already exists"},
+				{Row: 3, Status: "created", ID: "g-ghi"},
+			},
+			mockBehaviours: func() {
+				mockVillageRepo.On("FindByID", mock.AnythingOfType("string")).Return(
+					func(id string) entity.Village {
+						return entity.Village{ID: id}
+					},
+					func(id string) error {
+						return nil
+					},
+				).Once()
+				mockIDGen.On("GenerateGroupID").Return("g-abc", nil).Once()
+				mockIDGen.On("GenerateGroupID").Return("g-def", nil).Once()
+				mockIDGen.On("GenerateGroupID").Return("g-ghi", nil).Once()
+				mockGroupRepo.On(
+					"Insert",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.MatchedBy(func(group entity.Group) bool { return group.ID == "g-abc" }),
+					mock.AnythingOfType(fmt.Sprintf("%T", group.Registration{})),
+				).Return(repository.ErrDatabase).Once()
+				mockGroupRepo.On(
+					"Insert",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.MatchedBy(func(group entity.Group) bool { return group.ID == "g-def" }),
+					mock.AnythingOfType(fmt.Sprintf("%T", group.Registration{})),
+				).Return(repository.ErrRecordAlreadyExists).Once()
+				mockGroupRepo.On(
+					"Insert",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.MatchedBy(func(group entity.Group) bool { return group.ID == "g-ghi" }),
+					mock.AnythingOfType(fmt.Sprintf("%T", group.Registration{})),
+				).Return(nil).Once()
+			},
+		},
+		{
+			name:      "it should create the valid rows one by one with unique IDs, when no error is returned",
+			inputRows: []payload.CreateGroup{validRow, invalidRow, validRow},
+			expectedResponses: []response.ImportGroup{
+				{Row: 1, Status: "created", ID: "g-abc"},
+				{Row: 2, Status: "invalid", Error: "invalid payload: leader: less than min"},
+				{Row: 3, Status: "created", ID: "g-def"},
+			},
+			mockBehaviours: func() {
+				mockVillageRepo.On("FindByID", mock.AnythingOfType("string")).Return(
+					func(id string) entity.Village {
+						return entity.Village{ID: id}
+					},
+					func(id string) error {
+						return nil
+					},
+				).Once()
+				mockIDGen.On("GenerateGroupID").Return("g-abc", nil).Twice()
+				mockIDGen.On("GenerateGroupID").Return("g-def", nil).Once()
+				mockGroupRepo.On(
+					"Insert",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.MatchedBy(func(group entity.Group) bool { return group.ID == "g-abc" }),
+					mock.MatchedBy(func(registration group.Registration) bool {
+						return registration.Scope == "3502/3502030/{number}/2022" &&
+							registration.Number(1) == "3502/3502030/0001/2022"
+					}),
+				).Return(nil).Once()
+				mockGroupRepo.On(
+					"Insert",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.MatchedBy(func(group entity.Group) bool { return group.ID == "g-def" }),
+					mock.AnythingOfType(fmt.Sprintf("%T", group.Registration{})),
+				).Return(nil).Once()
+			},
+		},
+		{
+			name:              "it should create all of the rows at once, when in atomic mode and no error is returned",
+			inputRows:         []payload.CreateGroup{validRow, validRow},
+			inputImportGroups: payload.ImportGroups{Atomic: true},
+			expectedResponses: []response.ImportGroup{
+				{Row: 1, Status: "created", ID: "g-abc"},
+				{Row: 2, Status: "created", ID: "g-def"},
+			},
+			mockBehaviours: func() {
+				mockVillageRepo.On("FindByID", mock.AnythingOfType("string")).Return(
+					func(id string) entity.Village {
+						return entity.Village{ID: id}
+					},
+					func(id string) error {
+						return nil
+					},
+				).Once()
+				mockIDGen.On("GenerateGroupID").Return("g-abc", nil).Once()
+				mockIDGen.On("GenerateGroupID").Return("g-def", nil).Once()
+				mockGroupRepo.On(
+					"InsertAll",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.MatchedBy(func(groups []entity.Group) bool {
+						return len(groups) == 2 && groups[0].ID == "g-abc" && groups[1].ID == "g-def"
+					}),
+					mock.MatchedBy(func(registrations []group.Registration) bool {
+						return len(registrations) == 2 && registrations[1].Number(2) == "3502/3502030/0002/2022"
+					}),
+				).Return(nil).Once()
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.mockBehaviours()
+			gotResponses, gotErr := groupService.Import(context.Background(), testCase.inputRows, testCase.inputImportGroups)
+
+			if testCase.expectedError != nil {
+				assert.ErrorIs(t, gotErr, testCase.expectedError)
+			} else {
+				assert.NoError(t, gotErr)
+				assert.Equal(t, testCase.expectedResponses, gotResponses)
+			}
+		})
+	}
+}
+
+func TestGetAll(t *testing.T) {
+	mockGroupRepo := &mgr.GroupRepository{}
+	mockVillageRepo := &mvr.VillageRepository{}
+	mockIDGen := &mig.IDGenerator{}
+	mockQRGen := &mqg.QRCodeGenerator{}
+	mockScanLinkGen := &mqg.ScanLinkGenerator{}
+	mockCodeSigner := &mqg.CodeSigner{}
+	mockRegistrationNumberGen := &mig.RegistrationNumberGenerator{}
+	mockCertificateGen := &mig.CertificateGenerator{}
+
+	var groupService GroupService = NewGroupServiceImpl(
+		mockGroupRepo,
+		mockVillageRepo,
+		mockIDGen,
+		mockQRGen,
+		mockScanLinkGen,
+		mockCodeSigner,
+		mockRegistrationNumberGen,
+		mockCertificateGen,
+	)
+
+	testCases := []struct {
+		name               string
+		inputGetGroups     payload.GetGroups
+		expectedGroups     []response.Group
+		expectedPagination response.Pagination
+		expectedError      error
+		mockBehaviours     func()
+	}{
+		{
+			name:           "it should return service.ErrInvalidPayload error, when sort key is invalid",
+			inputGetGroups: payload.GetGroups{Sort: "leader"},
+			expectedGroups: []response.Group{},
+			expectedError:  service.ErrInvalidPayload,
+			mockBehaviours: func() {},
+		},
+		{
+			name:           "it should return service.ErrInvalidPayload error, when limit is too large",
+			inputGetGroups: payload.GetGroups{Limit: 1000},
+			expectedGroups: []response.Group{},
+			expectedError:  service.ErrInvalidPayload,
+			mockBehaviours: func() {},
+		},
+		{
+			name:           "it should return service.ErrInvalidPayload error, when page is too large",
+			inputGetGroups: payload.GetGroups{Page: math.MaxInt, Limit: 100},
+			expectedGroups: []response.Group{},
+			expectedError:  service.ErrInvalidPayload,
+			mockBehaviours: func() {},
+		},
+		{
+			name:           "it should return service.ErrRepository error, when group repository return an error",
+			expectedGroups: []response.Group{},
+			expectedError:  service.ErrRepository,
+			mockBehaviours: func() {
+				mockGroupRepo.On(
+					"FindAll",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", group.Filter{})),
+				).Return(
+					func(ctx context.Context, filter group.Filter) []entity.Group {
+						return []entity.Group{}
+					},
+					func(ctx context.Context, filter group.Filter) int64 {
+						return 0
+					},
+					func(ctx context.Context, filter group.Filter) error {
+						return repository.ErrDatabase
+					},
+				).Once()
+			},
+		},
+		{
+			name: "it should return a valid groups, when no error is returned",
+			inputGetGroups: payload.GetGroups{
+				Page:       2,
+				Limit:      1,
+				DistrictID: "3502030",
+				Sort:       "-property_count",
+			},
+			expectedPagination: response.Pagination{
+				Page:       2,
+				Limit:      1,
+				TotalItems: 3,
+				TotalPages: 3,
+			},
+			expectedGroups: []response.Group{
+				{
+					ID:     "g-Nzo",
+					Name:   "Paguyuban Reog",
+					Leader: "Erik Rio Setiawan",
+					Address: response.Address{
+						ID:           "g-Nzo",
+						Address:      "RT 05 RW 01 Dukuh Tengah",
+						VillageID:    "3502030007",
+						VillageName:  "Pager",
+						DistrictID:   "3502030",
+						DistrictName: "Bungkal",
+						RegencyID:    "3502",
+						RegencyName:  "Kabupaten Ponorogo",
+						ProvinceID:   "35",
+						ProvinceName: "Jawa Timur",
+					},
+					Properties: []response.Property{
+						{
+							ID:          "p-YIhpPgp",
+							Name:        "Dadak Merak",
+							Description: "Ini adalah deskripsi dari dadak merak",
+							Amount:      1,
+							Attachments: []response.Attachment{},
+						},
+					},
+					Attachments:  []response.Attachment{},
+					Achievements: []response.Achievement{},
+				},
+			},
+			expectedError: nil,
+			mockBehaviours: func() {
+				mockGroupRepo.On(
+					"FindAll",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					group.Filter{
+						DistrictID: "3502030",
+						SortBy:     group.SortByPropertyCount,
+						Descending: true,
+						Offset:     1,
+						Limit:      1,
+					},
+				).Return(func(ctx context.Context, filter group.Filter) []entity.Group {
+					return []entity.Group{
+						{
+							ID:     "g-Nzo",
+							Name:   "Paguyuban Reog",
+							Leader: "Erik Rio Setiawan",
+							Address: entity.Address{
+								ID:           "g-Nzo",
+								Address:      "RT 05 RW 01 Dukuh Tengah",
+								VillageID:    "3502030007",
+								VillageName:  "Pager",
+								DistrictID:   "3502030",
+								DistrictName: "Bungkal",
+								RegencyID:    "3502",
+								RegencyName:  "Kabupaten Ponorogo",
+								ProvinceID:   "35",
+								ProvinceName: "Jawa Timur",
+							},
+							Properties: []entity.Property{
+								{
+									ID:          "p-YIhpPgp",
+									Name:        "Dadak Merak",
+									Description: "Ini adalah deskripsi dari dadak merak",
+									Amount:      1,
+								},
+							},
+						},
+					}
+				}, func(ctx context.Context, filter group.Filter) int64 {
+					return 3
+				}, func(ctx context.Context, filter group.Filter) error {
+					return nil
+				}).Once()
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.mockBehaviours()
+			gotGroups, gotPagination, gotErr := groupService.GetAll(context.Background(), testCase.inputGetGroups)
+
+			if testCase.expectedError != nil {
+				assert.ErrorIs(t, gotErr, testCase.expectedError)
+			} else {
+				assert.NoError(t, gotErr)
+				assert.Equal(t, testCase.expectedGroups, gotGroups)
+				assert.Equal(t, testCase.expectedPagination, gotPagination)
+			}
+		})
+	}
+}
+
+func TestExport(t *testing.T) {
+	mockGroupRepo := &mgr.GroupRepository{}
+	mockVillageRepo := &mvr.VillageRepository{}
+	mockIDGen := &mig.IDGenerator{}
+	mockQRGen := &mqg.QRCodeGenerator{}
+	mockScanLinkGen := &mqg.ScanLinkGenerator{}
+	mockCodeSigner := &mqg.CodeSigner{}
+	mockRegistrationNumberGen := &mig.RegistrationNumberGenerator{}
+	mockCertificateGen := &mig.CertificateGenerator{}
+
+	var groupService GroupService = NewGroupServiceImpl(
+		mockGroupRepo,
+		mockVillageRepo,
+		mockIDGen,
+		mockQRGen,
+		mockScanLinkGen,
+		mockCodeSigner,
+		mockRegistrationNumberGen,
+		mockCertificateGen,
+	)
+
+	fullPageEntities := make([]entity.Group, exportPageSize)
+	fullPage := make([]response.Group, exportPageSize)
+	for i := range fullPage {
+		id := fmt.Sprintf("g-%03d", i)
+		fullPageEntities[i] = entity.Group{ID: id, Address: entity.Address{ID: id}}
+		fullPage[i] = response.Group{
+			ID:           id,
+			Address:      response.Address{ID: id},
+			Properties:   []response.Property{},
+			Attachments:  []response.Attachment{},
+			Achievements: []response.Achievement{},
+		}
+	}
+
+	testCases := []struct {
+		name           string
+		inputGetGroups payload.GetGroups
+		expectedPages  [][]response.Group
+		expectedError  error
+		mockBehaviours func()
+	}{
+		{
+			name:           "it should return service.ErrInvalidPayload error, when sort key is invalid",
+			inputGetGroups: payload.GetGroups{Sort: "leader"},
+			expectedError:  service.ErrInvalidPayload,
+			mockBehaviours: func() {},
+		},
+		{
+			name:           "it should return service.ErrRepository error, when group repository return an error",
+			inputGetGroups: payload.GetGroups{},
+			expectedError:  service.ErrRepository,
+			mockBehaviours: func() {
+				mockGroupRepo.On(
+					"FindAll",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", group.Filter{})),
+				).Return(
+					func(ctx context.Context, filter group.Filter) []entity.Group {
+						return []entity.Group{}
+					},
+					func(ctx context.Context, filter group.Filter) int64 {
+						return 0
+					},
+					func(ctx context.Context, filter group.Filter) error {
+						return repository.ErrDatabase
+					},
+				).Once()
+			},
+		},
+		{
+			name:           "it should write every matching group in a single page, when they fit in one",
+			inputGetGroups: payload.GetGroups{Page: 3, Limit: 10, VillageID: "3502030007"},
+			expectedPages: [][]response.Group{
+				{
+					{
+						ID:           "g-Nzo",
+						Name:         "Paguyuban Reog",
+						Leader:       "Erik Rio Setiawan",
+						Address:      response.Address{ID: "g-Nzo", VillageID: "3502030007"},
+						Properties:   []response.Property{},
+						Attachments:  []response.Attachment{},
+						Achievements: []response.Achievement{},
+					},
+				},
+			},
+			expectedError: nil,
+			mockBehaviours: func() {
+				mockGroupRepo.On(
+					"FindAll",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					group.Filter{VillageID: "3502030007", Limit: exportPageSize},
+				).Return(
+					func(ctx context.Context, filter group.Filter) []entity.Group {
+						return []entity.Group{
+							{
+								ID:      "g-Nzo",
+								Name:    "Paguyuban Reog",
+								Leader:  "Erik Rio Setiawan",
+								Address: entity.Address{ID: "g-Nzo", VillageID: "3502030007"},
+							},
+						}
+					},
+					func(ctx context.Context, filter group.Filter) int64 {
+						return 1
+					},
+					func(ctx context.Context, filter group.Filter) error {
+						return nil
+					},
+				).Once()
+			},
+		},
+		{
+			name:           "it should write the groups page by page, when they do not fit in one",
+			inputGetGroups: payload.GetGroups{},
+			expectedPages:  [][]response.Group{fullPage, {}},
+			expectedError:  nil,
+			mockBehaviours: func() {
+				mockGroupRepo.On(
+					"FindAll",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					group.Filter{Limit: exportPageSize},
+				).Return(
+					func(ctx context.Context, filter group.Filter) []entity.Group {
+						return fullPageEntities
+					},
+					func(ctx context.Context, filter group.Filter) int64 {
+						return exportPageSize
+					},
+					func(ctx context.Context, filter group.Filter) error {
+						return nil
+					},
+				).Once()
+				mockGroupRepo.On(
+					"FindAll",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					group.Filter{Offset: exportPageSize, Limit: exportPageSize},
+				).Return(
+					func(ctx context.Context, filter group.Filter) []entity.Group {
+						return []entity.Group{}
+					},
+					func(ctx context.Context, filter group.Filter) int64 {
+						return exportPageSize
+					},
+					func(ctx context.Context, filter group.Filter) error {
+						return nil
+					},
+				).Once()
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.mockBehaviours()
+			var gotPages [][]response.Group
+			gotErr := groupService.Export(context.Background(), testCase.inputGetGroups, func(groups []response.Group) error {
+				gotPages = append(gotPages, groups)
+				return nil
+			})
+
+			if testCase.expectedError != nil {
+				assert.ErrorIs(t, gotErr, testCase.expectedError)
+			} else {
+				assert.NoError(t, gotErr)
+				assert.Equal(t, testCase.expectedPages, gotPages)
+			}
+		})
+	}
+}
+
+func TestGetNearby(t *testing.T) {
+	mockGroupRepo := &mgr.GroupRepository{}
+	mockVillageRepo := &mvr.VillageRepository{}
+	mockIDGen := &mig.IDGenerator{}
+	mockQRGen := &mqg.QRCodeGenerator{}
+	mockScanLinkGen := &mqg.ScanLinkGenerator{}
+	mockCodeSigner := &mqg.CodeSigner{}
+	mockRegistrationNumberGen := &mig.RegistrationNumberGenerator{}
+	mockCertificateGen := &mig.CertificateGenerator{}
+
+	var groupService GroupService = NewGroupServiceImpl(
+		mockGroupRepo,
+		mockVillageRepo,
+		mockIDGen,
+		mockQRGen,
+		mockScanLinkGen,
+		mockCodeSigner,
+		mockRegistrationNumberGen,
+		mockCertificateGen,
+	)
+
+	center := geo.Point{Latitude: -7.87, Longitude: 111.46}
+	withinBounds := mock.MatchedBy(func(filter group.Filter) bool {
+		return filter.Within != nil && filter.Within.Contains(center)
+	})
+
+	testCases := []struct {
+		name              string
+		inputPayload      payload.GetNearbyGroups
+		expectedIDs       []string
+		expectedDistances []float64
+		expectedError     error
+		mockBehaviours    func()
+	}{
+		{
+			name:           "it should return service.ErrInvalidPayload error, when the point is missing",
+			inputPayload:   payload.GetNearbyGroups{Radius: 1000},
+			expectedError:  service.ErrInvalidPayload,
+			mockBehaviours: func() {},
+		},
+		{
+			name:           "it should return service.ErrInvalidPayload error, when radius is too large",
+			inputPayload:   payload.GetNearbyGroups{Latitude: floatPtr(-7.87), Longitude: floatPtr(111.46), Radius: 100000},
+			expectedError:  service.ErrInvalidPayload,
+			mockBehaviours: func() {},
+		},
+		{
+			name:          "it should return service.ErrRepository error, when group repository return an error",
+			inputPayload:  payload.GetNearbyGroups{Latitude: floatPtr(-7.87), Longitude: floatPtr(111.46)},
+			expectedError: service.ErrRepository,
+			mockBehaviours: func() {
+				mockGroupRepo.On(
+					"FindAll",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					withinBounds,
+				).Return(
+					func(ctx context.Context, filter group.Filter) []entity.Group {
+						return []entity.Group{}
+					},
+					func(ctx context.Context, filter group.Filter) int64 {
+						return 0
+					},
+					func(ctx context.Context, filter group.Filter) error {
+						return repository.ErrDatabase
+					},
+				).Once()
+			},
+		},
+		{
+			name:         "it should return the groups within the radius from the closest one, when no error is returned",
+			inputPayload: payload.GetNearbyGroups{Latitude: floatPtr(-7.87), Longitude: floatPtr(111.46), Radius: 3000},
+			expectedIDs:  []string{"g-near", "g-far"},
+			expectedDistances: []float64{
+				math.Round(geo.Distance(center, geo.Point{Latitude: -7.872, Longitude: 111.46})),
+				math.Round(geo.Distance(center, geo.Point{Latitude: -7.87, Longitude: 111.47})),
+			},
+			mockBehaviours: func() {
+				mockGroupRepo.On(
+					"FindAll",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					withinBounds,
+				).Return(
+					func(ctx context.Context, filter group.Filter) []entity.Group {
+						return []entity.Group{
+							{ID: "g-far", Address: entity.Address{ID: "g-far", Latitude: floatPtr(-7.87), Longitude: floatPtr(111.47)}},
+							{ID: "g-corner", Address: entity.Address{ID: "g-corner", Latitude: floatPtr(-7.895), Longitude: floatPtr(111.485)}},
+							{ID: "g-unknown", Address: entity.Address{ID: "g-unknown"}},
+							{ID: "g-near", Address: entity.Address{ID: "g-near", Latitude: floatPtr(-7.872), Longitude: floatPtr(111.46)}},
+						}
+					},
+					func(ctx context.Context, filter group.Filter) int64 {
+						return 4
+					},
+					func(ctx context.Context, filter group.Filter) error {
+						return nil
+					},
+				).Once()
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.mockBehaviours()
+			gotGroups, gotErr := groupService.GetNearby(context.Background(), testCase.inputPayload)
+
+			if testCase.expectedError != nil {
+				assert.ErrorIs(t, gotErr, testCase.expectedError)
+			} else {
+				assert.NoError(t, gotErr)
+				gotIDs := make([]string, len(gotGroups))
+				gotDistances := make([]float64, len(gotGroups))
+				for i, gotGroup := range gotGroups {
+					gotIDs[i] = gotGroup.ID
+					gotDistances[i] = gotGroup.Distance
+				}
+				assert.Equal(t, testCase.expectedIDs, gotIDs)
+				assert.Equal(t, testCase.expectedDistances, gotDistances)
+			}
+		})
+	}
+}
+
+func TestGetByID(t *testing.T) {
+	mockGroupRepo := &mgr.GroupRepository{}
+	mockVillageRepo := &mvr.VillageRepository{}
+	mockIDGen := &mig.IDGenerator{}
+	mockQRGen := &mqg.QRCodeGenerator{}
+	mockScanLinkGen := &mqg.ScanLinkGenerator{}
+	mockCodeSigner := &mqg.CodeSigner{}
+	mockRegistrationNumberGen := &mig.RegistrationNumberGenerator{}
+	mockCertificateGen := &mig.CertificateGenerator{}
+
+	var groupService GroupService = NewGroupServiceImpl(
+		mockGroupRepo,
+		mockVillageRepo,
+		mockIDGen,
+		mockQRGen,
+		mockScanLinkGen,
+		mockCodeSigner,
+		mockRegistrationNumberGen,
+		mockCertificateGen,
+	)
+
+	testCases := []struct {
+		name           string
+		inputID        string
+		expectedGroup  response.Group
+		expectedError  error
+		mockBehaviours func()
+	}{
+		{
+			name:          "it should return service.ErrRepository error, when group repository return an error",
+			inputID:       "g-xyz",
+			expectedGroup: response.Group{},
+			expectedError: service.ErrRepository,
+			mockBehaviours: func() {
+				mockGroupRepo.On(
+					"FindByID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, id string) entity.Group {
+						return entity.Group{}
+					},
+					func(ctx context.Context, id string) error {
+						return repository.ErrDatabase
+					},
+				).Once()
+			},
+		},
+		{
+			name:          "it should return service.ErrDataNotFound error, when group repository return an error",
+			inputID:       "g-xyz",
+			expectedGroup: response.Group{},
+			expectedError: service.ErrDataNotFound,
+			mockBehaviours: func() {
+				mockGroupRepo.On(
+					"FindByID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, id string) entity.Group {
+						return entity.Group{}
+					},
+					func(ctx context.Context, id string) error {
+						return repository.ErrRecordNotFound
+					},
+				).Once()
+			},
+		},
+		{
+			name:    "it should return a valid group, when no error is returned",
+			inputID: "g-xyz",
+			expectedGroup: response.Group{
+				ID:     "g-Nzo",
+				Name:   "Paguyuban Reog",
+				Leader: "Erik Rio Setiawan",
+				Address: response.Address{
+					ID:           "g-Nzo",
+					Address:      "RT 05 RW 01 Dukuh Tengah",
+					VillageID:    "3502030007",
+					VillageName:  "Pager",
+					DistrictID:   "3502030",
+					DistrictName: "Bungkal",
+					RegencyID:    "3502",
+					RegencyName:  "Kabupaten Ponorogo",
+					ProvinceID:   "35",
+					ProvinceName: "Jawa Timur",
+				},
+				Properties: []response.Property{
+					{
+						ID:          "p-YIhpPgp",
+						Name:        "Dadak Merak",
+						Description: "Ini adalah deskripsi dari dadak merak",
+						Amount:      1,
+						Attachments: []response.Attachment{
+							{
+								ID:           "f-aBcdEfG",
+								FileName:     "dadak-merak.jpg",
+								ContentType:  "image/jpeg",
+								Size:         204800,
+								URL:          "/uploads/properties/p-YIhpPgp/f-aBcdEfG.jpg",
+								ThumbnailURL: "/uploads/properties/p-YIhpPgp/f-aBcdEfG_thumbnail.jpg",
+							},
+						},
+					},
+				},
+				MemberCounts: response.MemberCounts{
+					Warok:     2,
+					Pembarong: 1,
+					Total:     3,
+				},
+				Attachments: []response.Attachment{
+					{
+						ID:          "f-hIjkLmN",
+						FileName:    "surat-keterangan.pdf",
+						ContentType: "application/pdf",
+						Size:        102400,
+						URL:         "/uploads/groups/g-Nzo/f-hIjkLmN.pdf",
+					},
+				},
+				Achievements: []response.Achievement{
+					{
+						ID:        "c-oPqrStU",
+						GroupID:   "g-Nzo",
+						EventName: "Festival Reog Nasional",
+						Year:      2021,
+						Level:     entity.AchievementLevelNational,
+						Rank:      1,
+						Certificate: &response.Attachment{
+							ID:          "f-vWxyZaB",
+							FileName:    "piagam.pdf",
+							ContentType: "application/pdf",
+							Size:        51200,
+							URL:         "/uploads/achievements/c-oPqrStU/f-vWxyZaB.pdf",
+						},
+					},
+					{
+						ID:        "c-cDefGhI",
+						GroupID:   "g-Nzo",
+						EventName: "Lomba Reog Desa",
+						Year:      2019,
+						Level:     entity.AchievementLevelVillage,
+						Rank:      2,
+					},
+				},
+			},
+			expectedError: nil,
+			mockBehaviours: func() {
+				mockGroupRepo.On(
+					"FindByID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, id string) entity.Group {
+						return entity.Group{
+							ID:     "g-Nzo",
+							Name:   "Paguyuban Reog",
+							Leader: "Erik Rio Setiawan",
+							Address: entity.Address{
+								ID:           "g-Nzo",
+								Address:      "RT 05 RW 01 Dukuh Tengah",
+								VillageID:    "3502030007",
+								VillageName:  "Pager",
+								DistrictID:   "3502030",
+								DistrictName: "Bungkal",
+								RegencyID:    "3502",
+								RegencyName:  "Kabupaten Ponorogo",
+								ProvinceID:   "35",
+								ProvinceName: "Jawa Timur",
+							},
+							Properties: []entity.Property{
+								{
+									ID:          "p-YIhpPgp",
+									Name:        "Dadak Merak",
+									Description: "Ini adalah deskripsi dari dadak merak",
+									Amount:      1,
+									Attachments: []entity.Attachment{
+										{
+											ID:           "f-aBcdEfG",
+											OwnerID:      "p-YIhpPgp",
+											OwnerType:    entity.AttachmentOwnerProperty,
+											FileName:     "dadak-merak.jpg",
+											ContentType:  "image/jpeg",
+											Size:         204800,
+											Key:          "properties/p-YIhpPgp/f-aBcdEfG.jpg",
+											URL:          "/uploads/properties/p-YIhpPgp/f-aBcdEfG.jpg",
+											ThumbnailKey: "properties/p-YIhpPgp/f-aBcdEfG_thumbnail.jpg",
+											ThumbnailURL: "/uploads/properties/p-YIhpPgp/f-aBcdEfG_thumbnail.jpg",
+										},
+									},
+								},
+							},
+							Members: []entity.Member{
+								{ID: "m-aBcdEfG", Role: entity.MemberRoleWarok},
+								{ID: "m-hIjkLmN", Role: entity.MemberRolePembarong},
+								{ID: "m-oPqrStU", Role: entity.MemberRoleWarok},
+							},
+							Attachments: []entity.Attachment{
+								{
+									ID:          "f-hIjkLmN",
+									OwnerID:     "g-Nzo",
+									OwnerType:   entity.AttachmentOwnerGroup,
+									FileName:    "surat-keterangan.pdf",
+									ContentType: "application/pdf",
+									Size:        102400,
+									Key:         "groups/g-Nzo/f-hIjkLmN.pdf",
+									URL:         "/uploads/groups/g-Nzo/f-hIjkLmN.pdf",
+								},
+							},
+							Achievements: []entity.Achievement{
+								{
+									ID:        "c-oPqrStU",
+									GroupID:   "g-Nzo",
+									EventName: "Festival Reog Nasional",
+									Year:      2021,
+									Level:     entity.AchievementLevelNational,
+									Rank:      1,
+									Certificate: &entity.Attachment{
+										ID:          "f-vWxyZaB",
+										OwnerID:     "c-oPqrStU",
+										OwnerType:   entity.AttachmentOwnerAchievement,
+										FileName:    "piagam.pdf",
+										ContentType: "application/pdf",
+										Size:        51200,
+										Key:         "achievements/c-oPqrStU/f-vWxyZaB.pdf",
+										URL:         "/uploads/achievements/c-oPqrStU/f-vWxyZaB.pdf",
+									},
+								},
+								{
+									ID:        "c-cDefGhI",
+									GroupID:   "g-Nzo",
+									EventName: "Lomba Reog Desa",
+									Year:      2019,
+									Level:     entity.AchievementLevelVillage,
+									Rank:      2,
+								},
+							},
+						}
+					},
+					func(ctx context.Context, id string) error {
+						return nil
+					},
+				).Once()
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.mockBehaviours()
+
+			gotGroup, gotErr := groupService.GetByID(context.Background(), testCase.inputID)
+
+			if testCase.expectedError != nil {
+				assert.ErrorIs(t, gotErr, testCase.expectedError)
+			} else {
+				assert.NoError(t, gotErr)
+				assert.Equal(t, testCase.expectedGroup, gotGroup)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	mockGroupRepo := &mgr.GroupRepository{}
+	mockVillageRepo := &mvr.VillageRepository{}
+	mockIDGen := &mig.IDGenerator{}
+	mockQRGen := &mqg.QRCodeGenerator{}
+	mockScanLinkGen := &mqg.ScanLinkGenerator{}
+	mockCodeSigner := &mqg.CodeSigner{}
+	mockRegistrationNumberGen := &mig.RegistrationNumberGenerator{}
+	mockCertificateGen := &mig.CertificateGenerator{}
+
+	var groupService GroupService = NewGroupServiceImpl(
+		mockGroupRepo,
+		mockVillageRepo,
+		mockIDGen,
+		mockQRGen,
+		mockScanLinkGen,
+		mockCodeSigner,
+		mockRegistrationNumberGen,
+		mockCertificateGen,
+	)
+
+	findGroup := func(err error) {
+		mockGroupRepo.On(
+			"FindByID",
+			mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+			mock.AnythingOfType(fmt.Sprintf("%T", "")),
+		).Return(
+			func(ctx context.Context, id string) entity.Group {
+				return entity.Group{ID: id, Name: "Paguyuban Reog", Leader: "Erik R", Version: 2}
+			},
+			func(ctx context.Context, id string) error {
+				return err
+			},
+		).Once()
+	}
+
+	testCases := []struct {
+		name             string
+		inputID          string
+		inputUpdateGroup payload.UpdateGroup
+		expectedError    error
+		mockBehaviours   func()
+	}{
+		{
+			name:    "it should return service.ErrInvalidPayload error, when payload is invalid",
+			inputID: "g-xyz",
+			inputUpdateGroup: payload.UpdateGroup{
+				Name:   "Paguyuban Reog",
+				Leader: "E",
+			},
+			expectedError:  service.ErrInvalidPayload,
+			mockBehaviours: func() {},
+		},
+		{
+			name:    "it should return service.ErrDataNotFound error, when group not exists",
+			inputID: "g-xyz",
+			inputUpdateGroup: payload.UpdateGroup{
+				Name:   "Paguyuban Reog",
+				Leader: "Erik R",
+			},
+			expectedError: service.ErrDataNotFound,
+			mockBehaviours: func() {
+				findGroup(repository.ErrRecordNotFound)
+			},
+		},
+		{
+			name:    "it should return service.ErrVersionMismatch error, when the group has been updated since the given version",
+			inputID: "g-xyz",
+			inputUpdateGroup: payload.UpdateGroup{
+				Name:   "Paguyuban Reog",
+				Leader: "Erik R",
+			},
+			expectedError: service.ErrVersionMismatch,
+			mockBehaviours: func() {
+				mockGroupRepo.On(
+					"FindByID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, id string) entity.Group {
+						return entity.Group{ID: id, Name: "Paguyuban Reog", Leader: "Erik R", Version: 3}
+					},
+					func(ctx context.Context, id string) error {
+						return nil
+					},
+				).Once()
+			},
+		},
+		{
+			name:    "it should return service.ErrVersionMismatch error, when the group is updated concurrently",
+			inputID: "g-xyz",
+			inputUpdateGroup: payload.UpdateGroup{
+				Name:   "Paguyuban Reog",
+				Leader: "Erik R",
+			},
+			expectedError: service.ErrVersionMismatch,
+			mockBehaviours: func() {
+				findGroup(nil)
+				mockGroupRepo.On(
+					"Update",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					2,
+					mock.AnythingOfType(fmt.Sprintf("%T", entity.Group{})),
+				).Return(
+					func(ctx context.Context, id string, version int, group entity.Group) error {
+						return repository.ErrRecordModified
+					},
+				).Once()
+			},
+		},
+		{
+			name:    "it should return service.ErrRepository error, when group repository return an error",
+			inputID: "g-xyz",
+			inputUpdateGroup: payload.UpdateGroup{
+				Name:   "Paguyuban Reog",
+				Leader: "Erik R",
+			},
+			expectedError: service.ErrRepository,
+			mockBehaviours: func() {
+				findGroup(nil)
+				mockGroupRepo.On(
+					"Update",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					2,
+					mock.AnythingOfType(fmt.Sprintf("%T", entity.Group{})),
+				).Return(
+					func(ctx context.Context, id string, version int, group entity.Group) error {
+						return repository.ErrDatabase
+					},
+				).Once()
+			},
+		},
+		{
+			name:    "it should return nil error without recording a leadership change, when the leader is unchanged",
+			inputID: "g-xyz",
+			inputUpdateGroup: payload.UpdateGroup{
+				Name:        "Paguyuban Reog Singo",
+				Leader:      "Erik R",
+				LeaderSince: "not a date",
+			},
+			expectedError: nil,
+			mockBehaviours: func() {
+				findGroup(nil)
+				mockGroupRepo.On(
+					"Update",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					2,
+					mock.AnythingOfType(fmt.Sprintf("%T", entity.Group{})),
+				).Return(
+					func(ctx context.Context, id string, version int, group entity.Group) error {
+						return nil
+					},
+				).Once()
+			},
+		},
+		{
+			name:    "it should return service.ErrDateParsing error, when leaderSince is invalid",
+			inputID: "g-xyz",
+			inputUpdateGroup: payload.UpdateGroup{
+				Name:        "Paguyuban Reog",
+				Leader:      "Rio S",
+				LeaderSince: "01-02-2022",
+			},
+			expectedError: service.ErrDateParsing,
+			mockBehaviours: func() {
+				findGroup(nil)
+			},
+		},
+		{
+			name:    "it should return service.ErrInvalidPayload error, when leaderSince is in the future",
+			inputID: "g-xyz",
+			inputUpdateGroup: payload.UpdateGroup{
+				Name:        "Paguyuban Reog",
+				Leader:      "Rio S",
+				LeaderSince: time.Now().AddDate(0, 0, 2).Format("2006-01-02"),
+			},
+			expectedError: service.ErrInvalidPayload,
+			mockBehaviours: func() {
+				findGroup(nil)
+			},
+		},
+		{
+			name:    "it should return service.ErrRepository error, when id generator return an error",
+			inputID: "g-xyz",
+			inputUpdateGroup: payload.UpdateGroup{
+				Name:   "Paguyuban Reog",
+				Leader: "Rio S",
+			},
+			expectedError: service.ErrRepository,
+			mockBehaviours: func() {
+				findGroup(nil)
+				mockIDGen.On("GenerateLeadershipChangeID").Return(
+					func() string {
+						return ""
+					},
+					func() error {
+						return errors.New("something error")
+					},
+				).Once()
+			},
+		},
+		{
+			name:    "it should return service.ErrDataNotFound error, when the leader was changed concurrently",
+			inputID: "g-xyz",
+			inputUpdateGroup: payload.UpdateGroup{
+				Name:   "Paguyuban Reog",
+				Leader: "Rio S",
+			},
+			expectedError: service.ErrDataNotFound,
+			mockBehaviours: func() {
+				findGroup(nil)
+				mockIDGen.On("GenerateLeadershipChangeID").Return(
+					func() string {
+						return "l-Ay8LmNI"
+					},
+					func() error {
+						return nil
+					},
+				).Once()
+				mockGroupRepo.On(
+					"UpdateLeader",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					2,
+					mock.AnythingOfType(fmt.Sprintf("%T", entity.Group{})),
+					mock.AnythingOfType(fmt.Sprintf("%T", entity.LeadershipChange{})),
+				).Return(
+					func(ctx context.Context, version int, group entity.Group, change entity.LeadershipChange) error {
+						return repository.ErrRecordNotFound
+					},
+				).Once()
+			},
+		},
+		{
+			name:    "it should record the leadership change, when the leader is replaced",
+			inputID: "g-xyz",
+			inputUpdateGroup: payload.UpdateGroup{
+				Name:        "Paguyuban Reog",
+				Leader:      "Rio S",
+				LeaderSince: "2022-01-02",
+				LeaderNote:  "  Elected at the annual meeting ",
+			},
+			expectedError: nil,
+			mockBehaviours: func() {
+				findGroup(nil)
+				mockIDGen.On("GenerateLeadershipChangeID").Return(
+					func() string {
+						return "l-Ay8LmNI"
+					},
+					func() error {
+						return nil
+					},
+				).Once()
+				mockGroupRepo.On(
+					"UpdateLeader",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					2,
+					entity.Group{ID: "g-xyz", Name: "Paguyuban Reog", Leader: "Rio S"},
+					entity.LeadershipChange{
+						ID:             "l-Ay8LmNI",
+						GroupID:        "g-xyz",
+						PreviousLeader: "Erik R",
+						NewLeader:      "Rio S",
+						EffectiveOn:    time.Date(2022, 1, 2, 0, 0, 0, 0, time.UTC),
+						Note:           "Elected at the annual meeting",
+						AdminID:        "a-XU",
+						AdminUsername:  "erikrios",
+					},
+				).Return(
+					func(ctx context.Context, version int, group entity.Group, change entity.LeadershipChange) error {
+						return nil
+					},
+				).Once()
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.mockBehaviours()
+			gotErr := groupService.Update(context.Background(), testCase.inputID, 2, "a-XU", "erikrios", testCase.inputUpdateGroup)
+
+			if testCase.expectedError != nil {
+				assert.ErrorIs(t, gotErr, testCase.expectedError)
+			} else {
+				assert.NoError(t, gotErr)
+			}
+		})
+	}
+}
+
+func TestPatch(t *testing.T) {
+	mockGroupRepo := &mgr.GroupRepository{}
+	mockVillageRepo := &mvr.VillageRepository{}
+	mockIDGen := &mig.IDGenerator{}
+	mockQRGen := &mqg.QRCodeGenerator{}
+	mockScanLinkGen := &mqg.ScanLinkGenerator{}
+	mockCodeSigner := &mqg.CodeSigner{}
+	mockRegistrationNumberGen := &mig.RegistrationNumberGenerator{}
+	mockCertificateGen := &mig.CertificateGenerator{}
+
+	var groupService GroupService = NewGroupServiceImpl(
+		mockGroupRepo,
+		mockVillageRepo,
+		mockIDGen,
+		mockQRGen,
+		mockScanLinkGen,
+		mockCodeSigner,
+		mockRegistrationNumberGen,
+		mockCertificateGen,
+	)
+
+	findGroup := func(version int) {
+		mockGroupRepo.On(
+			"FindByID",
+			mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+			mock.AnythingOfType(fmt.Sprintf("%T", "")),
+		).Return(
+			func(ctx context.Context, id string) entity.Group {
+				return entity.Group{ID: id, Name: "Paguyuban Reog", Leader: "Erik R", Version: version}
+			},
+			func(ctx context.Context, id string) error {
+				return nil
+			},
+		).Once()
+	}
+
+	emptyName, name, leader, leaderSince := "", "Paguyuban Reog Singo", "Rio S", "2022-01-02"
+
+	testCases := []struct {
+		name            string
+		inputPatchGroup payload.PatchGroup
+		expectedError   error
+		mockBehaviours  func()
+	}{
+		{
+			name:            "it should return service.ErrInvalidPayload error, when a present field is empty",
+			inputPatchGroup: payload.PatchGroup{Name: &emptyName},
+			expectedError:   service.ErrInvalidPayload,
+			mockBehaviours:  func() {},
+		},
+		{
+			name:            "it should return service.ErrVersionMismatch error, when the group has been updated since the given version",
+			inputPatchGroup: payload.PatchGroup{Name: &name},
+			expectedError:   service.ErrVersionMismatch,
+			mockBehaviours: func() {
+				findGroup(3)
+			},
+		},
+		{
+			name:            "it should keep the current leader, when the leader is absent from the patch",
+			inputPatchGroup: payload.PatchGroup{Name: &name},
+			expectedError:   nil,
+			mockBehaviours: func() {
+				findGroup(2)
+				mockGroupRepo.On(
+					"Update",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					"g-xyz",
+					2,
+					entity.Group{ID: "g-xyz", Name: "Paguyuban Reog Singo", Leader: "Erik R"},
+				).Return(
+					func(ctx context.Context, id string, version int, group entity.Group) error {
+						return nil
+					},
+				).Once()
+			},
+		},
+		{
+			name:            "it should record the leadership change and keep the current name, when the leader is patched",
+			inputPatchGroup: payload.PatchGroup{Leader: &leader, LeaderSince: &leaderSince},
+			expectedError:   nil,
+			mockBehaviours: func() {
+				findGroup(2)
+				mockIDGen.On("GenerateLeadershipChangeID").Return(
+					func() string {
+						return "l-Ay8LmNI"
+					},
+					func() error {
+						return nil
+					},
+				).Once()
+				mockGroupRepo.On(
+					"UpdateLeader",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					2,
+					entity.Group{ID: "g-xyz", Name: "Paguyuban Reog", Leader: "Rio S"},
+					entity.LeadershipChange{
+						ID:             "l-Ay8LmNI",
+						GroupID:        "g-xyz",
+						PreviousLeader: "Erik R",
+						NewLeader:      "Rio S",
+						EffectiveOn:    time.Date(2022, 1, 2, 0, 0, 0, 0, time.UTC),
+						AdminID:        "a-XU",
+						AdminUsername:  "erikrios",
+					},
+				).Return(
+					func(ctx context.Context, version int, group entity.Group, change entity.LeadershipChange) error {
+						return nil
+					},
+				).Once()
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.mockBehaviours()
+			gotErr := groupService.Patch(context.Background(), "g-xyz", 2, "a-XU", "erikrios", testCase.inputPatchGroup)
+
+			if testCase.expectedError != nil {
+				assert.ErrorIs(t, gotErr, testCase.expectedError)
+			} else {
+				assert.NoError(t, gotErr)
+			}
+		})
+	}
+}
+
+func TestUpdateContacts(t *testing.T) {
+	mockGroupRepo := &mgr.GroupRepository{}
+	mockVillageRepo := &mvr.VillageRepository{}
+	mockIDGen := &mig.IDGenerator{}
+	mockQRGen := &mqg.QRCodeGenerator{}
+	mockScanLinkGen := &mqg.ScanLinkGenerator{}
+	mockCodeSigner := &mqg.CodeSigner{}
+	mockRegistrationNumberGen := &mig.RegistrationNumberGenerator{}
+	mockCertificateGen := &mig.CertificateGenerator{}
+
+	var groupService GroupService = NewGroupServiceImpl(
+		mockGroupRepo,
+		mockVillageRepo,
+		mockIDGen,
+		mockQRGen,
+		mockScanLinkGen,
+		mockCodeSigner,
+		mockRegistrationNumberGen,
+		mockCertificateGen,
+	)
+
+	testCases := []struct {
+		name           string
+		inputID        string
+		inputPayload   payload.UpdateGroupContacts
+		expectedError  error
+		mockBehaviours func()
+	}{
+		{
+			name:           "it should return service.ErrInvalidPayload error, when the phone is not an Indonesian number",
+			inputID:        "g-xyz",
+			inputPayload:   payload.UpdateGroupContacts{Phone: "+1 202 555 0143"},
+			expectedError:  service.ErrInvalidPayload,
+			mockBehaviours: func() {},
+		},
+		{
+			name:           "it should return service.ErrInvalidPayload error, when the WhatsApp number is not a mobile number",
+			inputID:        "g-xyz",
+			inputPayload:   payload.UpdateGroupContacts{WhatsApp: "(0352) 481234"},
+			expectedError:  service.ErrInvalidPayload,
+			mockBehaviours: func() {},
+		},
+		{
+			name:           "it should return service.ErrInvalidPayload error, when the website is not an URL",
+			inputID:        "g-xyz",
+			inputPayload:   payload.UpdateGroupContacts{Website: "reogsingomudho.id"},
+			expectedError:  service.ErrInvalidPayload,
+			mockBehaviours: func() {},
+		},
+		{
+			name:           "it should return service.ErrInvalidPayload error, when the Instagram profile URL is on another network",
+			inputID:        "g-xyz",
+			inputPayload:   payload.UpdateGroupContacts{Instagram: "https://www.tiktok.com/@reogsingomudho"},
+			expectedError:  service.ErrInvalidPayload,
+			mockBehaviours: func() {},
+		},
+		{
+			name:          "it should return service.ErrDataNotFound error, when group repository return a not found error",
+			inputID:       "g-xyz",
+			inputPayload:  payload.UpdateGroupContacts{Email: "reog@example.com"},
+			expectedError: service.ErrDataNotFound,
+			mockBehaviours: func() {
+				mockGroupRepo.On(
+					"UpdateContacts",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					"g-xyz",
+					3,
+					mock.AnythingOfType(fmt.Sprintf("%T", entity.GroupContacts{})),
+				).Return(
+					func(ctx context.Context, id string, version int, contacts entity.GroupContacts) error {
+						return repository.ErrRecordNotFound
+					},
+				).Once()
+			},
+		},
+		{
+			name:          "it should return service.ErrVersionMismatch error, when group repository return a record modified error",
+			inputID:       "g-xyz",
+			inputPayload:  payload.UpdateGroupContacts{Email: "reog@example.com"},
+			expectedError: service.ErrVersionMismatch,
+			mockBehaviours: func() {
+				mockGroupRepo.On(
+					"UpdateContacts",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					"g-xyz",
+					3,
+					mock.AnythingOfType(fmt.Sprintf("%T", entity.GroupContacts{})),
+				).Return(
+					func(ctx context.Context, id string, version int, contacts entity.GroupContacts) error {
+						return repository.ErrRecordModified
+					},
+				).Once()
+			},
+		},
+		{
+			name:    "it should return nil error with normalized contacts, when no error is returned",
+			inputID: "g-xyz",
+			inputPayload: payload.UpdateGroupContacts{
+				Phone:     "(0352) 481234",
+				WhatsApp:  "62 812-3456-7890",
+				Email:     "reog@example.com",
+				Website:   "https://reogsingomudho.id",
+				Instagram: "@reog.singomudho",
+				YouTube:   "https://www.youtube.com/@ReogSingoMudho",
+				TikTok:    "https://www.tiktok.com/@reogsingomudho/",
+			},
+			expectedError: nil,
+			mockBehaviours: func() {
+				mockGroupRepo.On(
+					"UpdateContacts",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					"g-xyz",
+					3,
+					entity.GroupContacts{
+						Phone:     "+62352481234",
+						WhatsApp:  "+6281234567890",
+						Email:     "reog@example.com",
+						Website:   "https://reogsingomudho.id",
+						Instagram: "reog.singomudho",
+						YouTube:   "ReogSingoMudho",
+						TikTok:    "reogsingomudho",
+					},
+				).Return(
+					func(ctx context.Context, id string, version int, contacts entity.GroupContacts) error {
+						return nil
+					},
+				).Once()
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.mockBehaviours()
+			gotErr := groupService.UpdateContacts(context.Background(), testCase.inputID, 3, testCase.inputPayload)
+
+			if testCase.expectedError != nil {
+				assert.ErrorIs(t, gotErr, testCase.expectedError)
+			} else {
+				assert.NoError(t, gotErr)
+			}
+		})
+	}
+
+	mockGroupRepo.AssertExpectations(t)
+}
+
+func TestDelete(t *testing.T) {
+	mockGroupRepo := &mgr.GroupRepository{}
+	mockVillageRepo := &mvr.VillageRepository{}
+	mockIDGen := &mig.IDGenerator{}
+	mockQRGen := &mqg.QRCodeGenerator{}
+	mockScanLinkGen := &mqg.ScanLinkGenerator{}
+	mockCodeSigner := &mqg.CodeSigner{}
+	mockRegistrationNumberGen := &mig.RegistrationNumberGenerator{}
+	mockCertificateGen := &mig.CertificateGenerator{}
+
+	var groupService GroupService = NewGroupServiceImpl(
+		mockGroupRepo,
+		mockVillageRepo,
+		mockIDGen,
+		mockQRGen,
+		mockScanLinkGen,
+		mockCodeSigner,
+		mockRegistrationNumberGen,
+		mockCertificateGen,
+	)
+
+	testCases := []struct {
+		name           string
+		inputID        string
+		inputPayload   payload.DeleteGroup
+		expectedError  error
+		mockBehaviours func()
+	}{
+		{
+			name:           "it should return service.ErrInvalidPayload error, when the show schedule action is unknown",
+			inputID:        "g-xyz",
+			inputPayload:   payload.DeleteGroup{FutureShows: "keep"},
+			expectedError:  service.ErrInvalidPayload,
+			mockBehaviours: func() {},
+		},
+		{
+			name:          "it should return service.ErrRepository error, when group repository return an error",
+			inputID:       "g-xyz",
+			expectedError: service.ErrRepository,
+			mockBehaviours: func() {
+				mockGroupRepo.On(
+					"Delete",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					1,
+					mock.AnythingOfType(fmt.Sprintf("%T", group.DeleteOptions{})),
+				).Return(
+					func(ctx context.Context, id string, version int, options group.DeleteOptions) error {
+						return repository.ErrDatabase
+					},
+				).Once()
+			},
+		},
+		{
+			name:          "it should return service.ErrDataNotFound error, when group repository return an error",
+			inputID:       "g-xyz",
+			expectedError: service.ErrDataNotFound,
+			mockBehaviours: func() {
+				mockGroupRepo.On(
+					"Delete",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					1,
+					mock.AnythingOfType(fmt.Sprintf("%T", group.DeleteOptions{})),
+				).Return(
+					func(ctx context.Context, id string, version int, options group.DeleteOptions) error {
+						return repository.ErrRecordNotFound
+					},
+				).Once()
+			},
+		},
+		{
+			name:          "it should return service.ErrVersionMismatch error, when the group has been updated since the given version",
+			inputID:       "g-xyz",
+			expectedError: service.ErrVersionMismatch,
+			mockBehaviours: func() {
+				mockGroupRepo.On(
+					"Delete",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					1,
+					mock.AnythingOfType(fmt.Sprintf("%T", group.DeleteOptions{})),
+				).Return(
+					func(ctx context.Context, id string, version int, options group.DeleteOptions) error {
+						return repository.ErrRecordModified
+					},
+				).Once()
+			},
+		},
+		{
+			name:          "it should return service.ErrGroupHasShows error, when show schedules block the deletion",
+			inputID:       "g-xyz",
+			inputPayload:  payload.DeleteGroup{FutureShows: "block"},
+			expectedError: service.ErrGroupHasShows,
+			mockBehaviours: func() {
+				mockGroupRepo.On(
+					"Delete",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					"g-xyz",
+					1,
+					mock.MatchedBy(func(options group.DeleteOptions) bool {
+						return options.FutureShows == group.ShowScheduleBlock && options.PastShows == group.ShowScheduleCascade
+					}),
+				).Return(
+					func(ctx context.Context, id string, version int, options group.DeleteOptions) error {
+						return repository.ErrRecordReferenced
+					},
+				).Once()
+			},
+		},
+		{
+			name:          "it should return nil error, when no error is returned",
+			inputID:       "g-xyz",
+			inputPayload:  payload.DeleteGroup{PastShows: "detach"},
+			expectedError: nil,
+			mockBehaviours: func() {
+				mockGroupRepo.On(
+					"Delete",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					"g-xyz",
+					1,
+					mock.MatchedBy(func(options group.DeleteOptions) bool {
+						return options.FutureShows == group.ShowScheduleCascade && options.PastShows == group.ShowScheduleDetach
+					}),
+				).Return(
+					func(ctx context.Context, id string, version int, options group.DeleteOptions) error {
+						return nil
+					},
+				).Once()
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.mockBehaviours()
+			gotErr := groupService.Delete(context.Background(), testCase.inputID, 1, testCase.inputPayload)
+
+			if testCase.expectedError != nil {
+				assert.ErrorIs(t, gotErr, testCase.expectedError)
+			} else {
+				assert.NoError(t, gotErr)
+			}
+		})
+	}
+}
+
+func TestPreviewDelete(t *testing.T) {
+	mockGroupRepo := &mgr.GroupRepository{}
+	mockVillageRepo := &mvr.VillageRepository{}
+	mockIDGen := &mig.IDGenerator{}
+	mockQRGen := &mqg.QRCodeGenerator{}
+	mockScanLinkGen := &mqg.ScanLinkGenerator{}
+	mockCodeSigner := &mqg.CodeSigner{}
+	mockRegistrationNumberGen := &mig.RegistrationNumberGenerator{}
+	mockCertificateGen := &mig.CertificateGenerator{}
+
+	var groupService GroupService = NewGroupServiceImpl(
+		mockGroupRepo,
+		mockVillageRepo,
+		mockIDGen,
+		mockQRGen,
+		mockScanLinkGen,
+		mockCodeSigner,
+		mockRegistrationNumberGen,
+		mockCertificateGen,
+	)
+
+	dummyImpact := group.DeletionImpact{
+		AddressIDs:            []string{"g-xyz"},
+		PropertyIDs:           []string{"p-Ay8LmNI"},
+		MemberIDs:             []string{"m-1a2B3c4", "m-5d6E7f8"},
+		FutureShowScheduleIDs: []string{"s-EuKgD1O"},
+		PastShowScheduleIDs:   []string{"s-aBcdEfG", "s-HiJklMn"},
+	}
+
+	testCases := []struct {
+		name             string
+		inputPayload     payload.DeleteGroup
+		expectedResponse response.GroupDeletion
+		expectedError    error
+		mockBehaviours   func()
+	}{
+		{
+			name:           "it should return service.ErrInvalidPayload error, when the show schedule action is unknown",
+			inputPayload:   payload.DeleteGroup{DryRun: true, PastShows: "archive"},
+			expectedError:  service.ErrInvalidPayload,
+			mockBehaviours: func() {},
+		},
+		{
+			name:          "it should return service.ErrDataNotFound error, when the group is not found",
+			inputPayload:  payload.DeleteGroup{DryRun: true},
+			expectedError: service.ErrDataNotFound,
+			mockBehaviours: func() {
+				mockGroupRepo.On(
+					"FindDeletionImpact",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					"g-xyz",
+					mock.AnythingOfType(fmt.Sprintf("%T", time.Now())),
+				).Return(
+					func(ctx context.Context, id string, now time.Time) group.DeletionImpact {
+						return group.DeletionImpact{}
+					},
+					func(ctx context.Context, id string, now time.Time) error {
+						return repository.ErrRecordNotFound
+					},
+				).Once()
+			},
+		},
+		{
+			name:         "it should return every show schedule as deleted, when the deletion cascades by default",
+			inputPayload: payload.DeleteGroup{DryRun: true},
+			expectedResponse: response.GroupDeletion{
+				GroupID:               "g-xyz",
+				Deletable:             true,
+				Addresses:             []string{"g-xyz"},
+				Properties:            []string{"p-Ay8LmNI"},
+				Members:               []string{"m-1a2B3c4", "m-5d6E7f8"},
+				Achievements:          []string{},
+				DeletedShowSchedules:  []string{"s-EuKgD1O", "s-aBcdEfG", "s-HiJklMn"},
+				DetachedShowSchedules: []string{},
+				BlockingShowSchedules: []string{},
+			},
+			mockBehaviours: func() {
+				mockGroupRepo.On(
+					"FindDeletionImpact",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					"g-xyz",
+					mock.AnythingOfType(fmt.Sprintf("%T", time.Now())),
+				).Return(
+					func(ctx context.Context, id string, now time.Time) group.DeletionImpact {
+						return dummyImpact
+					},
+					func(ctx context.Context, id string, now time.Time) error {
+						return nil
+					},
+				).Once()
+			},
+		},
+		{
+			name:         "it should report the blocking and detached show schedules, when the group is not deletable",
+			inputPayload: payload.DeleteGroup{DryRun: true, FutureShows: "block", PastShows: "detach"},
+			expectedResponse: response.GroupDeletion{
+				GroupID:               "g-xyz",
+				Deletable:             false,
+				Addresses:             []string{"g-xyz"},
+				Properties:            []string{"p-Ay8LmNI"},
+				Members:               []string{"m-1a2B3c4", "m-5d6E7f8"},
+				Achievements:          []string{},
+				DeletedShowSchedules:  []string{},
+				DetachedShowSchedules: []string{"s-aBcdEfG", "s-HiJklMn"},
+				BlockingShowSchedules: []string{"s-EuKgD1O"},
+			},
+			mockBehaviours: func() {
+				mockGroupRepo.On(
+					"FindDeletionImpact",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					"g-xyz",
+					mock.AnythingOfType(fmt.Sprintf("%T", time.Now())),
+				).Return(
+					func(ctx context.Context, id string, now time.Time) group.DeletionImpact {
+						return dummyImpact
+					},
+					func(ctx context.Context, id string, now time.Time) error {
+						return nil
+					},
+				).Once()
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.mockBehaviours()
+			gotResponse, gotErr := groupService.PreviewDelete(context.Background(), "g-xyz", testCase.inputPayload)
+
+			if testCase.expectedError != nil {
+				assert.ErrorIs(t, gotErr, testCase.expectedError)
+			} else {
+				assert.NoError(t, gotErr)
+				assert.Equal(t, testCase.expectedResponse, gotResponse)
+			}
+		})
+	}
+}
+
+func TestGenerateQRCode(t *testing.T) {
+	mockGroupRepo := &mgr.GroupRepository{}
+	mockVillageRepo := &mvr.VillageRepository{}
+	mockIDGen := &mig.IDGenerator{}
+	mockQRGen := &mqg.QRCodeGenerator{}
+	mockScanLinkGen := &mqg.ScanLinkGenerator{}
+	mockCodeSigner := &mqg.CodeSigner{}
+	mockRegistrationNumberGen := &mig.RegistrationNumberGenerator{}
+	mockCertificateGen := &mig.CertificateGenerator{}
+
+	var groupService GroupService = NewGroupServiceImpl(
+		mockGroupRepo,
+		mockVillageRepo,
+		mockIDGen,
+		mockQRGen,
+		mockScanLinkGen,
+		mockCodeSigner,
+		mockRegistrationNumberGen,
+		mockCertificateGen,
+	)
+
+	mockScanLinkGen.On("GenerateScanLink", mock.AnythingOfType(fmt.Sprintf("%T", ""))).Return(
+		func(code string) string {
+			return "https://reog.example.com/api/v1/scan/" + code
+		},
+	)
+
+	testCases := []struct {
+		name           string
+		inputID        string
+		expectedFile   []byte
+		expectedError  error
+		mockBehaviours func()
+	}{
+		{
+			name:          "it should return service.ErrRepository error, when group repository return an error",
+			inputID:       "g-xyz",
+			expectedFile:  []byte{},
+			expectedError: service.ErrRepository,
+			mockBehaviours: func() {
+				mockGroupRepo.On(
+					"FindByID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, id string) entity.Group {
+						return entity.Group{}
+					},
+					func(ctx context.Context, id string) error {
+						return repository.ErrDatabase
+					},
+				).Once()
+			},
+		},
+		{
+			name:          "it should return service.ErrDataNotFound error, when group repository return an error",
+			inputID:       "g-xyz",
+			expectedFile:  []byte{},
+			expectedError: service.ErrDataNotFound,
+			mockBehaviours: func() {
+				mockGroupRepo.On(
+					"FindByID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, id string) entity.Group {
+						return entity.Group{}
+					},
+					func(ctx context.Context, id string) error {
+						return repository.ErrRecordNotFound
+					},
+				).Once()
+			},
+		},
+		{
+			name:          "it should return service.ErrRepository error, when code signer return an error",
+			inputID:       "g-xyz",
+			expectedFile:  []byte{},
+			expectedError: service.ErrRepository,
+			mockBehaviours: func() {
+				mockGroupRepo.On(
+					"FindByID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, id string) entity.Group {
+						return entity.Group{}
+					},
+					func(ctx context.Context, id string) error {
+						return nil
+					},
+				).Once()
+
+				mockCodeSigner.On("SignCode", qrsign.TypeGroup, "g-xyz", "g-xyz").Return(
+					func(codeType string, id string, groupID string) string {
+						return ""
+					},
+					func(codeType string, id string, groupID string) error {
+						return errors.New("error sign code")
+					},
+				).Once()
+			},
+		},
+		{
+			name:          "it should return service.ErrRepository error, when QR Code Generator return an error",
+			inputID:       "g-xyz",
+			expectedFile:  []byte{},
+			expectedError: service.ErrRepository,
+			mockBehaviours: func() {
+				mockGroupRepo.On(
+					"FindByID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, id string) entity.Group {
+						return entity.Group{}
+					},
+					func(ctx context.Context, id string) error {
+						return nil
+					},
+				).Once()
+
+				mockCodeSigner.On("SignCode", qrsign.TypeGroup, "g-xyz", "g-xyz").Return(
+					func(codeType string, id string, groupID string) string {
+						return "k1.group.g-xyz.g-xyz.20221018.c2lnbmF0dXJl"
+					},
+					func(codeType string, id string, groupID string) error {
+						return nil
+					},
+				).Once()
+
+				mockQRGen.On(
+					"GenerateQRCode",
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", qrcode.Medium)),
+					mock.AnythingOfType(fmt.Sprintf("%T", 2048)),
+				).Return(
+					func(id string, level qrcode.RecoveryLevel, size int) []byte {
+						return []byte{}
+					},
+					func(id string, level qrcode.RecoveryLevel, size int) error {
+						return errors.New("error generate qrcode")
+					},
+				).Once()
+			},
+		},
+		{
+			name:          "it should return a valid file, when no error is returned",
+			inputID:       "g-xyz",
+			expectedFile:  []byte{1},
+			expectedError: nil,
+			mockBehaviours: func() {
+				mockGroupRepo.On(
+					"FindByID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, id string) entity.Group {
+						return entity.Group{
+							ID:     "g-Nzo",
+							Name:   "Paguyuban Reog",
+							Leader: "Erik Rio Setiawan",
+							Address: entity.Address{
+								ID:           "g-Nzo",
+								Address:      "RT 05 RW 01 Dukuh Tengah",
+								VillageID:    "3502030007",
+								VillageName:  "Pager",
+								DistrictID:   "3502030",
+								DistrictName: "Bungkal",
+								RegencyID:    "3502",
+								RegencyName:  "Kabupaten Ponorogo",
+								ProvinceID:   "35",
+								ProvinceName: "Jawa Timur",
+							},
+							Properties: []entity.Property{
+								{
+									ID:          "p-YIhpPgp",
+									Name:        "Dadak Merak",
+									Description: "Ini adalah deskripsi dari dadak merak",
+									Amount:      1,
+								},
+							},
+						}
+					},
+					func(ctx context.Context, id string) error {
+						return nil
+					},
+				).Once()
+
+				mockCodeSigner.On("SignCode", qrsign.TypeGroup, "g-xyz", "g-xyz").Return(
+					func(codeType string, id string, groupID string) string {
+						return "k1.group.g-xyz.g-xyz.20221018.c2lnbmF0dXJl"
+					},
+					func(codeType string, id string, groupID string) error {
+						return nil
+					},
+				).Once()
+
+				mockQRGen.On(
+					"GenerateQRCode",
+					"https://reog.example.com/api/v1/scan/k1.group.g-xyz.g-xyz.20221018.c2lnbmF0dXJl",
+					mock.AnythingOfType(fmt.Sprintf("%T", qrcode.Medium)),
+					mock.AnythingOfType(fmt.Sprintf("%T", 2048)),
+				).Return(
+					func(id string, level qrcode.RecoveryLevel, size int) []byte {
+						return []byte{1}
+					},
+					func(id string, level qrcode.RecoveryLevel, size int) error {
+						return nil
+					},
+				).Once()
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.mockBehaviours()
+
+			gotFile, gotErr := groupService.GenerateQRCode(context.Background(), testCase.inputID)
+
+			if testCase.expectedError != nil {
+				assert.ErrorIs(t, gotErr, testCase.expectedError)
+			} else {
+				assert.NoError(t, gotErr)
+				assert.ElementsMatch(t, testCase.expectedFile, gotFile)
+			}
+		})
+	}
+}
+
+func TestGenerateVCard(t *testing.T) {
+	mockGroupRepo := &mgr.GroupRepository{}
+	mockVillageRepo := &mvr.VillageRepository{}
+	mockIDGen := &mig.IDGenerator{}
+	mockQRGen := &mqg.QRCodeGenerator{}
+	mockScanLinkGen := &mqg.ScanLinkGenerator{}
+	mockCodeSigner := &mqg.CodeSigner{}
+	mockRegistrationNumberGen := &mig.RegistrationNumberGenerator{}
+	mockCertificateGen := &mig.CertificateGenerator{}
+
+	var groupService GroupService = NewGroupServiceImpl(
+		mockGroupRepo,
+		mockVillageRepo,
+		mockIDGen,
+		mockQRGen,
+		mockScanLinkGen,
+		mockCodeSigner,
+		mockRegistrationNumberGen,
+		mockCertificateGen,
+	)
+
+	testCases := []struct {
+		name           string
+		inputID        string
+		expectedFile   string
+		expectedError  error
+		mockBehaviours func()
+	}{
+		{
+			name:          "it should return service.ErrDataNotFound error, when group repository return a not found error",
+			inputID:       "g-xyz",
+			expectedError: service.ErrDataNotFound,
+			mockBehaviours: func() {
+				mockGroupRepo.On(
+					"FindByID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					"g-xyz",
+				).Return(
+					func(ctx context.Context, id string) entity.Group {
+						return entity.Group{}
+					},
+					func(ctx context.Context, id string) error {
+						return repository.ErrRecordNotFound
+					},
+				).Once()
+			},
+		},
+		{
+			name:    "it should return the leader vCard, when no error is returned",
+			inputID: "g-xyz",
+			expectedFile: "BEGIN:VCARD\r\n" +
+				"VERSION:3.0\r\n" +
+				"FN:Erik Rio Setiawan\r\n" +
+				"N:Erik Rio Setiawan;;;;\r\n" +
+				"ORG:Paguyuban Reog Singo Mudho\r\n" +
+				"TITLE:Leader\r\n" +
+				"TEL;TYPE=CELL:+6281234567890\r\n" +
+				"ADR;TYPE=WORK:;;RT 01\\, RW 01\\; Dukuh Bibis\\, sebelah timur Masjid Al-Ikhla\r\n" +
+				" s\\, depan Balai Desa;Bibis;Kabupaten Ponorogo;;Indonesia\r\n" +
+				"X-SOCIALPROFILE;TYPE=instagram:https://www.instagram.com/reog.singomudho\r\n" +
+				"X-SOCIALPROFILE;TYPE=tiktok:https://www.tiktok.com/@reogsingomudho\r\n" +
+				"END:VCARD\r\n",
+			expectedError: nil,
+			mockBehaviours: func() {
+				mockGroupRepo.On(
+					"FindByID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					"g-xyz",
+				).Return(
+					func(ctx context.Context, id string) entity.Group {
+						return entity.Group{
+							ID:     "g-xyz",
+							Name:   "Paguyuban Reog Singo Mudho",
+							Leader: "Erik Rio Setiawan",
+							Address: entity.Address{
+								Address:     "RT 01, RW 01; Dukuh Bibis, sebelah timur Masjid Al-Ikhlas, depan Balai Desa",
+								VillageName: "Bibis",
+								RegencyName: "Kabupaten Ponorogo",
+							},
+							Contacts: entity.GroupContacts{
+								WhatsApp:  "+6281234567890",
+								Instagram: "reog.singomudho",
+								TikTok:    "reogsingomudho",
+							},
+						}
+					},
+					func(ctx context.Context, id string) error {
+						return nil
+					},
+				).Once()
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.mockBehaviours()
+			gotFile, gotErr := groupService.GenerateVCard(context.Background(), testCase.inputID)
+
+			if testCase.expectedError != nil {
+				assert.ErrorIs(t, gotErr, testCase.expectedError)
+			} else {
+				assert.NoError(t, gotErr)
+				assert.Equal(t, testCase.expectedFile, string(gotFile))
+			}
+		})
+	}
+}
+
+func TestAssignRegistrationNumbers(t *testing.T) {
+	mockGroupRepo := &mgr.GroupRepository{}
+	mockVillageRepo := &mvr.VillageRepository{}
+	mockIDGen := &mig.IDGenerator{}
+	mockQRGen := &mqg.QRCodeGenerator{}
+	mockScanLinkGen := &mqg.ScanLinkGenerator{}
+	mockCodeSigner := &mqg.CodeSigner{}
+	mockRegistrationNumberGen := &mig.RegistrationNumberGenerator{}
+	mockCertificateGen := &mig.CertificateGenerator{}
+
+	var groupService GroupService = NewGroupServiceImpl(
+		mockGroupRepo,
+		mockVillageRepo,
+		mockIDGen,
+		mockQRGen,
+		mockScanLinkGen,
+		mockCodeSigner,
+		mockRegistrationNumberGen,
+		mockCertificateGen,
+	)
+
+	mockRegistrationNumberGen.On(
+		"GenerateScope",
+		mock.AnythingOfType(fmt.Sprintf("%T", entity.Address{})),
+		mock.AnythingOfType(fmt.Sprintf("%T", 0)),
+	).Return(
+		func(address entity.Address, year int) string {
+			return fmt.Sprintf("%s/{number}/%d", address.DistrictID, year)
+		},
+	)
+
+	mockRegistrationNumberGen.On(
+		"GenerateRegistrationNumber",
+		mock.AnythingOfType(fmt.Sprintf("%T", entity.Address{})),
+		mock.AnythingOfType(fmt.Sprintf("%T", 0)),
+		mock.AnythingOfType(fmt.Sprintf("%T", 0)),
+	).Return(
+		func(address entity.Address, year int, number int) string {
+			return fmt.Sprintf("%s/%04d/%d", address.DistrictID, number, year)
+		},
+	)
+
+	testCases := []struct {
+		name           string
+		expectedError  error
+		mockBehaviours func()
+	}{
+		{
+			name:          "it should return service.ErrRepository error, when group repository return an error",
+			expectedError: service.ErrRepository,
+			mockBehaviours: func() {
+				mockGroupRepo.On(
+					"FindUnregistered",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+				).Return(
+					func(ctx context.Context) []entity.Group {
+						return nil
+					},
+					func(ctx context.Context) error {
+						return repository.ErrDatabase
+					},
+				).Once()
+			},
+		},
+		{
+			name:          "it should number the groups by the year they were created in, when no error is returned",
+			expectedError: nil,
+			mockBehaviours: func() {
+				mockGroupRepo.On(
+					"FindUnregistered",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+				).Return(
+					func(ctx context.Context) []entity.Group {
+						return []entity.Group{
+							{
+								ID:        "g-Nzo",
+								Address:   entity.Address{DistrictID: "3502030"},
+								CreatedAt: time.Date(2021, time.March, 1, 0, 0, 0, 0, time.UTC),
+							},
+							{
+								ID:        "g-xyz",
+								Address:   entity.Address{DistrictID: "3502030"},
+								CreatedAt: time.Date(2022, time.May, 9, 0, 0, 0, 0, time.UTC),
+							},
+						}
+					},
+					func(ctx context.Context) error {
+						return nil
+					},
+				).Once()
+
+				for _, scope := range []string{"3502030/{number}/2021", "3502030/{number}/2022"} {
+					mockGroupRepo.On(
+						"NextRegistrationNumber",
+						mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+						scope,
+					).Return(
+						func(ctx context.Context, scope string) int {
+							return 1
+						},
+						func(ctx context.Context, scope string) error {
+							return nil
+						},
+					).Once()
+				}
+
+				mockGroupRepo.On(
+					"Update",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					"g-Nzo",
+					mock.AnythingOfType(fmt.Sprintf("%T", 0)),
+					entity.Group{RegistrationNumber: "3502030/0001/2021"},
+				).Return(
+					func(ctx context.Context, id string, version int, group entity.Group) error {
+						return nil
+					},
+				).Once()
+
+				mockGroupRepo.On(
+					"Update",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					"g-xyz",
+					mock.AnythingOfType(fmt.Sprintf("%T", 0)),
+					entity.Group{RegistrationNumber: "3502030/0001/2022"},
+				).Return(
+					func(ctx context.Context, id string, version int, group entity.Group) error {
+						return nil
+					},
+				).Once()
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.mockBehaviours()
+
+			gotErr := groupService.AssignRegistrationNumbers(context.Background())
+
+			if testCase.expectedError != nil {
+				assert.ErrorIs(t, gotErr, testCase.expectedError)
+			} else {
+				assert.NoError(t, gotErr)
+			}
+		})
+	}
+
+	mockGroupRepo.AssertExpectations(t)
+}
+
+func TestGenerateCertificate(t *testing.T) {
+	mockGroupRepo := &mgr.GroupRepository{}
+	mockVillageRepo := &mvr.VillageRepository{}
+	mockIDGen := &mig.IDGenerator{}
+	mockQRGen := &mqg.QRCodeGenerator{}
+	mockScanLinkGen := &mqg.ScanLinkGenerator{}
+	mockCodeSigner := &mqg.CodeSigner{}
+	mockRegistrationNumberGen := &mig.RegistrationNumberGenerator{}
+	mockCertificateGen := &mig.CertificateGenerator{}
+
+	var groupService GroupService = NewGroupServiceImpl(
+		mockGroupRepo,
+		mockVillageRepo,
+		mockIDGen,
+		mockQRGen,
+		mockScanLinkGen,
+		mockCodeSigner,
+		mockRegistrationNumberGen,
+		mockCertificateGen,
+	)
+
+	mockScanLinkGen.On("GenerateScanLink", mock.AnythingOfType(fmt.Sprintf("%T", ""))).Return(
+		func(code string) string {
+			return "https://reog.example.com/api/v1/scan/" + code
+		},
+	)
+
+	dummyGroup := entity.Group{
+		ID:                 "g-Nzo",
+		RegistrationNumber: "3502/3502030/0001/2022",
+		Name:               "Paguyuban Reog",
+		Leader:             "Erik Rio Setiawan",
+		Address: entity.Address{
+			ID:           "g-Nzo",
+			VillageName:  "Pager",
+			DistrictName: "Bungkal",
+			RegencyName:  "Kabupaten Ponorogo",
+			ProvinceName: "Jawa Timur",
+		},
+	}
+
+	onFindByID := func(group entity.Group, err error) {
+		mockGroupRepo.On(
+			"FindByID",
+			mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+			mock.AnythingOfType(fmt.Sprintf("%T", "")),
+		).Return(
+			func(ctx context.Context, id string) entity.Group {
+				return group
+			},
+			func(ctx context.Context, id string) error {
+				return err
+			},
+		).Once()
+	}
+
+	onSignCode := func(err error) {
+		mockCodeSigner.On("SignCode", qrsign.TypeGroup, "g-Nzo", "g-Nzo").Return(
+			func(codeType string, id string, groupID string) string {
+				if err != nil {
+					return ""
+				}
+				return "k1.group.g-Nzo.g-Nzo.20221018.c2lnbmF0dXJl"
+			},
+			func(codeType string, id string, groupID string) error {
+				return err
+			},
+		).Once()
+	}
+
+	onGenerateQRCode := func(err error) {
+		mockQRGen.On(
+			"GenerateQRCode",
+			"https://reog.example.com/api/v1/scan/k1.group.g-Nzo.g-Nzo.20221018.c2lnbmF0dXJl",
+			qrcode.Medium,
+			mock.AnythingOfType(fmt.Sprintf("%T", 0)),
+		).Return(
+			func(id string, level qrcode.RecoveryLevel, size int) []byte {
+				return []byte{1}
+			},
+			func(id string, level qrcode.RecoveryLevel, size int) error {
+				return err
+			},
+		).Once()
+	}
+
+	testCases := []struct {
+		name           string
+		expectedFile   []byte
+		expectedError  error
+		mockBehaviours func()
+	}{
+		{
+			name:          "it should return service.ErrDataNotFound error, when group repository return an error",
+			expectedError: service.ErrDataNotFound,
+			mockBehaviours: func() {
+				onFindByID(entity.Group{}, repository.ErrRecordNotFound)
+			},
+		},
+		{
+			name:          "it should return service.ErrRepository error, when code signer return an error",
+			expectedError: service.ErrRepository,
+			mockBehaviours: func() {
+				onFindByID(dummyGroup, nil)
+				onSignCode(errors.New("error sign code"))
+			},
+		},
+		{
+			name:          "it should return service.ErrRepository error, when QR Code Generator return an error",
+			expectedError: service.ErrRepository,
+			mockBehaviours: func() {
+				onFindByID(dummyGroup, nil)
+				onSignCode(nil)
+				onGenerateQRCode(errors.New("error generate qrcode"))
+			},
+		},
+		{
+			name:          "it should return service.ErrRepository error, when certificate generator return an error",
+			expectedError: service.ErrRepository,
+			mockBehaviours: func() {
+				onFindByID(dummyGroup, nil)
+				onSignCode(nil)
+				onGenerateQRCode(nil)
+
+				mockCertificateGen.On(
+					"GenerateCertificate",
+					mock.AnythingOfType(fmt.Sprintf("%T", entity.Group{})),
+					mock.AnythingOfType(fmt.Sprintf("%T", []byte{})),
+				).Return(
+					func(group entity.Group, qrCode []byte) []byte {
+						return nil
+					},
+					func(group entity.Group, qrCode []byte) error {
+						return errors.New("error generate certificate")
+					},
+				).Once()
+			},
+		},
+		{
+			name:          "it should return service.ErrNotRegistered error, when the group has no registration number yet",
+			expectedError: service.ErrNotRegistered,
+			mockBehaviours: func() {
+				unregistered := dummyGroup
+				unregistered.RegistrationNumber = ""
+				onFindByID(unregistered, nil)
+			},
+		},
+		{
+			name:          "it should return the certificate, when no error is returned",
+			expectedFile:  []byte("%PDF-1.3"),
+			expectedError: nil,
+			mockBehaviours: func() {
+				onFindByID(dummyGroup, nil)
+				onSignCode(nil)
+				onGenerateQRCode(nil)
+
+				mockCertificateGen.On(
+					"GenerateCertificate",
+					dummyGroup,
+					[]byte{1},
+				).Return(
+					func(group entity.Group, qrCode []byte) []byte {
+						return []byte("%PDF-1.3")
+					},
+					func(group entity.Group, qrCode []byte) error {
+						return nil
+					},
+				).Once()
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.mockBehaviours()
+
+			gotFile, gotErr := groupService.GenerateCertificate(context.Background(), "g-Nzo")
+
+			if testCase.expectedError != nil {
+				assert.ErrorIs(t, gotErr, testCase.expectedError)
+			} else {
+				assert.NoError(t, gotErr)
+				assert.Equal(t, testCase.expectedFile, gotFile)
+			}
+		})
+	}
+
+	mockGroupRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	mockGroupRepo.AssertNotCalled(t, "NextRegistrationNumber", mock.Anything, mock.Anything)
+}
+
+func TestGetDuplicates(t *testing.T) {
+	mockGroupRepo := &mgr.GroupRepository{}
+	mockVillageRepo := &mvr.VillageRepository{}
+	mockIDGen := &mig.IDGenerator{}
+	mockQRGen := &mqg.QRCodeGenerator{}
+	mockScanLinkGen := &mqg.ScanLinkGenerator{}
+	mockCodeSigner := &mqg.CodeSigner{}
+	mockRegistrationNumberGen := &mig.RegistrationNumberGenerator{}
+	mockCertificateGen := &mig.CertificateGenerator{}
+
+	var groupService GroupService = NewGroupServiceImpl(
+		mockGroupRepo,
+		mockVillageRepo,
+		mockIDGen,
+		mockQRGen,
+		mockScanLinkGen,
+		mockCodeSigner,
+		mockRegistrationNumberGen,
+		mockCertificateGen,
+	)
+
+	createdAt := time.Date(2022, time.June, 1, 9, 30, 0, 0, time.UTC)
+	pager := entity.Address{VillageID: "3502030007", VillageName: "Pager"}
+	bibis := entity.Address{VillageID: "3502030008", VillageName: "Bibis"}
+
+	testCases := []struct {
+		name               string
+		inputPayload       payload.GetDuplicateGroups
+		expectedDuplicates []response.DuplicateGroups
+		expectedError      error
+		mockBehaviours     func()
+	}{
+		{
+			name:           "it should return service.ErrInvalidPayload error, when min similarity is greater than 1",
+			inputPayload:   payload.GetDuplicateGroups{MinSimilarity: 1.5},
+			expectedError:  service.ErrInvalidPayload,
+			mockBehaviours: func() {},
+		},
+		{
+			name:          "it should return service.ErrRepository error, when group repository return an error",
+			expectedError: service.ErrRepository,
+			mockBehaviours: func() {
+				mockGroupRepo.On(
+					"FindAll",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", group.Filter{})),
+				).Return(
+					func(ctx context.Context, filter group.Filter) []entity.Group {
+						return nil
+					},
+					func(ctx context.Context, filter group.Filter) int64 {
+						return 0
+					},
+					func(ctx context.Context, filter group.Filter) error {
+						return repository.ErrDatabase
+					},
+				).Once()
+			},
+		},
+		{
+			name:         "it should return the groups spelled alike within the same village, when no error is returned",
+			inputPayload: payload.GetDuplicateGroups{DistrictID: "3502030"},
+			expectedDuplicates: []response.DuplicateGroups{
+				{
+					VillageID:   "3502030007",
+					VillageName: "Pager",
+					Groups: []response.DuplicateGroup{
+						{ID: "g-abc", Name: "Singo Barong", Leader: "Erik Rio", PropertyCount: 1, CreatedAt: createdAt.Format(time.RFC822)},
+						{ID: "g-def", Name: "Singo  Barong.", Leader: "erik rio", CreatedAt: createdAt.Format(time.RFC822)},
+					},
+					Similarity:       1,
+					NameSimilarity:   1,
+					LeaderSimilarity: 1,
+				},
+				{
+					VillageID:   "3502030007",
+					VillageName: "Pager",
+					Groups: []response.DuplicateGroup{
+						{ID: "g-abc", Name: "Singo Barong", Leader: "Erik Rio", PropertyCount: 1, CreatedAt: createdAt.Format(time.RFC822)},
+						{ID: "g-ghi", Name: "Singo Baron", Leader: "Erik Rio S", CreatedAt: createdAt.Format(time.RFC822)},
+					},
+					Similarity:       0.86,
+					NameSimilarity:   0.92,
+					LeaderSimilarity: 0.8,
+				},
+				{
+					VillageID:   "3502030007",
+					VillageName: "Pager",
+					Groups: []response.DuplicateGroup{
+						{ID: "g-def", Name: "Singo  Barong.", Leader: "erik rio", CreatedAt: createdAt.Format(time.RFC822)},
+						{ID: "g-ghi", Name: "Singo Baron", Leader: "Erik Rio S", CreatedAt: createdAt.Format(time.RFC822)},
+					},
+					Similarity:       0.86,
+					NameSimilarity:   0.92,
+					LeaderSimilarity: 0.8,
+				},
+			},
+			mockBehaviours: func() {
+				mockGroupRepo.On(
+					"FindAll",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					group.Filter{DistrictID: "3502030", SortBy: group.SortByCreatedAt},
+				).Return(
+					func(ctx context.Context, filter group.Filter) []entity.Group {
+						return []entity.Group{
+							{ID: "g-abc", Name: "Singo Barong", Leader: "Erik Rio", Address: pager, Properties: []entity.Property{{ID: "p-Ay8LmNI"}}, CreatedAt: createdAt},
+							{ID: "g-def", Name: "Singo  Barong.", Leader: "erik rio", Address: pager, CreatedAt: createdAt},
+							{ID: "g-ghi", Name: "Singo Baron", Leader: "Erik Rio S", Address: pager, CreatedAt: createdAt},
+							{ID: "g-jkl", Name: "Paguyuban Reog", Leader: "Sutrisno", Address: pager, CreatedAt: createdAt},
+							{ID: "g-mno", Name: "Singo Barong", Leader: "Erik Rio", Address: bibis, CreatedAt: createdAt},
+						}
+					},
+					func(ctx context.Context, filter group.Filter) int64 {
+						return 5
+					},
+					func(ctx context.Context, filter group.Filter) error {
+						return nil
+					},
+				).Once()
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.mockBehaviours()
+			gotDuplicates, gotErr := groupService.GetDuplicates(context.Background(), testCase.inputPayload)
+
+			if testCase.expectedError != nil {
+				assert.ErrorIs(t, gotErr, testCase.expectedError)
+			} else {
+				assert.NoError(t, gotErr)
+				assert.Equal(t, testCase.expectedDuplicates, gotDuplicates)
+			}
+		})
+	}
+}
+
+func TestMerge(t *testing.T) {
+	mockGroupRepo := &mgr.GroupRepository{}
+	mockVillageRepo := &mvr.VillageRepository{}
+	mockIDGen := &mig.IDGenerator{}
+	mockQRGen := &mqg.QRCodeGenerator{}
+	mockScanLinkGen := &mqg.ScanLinkGenerator{}
+	mockCodeSigner := &mqg.CodeSigner{}
+	mockRegistrationNumberGen := &mig.RegistrationNumberGenerator{}
+	mockCertificateGen := &mig.CertificateGenerator{}
+
+	var groupService GroupService = NewGroupServiceImpl(
+		mockGroupRepo,
+		mockVillageRepo,
+		mockIDGen,
+		mockQRGen,
+		mockScanLinkGen,
+		mockCodeSigner,
+		mockRegistrationNumberGen,
+		mockCertificateGen,
+	)
+
+	findGroup := func(id string, version int, err error) {
+		mockGroupRepo.On(
+			"FindByID",
+			mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+			id,
+		).Return(
+			func(ctx context.Context, id string) entity.Group {
+				return entity.Group{ID: id, Version: version}
+			},
+			func(ctx context.Context, id string) error {
+				return err
+			},
+		).Once()
+	}
+
+	testCases := []struct {
+		name           string
+		inputID        string
+		inputPayload   payload.MergeGroup
+		expectedError  error
+		mockBehaviours func()
+	}{
+		{
+			name:           "it should return service.ErrInvalidPayload error, when target ID is empty",
+			inputID:        "g-def",
+			expectedError:  service.ErrInvalidPayload,
+			mockBehaviours: func() {},
+		},
+		{
+			name:           "it should return service.ErrInvalidPayload error, when the group is merged into itself",
+			inputID:        "g-def",
+			inputPayload:   payload.MergeGroup{TargetID: "g-def"},
+			expectedError:  service.ErrInvalidPayload,
+			mockBehaviours: func() {},
+		},
+		{
+			name:          "it should return service.ErrDataNotFound error, when the group not exists",
+			inputID:       "g-def",
+			inputPayload:  payload.MergeGroup{TargetID: "g-abc"},
+			expectedError: service.ErrDataNotFound,
+			mockBehaviours: func() {
+				findGroup("g-def", 0, repository.ErrRecordNotFound)
+			},
+		},
+		{
+			name:          "it should return service.ErrDataNotFound error, when the target group not exists",
+			inputID:       "g-def",
+			inputPayload:  payload.MergeGroup{TargetID: "g-abc"},
+			expectedError: service.ErrDataNotFound,
+			mockBehaviours: func() {
+				findGroup("g-def", 3, nil)
+				findGroup("g-abc", 0, repository.ErrRecordNotFound)
+			},
+		},
+		{
+			name:          "it should return service.ErrVersionMismatch error, when the group has changed since the given version",
+			inputID:       "g-def",
+			inputPayload:  payload.MergeGroup{TargetID: "g-abc"},
+			expectedError: service.ErrVersionMismatch,
+			mockBehaviours: func() {
+				findGroup("g-def", 4, nil)
+			},
+		},
+		{
+			name:          "it should return service.ErrVersionMismatch error, when the target group has changed since the given version",
+			inputID:       "g-def",
+			inputPayload:  payload.MergeGroup{TargetID: "g-abc"},
+			expectedError: service.ErrVersionMismatch,
+			mockBehaviours: func() {
+				findGroup("g-def", 3, nil)
+				findGroup("g-abc", 6, nil)
+			},
+		},
+		{
+			name:          "it should return service.ErrRepository error, when group repository return an error",
+			inputID:       "g-def",
+			inputPayload:  payload.MergeGroup{TargetID: "g-abc"},
+			expectedError: service.ErrRepository,
+			mockBehaviours: func() {
+				findGroup("g-def", 3, nil)
+				findGroup("g-abc", 5, nil)
+				mockGroupRepo.On(
+					"Merge",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					"g-def",
+					3,
+					"g-abc",
+					5,
+				).Return(
+					func(ctx context.Context, sourceID string, sourceVersion int, targetID string, targetVersion int) error {
+						return repository.ErrDatabase
+					},
+				).Once()
+			},
+		},
+		{
+			name:          "it should return nil error, when no error is returned",
+			inputID:       "g-def",
+			inputPayload:  payload.MergeGroup{TargetID: "g-abc"},
+			expectedError: nil,
+			mockBehaviours: func() {
+				findGroup("g-def", 3, nil)
+				findGroup("g-abc", 5, nil)
+				mockGroupRepo.On(
+					"Merge",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					"g-def",
+					3,
+					"g-abc",
+					5,
+				).Return(
+					func(ctx context.Context, sourceID string, sourceVersion int, targetID string, targetVersion int) error {
+						return nil
+					},
+				).Once()
+			},
+		},
+	}
+
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
 			testCase.mockBehaviours()
-
-			gotGroup, gotErr := groupService.GetByID(context.Background(), testCase.inputID)
+			gotErr := groupService.Merge(context.Background(), testCase.inputID, 3, 5, testCase.inputPayload)
 
 			if testCase.expectedError != nil {
 				assert.ErrorIs(t, gotErr, testCase.expectedError)
 			} else {
 				assert.NoError(t, gotErr)
-				assert.Equal(t, testCase.expectedGroup, gotGroup)
 			}
+			mockGroupRepo.AssertExpectations(t)
 		})
 	}
 }
 
-func TestUpdate(t *testing.T) {
+func TestUpdateStatus(t *testing.T) {
 	mockGroupRepo := &mgr.GroupRepository{}
 	mockVillageRepo := &mvr.VillageRepository{}
 	mockIDGen := &mig.IDGenerator{}
 	mockQRGen := &mqg.QRCodeGenerator{}
+	mockScanLinkGen := &mqg.ScanLinkGenerator{}
+	mockCodeSigner := &mqg.CodeSigner{}
+	mockRegistrationNumberGen := &mig.RegistrationNumberGenerator{}
+	mockCertificateGen := &mig.CertificateGenerator{}
 
 	var groupService GroupService = NewGroupServiceImpl(
 		mockGroupRepo,
 		mockVillageRepo,
 		mockIDGen,
 		mockQRGen,
+		mockScanLinkGen,
+		mockCodeSigner,
+		mockRegistrationNumberGen,
+		mockCertificateGen,
 	)
 
+	onFindByID := func(group entity.Group, err error) {
+		mockGroupRepo.On(
+			"FindByID",
+			mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+			mock.AnythingOfType(fmt.Sprintf("%T", "")),
+		).Return(
+			func(ctx context.Context, id string) entity.Group {
+				return group
+			},
+			func(ctx context.Context, id string) error {
+				return err
+			},
+		).Once()
+	}
+
+	onGenerateStatusTransitionID := func(err error) {
+		mockIDGen.On("GenerateStatusTransitionID").Return(
+			func() string {
+				return "t-Ay8LmNI"
+			},
+			func() error {
+				return err
+			},
+		).Once()
+	}
+
+	activeGroup := entity.Group{ID: "g-xyz", Status: entity.GroupStatusActive, Version: 3}
+
 	testCases := []struct {
-		name             string
-		inputID          string
-		inputUpdateGroup payload.UpdateGroup
-		expectedError    error
-		mockBehaviours   func()
+		name           string
+		inputPayload   payload.UpdateGroupStatus
+		expectedError  error
+		mockBehaviours func()
 	}{
 		{
-			name:    "it should return service.ErrInvalidPayload error, when payload is invalid",
-			inputID: "g-xyz",
-			inputUpdateGroup: payload.UpdateGroup{
-				Name:   "Paguyuban Reog",
-				Leader: "E",
-			},
+			name:           "it should return service.ErrInvalidPayload error, when status is unknown",
+			inputPayload:   payload.UpdateGroupStatus{Status: "retired", Reason: "No longer performing"},
 			expectedError:  service.ErrInvalidPayload,
 			mockBehaviours: func() {},
 		},
 		{
-			name:    "it should return service.ErrDataNotFound error, when repository return an error",
-			inputID: "g-xyz",
-			inputUpdateGroup: payload.UpdateGroup{
-				Name:   "Paguyuban Reog",
-				Leader: "Erik R",
-			},
+			name:           "it should return service.ErrInvalidPayload error, when reason is empty",
+			inputPayload:   payload.UpdateGroupStatus{Status: entity.GroupStatusDormant},
+			expectedError:  service.ErrInvalidPayload,
+			mockBehaviours: func() {},
+		},
+		{
+			name:          "it should return service.ErrDataNotFound error, when group repository return an error",
+			inputPayload:  payload.UpdateGroupStatus{Status: entity.GroupStatusDormant, Reason: "No show this year"},
 			expectedError: service.ErrDataNotFound,
 			mockBehaviours: func() {
-				mockGroupRepo.On(
-					"Update",
-					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
-					mock.AnythingOfType(fmt.Sprintf("%T", "")),
-					mock.AnythingOfType(fmt.Sprintf("%T", entity.Group{})),
-				).Return(
-					func(ctx context.Context, id string, group entity.Group) error {
-						return repository.ErrRecordNotFound
-					},
-				).Once()
+				onFindByID(entity.Group{}, repository.ErrRecordNotFound)
 			},
 		},
 		{
-			name:    "it should return service.ErrRepository error, when group repository return an error",
-			inputID: "g-xyz",
-			inputUpdateGroup: payload.UpdateGroup{
-				Name:   "Paguyuban Reog",
-				Leader: "Erik R",
+			name:          "it should return service.ErrVersionMismatch error, when group has changed since the given version",
+			inputPayload:  payload.UpdateGroupStatus{Status: entity.GroupStatusDormant, Reason: "No show this year"},
+			expectedError: service.ErrVersionMismatch,
+			mockBehaviours: func() {
+				onFindByID(entity.Group{ID: "g-xyz", Status: entity.GroupStatusActive, Version: 4}, nil)
+			},
+		},
+		{
+			name:          "it should return service.ErrStatusUnchanged error, when group already has the given status",
+			inputPayload:  payload.UpdateGroupStatus{Status: entity.GroupStatusActive, Reason: "Back on stage"},
+			expectedError: service.ErrStatusUnchanged,
+			mockBehaviours: func() {
+				onFindByID(activeGroup, nil)
+			},
+		},
+		{
+			name:          "it should return service.ErrRepository error, when id generator return an error",
+			inputPayload:  payload.UpdateGroupStatus{Status: entity.GroupStatusDormant, Reason: "No show this year"},
+			expectedError: service.ErrRepository,
+			mockBehaviours: func() {
+				onFindByID(activeGroup, nil)
+				onGenerateStatusTransitionID(errors.New("error generate status transition id"))
 			},
+		},
+		{
+			name:          "it should return service.ErrRepository error, when group repository fails to update the status",
+			inputPayload:  payload.UpdateGroupStatus{Status: entity.GroupStatusDormant, Reason: "No show this year"},
 			expectedError: service.ErrRepository,
 			mockBehaviours: func() {
+				onFindByID(activeGroup, nil)
+				onGenerateStatusTransitionID(nil)
+
 				mockGroupRepo.On(
-					"Update",
+					"UpdateStatus",
 					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
-					mock.AnythingOfType(fmt.Sprintf("%T", "")),
-					mock.AnythingOfType(fmt.Sprintf("%T", entity.Group{})),
+					3,
+					mock.AnythingOfType(fmt.Sprintf("%T", entity.GroupStatusTransition{})),
 				).Return(
-					func(ctx context.Context, id string, group entity.Group) error {
+					func(ctx context.Context, version int, transition entity.GroupStatusTransition) error {
 						return repository.ErrDatabase
 					},
 				).Once()
 			},
 		},
 		{
-			name:    "it should return nil error, when no error is returned",
-			inputID: "g-xyz",
-			inputUpdateGroup: payload.UpdateGroup{
-				Name:   "Paguyuban Reog",
-				Leader: "Erik R",
-			},
+			name:          "it should record the transition with the acting admin, when no error is returned",
+			inputPayload:  payload.UpdateGroupStatus{Status: entity.GroupStatusSuspended, Reason: " Unpaid membership fee "},
 			expectedError: nil,
 			mockBehaviours: func() {
+				onFindByID(activeGroup, nil)
+				onGenerateStatusTransitionID(nil)
+
 				mockGroupRepo.On(
-					"Update",
+					"UpdateStatus",
 					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
-					mock.AnythingOfType(fmt.Sprintf("%T", "")),
-					mock.AnythingOfType(fmt.Sprintf("%T", entity.Group{})),
+					3,
+					entity.GroupStatusTransition{
+						ID:            "t-Ay8LmNI",
+						GroupID:       "g-xyz",
+						FromStatus:    entity.GroupStatusActive,
+						ToStatus:      entity.GroupStatusSuspended,
+						Reason:        "Unpaid membership fee",
+						AdminID:       "a-XU",
+						AdminUsername: "erikrios",
+					},
 				).Return(
-					func(ctx context.Context, id string, group entity.Group) error {
+					func(ctx context.Context, version int, transition entity.GroupStatusTransition) error {
 						return nil
 					},
 				).Once()
@@ -561,7 +3404,8 @@ func TestUpdate(t *testing.T) {
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
 			testCase.mockBehaviours()
-			gotErr := groupService.Update(context.Background(), testCase.inputID, testCase.inputUpdateGroup)
+
+			gotErr := groupService.UpdateStatus(context.Background(), "g-xyz", 3, "a-XU", "erikrios", testCase.inputPayload)
 
 			if testCase.expectedError != nil {
 				assert.ErrorIs(t, gotErr, testCase.expectedError)
@@ -572,68 +3416,114 @@ func TestUpdate(t *testing.T) {
 	}
 }
 
-func TestDelete(t *testing.T) {
+func TestGetStatusHistory(t *testing.T) {
 	mockGroupRepo := &mgr.GroupRepository{}
 	mockVillageRepo := &mvr.VillageRepository{}
 	mockIDGen := &mig.IDGenerator{}
 	mockQRGen := &mqg.QRCodeGenerator{}
+	mockScanLinkGen := &mqg.ScanLinkGenerator{}
+	mockCodeSigner := &mqg.CodeSigner{}
+	mockRegistrationNumberGen := &mig.RegistrationNumberGenerator{}
+	mockCertificateGen := &mig.CertificateGenerator{}
 
 	var groupService GroupService = NewGroupServiceImpl(
 		mockGroupRepo,
 		mockVillageRepo,
 		mockIDGen,
 		mockQRGen,
+		mockScanLinkGen,
+		mockCodeSigner,
+		mockRegistrationNumberGen,
+		mockCertificateGen,
 	)
 
+	changedAt := time.Date(2022, time.June, 1, 9, 30, 0, 0, time.UTC)
+
+	onFindByID := func(err error) {
+		mockGroupRepo.On(
+			"FindByID",
+			mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+			mock.AnythingOfType(fmt.Sprintf("%T", "")),
+		).Return(
+			func(ctx context.Context, id string) entity.Group {
+				return entity.Group{ID: "g-xyz"}
+			},
+			func(ctx context.Context, id string) error {
+				return err
+			},
+		).Once()
+	}
+
 	testCases := []struct {
-		name           string
-		inputID        string
-		expectedError  error
-		mockBehaviours func()
+		name              string
+		expectedResponses []response.GroupStatusTransition
+		expectedError     error
+		mockBehaviours    func()
 	}{
 		{
-			name:          "it should return service.ErrRepository error, when group repository return an error",
-			inputID:       "g-xyz",
-			expectedError: service.ErrRepository,
+			name:          "it should return service.ErrDataNotFound error, when group not exists",
+			expectedError: service.ErrDataNotFound,
 			mockBehaviours: func() {
-				mockGroupRepo.On(
-					"Delete",
-					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
-					mock.AnythingOfType(fmt.Sprintf("%T", "")),
-				).Return(
-					func(ctx context.Context, id string) error {
-						return repository.ErrDatabase
-					},
-				).Once()
+				onFindByID(repository.ErrRecordNotFound)
 			},
 		},
 		{
-			name:          "it should return service.ErrDataNotFound error, when group repository return an error",
-			inputID:       "g-xyz",
-			expectedError: service.ErrDataNotFound,
+			name:          "it should return service.ErrRepository error, when group repository return an error",
+			expectedError: service.ErrRepository,
 			mockBehaviours: func() {
+				onFindByID(nil)
+
 				mockGroupRepo.On(
-					"Delete",
+					"FindStatusTransitions",
 					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
-					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					"g-xyz",
 				).Return(
-					func(ctx context.Context, id string) error {
-						return repository.ErrRecordNotFound
+					func(ctx context.Context, groupID string) []entity.GroupStatusTransition {
+						return nil
+					},
+					func(ctx context.Context, groupID string) error {
+						return repository.ErrDatabase
 					},
 				).Once()
 			},
 		},
 		{
-			name:          "it should return nil error, when no error is returned",
-			inputID:       "g-xyz",
+			name: "it should return the transitions, when no error is returned",
+			expectedResponses: []response.GroupStatusTransition{
+				{
+					ID:            "t-Ay8LmNI",
+					FromStatus:    entity.GroupStatusActive,
+					ToStatus:      entity.GroupStatusSuspended,
+					Reason:        "Unpaid membership fee",
+					AdminID:       "a-XU",
+					AdminUsername: "erikrios",
+					ChangedAt:     changedAt.Format(time.RFC822),
+				},
+			},
 			expectedError: nil,
 			mockBehaviours: func() {
+				onFindByID(nil)
+
 				mockGroupRepo.On(
-					"Delete",
+					"FindStatusTransitions",
 					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
-					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					"g-xyz",
 				).Return(
-					func(ctx context.Context, id string) error {
+					func(ctx context.Context, groupID string) []entity.GroupStatusTransition {
+						return []entity.GroupStatusTransition{
+							{
+								ID:            "t-Ay8LmNI",
+								GroupID:       "g-xyz",
+								FromStatus:    entity.GroupStatusActive,
+								ToStatus:      entity.GroupStatusSuspended,
+								Reason:        "Unpaid membership fee",
+								AdminID:       "a-XU",
+								AdminUsername: "erikrios",
+								CreatedAt:     changedAt,
+							},
+						}
+					},
+					func(ctx context.Context, groupID string) error {
 						return nil
 					},
 				).Once()
@@ -644,164 +3534,129 @@ func TestDelete(t *testing.T) {
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
 			testCase.mockBehaviours()
-			gotErr := groupService.Delete(context.Background(), testCase.inputID)
+
+			gotResponses, gotErr := groupService.GetStatusHistory(context.Background(), "g-xyz")
 
 			if testCase.expectedError != nil {
 				assert.ErrorIs(t, gotErr, testCase.expectedError)
 			} else {
 				assert.NoError(t, gotErr)
+				assert.Equal(t, testCase.expectedResponses, gotResponses)
 			}
 		})
 	}
 }
 
-func TestGenerateQRCode(t *testing.T) {
+func TestGetLeadershipHistory(t *testing.T) {
 	mockGroupRepo := &mgr.GroupRepository{}
 	mockVillageRepo := &mvr.VillageRepository{}
 	mockIDGen := &mig.IDGenerator{}
 	mockQRGen := &mqg.QRCodeGenerator{}
+	mockScanLinkGen := &mqg.ScanLinkGenerator{}
+	mockCodeSigner := &mqg.CodeSigner{}
+	mockRegistrationNumberGen := &mig.RegistrationNumberGenerator{}
+	mockCertificateGen := &mig.CertificateGenerator{}
 
 	var groupService GroupService = NewGroupServiceImpl(
 		mockGroupRepo,
 		mockVillageRepo,
 		mockIDGen,
 		mockQRGen,
+		mockScanLinkGen,
+		mockCodeSigner,
+		mockRegistrationNumberGen,
+		mockCertificateGen,
 	)
 
+	recordedAt := time.Date(2022, time.June, 1, 9, 30, 0, 0, time.UTC)
+
+	onFindByID := func(err error) {
+		mockGroupRepo.On(
+			"FindByID",
+			mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+			mock.AnythingOfType(fmt.Sprintf("%T", "")),
+		).Return(
+			func(ctx context.Context, id string) entity.Group {
+				return entity.Group{ID: "g-xyz"}
+			},
+			func(ctx context.Context, id string) error {
+				return err
+			},
+		).Once()
+	}
+
 	testCases := []struct {
-		name           string
-		inputID        string
-		expectedFile   []byte
-		expectedError  error
-		mockBehaviours func()
+		name              string
+		expectedResponses []response.LeadershipChange
+		expectedError     error
+		mockBehaviours    func()
 	}{
 		{
-			name:          "it should return service.ErrRepository error, when group repository return an error",
-			inputID:       "g-xyz",
-			expectedFile:  []byte{},
-			expectedError: service.ErrRepository,
-			mockBehaviours: func() {
-				mockGroupRepo.On(
-					"FindByID",
-					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
-					mock.AnythingOfType(fmt.Sprintf("%T", "")),
-				).Return(
-					func(ctx context.Context, id string) entity.Group {
-						return entity.Group{}
-					},
-					func(ctx context.Context, id string) error {
-						return repository.ErrDatabase
-					},
-				).Once()
-			},
-		},
-		{
-			name:          "it should return service.ErrDataNotFound error, when group repository return an error",
-			inputID:       "g-xyz",
-			expectedFile:  []byte{},
+			name:          "it should return service.ErrDataNotFound error, when group not exists",
 			expectedError: service.ErrDataNotFound,
 			mockBehaviours: func() {
-				mockGroupRepo.On(
-					"FindByID",
-					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
-					mock.AnythingOfType(fmt.Sprintf("%T", "")),
-				).Return(
-					func(ctx context.Context, id string) entity.Group {
-						return entity.Group{}
-					},
-					func(ctx context.Context, id string) error {
-						return repository.ErrRecordNotFound
-					},
-				).Once()
+				onFindByID(repository.ErrRecordNotFound)
 			},
 		},
 		{
-			name:          "it should return service.ErrRepository error, when QR Code Generator return an error",
-			inputID:       "g-xyz",
-			expectedFile:  []byte{},
+			name:          "it should return service.ErrRepository error, when group repository return an error",
 			expectedError: service.ErrRepository,
 			mockBehaviours: func() {
+				onFindByID(nil)
+
 				mockGroupRepo.On(
-					"FindByID",
+					"FindLeadershipChanges",
 					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
-					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					"g-xyz",
 				).Return(
-					func(ctx context.Context, id string) entity.Group {
-						return entity.Group{}
-					},
-					func(ctx context.Context, id string) error {
+					func(ctx context.Context, groupID string) []entity.LeadershipChange {
 						return nil
 					},
-				).Once()
-
-				mockQRGen.On(
-					"GenerateQRCode",
-					mock.AnythingOfType(fmt.Sprintf("%T", "")),
-					mock.AnythingOfType(fmt.Sprintf("%T", qrcode.Medium)),
-					mock.AnythingOfType(fmt.Sprintf("%T", 2048)),
-				).Return(
-					func(id string, level qrcode.RecoveryLevel, size int) []byte {
-						return []byte{}
-					},
-					func(id string, level qrcode.RecoveryLevel, size int) error {
-						return errors.New("error generate qrcode")
+					func(ctx context.Context, groupID string) error {
+						return repository.ErrDatabase
 					},
 				).Once()
 			},
 		},
 		{
-			name:          "it should return a valid file, when no error is returned",
-			inputID:       "g-xyz",
-			expectedFile:  []byte{1},
+			name: "it should return the leadership changes, when no error is returned",
+			expectedResponses: []response.LeadershipChange{
+				{
+					ID:             "l-Ay8LmNI",
+					PreviousLeader: "Erik R",
+					NewLeader:      "Rio S",
+					EffectiveOn:    "2022-01-02",
+					Note:           "Elected at the annual meeting",
+					AdminID:        "a-XU",
+					AdminUsername:  "erikrios",
+					RecordedAt:     recordedAt.Format(time.RFC822),
+				},
+			},
 			expectedError: nil,
 			mockBehaviours: func() {
+				onFindByID(nil)
+
 				mockGroupRepo.On(
-					"FindByID",
+					"FindLeadershipChanges",
 					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
-					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					"g-xyz",
 				).Return(
-					func(ctx context.Context, id string) entity.Group {
-						return entity.Group{
-							ID:     "g-Nzo",
-							Name:   "Paguyuban Reog",
-							Leader: "Erik Rio Setiawan",
-							Address: entity.Address{
-								ID:           "g-Nzo",
-								Address:      "RT 05 RW 01 Dukuh Tengah",
-								VillageID:    "3502030007",
-								VillageName:  "Pager",
-								DistrictID:   "3502030",
-								DistrictName: "Bungkal",
-								RegencyID:    "3502",
-								RegencyName:  "Kabupaten Ponorogo",
-								ProvinceID:   "35",
-								ProvinceName: "Jawa Timur",
-							},
-							Properties: []entity.Property{
-								{
-									ID:          "p-YIhpPgp",
-									Name:        "Dadak Merak",
-									Description: "Ini adalah deskripsi dari dadak merak",
-									Amount:      1,
-								},
+					func(ctx context.Context, groupID string) []entity.LeadershipChange {
+						return []entity.LeadershipChange{
+							{
+								ID:             "l-Ay8LmNI",
+								GroupID:        "g-xyz",
+								PreviousLeader: "Erik R",
+								NewLeader:      "Rio S",
+								EffectiveOn:    time.Date(2022, time.January, 2, 0, 0, 0, 0, time.UTC),
+								Note:           "Elected at the annual meeting",
+								AdminID:        "a-XU",
+								AdminUsername:  "erikrios",
+								CreatedAt:      recordedAt,
 							},
 						}
 					},
-					func(ctx context.Context, id string) error {
-						return nil
-					},
-				).Once()
-
-				mockQRGen.On(
-					"GenerateQRCode",
-					mock.AnythingOfType(fmt.Sprintf("%T", "")),
-					mock.AnythingOfType(fmt.Sprintf("%T", qrcode.Medium)),
-					mock.AnythingOfType(fmt.Sprintf("%T", 2048)),
-				).Return(
-					func(id string, level qrcode.RecoveryLevel, size int) []byte {
-						return []byte{1}
-					},
-					func(id string, level qrcode.RecoveryLevel, size int) error {
+					func(ctx context.Context, groupID string) error {
 						return nil
 					},
 				).Once()
@@ -813,14 +3668,18 @@ func TestGenerateQRCode(t *testing.T) {
 		t.Run(testCase.name, func(t *testing.T) {
 			testCase.mockBehaviours()
 
-			gotFile, gotErr := groupService.GenerateQRCode(context.Background(), testCase.inputID)
+			gotResponses, gotErr := groupService.GetLeadershipHistory(context.Background(), "g-xyz")
 
 			if testCase.expectedError != nil {
 				assert.ErrorIs(t, gotErr, testCase.expectedError)
 			} else {
 				assert.NoError(t, gotErr)
-				assert.ElementsMatch(t, testCase.expectedFile, gotFile)
+				assert.Equal(t, testCase.expectedResponses, gotResponses)
 			}
 		})
 	}
 }
+
+func floatPtr(f float64) *float64 {
+	return &f
+}
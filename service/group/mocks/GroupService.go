@@ -5,11 +5,9 @@ package mocks
 import (
 	context "context"
 
-	mock "github.com/stretchr/testify/mock"
-
 	payload "github.com/erikrios/reog-apps-apis/model/payload"
-
 	response "github.com/erikrios/reog-apps-apis/model/response"
+	mock "github.com/stretchr/testify/mock"
 )
 
 // GroupService is an autogenerated mock type for the GroupService type
@@ -17,6 +15,20 @@ type GroupService struct {
 	mock.Mock
 }
 
+// AssignRegistrationNumbers provides a mock function with given fields: ctx
+func (_m *GroupService) AssignRegistrationNumbers(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Create provides a mock function with given fields: ctx, p
 func (_m *GroupService) Create(ctx context.Context, p payload.CreateGroup) (string, error) {
 	ret := _m.Called(ctx, p)
@@ -38,13 +50,48 @@ func (_m *GroupService) Create(ctx context.Context, p payload.CreateGroup) (stri
 	return r0, r1
 }
 
-// Delete provides a mock function with given fields: ctx, id
-func (_m *GroupService) Delete(ctx context.Context, id string) error {
-	ret := _m.Called(ctx, id)
+// CreateWithContacts provides a mock function with given fields: ctx, p, c
+func (_m *GroupService) CreateWithContacts(ctx context.Context, p payload.CreateGroup, c payload.UpdateGroupContacts) (string, error) {
+	ret := _m.Called(ctx, p, c)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(context.Context, payload.CreateGroup, payload.UpdateGroupContacts) string); ok {
+		r0 = rf(ctx, p, c)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, payload.CreateGroup, payload.UpdateGroupContacts) error); ok {
+		r1 = rf(ctx, p, c)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Delete provides a mock function with given fields: ctx, id, version, p
+func (_m *GroupService) Delete(ctx context.Context, id string, version int, p payload.DeleteGroup) error {
+	ret := _m.Called(ctx, id, version, p)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
-		r0 = rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, payload.DeleteGroup) error); ok {
+		r0 = rf(ctx, id, version, p)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Export provides a mock function with given fields: ctx, p, write
+func (_m *GroupService) Export(ctx context.Context, p payload.GetGroups, write func([]response.Group) error) error {
+	ret := _m.Called(ctx, p, write)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, payload.GetGroups, func([]response.Group) error) error); ok {
+		r0 = rf(ctx, p, write)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -52,6 +99,29 @@ func (_m *GroupService) Delete(ctx context.Context, id string) error {
 	return r0
 }
 
+// GenerateCertificate provides a mock function with given fields: ctx, id
+func (_m *GroupService) GenerateCertificate(ctx context.Context, id string) ([]byte, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 []byte
+	if rf, ok := ret.Get(0).(func(context.Context, string) []byte); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GenerateQRCode provides a mock function with given fields: ctx, id
 func (_m *GroupService) GenerateQRCode(ctx context.Context, id string) ([]byte, error) {
 	ret := _m.Called(ctx, id)
@@ -75,22 +145,22 @@ func (_m *GroupService) GenerateQRCode(ctx context.Context, id string) ([]byte,
 	return r0, r1
 }
 
-// GetAll provides a mock function with given fields: ctx
-func (_m *GroupService) GetAll(ctx context.Context) ([]response.Group, error) {
-	ret := _m.Called(ctx)
+// GenerateVCard provides a mock function with given fields: ctx, id
+func (_m *GroupService) GenerateVCard(ctx context.Context, id string) ([]byte, error) {
+	ret := _m.Called(ctx, id)
 
-	var r0 []response.Group
-	if rf, ok := ret.Get(0).(func(context.Context) []response.Group); ok {
-		r0 = rf(ctx)
+	var r0 []byte
+	if rf, ok := ret.Get(0).(func(context.Context, string) []byte); ok {
+		r0 = rf(ctx, id)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]response.Group)
+			r0 = ret.Get(0).([]byte)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = rf(ctx)
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -98,6 +168,36 @@ func (_m *GroupService) GetAll(ctx context.Context) ([]response.Group, error) {
 	return r0, r1
 }
 
+// GetAll provides a mock function with given fields: ctx, p
+func (_m *GroupService) GetAll(ctx context.Context, p payload.GetGroups) ([]response.Group, response.Pagination, error) {
+	ret := _m.Called(ctx, p)
+
+	var r0 []response.Group
+	if rf, ok := ret.Get(0).(func(context.Context, payload.GetGroups) []response.Group); ok {
+		r0 = rf(ctx, p)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]response.Group)
+		}
+	}
+
+	var r1 response.Pagination
+	if rf, ok := ret.Get(1).(func(context.Context, payload.GetGroups) response.Pagination); ok {
+		r1 = rf(ctx, p)
+	} else {
+		r1 = ret.Get(1).(response.Pagination)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, payload.GetGroups) error); ok {
+		r2 = rf(ctx, p)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
 // GetByID provides a mock function with given fields: ctx, id
 func (_m *GroupService) GetByID(ctx context.Context, id string) (response.Group, error) {
 	ret := _m.Called(ctx, id)
@@ -119,13 +219,205 @@ func (_m *GroupService) GetByID(ctx context.Context, id string) (response.Group,
 	return r0, r1
 }
 
-// Update provides a mock function with given fields: ctx, id, p
-func (_m *GroupService) Update(ctx context.Context, id string, p payload.UpdateGroup) error {
-	ret := _m.Called(ctx, id, p)
+// GetDuplicates provides a mock function with given fields: ctx, p
+func (_m *GroupService) GetDuplicates(ctx context.Context, p payload.GetDuplicateGroups) ([]response.DuplicateGroups, error) {
+	ret := _m.Called(ctx, p)
+
+	var r0 []response.DuplicateGroups
+	if rf, ok := ret.Get(0).(func(context.Context, payload.GetDuplicateGroups) []response.DuplicateGroups); ok {
+		r0 = rf(ctx, p)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]response.DuplicateGroups)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, payload.GetDuplicateGroups) error); ok {
+		r1 = rf(ctx, p)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetLeadershipHistory provides a mock function with given fields: ctx, id
+func (_m *GroupService) GetLeadershipHistory(ctx context.Context, id string) ([]response.LeadershipChange, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 []response.LeadershipChange
+	if rf, ok := ret.Get(0).(func(context.Context, string) []response.LeadershipChange); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]response.LeadershipChange)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetNearby provides a mock function with given fields: ctx, p
+func (_m *GroupService) GetNearby(ctx context.Context, p payload.GetNearbyGroups) ([]response.NearbyGroup, error) {
+	ret := _m.Called(ctx, p)
+
+	var r0 []response.NearbyGroup
+	if rf, ok := ret.Get(0).(func(context.Context, payload.GetNearbyGroups) []response.NearbyGroup); ok {
+		r0 = rf(ctx, p)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]response.NearbyGroup)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, payload.GetNearbyGroups) error); ok {
+		r1 = rf(ctx, p)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetStatusHistory provides a mock function with given fields: ctx, id
+func (_m *GroupService) GetStatusHistory(ctx context.Context, id string) ([]response.GroupStatusTransition, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 []response.GroupStatusTransition
+	if rf, ok := ret.Get(0).(func(context.Context, string) []response.GroupStatusTransition); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]response.GroupStatusTransition)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Import provides a mock function with given fields: ctx, rows, p
+func (_m *GroupService) Import(ctx context.Context, rows []payload.CreateGroup, p payload.ImportGroups) ([]response.ImportGroup, error) {
+	ret := _m.Called(ctx, rows, p)
+
+	var r0 []response.ImportGroup
+	if rf, ok := ret.Get(0).(func(context.Context, []payload.CreateGroup, payload.ImportGroups) []response.ImportGroup); ok {
+		r0 = rf(ctx, rows, p)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]response.ImportGroup)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, []payload.CreateGroup, payload.ImportGroups) error); ok {
+		r1 = rf(ctx, rows, p)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Merge provides a mock function with given fields: ctx, id, version, targetVersion, p
+func (_m *GroupService) Merge(ctx context.Context, id string, version int, targetVersion int, p payload.MergeGroup) error {
+	ret := _m.Called(ctx, id, version, targetVersion, p)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, int, payload.MergeGroup) error); ok {
+		r0 = rf(ctx, id, version, targetVersion, p)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Patch provides a mock function with given fields: ctx, id, version, adminID, adminUsername, p
+func (_m *GroupService) Patch(ctx context.Context, id string, version int, adminID string, adminUsername string, p payload.PatchGroup) error {
+	ret := _m.Called(ctx, id, version, adminID, adminUsername, p)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, payload.UpdateGroup) error); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, string, string, payload.PatchGroup) error); ok {
+		r0 = rf(ctx, id, version, adminID, adminUsername, p)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PreviewDelete provides a mock function with given fields: ctx, id, p
+func (_m *GroupService) PreviewDelete(ctx context.Context, id string, p payload.DeleteGroup) (response.GroupDeletion, error) {
+	ret := _m.Called(ctx, id, p)
+
+	var r0 response.GroupDeletion
+	if rf, ok := ret.Get(0).(func(context.Context, string, payload.DeleteGroup) response.GroupDeletion); ok {
 		r0 = rf(ctx, id, p)
+	} else {
+		r0 = ret.Get(0).(response.GroupDeletion)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, payload.DeleteGroup) error); ok {
+		r1 = rf(ctx, id, p)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Update provides a mock function with given fields: ctx, id, version, adminID, adminUsername, p
+func (_m *GroupService) Update(ctx context.Context, id string, version int, adminID string, adminUsername string, p payload.UpdateGroup) error {
+	ret := _m.Called(ctx, id, version, adminID, adminUsername, p)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, string, string, payload.UpdateGroup) error); ok {
+		r0 = rf(ctx, id, version, adminID, adminUsername, p)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateContacts provides a mock function with given fields: ctx, id, version, p
+func (_m *GroupService) UpdateContacts(ctx context.Context, id string, version int, p payload.UpdateGroupContacts) error {
+	ret := _m.Called(ctx, id, version, p)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, payload.UpdateGroupContacts) error); ok {
+		r0 = rf(ctx, id, version, p)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateStatus provides a mock function with given fields: ctx, id, version, adminID, adminUsername, p
+func (_m *GroupService) UpdateStatus(ctx context.Context, id string, version int, adminID string, adminUsername string, p payload.UpdateGroupStatus) error {
+	ret := _m.Called(ctx, id, version, adminID, adminUsername, p)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, string, string, payload.UpdateGroupStatus) error); ok {
+		r0 = rf(ctx, id, version, adminID, adminUsername, p)
 	} else {
 		r0 = ret.Error(0)
 	}
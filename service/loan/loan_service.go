@@ -0,0 +1,14 @@
+package loan
+
+import (
+	"context"
+
+	"github.com/erikrios/reog-apps-apis/model/payload"
+	"github.com/erikrios/reog-apps-apis/model/response"
+)
+
+type LoanService interface {
+	Create(ctx context.Context, groupID, propertyID string, p payload.CreateLoan) (id string, err error)
+	GetByPropertyID(ctx context.Context, groupID, propertyID string) (response response.PropertyLoans, err error)
+	Return(ctx context.Context, groupID, propertyID, id string, p payload.ReturnLoan) (err error)
+}
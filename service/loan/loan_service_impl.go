@@ -0,0 +1,230 @@
+package loan
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/erikrios/reog-apps-apis/entity"
+	"github.com/erikrios/reog-apps-apis/model/payload"
+	"github.com/erikrios/reog-apps-apis/model/response"
+	"github.com/erikrios/reog-apps-apis/repository"
+	"github.com/erikrios/reog-apps-apis/repository/group"
+	"github.com/erikrios/reog-apps-apis/repository/loan"
+	"github.com/erikrios/reog-apps-apis/repository/property"
+	"github.com/erikrios/reog-apps-apis/service"
+	"github.com/erikrios/reog-apps-apis/utils/generator"
+	"gopkg.in/validator.v2"
+)
+
+const dateLayout = "2006-01-02"
+
+type loanServiceImpl struct {
+	loanRepository     loan.LoanRepository
+	propertyRepository property.PropertyRepository
+	groupRepository    group.GroupRepository
+	idGenerator        generator.IDGenerator
+}
+
+func NewLoanServiceImpl(
+	loanRepository loan.LoanRepository,
+	propertyRepository property.PropertyRepository,
+	groupRepository group.GroupRepository,
+	idGenerator generator.IDGenerator,
+) *loanServiceImpl {
+	return &loanServiceImpl{
+		loanRepository:     loanRepository,
+		propertyRepository: propertyRepository,
+		groupRepository:    groupRepository,
+		idGenerator:        idGenerator,
+	}
+}
+
+// Create checks the items out, lending either to another group or to a borrower written as free text. The loan is
+// rejected when fewer items than the quantity are available.
+func (l *loanServiceImpl) Create(ctx context.Context, groupID, propertyID string, p payload.CreateLoan) (id string, err error) {
+	borrowerName := strings.TrimSpace(p.BorrowerName)
+	if validateErr := validator.Validate(p); validateErr != nil || (p.BorrowerGroupID == "") == (borrowerName == "") || p.BorrowerGroupID == groupID {
+		err = service.ErrInvalidPayload
+		return
+	}
+
+	today := currentDate()
+	dueOn, parseErr := time.Parse(dateLayout, p.DueOn)
+	if parseErr != nil {
+		err = service.ErrDateParsing
+		return
+	}
+	if dueOn.Before(today) {
+		err = service.ErrInvalidPayload
+		return
+	}
+
+	if _, findErr := l.findProperty(ctx, groupID, propertyID); findErr != nil {
+		err = findErr
+		return
+	}
+
+	var borrowerGroupID *string
+	if p.BorrowerGroupID != "" {
+		borrower, repoErr := l.groupRepository.FindByID(ctx, p.BorrowerGroupID)
+		if repoErr != nil {
+			err = service.MapError(repoErr)
+			return
+		}
+		borrowerGroupID = &borrower.ID
+		borrowerName = borrower.Name
+	}
+
+	id, genErr := l.idGenerator.GenerateLoanID()
+	if genErr != nil {
+		err = service.MapError(genErr)
+		return
+	}
+
+	loan := entity.Loan{
+		ID:              id,
+		PropertyID:      propertyID,
+		BorrowerGroupID: borrowerGroupID,
+		BorrowerName:    borrowerName,
+		Quantity:        p.Quantity,
+		LoanedOn:        today,
+		DueOn:           dueOn,
+		Note:            strings.TrimSpace(p.Note),
+	}
+
+	if repoErr := l.loanRepository.Insert(ctx, loan); repoErr != nil {
+		err = service.MapError(repoErr)
+	}
+	return
+}
+
+// GetByPropertyID gets the loans of the property along with the quantity still available to lend.
+func (l *loanServiceImpl) GetByPropertyID(ctx context.Context, groupID, propertyID string) (response response.PropertyLoans, err error) {
+	property, findErr := l.findProperty(ctx, groupID, propertyID)
+	if findErr != nil {
+		err = findErr
+		return
+	}
+
+	loans, repoErr := l.loanRepository.FindByPropertyID(ctx, propertyID)
+	if repoErr != nil {
+		err = service.MapError(repoErr)
+		return
+	}
+
+	response = mapToPropertyLoans(property, loans, currentDate())
+	return
+}
+
+// Return checks the items of a loan back in, today unless the payload tells an earlier day.
+func (l *loanServiceImpl) Return(ctx context.Context, groupID, propertyID, id string, p payload.ReturnLoan) (err error) {
+	if validateErr := validator.Validate(p); validateErr != nil {
+		err = service.ErrInvalidPayload
+		return
+	}
+
+	today := currentDate()
+	returnedOn := today
+	if p.ReturnedOn != "" {
+		date, parseErr := time.Parse(dateLayout, p.ReturnedOn)
+		if parseErr != nil {
+			err = service.ErrDateParsing
+			return
+		}
+		returnedOn = date
+	}
+
+	if _, findErr := l.findProperty(ctx, groupID, propertyID); findErr != nil {
+		err = findErr
+		return
+	}
+
+	loan, repoErr := l.loanRepository.FindByID(ctx, propertyID, id)
+	if repoErr != nil {
+		err = service.MapError(repoErr)
+		return
+	}
+
+	if loan.ReturnedOn != nil {
+		err = service.ErrLoanReturned
+		return
+	}
+
+	if returnedOn.Before(loan.LoanedOn) || returnedOn.After(today) {
+		err = service.ErrInvalidPayload
+		return
+	}
+
+	if repoErr := l.loanRepository.Return(ctx, propertyID, id, returnedOn); repoErr != nil {
+		// The loan was found still out just before, so it has been returned in the meantime
+		if errors.Is(repoErr, repository.ErrRecordNotFound) {
+			err = service.ErrLoanReturned
+			return
+		}
+
+		err = service.MapError(repoErr)
+	}
+	return
+}
+
+// findProperty finds the property, which is only found through the group it belongs to.
+func (l *loanServiceImpl) findProperty(ctx context.Context, groupID, propertyID string) (property entity.Property, err error) {
+	property, repoErr := l.propertyRepository.FindByID(ctx, propertyID)
+	if repoErr != nil {
+		err = service.MapError(repoErr)
+		return
+	}
+
+	if property.GroupID != groupID {
+		err = service.ErrDataNotFound
+	}
+	return
+}
+
+// currentDate is today at midnight UTC, as the dates of the loans are stored
+func currentDate() time.Time {
+	date, _ := time.Parse(dateLayout, time.Now().Format(dateLayout))
+	return date
+}
+
+func mapToPropertyLoans(property entity.Property, loans []entity.Loan, today time.Time) response.PropertyLoans {
+	responses := make([]response.Loan, len(loans))
+	onLoan := 0
+	for i, loan := range loans {
+		responses[i] = mapToModel(loan, today)
+		if loan.ReturnedOn == nil {
+			onLoan += int(loan.Quantity)
+		}
+	}
+
+	return response.PropertyLoans{
+		PropertyID: property.ID,
+		Amount:     property.Amount,
+		OnLoan:     onLoan,
+		Available:  int(property.Amount) - onLoan,
+		Loans:      responses,
+	}
+}
+
+func mapToModel(loan entity.Loan, today time.Time) response.Loan {
+	res := response.Loan{
+		ID:           loan.ID,
+		PropertyID:   loan.PropertyID,
+		BorrowerName: loan.BorrowerName,
+		Quantity:     loan.Quantity,
+		LoanedOn:     loan.LoanedOn.Format(dateLayout),
+		DueOn:        loan.DueOn.Format(dateLayout),
+		Overdue:      loan.ReturnedOn == nil && loan.DueOn.Before(today),
+		Note:         loan.Note,
+	}
+
+	if loan.BorrowerGroupID != nil {
+		res.BorrowerGroupID = *loan.BorrowerGroupID
+	}
+	if loan.ReturnedOn != nil {
+		res.ReturnedOn = loan.ReturnedOn.Format(dateLayout)
+	}
+	return res
+}
@@ -0,0 +1,626 @@
+package loan
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/erikrios/reog-apps-apis/entity"
+	"github.com/erikrios/reog-apps-apis/model/payload"
+	"github.com/erikrios/reog-apps-apis/model/response"
+	"github.com/erikrios/reog-apps-apis/repository"
+	mgr "github.com/erikrios/reog-apps-apis/repository/group/mocks"
+	mlr "github.com/erikrios/reog-apps-apis/repository/loan/mocks"
+	mpr "github.com/erikrios/reog-apps-apis/repository/property/mocks"
+	"github.com/erikrios/reog-apps-apis/service"
+	mig "github.com/erikrios/reog-apps-apis/utils/generator/mocks"
+	_ "github.com/erikrios/reog-apps-apis/validation"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCreate(t *testing.T) {
+	mockLoanRepo := &mlr.LoanRepository{}
+	mockPropertyRepo := &mpr.PropertyRepository{}
+	mockGroupRepo := &mgr.GroupRepository{}
+	mockIDGen := &mig.IDGenerator{}
+
+	var loanService LoanService = NewLoanServiceImpl(
+		mockLoanRepo,
+		mockPropertyRepo,
+		mockGroupRepo,
+		mockIDGen,
+	)
+
+	tomorrow := currentDate().AddDate(0, 0, 1).Format(dateLayout)
+	yesterday := currentDate().AddDate(0, 0, -1).Format(dateLayout)
+
+	findProperty := func(groupID string) {
+		mockPropertyRepo.On(
+			"FindByID",
+			mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+			mock.AnythingOfType(fmt.Sprintf("%T", "")),
+		).Return(
+			func(ctx context.Context, id string) entity.Property {
+				return entity.Property{ID: id, GroupID: groupID, Amount: 10}
+			},
+			func(ctx context.Context, id string) error {
+				return nil
+			},
+		).Once()
+	}
+
+	findBorrowerGroup := func(err error) {
+		mockGroupRepo.On(
+			"FindByID",
+			mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+			mock.AnythingOfType(fmt.Sprintf("%T", "")),
+		).Return(
+			func(ctx context.Context, id string) entity.Group {
+				if err != nil {
+					return entity.Group{}
+				}
+				return entity.Group{ID: id, Name: "Paguyuban Reog Sardulo Nareswara"}
+			},
+			func(ctx context.Context, id string) error {
+				return err
+			},
+		).Once()
+	}
+
+	generateID := func() {
+		mockIDGen.On("GenerateLoanID").Return(
+			func() string {
+				return "o-aBcdEfG"
+			},
+			func() error {
+				return nil
+			},
+		).Once()
+	}
+
+	testCases := []struct {
+		name            string
+		inputGroupID    string
+		inputPropertyID string
+		inputPayload    payload.CreateLoan
+		expectedID      string
+		expectedError   error
+		mockBehaviours  func()
+	}{
+		{
+			name:            "it should return service.ErrInvalidPayload error, when neither a borrower group nor a borrower name is given",
+			inputGroupID:    "g-xyz",
+			inputPropertyID: "p-xyz",
+			inputPayload:    payload.CreateLoan{BorrowerName: "  ", Quantity: 2, DueOn: tomorrow},
+			expectedError:   service.ErrInvalidPayload,
+			mockBehaviours:  func() {},
+		},
+		{
+			name:            "it should return service.ErrInvalidPayload error, when both a borrower group and a borrower name are given",
+			inputGroupID:    "g-xyz",
+			inputPropertyID: "p-xyz",
+			inputPayload:    payload.CreateLoan{BorrowerGroupID: "g-abc", BorrowerName: "SMAN 1 Ponorogo", Quantity: 2, DueOn: tomorrow},
+			expectedError:   service.ErrInvalidPayload,
+			mockBehaviours:  func() {},
+		},
+		{
+			name:            "it should return service.ErrInvalidPayload error, when the borrower group is the owner group",
+			inputGroupID:    "g-xyz",
+			inputPropertyID: "p-xyz",
+			inputPayload:    payload.CreateLoan{BorrowerGroupID: "g-xyz", Quantity: 2, DueOn: tomorrow},
+			expectedError:   service.ErrInvalidPayload,
+			mockBehaviours:  func() {},
+		},
+		{
+			name:            "it should return service.ErrInvalidPayload error, when quantity is zero",
+			inputGroupID:    "g-xyz",
+			inputPropertyID: "p-xyz",
+			inputPayload:    payload.CreateLoan{BorrowerName: "SMAN 1 Ponorogo", DueOn: tomorrow},
+			expectedError:   service.ErrInvalidPayload,
+			mockBehaviours:  func() {},
+		},
+		{
+			name:            "it should return service.ErrDateParsing error, when due date is not a date",
+			inputGroupID:    "g-xyz",
+			inputPropertyID: "p-xyz",
+			inputPayload:    payload.CreateLoan{BorrowerName: "SMAN 1 Ponorogo", Quantity: 2, DueOn: "31/12/2022"},
+			expectedError:   service.ErrDateParsing,
+			mockBehaviours:  func() {},
+		},
+		{
+			name:            "it should return service.ErrInvalidPayload error, when due date is in the past",
+			inputGroupID:    "g-xyz",
+			inputPropertyID: "p-xyz",
+			inputPayload:    payload.CreateLoan{BorrowerName: "SMAN 1 Ponorogo", Quantity: 2, DueOn: yesterday},
+			expectedError:   service.ErrInvalidPayload,
+			mockBehaviours:  func() {},
+		},
+		{
+			name:            "it should return service.ErrDataNotFound error, when the property belongs to another group",
+			inputGroupID:    "g-xyz",
+			inputPropertyID: "p-xyz",
+			inputPayload:    payload.CreateLoan{BorrowerName: "SMAN 1 Ponorogo", Quantity: 2, DueOn: tomorrow},
+			expectedError:   service.ErrDataNotFound,
+			mockBehaviours: func() {
+				findProperty("g-abc")
+			},
+		},
+		{
+			name:            "it should return service.ErrDataNotFound error, when the borrower group is not found",
+			inputGroupID:    "g-xyz",
+			inputPropertyID: "p-xyz",
+			inputPayload:    payload.CreateLoan{BorrowerGroupID: "g-abc", Quantity: 2, DueOn: tomorrow},
+			expectedError:   service.ErrDataNotFound,
+			mockBehaviours: func() {
+				findProperty("g-xyz")
+				findBorrowerGroup(repository.ErrRecordNotFound)
+			},
+		},
+		{
+			name:            "it should return service.ErrNotEnoughAvailable error, when the quantity exceeds the available quantity",
+			inputGroupID:    "g-xyz",
+			inputPropertyID: "p-xyz",
+			inputPayload:    payload.CreateLoan{BorrowerName: "SMAN 1 Ponorogo", Quantity: 11, DueOn: tomorrow},
+			expectedError:   service.ErrNotEnoughAvailable,
+			mockBehaviours: func() {
+				findProperty("g-xyz")
+				generateID()
+
+				mockLoanRepo.On(
+					"Insert",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", entity.Loan{})),
+				).Return(
+					func(ctx context.Context, loan entity.Loan) error {
+						return repository.ErrNotEnoughQuantity
+					},
+				).Once()
+			},
+		},
+		{
+			name:            "it should return a valid ID, when lending to another group",
+			inputGroupID:    "g-xyz",
+			inputPropertyID: "p-xyz",
+			inputPayload:    payload.CreateLoan{BorrowerGroupID: "g-abc", Quantity: 2, DueOn: tomorrow},
+			expectedID:      "o-aBcdEfG",
+			mockBehaviours: func() {
+				findProperty("g-xyz")
+				findBorrowerGroup(nil)
+				generateID()
+
+				mockLoanRepo.On(
+					"Insert",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.MatchedBy(func(loan entity.Loan) bool {
+						return loan.ID == "o-aBcdEfG" &&
+							loan.PropertyID == "p-xyz" &&
+							loan.BorrowerGroupID != nil && *loan.BorrowerGroupID == "g-abc" &&
+							loan.BorrowerName == "Paguyuban Reog Sardulo Nareswara" &&
+							loan.Quantity == 2 &&
+							loan.LoanedOn.Equal(currentDate())
+					}),
+				).Return(
+					func(ctx context.Context, loan entity.Loan) error {
+						return nil
+					},
+				).Once()
+			},
+		},
+		{
+			name:            "it should return a valid ID, when lending to a borrower written as free text",
+			inputGroupID:    "g-xyz",
+			inputPropertyID: "p-xyz",
+			inputPayload:    payload.CreateLoan{BorrowerName: " SMAN 1 Ponorogo ", Quantity: 2, DueOn: tomorrow},
+			expectedID:      "o-aBcdEfG",
+			mockBehaviours: func() {
+				findProperty("g-xyz")
+				generateID()
+
+				mockLoanRepo.On(
+					"Insert",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.MatchedBy(func(loan entity.Loan) bool {
+						return loan.BorrowerGroupID == nil && loan.BorrowerName == "SMAN 1 Ponorogo"
+					}),
+				).Return(
+					func(ctx context.Context, loan entity.Loan) error {
+						return nil
+					},
+				).Once()
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.mockBehaviours()
+			gotID, gotErr := loanService.Create(context.Background(), testCase.inputGroupID, testCase.inputPropertyID, testCase.inputPayload)
+
+			if testCase.expectedError != nil {
+				assert.ErrorIs(t, gotErr, testCase.expectedError)
+			} else {
+				assert.NoError(t, gotErr)
+				assert.Equal(t, testCase.expectedID, gotID)
+			}
+		})
+	}
+}
+
+func TestGetByPropertyID(t *testing.T) {
+	mockLoanRepo := &mlr.LoanRepository{}
+	mockPropertyRepo := &mpr.PropertyRepository{}
+	mockGroupRepo := &mgr.GroupRepository{}
+	mockIDGen := &mig.IDGenerator{}
+
+	var loanService LoanService = NewLoanServiceImpl(
+		mockLoanRepo,
+		mockPropertyRepo,
+		mockGroupRepo,
+		mockIDGen,
+	)
+
+	today := currentDate()
+	borrowerGroupID := "g-abc"
+	returnedOn := today.AddDate(0, 0, -3)
+
+	loans := []entity.Loan{
+		{
+			ID:              "o-aBcdEfG",
+			PropertyID:      "p-xyz",
+			BorrowerGroupID: &borrowerGroupID,
+			BorrowerName:    "Paguyuban Reog Sardulo Nareswara",
+			Quantity:        3,
+			LoanedOn:        today.AddDate(0, 0, -10),
+			DueOn:           today.AddDate(0, 0, -1),
+		},
+		{
+			ID:           "o-hIjkLmN",
+			PropertyID:   "p-xyz",
+			BorrowerName: "SMAN 1 Ponorogo",
+			Quantity:     2,
+			LoanedOn:     today,
+			DueOn:        today.AddDate(0, 0, 7),
+		},
+		{
+			ID:           "o-oPqrStU",
+			PropertyID:   "p-xyz",
+			BorrowerName: "SMAN 2 Ponorogo",
+			Quantity:     4,
+			LoanedOn:     today.AddDate(0, 0, -20),
+			DueOn:        today.AddDate(0, 0, -5),
+			ReturnedOn:   &returnedOn,
+		},
+	}
+
+	testCases := []struct {
+		name            string
+		inputGroupID    string
+		inputPropertyID string
+		expected        response.PropertyLoans
+		expectedError   error
+		mockBehaviours  func()
+	}{
+		{
+			name:            "it should return service.ErrDataNotFound error, when property repository return an error",
+			inputGroupID:    "g-xyz",
+			inputPropertyID: "p-xyz",
+			expectedError:   service.ErrDataNotFound,
+			mockBehaviours: func() {
+				mockPropertyRepo.On(
+					"FindByID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, id string) entity.Property {
+						return entity.Property{}
+					},
+					func(ctx context.Context, id string) error {
+						return repository.ErrRecordNotFound
+					},
+				).Once()
+			},
+		},
+		{
+			name:            "it should return service.ErrRepository error, when loan repository return an error",
+			inputGroupID:    "g-xyz",
+			inputPropertyID: "p-xyz",
+			expectedError:   service.ErrRepository,
+			mockBehaviours: func() {
+				mockPropertyRepo.On(
+					"FindByID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, id string) entity.Property {
+						return entity.Property{ID: id, GroupID: "g-xyz", Amount: 10}
+					},
+					func(ctx context.Context, id string) error {
+						return nil
+					},
+				).Once()
+
+				mockLoanRepo.On(
+					"FindByPropertyID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, propertyID string) []entity.Loan {
+						return nil
+					},
+					func(ctx context.Context, propertyID string) error {
+						return repository.ErrDatabase
+					},
+				).Once()
+			},
+		},
+		{
+			name:            "it should return the loans along with the available quantity, when no error is returned",
+			inputGroupID:    "g-xyz",
+			inputPropertyID: "p-xyz",
+			expected: response.PropertyLoans{
+				PropertyID: "p-xyz",
+				Amount:     10,
+				OnLoan:     5,
+				Available:  5,
+				Loans: []response.Loan{
+					{
+						ID:              "o-aBcdEfG",
+						PropertyID:      "p-xyz",
+						BorrowerGroupID: "g-abc",
+						BorrowerName:    "Paguyuban Reog Sardulo Nareswara",
+						Quantity:        3,
+						LoanedOn:        today.AddDate(0, 0, -10).Format(dateLayout),
+						DueOn:           today.AddDate(0, 0, -1).Format(dateLayout),
+						Overdue:         true,
+					},
+					{
+						ID:           "o-hIjkLmN",
+						PropertyID:   "p-xyz",
+						BorrowerName: "SMAN 1 Ponorogo",
+						Quantity:     2,
+						LoanedOn:     today.Format(dateLayout),
+						DueOn:        today.AddDate(0, 0, 7).Format(dateLayout),
+					},
+					{
+						ID:           "o-oPqrStU",
+						PropertyID:   "p-xyz",
+						BorrowerName: "SMAN 2 Ponorogo",
+						Quantity:     4,
+						LoanedOn:     today.AddDate(0, 0, -20).Format(dateLayout),
+						DueOn:        today.AddDate(0, 0, -5).Format(dateLayout),
+						ReturnedOn:   returnedOn.Format(dateLayout),
+					},
+				},
+			},
+			mockBehaviours: func() {
+				mockPropertyRepo.On(
+					"FindByID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, id string) entity.Property {
+						return entity.Property{ID: id, GroupID: "g-xyz", Amount: 10}
+					},
+					func(ctx context.Context, id string) error {
+						return nil
+					},
+				).Once()
+
+				mockLoanRepo.On(
+					"FindByPropertyID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, propertyID string) []entity.Loan {
+						return loans
+					},
+					func(ctx context.Context, propertyID string) error {
+						return nil
+					},
+				).Once()
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.mockBehaviours()
+			got, gotErr := loanService.GetByPropertyID(context.Background(), testCase.inputGroupID, testCase.inputPropertyID)
+
+			if testCase.expectedError != nil {
+				assert.ErrorIs(t, gotErr, testCase.expectedError)
+			} else {
+				assert.NoError(t, gotErr)
+				assert.Equal(t, testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestReturn(t *testing.T) {
+	mockLoanRepo := &mlr.LoanRepository{}
+	mockPropertyRepo := &mpr.PropertyRepository{}
+	mockGroupRepo := &mgr.GroupRepository{}
+	mockIDGen := &mig.IDGenerator{}
+
+	var loanService LoanService = NewLoanServiceImpl(
+		mockLoanRepo,
+		mockPropertyRepo,
+		mockGroupRepo,
+		mockIDGen,
+	)
+
+	today := currentDate()
+	loanedOn := today.AddDate(0, 0, -5)
+
+	findProperty := func() {
+		mockPropertyRepo.On(
+			"FindByID",
+			mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+			mock.AnythingOfType(fmt.Sprintf("%T", "")),
+		).Return(
+			func(ctx context.Context, id string) entity.Property {
+				return entity.Property{ID: id, GroupID: "g-xyz", Amount: 10}
+			},
+			func(ctx context.Context, id string) error {
+				return nil
+			},
+		).Once()
+	}
+
+	findLoan := func(returnedOn *time.Time) {
+		mockLoanRepo.On(
+			"FindByID",
+			mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+			mock.AnythingOfType(fmt.Sprintf("%T", "")),
+			mock.AnythingOfType(fmt.Sprintf("%T", "")),
+		).Return(
+			func(ctx context.Context, propertyID, id string) entity.Loan {
+				return entity.Loan{ID: id, PropertyID: propertyID, Quantity: 2, LoanedOn: loanedOn, ReturnedOn: returnedOn}
+			},
+			func(ctx context.Context, propertyID, id string) error {
+				return nil
+			},
+		).Once()
+	}
+
+	testCases := []struct {
+		name           string
+		inputPayload   payload.ReturnLoan
+		expectedError  error
+		mockBehaviours func()
+	}{
+		{
+			name:           "it should return service.ErrDateParsing error, when returned date is not a date",
+			inputPayload:   payload.ReturnLoan{ReturnedOn: "yesterday"},
+			expectedError:  service.ErrDateParsing,
+			mockBehaviours: func() {},
+		},
+		{
+			name:          "it should return service.ErrDataNotFound error, when loan repository return an error",
+			expectedError: service.ErrDataNotFound,
+			mockBehaviours: func() {
+				findProperty()
+
+				mockLoanRepo.On(
+					"FindByID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, propertyID, id string) entity.Loan {
+						return entity.Loan{}
+					},
+					func(ctx context.Context, propertyID, id string) error {
+						return repository.ErrRecordNotFound
+					},
+				).Once()
+			},
+		},
+		{
+			name:          "it should return service.ErrLoanReturned error, when the loan has already been returned",
+			expectedError: service.ErrLoanReturned,
+			mockBehaviours: func() {
+				findProperty()
+				findLoan(&today)
+			},
+		},
+		{
+			name:          "it should return service.ErrInvalidPayload error, when returned date is before the loan",
+			inputPayload:  payload.ReturnLoan{ReturnedOn: loanedOn.AddDate(0, 0, -1).Format(dateLayout)},
+			expectedError: service.ErrInvalidPayload,
+			mockBehaviours: func() {
+				findProperty()
+				findLoan(nil)
+			},
+		},
+		{
+			name:          "it should return service.ErrInvalidPayload error, when returned date is in the future",
+			inputPayload:  payload.ReturnLoan{ReturnedOn: today.AddDate(0, 0, 1).Format(dateLayout)},
+			expectedError: service.ErrInvalidPayload,
+			mockBehaviours: func() {
+				findProperty()
+				findLoan(nil)
+			},
+		},
+		{
+			name:          "it should return service.ErrLoanReturned error, when the loan is returned in the meantime",
+			expectedError: service.ErrLoanReturned,
+			mockBehaviours: func() {
+				findProperty()
+				findLoan(nil)
+
+				mockLoanRepo.On(
+					"Return",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", time.Time{})),
+				).Return(
+					func(ctx context.Context, propertyID, id string, returnedOn time.Time) error {
+						return repository.ErrRecordNotFound
+					},
+				).Once()
+			},
+		},
+		{
+			name:          "it should return service.ErrRepository error, when loan repository fails to return the loan",
+			expectedError: service.ErrRepository,
+			mockBehaviours: func() {
+				findProperty()
+				findLoan(nil)
+
+				mockLoanRepo.On(
+					"Return",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", time.Time{})),
+				).Return(
+					func(ctx context.Context, propertyID, id string, returnedOn time.Time) error {
+						return errors.New("connection reset")
+					},
+				).Once()
+			},
+		},
+		{
+			name:          "it should return nil error, when the loan is returned on the given date",
+			inputPayload:  payload.ReturnLoan{ReturnedOn: loanedOn.Format(dateLayout)},
+			expectedError: nil,
+			mockBehaviours: func() {
+				findProperty()
+				findLoan(nil)
+
+				mockLoanRepo.On(
+					"Return",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.MatchedBy(func(returnedOn time.Time) bool {
+						return returnedOn.Equal(loanedOn)
+					}),
+				).Return(
+					func(ctx context.Context, propertyID, id string, returnedOn time.Time) error {
+						return nil
+					},
+				).Once()
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.mockBehaviours()
+			gotErr := loanService.Return(context.Background(), "g-xyz", "p-xyz", "o-aBcdEfG", testCase.inputPayload)
+
+			if testCase.expectedError != nil {
+				assert.ErrorIs(t, gotErr, testCase.expectedError)
+			} else {
+				assert.NoError(t, gotErr)
+			}
+		})
+	}
+}
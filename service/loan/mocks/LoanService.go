@@ -0,0 +1,72 @@
+// Code generated by mockery v2.10.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	payload "github.com/erikrios/reog-apps-apis/model/payload"
+	response "github.com/erikrios/reog-apps-apis/model/response"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// LoanService is an autogenerated mock type for the LoanService type
+type LoanService struct {
+	mock.Mock
+}
+
+// Create provides a mock function with given fields: ctx, groupID, propertyID, p
+func (_m *LoanService) Create(ctx context.Context, groupID string, propertyID string, p payload.CreateLoan) (string, error) {
+	ret := _m.Called(ctx, groupID, propertyID, p)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, payload.CreateLoan) string); ok {
+		r0 = rf(ctx, groupID, propertyID, p)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, payload.CreateLoan) error); ok {
+		r1 = rf(ctx, groupID, propertyID, p)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetByPropertyID provides a mock function with given fields: ctx, groupID, propertyID
+func (_m *LoanService) GetByPropertyID(ctx context.Context, groupID string, propertyID string) (response.PropertyLoans, error) {
+	ret := _m.Called(ctx, groupID, propertyID)
+
+	var r0 response.PropertyLoans
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) response.PropertyLoans); ok {
+		r0 = rf(ctx, groupID, propertyID)
+	} else {
+		r0 = ret.Get(0).(response.PropertyLoans)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, groupID, propertyID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Return provides a mock function with given fields: ctx, groupID, propertyID, id, p
+func (_m *LoanService) Return(ctx context.Context, groupID string, propertyID string, id string, p payload.ReturnLoan) error {
+	ret := _m.Called(ctx, groupID, propertyID, id, p)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, payload.ReturnLoan) error); ok {
+		r0 = rf(ctx, groupID, propertyID, id, p)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
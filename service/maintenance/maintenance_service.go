@@ -0,0 +1,18 @@
+package maintenance
+
+import (
+	"context"
+
+	"github.com/erikrios/reog-apps-apis/model/payload"
+	"github.com/erikrios/reog-apps-apis/model/response"
+)
+
+type MaintenanceService interface {
+	Create(ctx context.Context, groupID, propertyID string, p payload.CreateMaintenance) (id string, err error)
+	GetByPropertyID(ctx context.Context, groupID, propertyID string) (responses []response.Maintenance, err error)
+	GetByID(ctx context.Context, groupID, propertyID, id string) (response response.Maintenance, err error)
+	Update(ctx context.Context, groupID, propertyID, id string, p payload.UpdateMaintenance) (err error)
+	Delete(ctx context.Context, groupID, propertyID, id string) (err error)
+	// GetPoorConditionReport lists the items across the regency which need a repair, as evidence for grant applications.
+	GetPoorConditionReport(ctx context.Context, p payload.GetPoorConditionReport) (responses []response.PoorConditionItem, err error)
+}
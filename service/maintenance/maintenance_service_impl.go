@@ -0,0 +1,241 @@
+package maintenance
+
+import (
+	"context"
+	"time"
+
+	"github.com/erikrios/reog-apps-apis/entity"
+	"github.com/erikrios/reog-apps-apis/model/payload"
+	"github.com/erikrios/reog-apps-apis/model/response"
+	"github.com/erikrios/reog-apps-apis/repository/maintenance"
+	"github.com/erikrios/reog-apps-apis/repository/property"
+	"github.com/erikrios/reog-apps-apis/service"
+	"github.com/erikrios/reog-apps-apis/utils/generator"
+	"gopkg.in/validator.v2"
+)
+
+const dateLayout = "2006-01-02"
+
+type maintenanceServiceImpl struct {
+	maintenanceRepository maintenance.MaintenanceRepository
+	propertyRepository    property.PropertyRepository
+	idGenerator           generator.IDGenerator
+}
+
+func NewMaintenanceServiceImpl(
+	maintenanceRepository maintenance.MaintenanceRepository,
+	propertyRepository property.PropertyRepository,
+	idGenerator generator.IDGenerator,
+) *maintenanceServiceImpl {
+	return &maintenanceServiceImpl{
+		maintenanceRepository: maintenanceRepository,
+		propertyRepository:    propertyRepository,
+		idGenerator:           idGenerator,
+	}
+}
+
+func (m *maintenanceServiceImpl) Create(ctx context.Context, groupID, propertyID string, p payload.CreateMaintenance) (id string, err error) {
+	if validateErr := validator.Validate(p); validateErr != nil {
+		err = service.ErrInvalidPayload
+		return
+	}
+
+	maintenance, err := newMaintenance(p.Condition, p.InspectedOn, p.DamageDescription, p.RepairedOn, p.Cost)
+	if err != nil {
+		return
+	}
+
+	if err = m.findProperty(ctx, groupID, propertyID); err != nil {
+		return
+	}
+
+	id, genErr := m.idGenerator.GenerateMaintenanceID()
+	if genErr != nil {
+		err = service.MapError(genErr)
+		return
+	}
+
+	maintenance.ID = id
+	maintenance.PropertyID = propertyID
+
+	if repoErr := m.maintenanceRepository.Insert(ctx, maintenance); repoErr != nil {
+		err = service.MapError(repoErr)
+	}
+	return
+}
+
+func (m *maintenanceServiceImpl) GetByPropertyID(ctx context.Context, groupID, propertyID string) (responses []response.Maintenance, err error) {
+	if err = m.findProperty(ctx, groupID, propertyID); err != nil {
+		return
+	}
+
+	maintenances, repoErr := m.maintenanceRepository.FindByPropertyID(ctx, propertyID)
+	if repoErr != nil {
+		err = service.MapError(repoErr)
+		return
+	}
+
+	responses = make([]response.Maintenance, len(maintenances))
+	for i, maintenance := range maintenances {
+		responses[i] = mapToModel(maintenance)
+	}
+	return
+}
+
+func (m *maintenanceServiceImpl) GetByID(ctx context.Context, groupID, propertyID, id string) (response response.Maintenance, err error) {
+	if err = m.findProperty(ctx, groupID, propertyID); err != nil {
+		return
+	}
+
+	maintenance, repoErr := m.maintenanceRepository.FindByID(ctx, propertyID, id)
+	if repoErr != nil {
+		err = service.MapError(repoErr)
+		return
+	}
+
+	response = mapToModel(maintenance)
+	return
+}
+
+func (m *maintenanceServiceImpl) Update(ctx context.Context, groupID, propertyID, id string, p payload.UpdateMaintenance) (err error) {
+	if validateErr := validator.Validate(p); validateErr != nil {
+		err = service.ErrInvalidPayload
+		return
+	}
+
+	maintenance, err := newMaintenance(p.Condition, p.InspectedOn, p.DamageDescription, p.RepairedOn, p.Cost)
+	if err != nil {
+		return
+	}
+
+	if err = m.findProperty(ctx, groupID, propertyID); err != nil {
+		return
+	}
+
+	if repoErr := m.maintenanceRepository.Update(ctx, propertyID, id, maintenance); repoErr != nil {
+		err = service.MapError(repoErr)
+	}
+	return
+}
+
+func (m *maintenanceServiceImpl) Delete(ctx context.Context, groupID, propertyID, id string) (err error) {
+	if err = m.findProperty(ctx, groupID, propertyID); err != nil {
+		return
+	}
+
+	if repoErr := m.maintenanceRepository.Delete(ctx, propertyID, id); repoErr != nil {
+		err = service.MapError(repoErr)
+	}
+	return
+}
+
+func (m *maintenanceServiceImpl) GetPoorConditionReport(ctx context.Context, p payload.GetPoorConditionReport) (responses []response.PoorConditionItem, err error) {
+	if validateErr := validator.Validate(p); validateErr != nil {
+		err = service.ErrInvalidPayload
+		return
+	}
+
+	items, repoErr := m.maintenanceRepository.FindPoorCondition(ctx, maintenance.ReportFilter{DistrictID: p.DistrictID})
+	if repoErr != nil {
+		err = service.MapError(repoErr)
+		return
+	}
+
+	responses = make([]response.PoorConditionItem, len(items))
+	for i, item := range items {
+		responses[i] = response.PoorConditionItem{
+			PropertyID:        item.PropertyID,
+			PropertyName:      item.PropertyName,
+			Amount:            item.Amount,
+			GroupID:           item.GroupID,
+			GroupName:         item.GroupName,
+			DistrictID:        item.DistrictID,
+			DistrictName:      item.DistrictName,
+			MaintenanceID:     item.MaintenanceID,
+			Condition:         item.Condition,
+			InspectedOn:       item.InspectedOn.Format(dateLayout),
+			DamageDescription: item.DamageDescription,
+		}
+	}
+	return
+}
+
+// findProperty finds the property, which is only found through the group it belongs to.
+func (m *maintenanceServiceImpl) findProperty(ctx context.Context, groupID, propertyID string) (err error) {
+	property, repoErr := m.propertyRepository.FindByID(ctx, propertyID)
+	if repoErr != nil {
+		err = service.MapError(repoErr)
+		return
+	}
+
+	if property.GroupID != groupID {
+		err = service.ErrDataNotFound
+	}
+	return
+}
+
+// newMaintenance parses the dates of the record. Neither the inspection nor the repair can be in the future, and
+// the repair cannot come before the inspection.
+func newMaintenance(condition, inspectedOn, damageDescription, repairedOn string, cost uint32) (maintenance entity.Maintenance, err error) {
+	today, _ := time.Parse(dateLayout, time.Now().Format(dateLayout))
+
+	inspectedDate, parseErr := time.Parse(dateLayout, inspectedOn)
+	if parseErr != nil {
+		err = service.ErrDateParsing
+		return
+	}
+	if inspectedDate.After(today) {
+		err = service.ErrInvalidPayload
+		return
+	}
+
+	maintenance = entity.Maintenance{
+		Condition:         condition,
+		InspectedOn:       inspectedDate,
+		DamageDescription: damageDescription,
+		Cost:              cost,
+	}
+
+	if repairedOn != "" {
+		repairedDate, parseErr := time.Parse(dateLayout, repairedOn)
+		if parseErr != nil {
+			err = service.ErrDateParsing
+			return
+		}
+		if repairedDate.Before(inspectedDate) || repairedDate.After(today) {
+			err = service.ErrInvalidPayload
+			return
+		}
+
+		maintenance.RepairedOn = &repairedDate
+	}
+	return
+}
+
+func mapToModel(e entity.Maintenance) response.Maintenance {
+	maintenance := response.Maintenance{
+		ID:                e.ID,
+		PropertyID:        e.PropertyID,
+		Condition:         e.Condition,
+		InspectedOn:       e.InspectedOn.Format(dateLayout),
+		DamageDescription: e.DamageDescription,
+		Cost:              e.Cost,
+		Photos:            make([]response.Attachment, len(e.Photos)),
+	}
+
+	if e.RepairedOn != nil {
+		maintenance.RepairedOn = e.RepairedOn.Format(dateLayout)
+	}
+
+	for i, photo := range e.Photos {
+		maintenance.Photos[i] = response.Attachment{
+			ID:           photo.ID,
+			FileName:     photo.FileName,
+			ContentType:  photo.ContentType,
+			Size:         photo.Size,
+			URL:          photo.URL,
+			ThumbnailURL: photo.ThumbnailURL,
+		}
+	}
+	return maintenance
+}
@@ -0,0 +1,487 @@
+package maintenance
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/erikrios/reog-apps-apis/entity"
+	"github.com/erikrios/reog-apps-apis/model/payload"
+	"github.com/erikrios/reog-apps-apis/model/response"
+	"github.com/erikrios/reog-apps-apis/repository"
+	"github.com/erikrios/reog-apps-apis/repository/maintenance"
+	mmr "github.com/erikrios/reog-apps-apis/repository/maintenance/mocks"
+	mpr "github.com/erikrios/reog-apps-apis/repository/property/mocks"
+	"github.com/erikrios/reog-apps-apis/service"
+	mig "github.com/erikrios/reog-apps-apis/utils/generator/mocks"
+	_ "github.com/erikrios/reog-apps-apis/validation"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func onFindProperty(mockPropertyRepo *mpr.PropertyRepository, groupID string, err error) {
+	mockPropertyRepo.On(
+		"FindByID",
+		mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+		mock.AnythingOfType(fmt.Sprintf("%T", "")),
+	).Return(
+		func(ctx context.Context, id string) entity.Property {
+			return entity.Property{ID: id, GroupID: groupID}
+		},
+		func(ctx context.Context, id string) error {
+			return err
+		},
+	).Once()
+}
+
+func TestCreate(t *testing.T) {
+	mockMaintenanceRepo := &mmr.MaintenanceRepository{}
+	mockPropertyRepo := &mpr.PropertyRepository{}
+	mockIDGen := &mig.IDGenerator{}
+
+	var maintenanceService MaintenanceService = NewMaintenanceServiceImpl(
+		mockMaintenanceRepo,
+		mockPropertyRepo,
+		mockIDGen,
+	)
+
+	today := time.Now().Format(dateLayout)
+	tomorrow := time.Now().AddDate(0, 0, 1).Format(dateLayout)
+
+	validPayload := payload.CreateMaintenance{
+		Condition:         "poor",
+		InspectedOn:       "2022-06-01",
+		DamageDescription: "Bulu merak barongan rontok di bagian kiri",
+	}
+
+	testCases := []struct {
+		name             string
+		inputGroupID     string
+		inputMaintenance payload.CreateMaintenance
+		expectedID       string
+		expectedError    error
+		mockBehaviours   func()
+	}{
+		{
+			name:             "it should return service.ErrInvalidPayload error, when condition is invalid",
+			inputGroupID:     "g-xyz",
+			inputMaintenance: payload.CreateMaintenance{Condition: "bad", InspectedOn: "2022-06-01"},
+			expectedError:    service.ErrInvalidPayload,
+			mockBehaviours:   func() {},
+		},
+		{
+			name:             "it should return service.ErrDateParsing error, when inspected date is not a date",
+			inputGroupID:     "g-xyz",
+			inputMaintenance: payload.CreateMaintenance{Condition: "poor", InspectedOn: "01/06/2022"},
+			expectedError:    service.ErrDateParsing,
+			mockBehaviours:   func() {},
+		},
+		{
+			name:             "it should return service.ErrInvalidPayload error, when inspected date is in the future",
+			inputGroupID:     "g-xyz",
+			inputMaintenance: payload.CreateMaintenance{Condition: "poor", InspectedOn: tomorrow},
+			expectedError:    service.ErrInvalidPayload,
+			mockBehaviours:   func() {},
+		},
+		{
+			name:             "it should return service.ErrInvalidPayload error, when repaired date is before inspected date",
+			inputGroupID:     "g-xyz",
+			inputMaintenance: payload.CreateMaintenance{Condition: "poor", InspectedOn: "2022-06-01", RepairedOn: "2022-05-31"},
+			expectedError:    service.ErrInvalidPayload,
+			mockBehaviours:   func() {},
+		},
+		{
+			name:             "it should return service.ErrDataNotFound error, when the property belongs to another group",
+			inputGroupID:     "g-xyz",
+			inputMaintenance: validPayload,
+			expectedError:    service.ErrDataNotFound,
+			mockBehaviours: func() {
+				onFindProperty(mockPropertyRepo, "g-abc", nil)
+			},
+		},
+		{
+			name:             "it should return a valid ID, when no error is returned",
+			inputGroupID:     "g-xyz",
+			inputMaintenance: payload.CreateMaintenance{Condition: "poor", InspectedOn: "2022-06-01", RepairedOn: today, Cost: 750000},
+			expectedID:       "i-aBcdEfG",
+			expectedError:    nil,
+			mockBehaviours: func() {
+				onFindProperty(mockPropertyRepo, "g-xyz", nil)
+
+				mockIDGen.On("GenerateMaintenanceID").Return(
+					func() string {
+						return "i-aBcdEfG"
+					},
+					func() error {
+						return nil
+					},
+				).Once()
+
+				mockMaintenanceRepo.On(
+					"Insert",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.MatchedBy(func(maintenance entity.Maintenance) bool {
+						return maintenance.ID == "i-aBcdEfG" &&
+							maintenance.PropertyID == "p-xyz" &&
+							maintenance.Condition == entity.ConditionPoor &&
+							maintenance.RepairedOn != nil && maintenance.RepairedOn.Format(dateLayout) == today &&
+							maintenance.Cost == 750000
+					}),
+				).Return(
+					func(ctx context.Context, maintenance entity.Maintenance) error {
+						return nil
+					},
+				).Once()
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.mockBehaviours()
+			gotID, gotErr := maintenanceService.Create(context.Background(), testCase.inputGroupID, "p-xyz", testCase.inputMaintenance)
+
+			if testCase.expectedError != nil {
+				assert.ErrorIs(t, gotErr, testCase.expectedError)
+			} else {
+				assert.NoError(t, gotErr)
+				assert.Equal(t, testCase.expectedID, gotID)
+			}
+		})
+	}
+}
+
+func TestGetByPropertyID(t *testing.T) {
+	mockMaintenanceRepo := &mmr.MaintenanceRepository{}
+	mockPropertyRepo := &mpr.PropertyRepository{}
+	mockIDGen := &mig.IDGenerator{}
+
+	var maintenanceService MaintenanceService = NewMaintenanceServiceImpl(
+		mockMaintenanceRepo,
+		mockPropertyRepo,
+		mockIDGen,
+	)
+
+	repairedOn := time.Date(2022, 6, 10, 0, 0, 0, 0, time.UTC)
+	dummyMaintenances := []entity.Maintenance{
+		{
+			ID:                "i-aBcdEfG",
+			PropertyID:        "p-xyz",
+			Condition:         entity.ConditionPoor,
+			InspectedOn:       time.Date(2022, 6, 1, 0, 0, 0, 0, time.UTC),
+			DamageDescription: "Bulu merak barongan rontok di bagian kiri",
+			RepairedOn:        &repairedOn,
+			Cost:              750000,
+			Photos: []entity.Attachment{
+				{ID: "f-hIjkLmN", FileName: "barongan.jpg", ContentType: "image/jpeg", Size: 1024, URL: "/uploads/maintenances/i-aBcdEfG/f-hIjkLmN.jpg"},
+			},
+		},
+	}
+
+	testCases := []struct {
+		name           string
+		expected       []response.Maintenance
+		expectedError  error
+		mockBehaviours func()
+	}{
+		{
+			name:          "it should return service.ErrDataNotFound error, when property repository return an error",
+			expectedError: service.ErrDataNotFound,
+			mockBehaviours: func() {
+				onFindProperty(mockPropertyRepo, "", repository.ErrRecordNotFound)
+			},
+		},
+		{
+			name: "it should return the maintenance records, when no error is returned",
+			expected: []response.Maintenance{
+				{
+					ID:                "i-aBcdEfG",
+					PropertyID:        "p-xyz",
+					Condition:         "poor",
+					InspectedOn:       "2022-06-01",
+					DamageDescription: "Bulu merak barongan rontok di bagian kiri",
+					RepairedOn:        "2022-06-10",
+					Cost:              750000,
+					Photos: []response.Attachment{
+						{ID: "f-hIjkLmN", FileName: "barongan.jpg", ContentType: "image/jpeg", Size: 1024, URL: "/uploads/maintenances/i-aBcdEfG/f-hIjkLmN.jpg"},
+					},
+				},
+			},
+			mockBehaviours: func() {
+				onFindProperty(mockPropertyRepo, "g-xyz", nil)
+
+				mockMaintenanceRepo.On(
+					"FindByPropertyID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					"p-xyz",
+				).Return(
+					func(ctx context.Context, propertyID string) []entity.Maintenance {
+						return dummyMaintenances
+					},
+					func(ctx context.Context, propertyID string) error {
+						return nil
+					},
+				).Once()
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.mockBehaviours()
+			got, gotErr := maintenanceService.GetByPropertyID(context.Background(), "g-xyz", "p-xyz")
+
+			if testCase.expectedError != nil {
+				assert.ErrorIs(t, gotErr, testCase.expectedError)
+			} else {
+				assert.NoError(t, gotErr)
+				assert.Equal(t, testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	mockMaintenanceRepo := &mmr.MaintenanceRepository{}
+	mockPropertyRepo := &mpr.PropertyRepository{}
+	mockIDGen := &mig.IDGenerator{}
+
+	var maintenanceService MaintenanceService = NewMaintenanceServiceImpl(
+		mockMaintenanceRepo,
+		mockPropertyRepo,
+		mockIDGen,
+	)
+
+	testCases := []struct {
+		name           string
+		inputPayload   payload.UpdateMaintenance
+		expectedError  error
+		mockBehaviours func()
+	}{
+		{
+			name:           "it should return service.ErrInvalidPayload error, when inspected date is empty",
+			inputPayload:   payload.UpdateMaintenance{Condition: "fair"},
+			expectedError:  service.ErrInvalidPayload,
+			mockBehaviours: func() {},
+		},
+		{
+			name:          "it should return service.ErrDataNotFound error, when maintenance repository return an error",
+			inputPayload:  payload.UpdateMaintenance{Condition: "fair", InspectedOn: "2022-06-01"},
+			expectedError: service.ErrDataNotFound,
+			mockBehaviours: func() {
+				onFindProperty(mockPropertyRepo, "g-xyz", nil)
+
+				mockMaintenanceRepo.On(
+					"Update",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					"p-xyz",
+					"i-aBcdEfG",
+					mock.AnythingOfType(fmt.Sprintf("%T", entity.Maintenance{})),
+				).Return(
+					func(ctx context.Context, propertyID string, id string, maintenance entity.Maintenance) error {
+						return repository.ErrRecordNotFound
+					},
+				).Once()
+			},
+		},
+		{
+			name:          "it should return nil error, when no error is returned",
+			inputPayload:  payload.UpdateMaintenance{Condition: "good", InspectedOn: "2022-06-01", RepairedOn: "2022-06-10", Cost: 750000},
+			expectedError: nil,
+			mockBehaviours: func() {
+				onFindProperty(mockPropertyRepo, "g-xyz", nil)
+
+				mockMaintenanceRepo.On(
+					"Update",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					"p-xyz",
+					"i-aBcdEfG",
+					mock.MatchedBy(func(maintenance entity.Maintenance) bool {
+						return maintenance.Condition == entity.ConditionGood && maintenance.RepairedOn != nil
+					}),
+				).Return(
+					func(ctx context.Context, propertyID string, id string, maintenance entity.Maintenance) error {
+						return nil
+					},
+				).Once()
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.mockBehaviours()
+			gotErr := maintenanceService.Update(context.Background(), "g-xyz", "p-xyz", "i-aBcdEfG", testCase.inputPayload)
+
+			if testCase.expectedError != nil {
+				assert.ErrorIs(t, gotErr, testCase.expectedError)
+			} else {
+				assert.NoError(t, gotErr)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	mockMaintenanceRepo := &mmr.MaintenanceRepository{}
+	mockPropertyRepo := &mpr.PropertyRepository{}
+	mockIDGen := &mig.IDGenerator{}
+
+	var maintenanceService MaintenanceService = NewMaintenanceServiceImpl(
+		mockMaintenanceRepo,
+		mockPropertyRepo,
+		mockIDGen,
+	)
+
+	testCases := []struct {
+		name           string
+		expectedError  error
+		mockBehaviours func()
+	}{
+		{
+			name:          "it should return service.ErrDataNotFound error, when the property belongs to another group",
+			expectedError: service.ErrDataNotFound,
+			mockBehaviours: func() {
+				onFindProperty(mockPropertyRepo, "g-abc", nil)
+			},
+		},
+		{
+			name:          "it should return nil error, when no error is returned",
+			expectedError: nil,
+			mockBehaviours: func() {
+				onFindProperty(mockPropertyRepo, "g-xyz", nil)
+
+				mockMaintenanceRepo.On(
+					"Delete",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					"p-xyz",
+					"i-aBcdEfG",
+				).Return(
+					func(ctx context.Context, propertyID string, id string) error {
+						return nil
+					},
+				).Once()
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.mockBehaviours()
+			gotErr := maintenanceService.Delete(context.Background(), "g-xyz", "p-xyz", "i-aBcdEfG")
+
+			if testCase.expectedError != nil {
+				assert.ErrorIs(t, gotErr, testCase.expectedError)
+			} else {
+				assert.NoError(t, gotErr)
+			}
+		})
+	}
+}
+
+func TestGetPoorConditionReport(t *testing.T) {
+	mockMaintenanceRepo := &mmr.MaintenanceRepository{}
+	mockPropertyRepo := &mpr.PropertyRepository{}
+	mockIDGen := &mig.IDGenerator{}
+
+	var maintenanceService MaintenanceService = NewMaintenanceServiceImpl(
+		mockMaintenanceRepo,
+		mockPropertyRepo,
+		mockIDGen,
+	)
+
+	testCases := []struct {
+		name           string
+		inputPayload   payload.GetPoorConditionReport
+		expected       []response.PoorConditionItem
+		expectedError  error
+		mockBehaviours func()
+	}{
+		{
+			name:           "it should return service.ErrInvalidPayload error, when district ID is too long",
+			inputPayload:   payload.GetPoorConditionReport{DistrictID: "35.02.010.2001"},
+			expectedError:  service.ErrInvalidPayload,
+			mockBehaviours: func() {},
+		},
+		{
+			name:          "it should return service.ErrRepository error, when maintenance repository return an error",
+			expectedError: service.ErrRepository,
+			mockBehaviours: func() {
+				mockMaintenanceRepo.On(
+					"FindPoorCondition",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					maintenance.ReportFilter{},
+				).Return(
+					func(ctx context.Context, filter maintenance.ReportFilter) []maintenance.PoorConditionItem {
+						return nil
+					},
+					func(ctx context.Context, filter maintenance.ReportFilter) error {
+						return repository.ErrDatabase
+					},
+				).Once()
+			},
+		},
+		{
+			name:         "it should return the report of the district, when no error is returned",
+			inputPayload: payload.GetPoorConditionReport{DistrictID: "3502030"},
+			expected: []response.PoorConditionItem{
+				{
+					PropertyID:        "p-xyz",
+					PropertyName:      "Barongan",
+					Amount:            2,
+					GroupID:           "g-xyz",
+					GroupName:         "Paguyuban Reog Sardulo Nareswara",
+					DistrictID:        "3502030",
+					DistrictName:      "Sampung",
+					MaintenanceID:     "i-aBcdEfG",
+					Condition:         "broken",
+					InspectedOn:       "2022-06-01",
+					DamageDescription: "Rangka bambu patah",
+				},
+			},
+			mockBehaviours: func() {
+				mockMaintenanceRepo.On(
+					"FindPoorCondition",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					maintenance.ReportFilter{DistrictID: "3502030"},
+				).Return(
+					func(ctx context.Context, filter maintenance.ReportFilter) []maintenance.PoorConditionItem {
+						return []maintenance.PoorConditionItem{
+							{
+								PropertyID:        "p-xyz",
+								PropertyName:      "Barongan",
+								Amount:            2,
+								GroupID:           "g-xyz",
+								GroupName:         "Paguyuban Reog Sardulo Nareswara",
+								DistrictID:        "3502030",
+								DistrictName:      "Sampung",
+								MaintenanceID:     "i-aBcdEfG",
+								Condition:         entity.ConditionBroken,
+								InspectedOn:       time.Date(2022, 6, 1, 0, 0, 0, 0, time.UTC),
+								DamageDescription: "Rangka bambu patah",
+							},
+						}
+					},
+					func(ctx context.Context, filter maintenance.ReportFilter) error {
+						return nil
+					},
+				).Once()
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.mockBehaviours()
+			got, gotErr := maintenanceService.GetPoorConditionReport(context.Background(), testCase.inputPayload)
+
+			if testCase.expectedError != nil {
+				assert.ErrorIs(t, gotErr, testCase.expectedError)
+			} else {
+				assert.NoError(t, gotErr)
+				assert.Equal(t, testCase.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,132 @@
+// Code generated by mockery v2.10.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	payload "github.com/erikrios/reog-apps-apis/model/payload"
+	response "github.com/erikrios/reog-apps-apis/model/response"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MaintenanceService is an autogenerated mock type for the MaintenanceService type
+type MaintenanceService struct {
+	mock.Mock
+}
+
+// Create provides a mock function with given fields: ctx, groupID, propertyID, p
+func (_m *MaintenanceService) Create(ctx context.Context, groupID string, propertyID string, p payload.CreateMaintenance) (string, error) {
+	ret := _m.Called(ctx, groupID, propertyID, p)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, payload.CreateMaintenance) string); ok {
+		r0 = rf(ctx, groupID, propertyID, p)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, payload.CreateMaintenance) error); ok {
+		r1 = rf(ctx, groupID, propertyID, p)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Delete provides a mock function with given fields: ctx, groupID, propertyID, id
+func (_m *MaintenanceService) Delete(ctx context.Context, groupID string, propertyID string, id string) error {
+	ret := _m.Called(ctx, groupID, propertyID, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) error); ok {
+		r0 = rf(ctx, groupID, propertyID, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetByID provides a mock function with given fields: ctx, groupID, propertyID, id
+func (_m *MaintenanceService) GetByID(ctx context.Context, groupID string, propertyID string, id string) (response.Maintenance, error) {
+	ret := _m.Called(ctx, groupID, propertyID, id)
+
+	var r0 response.Maintenance
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) response.Maintenance); ok {
+		r0 = rf(ctx, groupID, propertyID, id)
+	} else {
+		r0 = ret.Get(0).(response.Maintenance)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = rf(ctx, groupID, propertyID, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetByPropertyID provides a mock function with given fields: ctx, groupID, propertyID
+func (_m *MaintenanceService) GetByPropertyID(ctx context.Context, groupID string, propertyID string) ([]response.Maintenance, error) {
+	ret := _m.Called(ctx, groupID, propertyID)
+
+	var r0 []response.Maintenance
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) []response.Maintenance); ok {
+		r0 = rf(ctx, groupID, propertyID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]response.Maintenance)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, groupID, propertyID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetPoorConditionReport provides a mock function with given fields: ctx, p
+func (_m *MaintenanceService) GetPoorConditionReport(ctx context.Context, p payload.GetPoorConditionReport) ([]response.PoorConditionItem, error) {
+	ret := _m.Called(ctx, p)
+
+	var r0 []response.PoorConditionItem
+	if rf, ok := ret.Get(0).(func(context.Context, payload.GetPoorConditionReport) []response.PoorConditionItem); ok {
+		r0 = rf(ctx, p)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]response.PoorConditionItem)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, payload.GetPoorConditionReport) error); ok {
+		r1 = rf(ctx, p)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Update provides a mock function with given fields: ctx, groupID, propertyID, id, p
+func (_m *MaintenanceService) Update(ctx context.Context, groupID string, propertyID string, id string, p payload.UpdateMaintenance) error {
+	ret := _m.Called(ctx, groupID, propertyID, id, p)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, payload.UpdateMaintenance) error); ok {
+		r0 = rf(ctx, groupID, propertyID, id, p)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
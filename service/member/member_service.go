@@ -0,0 +1,16 @@
+package member
+
+import (
+	"context"
+
+	"github.com/erikrios/reog-apps-apis/model/payload"
+	"github.com/erikrios/reog-apps-apis/model/response"
+)
+
+type MemberService interface {
+	Create(ctx context.Context, groupID string, p payload.CreateMember) (id string, err error)
+	GetByGroupID(ctx context.Context, groupID string) (responses []response.Member, err error)
+	GetByID(ctx context.Context, groupID, id string) (response response.Member, err error)
+	Update(ctx context.Context, groupID, id string, p payload.UpdateMember) (err error)
+	Delete(ctx context.Context, groupID, id string) (err error)
+}
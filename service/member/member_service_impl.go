@@ -0,0 +1,152 @@
+package member
+
+import (
+	"context"
+	"time"
+
+	"github.com/erikrios/reog-apps-apis/entity"
+	"github.com/erikrios/reog-apps-apis/model/payload"
+	"github.com/erikrios/reog-apps-apis/model/response"
+	"github.com/erikrios/reog-apps-apis/repository/group"
+	"github.com/erikrios/reog-apps-apis/repository/member"
+	"github.com/erikrios/reog-apps-apis/service"
+	"github.com/erikrios/reog-apps-apis/utils/generator"
+	"gopkg.in/validator.v2"
+)
+
+const dateLayout = "2006-01-02"
+
+type memberServiceImpl struct {
+	memberRepository member.MemberRepository
+	groupRepository  group.GroupRepository
+	idGenerator      generator.IDGenerator
+}
+
+func NewMemberServiceImpl(
+	memberRepository member.MemberRepository,
+	groupRepository group.GroupRepository,
+	idGenerator generator.IDGenerator,
+) *memberServiceImpl {
+	return &memberServiceImpl{
+		memberRepository: memberRepository,
+		groupRepository:  groupRepository,
+		idGenerator:      idGenerator,
+	}
+}
+
+func (m *memberServiceImpl) Create(ctx context.Context, groupID string, p payload.CreateMember) (id string, err error) {
+	if validateErr := validator.Validate(p); validateErr != nil || int(p.BirthYear) > time.Now().Year() {
+		err = service.ErrInvalidPayload
+		return
+	}
+
+	joinedOn, parseErr := time.Parse(dateLayout, p.JoinedOn)
+	if parseErr != nil {
+		err = service.ErrDateParsing
+		return
+	}
+
+	if _, repoErr := m.groupRepository.FindByID(ctx, groupID); repoErr != nil {
+		err = service.MapError(repoErr)
+		return
+	}
+
+	id, genErr := m.idGenerator.GenerateMemberID()
+	if genErr != nil {
+		err = service.MapError(genErr)
+		return
+	}
+
+	member := entity.Member{
+		ID:        id,
+		GroupID:   groupID,
+		Name:      p.Name,
+		Role:      p.Role,
+		Gender:    p.Gender,
+		BirthYear: p.BirthYear,
+		Phone:     p.Phone,
+		JoinedOn:  joinedOn,
+	}
+
+	if repoErr := m.memberRepository.Insert(ctx, member); repoErr != nil {
+		err = service.MapError(repoErr)
+	}
+	return
+}
+
+func (m *memberServiceImpl) GetByGroupID(ctx context.Context, groupID string) (responses []response.Member, err error) {
+	if _, repoErr := m.groupRepository.FindByID(ctx, groupID); repoErr != nil {
+		err = service.MapError(repoErr)
+		return
+	}
+
+	members, repoErr := m.memberRepository.FindByGroupID(ctx, groupID)
+	if repoErr != nil {
+		err = service.MapError(repoErr)
+		return
+	}
+
+	responses = make([]response.Member, len(members))
+	for i, member := range members {
+		responses[i] = mapToModel(member)
+	}
+	return
+}
+
+func (m *memberServiceImpl) GetByID(ctx context.Context, groupID, id string) (response response.Member, err error) {
+	member, repoErr := m.memberRepository.FindByID(ctx, groupID, id)
+	if repoErr != nil {
+		err = service.MapError(repoErr)
+		return
+	}
+
+	response = mapToModel(member)
+	return
+}
+
+func (m *memberServiceImpl) Update(ctx context.Context, groupID, id string, p payload.UpdateMember) (err error) {
+	if validateErr := validator.Validate(p); validateErr != nil || int(p.BirthYear) > time.Now().Year() {
+		err = service.ErrInvalidPayload
+		return
+	}
+
+	joinedOn, parseErr := time.Parse(dateLayout, p.JoinedOn)
+	if parseErr != nil {
+		err = service.ErrDateParsing
+		return
+	}
+
+	member := entity.Member{
+		Name:      p.Name,
+		Role:      p.Role,
+		Gender:    p.Gender,
+		BirthYear: p.BirthYear,
+		Phone:     p.Phone,
+		JoinedOn:  joinedOn,
+	}
+
+	if repoErr := m.memberRepository.Update(ctx, groupID, id, member); repoErr != nil {
+		err = service.MapError(repoErr)
+	}
+	return
+}
+
+func (m *memberServiceImpl) Delete(ctx context.Context, groupID, id string) (err error) {
+	if repoErr := m.memberRepository.Delete(ctx, groupID, id); repoErr != nil {
+		err = service.MapError(repoErr)
+	}
+	return
+}
+
+func mapToModel(e entity.Member) response.Member {
+	return response.Member{
+		ID:        e.ID,
+		GroupID:   e.GroupID,
+		Name:      e.Name,
+		Role:      e.Role,
+		Gender:    e.Gender,
+		BirthYear: e.BirthYear,
+		Phone:     e.Phone,
+		JoinedOn:  e.JoinedOn.Format(dateLayout),
+	}
+}
@@ -0,0 +1,631 @@
+package member
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/erikrios/reog-apps-apis/entity"
+	"github.com/erikrios/reog-apps-apis/model/payload"
+	"github.com/erikrios/reog-apps-apis/model/response"
+	"github.com/erikrios/reog-apps-apis/repository"
+	mgr "github.com/erikrios/reog-apps-apis/repository/group/mocks"
+	mmr "github.com/erikrios/reog-apps-apis/repository/member/mocks"
+	"github.com/erikrios/reog-apps-apis/service"
+	mig "github.com/erikrios/reog-apps-apis/utils/generator/mocks"
+	_ "github.com/erikrios/reog-apps-apis/validation"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCreate(t *testing.T) {
+	mockMemberRepo := &mmr.MemberRepository{}
+	mockGroupRepo := &mgr.GroupRepository{}
+	mockIDGen := &mig.IDGenerator{}
+
+	var memberService MemberService = NewMemberServiceImpl(
+		mockMemberRepo,
+		mockGroupRepo,
+		mockIDGen,
+	)
+
+	validPayload := payload.CreateMember{
+		Name:      "Erik Rio Setiawan",
+		Role:      "warok",
+		Gender:    "male",
+		BirthYear: 1998,
+		Phone:     "+6281234567890",
+		JoinedOn:  "2015-08-17",
+	}
+
+	testCases := []struct {
+		name              string
+		inputGroupID      string
+		inputCreateMember payload.CreateMember
+		expectedID        string
+		expectedError     error
+		mockBehaviours    func()
+	}{
+		{
+			name:         "it should return service.ErrInvalidPayload error, when role is invalid",
+			inputGroupID: "g-xyz",
+			inputCreateMember: payload.CreateMember{
+				Name:      "Erik Rio Setiawan",
+				Role:      "dancer",
+				Gender:    "male",
+				BirthYear: 1998,
+				JoinedOn:  "2015-08-17",
+			},
+			expectedError:  service.ErrInvalidPayload,
+			mockBehaviours: func() {},
+		},
+		{
+			name:         "it should return service.ErrInvalidPayload error, when birth year is in the future",
+			inputGroupID: "g-xyz",
+			inputCreateMember: payload.CreateMember{
+				Name:      "Erik Rio Setiawan",
+				Role:      "jathil",
+				Gender:    "female",
+				BirthYear: uint16(time.Now().Year() + 1),
+				JoinedOn:  "2015-08-17",
+			},
+			expectedError:  service.ErrInvalidPayload,
+			mockBehaviours: func() {},
+		},
+		{
+			name:         "it should return service.ErrDateParsing error, when joined on is invalid",
+			inputGroupID: "g-xyz",
+			inputCreateMember: payload.CreateMember{
+				Name:      "Erik Rio Setiawan",
+				Role:      "musician",
+				Gender:    "male",
+				BirthYear: 1998,
+				JoinedOn:  "17-08-2015",
+			},
+			expectedError:  service.ErrDateParsing,
+			mockBehaviours: func() {},
+		},
+		{
+			name:              "it should return service.ErrDataNotFound error, when group repository return an error",
+			inputGroupID:      "g-xyz",
+			inputCreateMember: validPayload,
+			expectedError:     service.ErrDataNotFound,
+			mockBehaviours: func() {
+				mockGroupRepo.On(
+					"FindByID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, id string) entity.Group {
+						return entity.Group{}
+					},
+					func(ctx context.Context, id string) error {
+						return repository.ErrRecordNotFound
+					},
+				).Once()
+			},
+		},
+		{
+			name:              "it should return service.ErrRepository error, when id generator return an error",
+			inputGroupID:      "g-xyz",
+			inputCreateMember: validPayload,
+			expectedError:     service.ErrRepository,
+			mockBehaviours: func() {
+				mockGroupRepo.On(
+					"FindByID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, id string) entity.Group {
+						return entity.Group{}
+					},
+					func(ctx context.Context, id string) error {
+						return nil
+					},
+				).Once()
+
+				mockIDGen.On("GenerateMemberID").Return(
+					func() string {
+						return ""
+					},
+					func() error {
+						return errors.New("error generate member id")
+					},
+				).Once()
+			},
+		},
+		{
+			name:              "it should return service.ErrRepository error, when member repository return an error",
+			inputGroupID:      "g-xyz",
+			inputCreateMember: validPayload,
+			expectedError:     service.ErrRepository,
+			mockBehaviours: func() {
+				mockGroupRepo.On(
+					"FindByID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, id string) entity.Group {
+						return entity.Group{}
+					},
+					func(ctx context.Context, id string) error {
+						return nil
+					},
+				).Once()
+
+				mockIDGen.On("GenerateMemberID").Return(
+					func() string {
+						return "m-aBcdEfG"
+					},
+					func() error {
+						return nil
+					},
+				).Once()
+
+				mockMemberRepo.On(
+					"Insert",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", entity.Member{})),
+				).Return(
+					func(ctx context.Context, member entity.Member) error {
+						return repository.ErrDatabase
+					},
+				).Once()
+			},
+		},
+		{
+			name:              "it should return a valid ID, when no error is returned",
+			inputGroupID:      "g-xyz",
+			inputCreateMember: validPayload,
+			expectedID:        "m-aBcdEfG",
+			expectedError:     nil,
+			mockBehaviours: func() {
+				mockGroupRepo.On(
+					"FindByID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, id string) entity.Group {
+						return entity.Group{}
+					},
+					func(ctx context.Context, id string) error {
+						return nil
+					},
+				).Once()
+
+				mockIDGen.On("GenerateMemberID").Return(
+					func() string {
+						return "m-aBcdEfG"
+					},
+					func() error {
+						return nil
+					},
+				).Once()
+
+				mockMemberRepo.On(
+					"Insert",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.MatchedBy(func(member entity.Member) bool {
+						return member.GroupID == "g-xyz" && member.JoinedOn.Equal(time.Date(2015, time.August, 17, 0, 0, 0, 0, time.UTC))
+					}),
+				).Return(
+					func(ctx context.Context, member entity.Member) error {
+						return nil
+					},
+				).Once()
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.mockBehaviours()
+			gotID, gotErr := memberService.Create(context.Background(), testCase.inputGroupID, testCase.inputCreateMember)
+
+			if testCase.expectedError != nil {
+				assert.ErrorIs(t, gotErr, testCase.expectedError)
+			} else {
+				assert.NoError(t, gotErr)
+				assert.Equal(t, testCase.expectedID, gotID)
+			}
+		})
+	}
+}
+
+func TestGetByGroupID(t *testing.T) {
+	mockMemberRepo := &mmr.MemberRepository{}
+	mockGroupRepo := &mgr.GroupRepository{}
+	mockIDGen := &mig.IDGenerator{}
+
+	var memberService MemberService = NewMemberServiceImpl(
+		mockMemberRepo,
+		mockGroupRepo,
+		mockIDGen,
+	)
+
+	testCases := []struct {
+		name            string
+		inputGroupID    string
+		expectedMembers []response.Member
+		expectedError   error
+		mockBehaviours  func()
+	}{
+		{
+			name:          "it should return service.ErrDataNotFound error, when group repository return an error",
+			inputGroupID:  "g-xyz",
+			expectedError: service.ErrDataNotFound,
+			mockBehaviours: func() {
+				mockGroupRepo.On(
+					"FindByID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, id string) entity.Group {
+						return entity.Group{}
+					},
+					func(ctx context.Context, id string) error {
+						return repository.ErrRecordNotFound
+					},
+				).Once()
+			},
+		},
+		{
+			name:          "it should return service.ErrRepository error, when member repository return an error",
+			inputGroupID:  "g-xyz",
+			expectedError: service.ErrRepository,
+			mockBehaviours: func() {
+				mockGroupRepo.On(
+					"FindByID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, id string) entity.Group {
+						return entity.Group{}
+					},
+					func(ctx context.Context, id string) error {
+						return nil
+					},
+				).Once()
+
+				mockMemberRepo.On(
+					"FindByGroupID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, groupID string) []entity.Member {
+						return []entity.Member{}
+					},
+					func(ctx context.Context, groupID string) error {
+						return repository.ErrDatabase
+					},
+				).Once()
+			},
+		},
+		{
+			name:         "it should return valid members, when no error is returned",
+			inputGroupID: "g-xyz",
+			expectedMembers: []response.Member{
+				{
+					ID:        "m-aBcdEfG",
+					GroupID:   "g-xyz",
+					Name:      "Erik Rio Setiawan",
+					Role:      "warok",
+					Gender:    "male",
+					BirthYear: 1998,
+					Phone:     "+6281234567890",
+					JoinedOn:  "2015-08-17",
+				},
+			},
+			expectedError: nil,
+			mockBehaviours: func() {
+				mockGroupRepo.On(
+					"FindByID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, id string) entity.Group {
+						return entity.Group{}
+					},
+					func(ctx context.Context, id string) error {
+						return nil
+					},
+				).Once()
+
+				mockMemberRepo.On(
+					"FindByGroupID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, groupID string) []entity.Member {
+						return []entity.Member{
+							{
+								ID:        "m-aBcdEfG",
+								GroupID:   "g-xyz",
+								Name:      "Erik Rio Setiawan",
+								Role:      "warok",
+								Gender:    "male",
+								BirthYear: 1998,
+								Phone:     "+6281234567890",
+								JoinedOn:  time.Date(2015, time.August, 17, 0, 0, 0, 0, time.UTC),
+							},
+						}
+					},
+					func(ctx context.Context, groupID string) error {
+						return nil
+					},
+				).Once()
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.mockBehaviours()
+			gotMembers, gotErr := memberService.GetByGroupID(context.Background(), testCase.inputGroupID)
+
+			if testCase.expectedError != nil {
+				assert.ErrorIs(t, gotErr, testCase.expectedError)
+			} else {
+				assert.NoError(t, gotErr)
+				assert.Equal(t, testCase.expectedMembers, gotMembers)
+			}
+		})
+	}
+}
+
+func TestGetByID(t *testing.T) {
+	mockMemberRepo := &mmr.MemberRepository{}
+	mockGroupRepo := &mgr.GroupRepository{}
+	mockIDGen := &mig.IDGenerator{}
+
+	var memberService MemberService = NewMemberServiceImpl(
+		mockMemberRepo,
+		mockGroupRepo,
+		mockIDGen,
+	)
+
+	testCases := []struct {
+		name           string
+		expectedMember response.Member
+		expectedError  error
+		mockBehaviours func()
+	}{
+		{
+			name:          "it should return service.ErrDataNotFound error, when member repository return an error",
+			expectedError: service.ErrDataNotFound,
+			mockBehaviours: func() {
+				mockMemberRepo.On(
+					"FindByID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, groupID string, id string) entity.Member {
+						return entity.Member{}
+					},
+					func(ctx context.Context, groupID string, id string) error {
+						return repository.ErrRecordNotFound
+					},
+				).Once()
+			},
+		},
+		{
+			name: "it should return a valid member, when no error is returned",
+			expectedMember: response.Member{
+				ID:        "m-aBcdEfG",
+				GroupID:   "g-xyz",
+				Name:      "Sri Wahyuni",
+				Role:      "jathil",
+				Gender:    "female",
+				BirthYear: 2001,
+				JoinedOn:  "2019-01-05",
+			},
+			expectedError: nil,
+			mockBehaviours: func() {
+				mockMemberRepo.On(
+					"FindByID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, groupID string, id string) entity.Member {
+						return entity.Member{
+							ID:        "m-aBcdEfG",
+							GroupID:   "g-xyz",
+							Name:      "Sri Wahyuni",
+							Role:      "jathil",
+							Gender:    "female",
+							BirthYear: 2001,
+							JoinedOn:  time.Date(2019, time.January, 5, 0, 0, 0, 0, time.UTC),
+						}
+					},
+					func(ctx context.Context, groupID string, id string) error {
+						return nil
+					},
+				).Once()
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.mockBehaviours()
+			gotMember, gotErr := memberService.GetByID(context.Background(), "g-xyz", "m-aBcdEfG")
+
+			if testCase.expectedError != nil {
+				assert.ErrorIs(t, gotErr, testCase.expectedError)
+			} else {
+				assert.NoError(t, gotErr)
+				assert.Equal(t, testCase.expectedMember, gotMember)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	mockMemberRepo := &mmr.MemberRepository{}
+	mockGroupRepo := &mgr.GroupRepository{}
+	mockIDGen := &mig.IDGenerator{}
+
+	var memberService MemberService = NewMemberServiceImpl(
+		mockMemberRepo,
+		mockGroupRepo,
+		mockIDGen,
+	)
+
+	validPayload := payload.UpdateMember{
+		Name:      "Erik Rio Setiawan",
+		Role:      "klono_sewandono",
+		Gender:    "male",
+		BirthYear: 1998,
+		JoinedOn:  "2015-08-17",
+	}
+
+	testCases := []struct {
+		name              string
+		inputUpdateMember payload.UpdateMember
+		expectedError     error
+		mockBehaviours    func()
+	}{
+		{
+			name: "it should return service.ErrInvalidPayload error, when phone is invalid",
+			inputUpdateMember: payload.UpdateMember{
+				Name:      "Erik Rio Setiawan",
+				Role:      "pembarong",
+				Gender:    "male",
+				BirthYear: 1998,
+				Phone:     "0812-abc",
+				JoinedOn:  "2015-08-17",
+			},
+			expectedError:  service.ErrInvalidPayload,
+			mockBehaviours: func() {},
+		},
+		{
+			name: "it should return service.ErrDateParsing error, when joined on is invalid",
+			inputUpdateMember: payload.UpdateMember{
+				Name:      "Erik Rio Setiawan",
+				Role:      "bujang_ganong",
+				Gender:    "male",
+				BirthYear: 1998,
+				JoinedOn:  "2015/08/17",
+			},
+			expectedError:  service.ErrDateParsing,
+			mockBehaviours: func() {},
+		},
+		{
+			name:              "it should return service.ErrDataNotFound error, when member repository return an error",
+			inputUpdateMember: validPayload,
+			expectedError:     service.ErrDataNotFound,
+			mockBehaviours: func() {
+				mockMemberRepo.On(
+					"Update",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", entity.Member{})),
+				).Return(
+					func(ctx context.Context, groupID string, id string, member entity.Member) error {
+						return repository.ErrRecordNotFound
+					},
+				).Once()
+			},
+		},
+		{
+			name:              "it should return nil error, when no error is returned",
+			inputUpdateMember: validPayload,
+			expectedError:     nil,
+			mockBehaviours: func() {
+				mockMemberRepo.On(
+					"Update",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", entity.Member{})),
+				).Return(
+					func(ctx context.Context, groupID string, id string, member entity.Member) error {
+						return nil
+					},
+				).Once()
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.mockBehaviours()
+			gotErr := memberService.Update(context.Background(), "g-xyz", "m-aBcdEfG", testCase.inputUpdateMember)
+
+			if testCase.expectedError != nil {
+				assert.ErrorIs(t, gotErr, testCase.expectedError)
+			} else {
+				assert.NoError(t, gotErr)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	mockMemberRepo := &mmr.MemberRepository{}
+	mockGroupRepo := &mgr.GroupRepository{}
+	mockIDGen := &mig.IDGenerator{}
+
+	var memberService MemberService = NewMemberServiceImpl(
+		mockMemberRepo,
+		mockGroupRepo,
+		mockIDGen,
+	)
+
+	testCases := []struct {
+		name           string
+		expectedError  error
+		mockBehaviours func()
+	}{
+		{
+			name:          "it should return service.ErrRepository error, when member repository return an error",
+			expectedError: service.ErrRepository,
+			mockBehaviours: func() {
+				mockMemberRepo.On(
+					"Delete",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, groupID string, id string) error {
+						return repository.ErrDatabase
+					},
+				).Once()
+			},
+		},
+		{
+			name:          "it should return nil error, when no error is returned",
+			expectedError: nil,
+			mockBehaviours: func() {
+				mockMemberRepo.On(
+					"Delete",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, groupID string, id string) error {
+						return nil
+					},
+				).Once()
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.mockBehaviours()
+			gotErr := memberService.Delete(context.Background(), "g-xyz", "m-aBcdEfG")
+
+			if testCase.expectedError != nil {
+				assert.ErrorIs(t, gotErr, testCase.expectedError)
+			} else {
+				assert.NoError(t, gotErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,109 @@
+// Code generated by mockery v2.10.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	payload "github.com/erikrios/reog-apps-apis/model/payload"
+	response "github.com/erikrios/reog-apps-apis/model/response"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MemberService is an autogenerated mock type for the MemberService type
+type MemberService struct {
+	mock.Mock
+}
+
+// Create provides a mock function with given fields: ctx, groupID, p
+func (_m *MemberService) Create(ctx context.Context, groupID string, p payload.CreateMember) (string, error) {
+	ret := _m.Called(ctx, groupID, p)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(context.Context, string, payload.CreateMember) string); ok {
+		r0 = rf(ctx, groupID, p)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, payload.CreateMember) error); ok {
+		r1 = rf(ctx, groupID, p)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Delete provides a mock function with given fields: ctx, groupID, id
+func (_m *MemberService) Delete(ctx context.Context, groupID string, id string) error {
+	ret := _m.Called(ctx, groupID, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, groupID, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetByGroupID provides a mock function with given fields: ctx, groupID
+func (_m *MemberService) GetByGroupID(ctx context.Context, groupID string) ([]response.Member, error) {
+	ret := _m.Called(ctx, groupID)
+
+	var r0 []response.Member
+	if rf, ok := ret.Get(0).(func(context.Context, string) []response.Member); ok {
+		r0 = rf(ctx, groupID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]response.Member)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, groupID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetByID provides a mock function with given fields: ctx, groupID, id
+func (_m *MemberService) GetByID(ctx context.Context, groupID string, id string) (response.Member, error) {
+	ret := _m.Called(ctx, groupID, id)
+
+	var r0 response.Member
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) response.Member); ok {
+		r0 = rf(ctx, groupID, id)
+	} else {
+		r0 = ret.Get(0).(response.Member)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, groupID, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Update provides a mock function with given fields: ctx, groupID, id, p
+func (_m *MemberService) Update(ctx context.Context, groupID string, id string, p payload.UpdateMember) error {
+	ret := _m.Called(ctx, groupID, id, p)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, payload.UpdateMember) error); ok {
+		r0 = rf(ctx, groupID, id, p)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
@@ -35,13 +35,13 @@ func (_m *PropertyService) Create(ctx context.Context, groupID string, p payload
 	return r0, r1
 }
 
-// Delete provides a mock function with given fields: ctx, id
-func (_m *PropertyService) Delete(ctx context.Context, id string) error {
-	ret := _m.Called(ctx, id)
+// Delete provides a mock function with given fields: ctx, id, version
+func (_m *PropertyService) Delete(ctx context.Context, id string, version int) error {
+	ret := _m.Called(ctx, id, version)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
-		r0 = rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) error); ok {
+		r0 = rf(ctx, id, version)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -72,13 +72,27 @@ func (_m *PropertyService) GenerateQRCode(ctx context.Context, id string) ([]byt
 	return r0, r1
 }
 
-// Update provides a mock function with given fields: ctx, id, p
-func (_m *PropertyService) Update(ctx context.Context, id string, p payload.UpdateProperty) error {
-	ret := _m.Called(ctx, id, p)
+// Patch provides a mock function with given fields: ctx, id, version, p
+func (_m *PropertyService) Patch(ctx context.Context, id string, version int, p payload.PatchProperty) error {
+	ret := _m.Called(ctx, id, version, p)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, payload.PatchProperty) error); ok {
+		r0 = rf(ctx, id, version, p)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Update provides a mock function with given fields: ctx, id, version, p
+func (_m *PropertyService) Update(ctx context.Context, id string, version int, p payload.UpdateProperty) error {
+	ret := _m.Called(ctx, id, version, p)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, payload.UpdateProperty) error); ok {
-		r0 = rf(ctx, id, p)
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, payload.UpdateProperty) error); ok {
+		r0 = rf(ctx, id, version, p)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -8,7 +8,8 @@ import (
 
 type PropertyService interface {
 	Create(ctx context.Context, groupID string, p payload.CreateProperty) (id string, err error)
-	Update(ctx context.Context, id string, p payload.UpdateProperty) (err error)
-	Delete(ctx context.Context, id string) (err error)
+	Update(ctx context.Context, id string, version int, p payload.UpdateProperty) (err error)
+	Patch(ctx context.Context, id string, version int, p payload.PatchProperty) (err error)
+	Delete(ctx context.Context, id string, version int) (err error)
 	GenerateQRCode(ctx context.Context, id string) (file []byte, err error)
 }
@@ -2,13 +2,16 @@ package property
 
 import (
 	"context"
+	"errors"
 
 	"github.com/erikrios/reog-apps-apis/entity"
 	"github.com/erikrios/reog-apps-apis/model/payload"
+	"github.com/erikrios/reog-apps-apis/repository"
 	"github.com/erikrios/reog-apps-apis/repository/group"
 	"github.com/erikrios/reog-apps-apis/repository/property"
 	"github.com/erikrios/reog-apps-apis/service"
 	"github.com/erikrios/reog-apps-apis/utils/generator"
+	"github.com/erikrios/reog-apps-apis/utils/qrsign"
 	"github.com/skip2/go-qrcode"
 	"gopkg.in/validator.v2"
 )
@@ -18,6 +21,8 @@ type propertyServiceImpl struct {
 	groupRepository    group.GroupRepository
 	idGenerator        generator.IDGenerator
 	qrCodeGenerator    generator.QRCodeGenerator
+	scanLinkGenerator  generator.ScanLinkGenerator
+	codeSigner         generator.CodeSigner
 }
 
 func NewPropertyServiceImpl(
@@ -25,12 +30,16 @@ func NewPropertyServiceImpl(
 	groupRepository group.GroupRepository,
 	idGenerator generator.IDGenerator,
 	qrCodeGenerator generator.QRCodeGenerator,
+	scanLinkGenerator generator.ScanLinkGenerator,
+	codeSigner generator.CodeSigner,
 ) *propertyServiceImpl {
 	return &propertyServiceImpl{
 		propertyRepository: propertyRepository,
 		groupRepository:    groupRepository,
 		idGenerator:        idGenerator,
 		qrCodeGenerator:    qrCodeGenerator,
+		scanLinkGenerator:  scanLinkGenerator,
+		codeSigner:         codeSigner,
 	}
 }
 
@@ -66,7 +75,7 @@ func (p *propertyServiceImpl) Create(ctx context.Context, groupID string, payloa
 	return
 }
 
-func (p *propertyServiceImpl) Update(ctx context.Context, id string, payload payload.UpdateProperty) (err error) {
+func (p *propertyServiceImpl) Update(ctx context.Context, id string, version int, payload payload.UpdateProperty) (err error) {
 	if validateErr := validator.Validate(payload); validateErr != nil {
 		err = service.ErrInvalidPayload
 		return
@@ -79,21 +88,66 @@ func (p *propertyServiceImpl) Update(ctx context.Context, id string, payload pay
 		Amount:      payload.Amount,
 	}
 
-	if repoErr := p.propertyRepository.Update(ctx, id, property); repoErr != nil {
+	if repoErr := p.propertyRepository.Update(ctx, id, version, property); repoErr != nil {
 		err = service.MapError(repoErr)
 	}
 	return
 }
 
-func (p *propertyServiceImpl) Delete(ctx context.Context, id string) (err error) {
-	if repoErr := p.propertyRepository.Delete(ctx, id); repoErr != nil {
+// Patch applies a merge patch to the property if it still has the given version. The repository leaves the zero
+// fields of the property untouched, so only the members present in the patch are written.
+func (p *propertyServiceImpl) Patch(ctx context.Context, id string, version int, payload payload.PatchProperty) (err error) {
+	if validateErr := validator.Validate(payload); validateErr != nil {
+		err = service.ErrInvalidPayload
+		return
+	}
+
+	property := entity.Property{ID: id}
+	if payload.Name != nil {
+		property.Name = *payload.Name
+	}
+	if payload.Description != nil {
+		property.Description = *payload.Description
+	}
+	if payload.Amount != nil {
+		property.Amount = *payload.Amount
+	}
+
+	if repoErr := p.propertyRepository.Update(ctx, id, version, property); repoErr != nil {
+		err = service.MapError(repoErr)
+	}
+	return
+}
+
+// Delete refuses to delete a property while some of its items are out on loan.
+func (p *propertyServiceImpl) Delete(ctx context.Context, id string, version int) (err error) {
+	if repoErr := p.propertyRepository.Delete(ctx, id, version); repoErr != nil {
+		if errors.Is(repoErr, repository.ErrRecordReferenced) {
+			err = service.ErrPropertyOnLoan
+			return
+		}
+
 		err = service.MapError(repoErr)
 	}
 	return
 }
 
+// GenerateQRCode signs the group of the property in the code too, so a property label cannot be moved to the
+// property of another group unnoticed.
 func (p *propertyServiceImpl) GenerateQRCode(ctx context.Context, id string) (file []byte, err error) {
-	file, genErr := p.qrCodeGenerator.GenerateQRCode(id, qrcode.Medium, 2048)
+	property, repoErr := p.propertyRepository.FindByID(ctx, id)
+	if repoErr != nil {
+		err = service.MapError(repoErr)
+		return
+	}
+
+	code, genErr := p.codeSigner.SignCode(qrsign.TypeProperty, id, property.GroupID)
+	if genErr != nil {
+		err = service.MapError(genErr)
+		return
+	}
+
+	file, genErr = p.qrCodeGenerator.GenerateQRCode(p.scanLinkGenerator.GenerateScanLink(code), qrcode.Medium, 2048)
 	if genErr != nil {
 		err = service.MapError(genErr)
 	}
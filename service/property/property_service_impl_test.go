@@ -14,6 +14,7 @@ import (
 	"github.com/erikrios/reog-apps-apis/service"
 	mig "github.com/erikrios/reog-apps-apis/utils/generator/mocks"
 	mqg "github.com/erikrios/reog-apps-apis/utils/generator/mocks"
+	"github.com/erikrios/reog-apps-apis/utils/qrsign"
 	"github.com/skip2/go-qrcode"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -24,12 +25,16 @@ func TestCreate(t *testing.T) {
 	mockGroupRepo := &mgr.GroupRepository{}
 	mockIDGen := &mig.IDGenerator{}
 	mockQRGen := &mqg.QRCodeGenerator{}
+	mockScanLinkGen := &mqg.ScanLinkGenerator{}
+	mockCodeSigner := &mqg.CodeSigner{}
 
 	var propertyService PropertyService = NewPropertyServiceImpl(
 		mockPropertyRepo,
 		mockGroupRepo,
 		mockIDGen,
 		mockQRGen,
+		mockScanLinkGen,
+		mockCodeSigner,
 	)
 
 	testCases := []struct {
@@ -222,12 +227,16 @@ func TestUpdate(t *testing.T) {
 	mockGroupRepo := &mgr.GroupRepository{}
 	mockIDGen := &mig.IDGenerator{}
 	mockQRGen := &mqg.QRCodeGenerator{}
+	mockScanLinkGen := &mqg.ScanLinkGenerator{}
+	mockCodeSigner := &mqg.CodeSigner{}
 
 	var propertyService PropertyService = NewPropertyServiceImpl(
 		mockPropertyRepo,
 		mockGroupRepo,
 		mockIDGen,
 		mockQRGen,
+		mockScanLinkGen,
+		mockCodeSigner,
 	)
 
 	testCases := []struct {
@@ -262,14 +271,61 @@ func TestUpdate(t *testing.T) {
 					"Update",
 					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
 					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					1,
 					mock.AnythingOfType(fmt.Sprintf("%T", entity.Property{})),
 				).Return(
-					func(ctx context.Context, id string, p entity.Property) error {
+					func(ctx context.Context, id string, version int, p entity.Property) error {
 						return repository.ErrRecordNotFound
 					},
 				).Once()
 			},
 		},
+		{
+			name:    "it should return service.ErrVersionMismatch error, when the property has been updated since the given version",
+			inputID: "p-Gx9LkMn",
+			inputUpdateProperty: payload.UpdateProperty{
+				Name:        "Dadak Merak",
+				Description: "Ini Deskripsi Dadak Merak",
+				Amount:      1,
+			},
+			expectedError: service.ErrVersionMismatch,
+			mockBehaviours: func() {
+				mockPropertyRepo.On(
+					"Update",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					1,
+					mock.AnythingOfType(fmt.Sprintf("%T", entity.Property{})),
+				).Return(
+					func(ctx context.Context, id string, version int, p entity.Property) error {
+						return repository.ErrRecordModified
+					},
+				).Once()
+			},
+		},
+		{
+			name:    "it should return service.ErrNotEnoughAvailable error, when the amount is lower than the quantity on loan",
+			inputID: "p-Gx9LkMn",
+			inputUpdateProperty: payload.UpdateProperty{
+				Name:        "Dadak Merak",
+				Description: "Ini Deskripsi Dadak Merak",
+				Amount:      1,
+			},
+			expectedError: service.ErrNotEnoughAvailable,
+			mockBehaviours: func() {
+				mockPropertyRepo.On(
+					"Update",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					1,
+					mock.AnythingOfType(fmt.Sprintf("%T", entity.Property{})),
+				).Return(
+					func(ctx context.Context, id string, version int, p entity.Property) error {
+						return repository.ErrNotEnoughQuantity
+					},
+				).Once()
+			},
+		},
 		{
 			name:    "it should return service.ErrRepository error, when property repository return an error",
 			inputID: "p-Gx9LkMn",
@@ -284,9 +340,10 @@ func TestUpdate(t *testing.T) {
 					"Update",
 					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
 					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					1,
 					mock.AnythingOfType(fmt.Sprintf("%T", entity.Property{})),
 				).Return(
-					func(ctx context.Context, id string, p entity.Property) error {
+					func(ctx context.Context, id string, version int, p entity.Property) error {
 						return repository.ErrDatabase
 					},
 				).Once()
@@ -306,9 +363,99 @@ func TestUpdate(t *testing.T) {
 					"Update",
 					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
 					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					1,
+					mock.AnythingOfType(fmt.Sprintf("%T", entity.Property{})),
+				).Return(
+					func(ctx context.Context, id string, version int, p entity.Property) error {
+						return nil
+					},
+				).Once()
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.mockBehaviours()
+
+			gotErr := propertyService.Update(context.Background(), testCase.inputID, 1, testCase.inputUpdateProperty)
+
+			if testCase.expectedError != nil {
+				assert.ErrorIs(t, gotErr, testCase.expectedError)
+			} else {
+				assert.NoError(t, gotErr)
+			}
+		})
+	}
+}
+
+func TestPatch(t *testing.T) {
+	mockPropertyRepo := &mpr.PropertyRepository{}
+	mockGroupRepo := &mgr.GroupRepository{}
+	mockIDGen := &mig.IDGenerator{}
+	mockQRGen := &mqg.QRCodeGenerator{}
+	mockScanLinkGen := &mqg.ScanLinkGenerator{}
+	mockCodeSigner := &mqg.CodeSigner{}
+
+	var propertyService PropertyService = NewPropertyServiceImpl(
+		mockPropertyRepo,
+		mockGroupRepo,
+		mockIDGen,
+		mockQRGen,
+		mockScanLinkGen,
+		mockCodeSigner,
+	)
+
+	name := "Dadak Merak"
+	var zeroAmount, amount uint16 = 0, 2
+
+	testCases := []struct {
+		name               string
+		inputID            string
+		inputPatchProperty payload.PatchProperty
+		expectedError      error
+		mockBehaviours     func()
+	}{
+		{
+			name:               "it should return service.ErrInvalidPayload error, when the amount is 0",
+			inputID:            "p-Gx9LkMn",
+			inputPatchProperty: payload.PatchProperty{Amount: &zeroAmount},
+			expectedError:      service.ErrInvalidPayload,
+			mockBehaviours:     func() {},
+		},
+		{
+			name:               "it should return service.ErrVersionMismatch error, when the property has been updated since the given version",
+			inputID:            "p-Gx9LkMn",
+			inputPatchProperty: payload.PatchProperty{Amount: &amount},
+			expectedError:      service.ErrVersionMismatch,
+			mockBehaviours: func() {
+				mockPropertyRepo.On(
+					"Update",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					1,
 					mock.AnythingOfType(fmt.Sprintf("%T", entity.Property{})),
 				).Return(
-					func(ctx context.Context, id string, p entity.Property) error {
+					func(ctx context.Context, id string, version int, p entity.Property) error {
+						return repository.ErrRecordModified
+					},
+				).Once()
+			},
+		},
+		{
+			name:               "it should only write the present fields, when no error is returned",
+			inputID:            "p-Gx9LkMn",
+			inputPatchProperty: payload.PatchProperty{Name: &name, Amount: &amount},
+			expectedError:      nil,
+			mockBehaviours: func() {
+				mockPropertyRepo.On(
+					"Update",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					"p-Gx9LkMn",
+					1,
+					entity.Property{ID: "p-Gx9LkMn", Name: "Dadak Merak", Amount: 2},
+				).Return(
+					func(ctx context.Context, id string, version int, p entity.Property) error {
 						return nil
 					},
 				).Once()
@@ -320,7 +467,7 @@ func TestUpdate(t *testing.T) {
 		t.Run(testCase.name, func(t *testing.T) {
 			testCase.mockBehaviours()
 
-			gotErr := propertyService.Update(context.Background(), testCase.inputID, testCase.inputUpdateProperty)
+			gotErr := propertyService.Patch(context.Background(), testCase.inputID, 1, testCase.inputPatchProperty)
 
 			if testCase.expectedError != nil {
 				assert.ErrorIs(t, gotErr, testCase.expectedError)
@@ -336,12 +483,16 @@ func TestDelete(t *testing.T) {
 	mockGroupRepo := &mgr.GroupRepository{}
 	mockIDGen := &mig.IDGenerator{}
 	mockQRGen := &mqg.QRCodeGenerator{}
+	mockScanLinkGen := &mqg.ScanLinkGenerator{}
+	mockCodeSigner := &mqg.CodeSigner{}
 
 	var propertyService PropertyService = NewPropertyServiceImpl(
 		mockPropertyRepo,
 		mockGroupRepo,
 		mockIDGen,
 		mockQRGen,
+		mockScanLinkGen,
+		mockCodeSigner,
 	)
 
 	testCases := []struct {
@@ -359,13 +510,48 @@ func TestDelete(t *testing.T) {
 					"Delete",
 					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
 					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					1,
 				).Return(
-					func(ctx context.Context, id string) error {
+					func(ctx context.Context, id string, version int) error {
 						return repository.ErrRecordNotFound
 					},
 				).Once()
 			},
 		},
+		{
+			name:          "it should return service.ErrVersionMismatch error, when the property has been updated since the given version",
+			inputID:       "p-Gx9LkMn",
+			expectedError: service.ErrVersionMismatch,
+			mockBehaviours: func() {
+				mockPropertyRepo.On(
+					"Delete",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					1,
+				).Return(
+					func(ctx context.Context, id string, version int) error {
+						return repository.ErrRecordModified
+					},
+				).Once()
+			},
+		},
+		{
+			name:          "it should return service.ErrPropertyOnLoan error, when some of the items are out on loan",
+			inputID:       "p-Gx9LkMn",
+			expectedError: service.ErrPropertyOnLoan,
+			mockBehaviours: func() {
+				mockPropertyRepo.On(
+					"Delete",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					1,
+				).Return(
+					func(ctx context.Context, id string, version int) error {
+						return repository.ErrRecordReferenced
+					},
+				).Once()
+			},
+		},
 		{
 			name:          "it should return service.ErrRepository error, when property repository return an error",
 			inputID:       "p-Gx9LkMn",
@@ -375,8 +561,9 @@ func TestDelete(t *testing.T) {
 					"Delete",
 					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
 					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					1,
 				).Return(
-					func(ctx context.Context, id string) error {
+					func(ctx context.Context, id string, version int) error {
 						return repository.ErrDatabase
 					},
 				).Once()
@@ -391,8 +578,9 @@ func TestDelete(t *testing.T) {
 					"Delete",
 					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
 					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					1,
 				).Return(
-					func(ctx context.Context, id string) error {
+					func(ctx context.Context, id string, version int) error {
 						return nil
 					},
 				).Once()
@@ -404,7 +592,7 @@ func TestDelete(t *testing.T) {
 		t.Run(testCase.name, func(t *testing.T) {
 			testCase.mockBehaviours()
 
-			gotErr := propertyService.Delete(context.Background(), testCase.inputID)
+			gotErr := propertyService.Delete(context.Background(), testCase.inputID, 1)
 
 			if testCase.expectedError != nil {
 				assert.ErrorIs(t, gotErr, testCase.expectedError)
@@ -420,14 +608,72 @@ func TestGenerateQRCode(t *testing.T) {
 	mockGroupRepo := &mgr.GroupRepository{}
 	mockIDGen := &mig.IDGenerator{}
 	mockQRGen := &mqg.QRCodeGenerator{}
+	mockScanLinkGen := &mqg.ScanLinkGenerator{}
+	mockCodeSigner := &mqg.CodeSigner{}
 
 	var propertyService PropertyService = NewPropertyServiceImpl(
 		mockPropertyRepo,
 		mockGroupRepo,
 		mockIDGen,
 		mockQRGen,
+		mockScanLinkGen,
+		mockCodeSigner,
+	)
+
+	mockScanLinkGen.On("GenerateScanLink", mock.AnythingOfType(fmt.Sprintf("%T", ""))).Return(
+		func(code string) string {
+			return "https://reog.example.com/api/v1/scan/" + code
+		},
 	)
 
+	onFindByID := func(err error) {
+		mockPropertyRepo.On(
+			"FindByID",
+			mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+			"p-abc",
+		).Return(
+			func(ctx context.Context, id string) entity.Property {
+				if err != nil {
+					return entity.Property{}
+				}
+				return entity.Property{ID: "p-abc", GroupID: "g-xyz", Name: "Dadak Merak", Amount: 2}
+			},
+			func(ctx context.Context, id string) error {
+				return err
+			},
+		).Once()
+	}
+
+	onSignCode := func(err error) {
+		mockCodeSigner.On("SignCode", qrsign.TypeProperty, "p-abc", "g-xyz").Return(
+			func(codeType string, id string, groupID string) string {
+				if err != nil {
+					return ""
+				}
+				return "k1.property.p-abc.g-xyz.20221018.c2lnbmF0dXJl"
+			},
+			func(codeType string, id string, groupID string) error {
+				return err
+			},
+		).Once()
+	}
+
+	onGenerateQRCode := func(file []byte, err error) {
+		mockQRGen.On(
+			"GenerateQRCode",
+			"https://reog.example.com/api/v1/scan/k1.property.p-abc.g-xyz.20221018.c2lnbmF0dXJl",
+			qrcode.Medium,
+			2048,
+		).Return(
+			func(content string, level qrcode.RecoveryLevel, size int) []byte {
+				return file
+			},
+			func(content string, level qrcode.RecoveryLevel, size int) error {
+				return err
+			},
+		).Once()
+	}
+
 	testCases := []struct {
 		name           string
 		inputID        string
@@ -435,46 +681,43 @@ func TestGenerateQRCode(t *testing.T) {
 		expectedError  error
 		mockBehaviours func()
 	}{
+		{
+			name:          "it should return service.ErrDataNotFound error, when property repository return an error",
+			inputID:       "p-abc",
+			expectedError: service.ErrDataNotFound,
+			mockBehaviours: func() {
+				onFindByID(repository.ErrRecordNotFound)
+			},
+		},
+		{
+			name:          "it should return service.ErrRepository error, when code signer return an error",
+			inputID:       "p-abc",
+			expectedError: service.ErrRepository,
+			mockBehaviours: func() {
+				onFindByID(nil)
+				onSignCode(errors.New("error sign code"))
+			},
+		},
 		{
 			name:          "it should return service.ErrRepository error, when QR Code Generator return an error",
-			inputID:       "g-xyz",
+			inputID:       "p-abc",
 			expectedFile:  []byte{},
 			expectedError: service.ErrRepository,
 			mockBehaviours: func() {
-				mockQRGen.On(
-					"GenerateQRCode",
-					mock.AnythingOfType(fmt.Sprintf("%T", "")),
-					mock.AnythingOfType(fmt.Sprintf("%T", qrcode.Medium)),
-					mock.AnythingOfType(fmt.Sprintf("%T", 2048)),
-				).Return(
-					func(id string, level qrcode.RecoveryLevel, size int) []byte {
-						return []byte{}
-					},
-					func(id string, level qrcode.RecoveryLevel, size int) error {
-						return errors.New("error generate qrcode")
-					},
-				).Once()
+				onFindByID(nil)
+				onSignCode(nil)
+				onGenerateQRCode([]byte{}, errors.New("error generate qrcode"))
 			},
 		},
 		{
-			name:          "it should return a valid file, when no error is returned",
-			inputID:       "g-xyz",
+			name:          "it should return a valid file encoding the code signed with the group of the property, when no error is returned",
+			inputID:       "p-abc",
 			expectedFile:  []byte{1},
 			expectedError: nil,
 			mockBehaviours: func() {
-				mockQRGen.On(
-					"GenerateQRCode",
-					mock.AnythingOfType(fmt.Sprintf("%T", "")),
-					mock.AnythingOfType(fmt.Sprintf("%T", qrcode.Medium)),
-					mock.AnythingOfType(fmt.Sprintf("%T", 2048)),
-				).Return(
-					func(id string, level qrcode.RecoveryLevel, size int) []byte {
-						return []byte{1}
-					},
-					func(id string, level qrcode.RecoveryLevel, size int) error {
-						return nil
-					},
-				).Once()
+				onFindByID(nil)
+				onSignCode(nil)
+				onGenerateQRCode([]byte{1}, nil)
 			},
 		},
 	}
@@ -0,0 +1,133 @@
+// Code generated by mockery v2.10.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	payload "github.com/erikrios/reog-apps-apis/model/payload"
+	mock "github.com/stretchr/testify/mock"
+
+	response "github.com/erikrios/reog-apps-apis/model/response"
+)
+
+// PublicService is an autogenerated mock type for the PublicService type
+type PublicService struct {
+	mock.Mock
+}
+
+// GetGroupByID provides a mock function with given fields: ctx, id
+func (_m *PublicService) GetGroupByID(ctx context.Context, id string) (response.PublicGroup, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 response.PublicGroup
+	if rf, ok := ret.Get(0).(func(context.Context, string) response.PublicGroup); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(response.PublicGroup)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetGroups provides a mock function with given fields: ctx, p
+func (_m *PublicService) GetGroups(ctx context.Context, p payload.GetPublicGroups) ([]response.PublicGroup, response.Pagination, error) {
+	ret := _m.Called(ctx, p)
+
+	var r0 []response.PublicGroup
+	if rf, ok := ret.Get(0).(func(context.Context, payload.GetPublicGroups) []response.PublicGroup); ok {
+		r0 = rf(ctx, p)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]response.PublicGroup)
+		}
+	}
+
+	var r1 response.Pagination
+	if rf, ok := ret.Get(1).(func(context.Context, payload.GetPublicGroups) response.Pagination); ok {
+		r1 = rf(ctx, p)
+	} else {
+		r1 = ret.Get(1).(response.Pagination)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, payload.GetPublicGroups) error); ok {
+		r2 = rf(ctx, p)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetUpcomingShows provides a mock function with given fields: ctx, p
+func (_m *PublicService) GetUpcomingShows(ctx context.Context, p payload.GetUpcomingShows) ([]response.PublicShowSchedule, error) {
+	ret := _m.Called(ctx, p)
+
+	var r0 []response.PublicShowSchedule
+	if rf, ok := ret.Get(0).(func(context.Context, payload.GetUpcomingShows) []response.PublicShowSchedule); ok {
+		r0 = rf(ctx, p)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]response.PublicShowSchedule)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, payload.GetUpcomingShows) error); ok {
+		r1 = rf(ctx, p)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Scan provides a mock function with given fields: ctx, code
+func (_m *PublicService) Scan(ctx context.Context, code string) (response.ScanResult, error) {
+	ret := _m.Called(ctx, code)
+
+	var r0 response.ScanResult
+	if rf, ok := ret.Get(0).(func(context.Context, string) response.ScanResult); ok {
+		r0 = rf(ctx, code)
+	} else {
+		r0 = ret.Get(0).(response.ScanResult)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, code)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Verify provides a mock function with given fields: ctx, code
+func (_m *PublicService) Verify(ctx context.Context, code string) (response.CodeVerification, error) {
+	ret := _m.Called(ctx, code)
+
+	var r0 response.CodeVerification
+	if rf, ok := ret.Get(0).(func(context.Context, string) response.CodeVerification); ok {
+		r0 = rf(ctx, code)
+	} else {
+		r0 = ret.Get(0).(response.CodeVerification)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, code)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
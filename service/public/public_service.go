@@ -0,0 +1,21 @@
+package public
+
+import (
+	"context"
+
+	"github.com/erikrios/reog-apps-apis/model/payload"
+	"github.com/erikrios/reog-apps-apis/model/response"
+)
+
+// PublicService serves the read-only views given without authentication, to the tourism website among others.
+// Only the active groups are listed.
+type PublicService interface {
+	GetGroups(ctx context.Context, p payload.GetPublicGroups) (responses []response.PublicGroup, pagination response.Pagination, err error)
+	GetGroupByID(ctx context.Context, id string) (response response.PublicGroup, err error)
+	GetUpcomingShows(ctx context.Context, p payload.GetUpcomingShows) (responses []response.PublicShowSchedule, err error)
+	// Scan resolves the code of a scanned QR code, signed or the bare ID of either a group or a property, to what it
+	// stands for.
+	Scan(ctx context.Context, code string) (response response.ScanResult, err error)
+	// Verify tells whether a signed code is genuine and still stands for an existing group or property.
+	Verify(ctx context.Context, code string) (response response.CodeVerification, err error)
+}
@@ -0,0 +1,249 @@
+package public
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/erikrios/reog-apps-apis/entity"
+	"github.com/erikrios/reog-apps-apis/model/payload"
+	"github.com/erikrios/reog-apps-apis/model/response"
+	"github.com/erikrios/reog-apps-apis/repository/group"
+	"github.com/erikrios/reog-apps-apis/repository/property"
+	"github.com/erikrios/reog-apps-apis/repository/showschedule"
+	"github.com/erikrios/reog-apps-apis/service"
+	"github.com/erikrios/reog-apps-apis/utils/generator"
+	"github.com/erikrios/reog-apps-apis/utils/qrsign"
+	"gopkg.in/validator.v2"
+)
+
+const defaultLimit = 20
+
+// dateLayout is the layout of the issue dates of the verified codes
+const dateLayout = "2006-01-02"
+
+const (
+	scanTypeGroup    = "group"
+	scanTypeProperty = "property"
+)
+
+type publicServiceImpl struct {
+	groupRepository        group.GroupRepository
+	showScheduleRepository showschedule.ShowScheduleRepository
+	propertyRepository     property.PropertyRepository
+	codeSigner             generator.CodeSigner
+}
+
+func NewPublicServiceImpl(
+	groupRepository group.GroupRepository,
+	showScheduleRepository showschedule.ShowScheduleRepository,
+	propertyRepository property.PropertyRepository,
+	codeSigner generator.CodeSigner,
+) *publicServiceImpl {
+	return &publicServiceImpl{
+		groupRepository:        groupRepository,
+		showScheduleRepository: showScheduleRepository,
+		propertyRepository:     propertyRepository,
+		codeSigner:             codeSigner,
+	}
+}
+
+func (s *publicServiceImpl) GetGroups(ctx context.Context, p payload.GetPublicGroups) (responses []response.PublicGroup, pagination response.Pagination, err error) {
+	if validateErr := validator.Validate(p); validateErr != nil {
+		err = service.ErrInvalidPayload
+		return
+	}
+
+	if p.Page == 0 {
+		p.Page = 1
+	}
+
+	if p.Limit == 0 {
+		p.Limit = defaultLimit
+	}
+
+	groups, total, repoErr := s.groupRepository.FindAll(ctx, group.Filter{
+		DistrictID: p.DistrictID,
+		VillageID:  p.VillageID,
+		Name:       p.Name,
+		Status:     entity.GroupStatusActive,
+		SortBy:     group.SortByName,
+		Offset:     (p.Page - 1) * p.Limit,
+		Limit:      p.Limit,
+	})
+	if repoErr != nil {
+		err = service.MapError(repoErr)
+		return
+	}
+
+	responses = make([]response.PublicGroup, len(groups))
+	for i, group := range groups {
+		responses[i] = mapToPublicGroup(group)
+	}
+
+	pagination = response.Pagination{
+		Page:       p.Page,
+		Limit:      p.Limit,
+		TotalItems: total,
+		TotalPages: int((total + int64(p.Limit) - 1) / int64(p.Limit)),
+	}
+	return
+}
+
+// GetGroupByID reports the groups that are not active as not found, as they are not listed either.
+func (s *publicServiceImpl) GetGroupByID(ctx context.Context, id string) (response response.PublicGroup, err error) {
+	group, repoErr := s.groupRepository.FindByID(ctx, id)
+	if repoErr != nil {
+		err = service.MapError(repoErr)
+		return
+	}
+
+	if group.Status != entity.GroupStatusActive {
+		err = service.ErrDataNotFound
+		return
+	}
+
+	response = mapToPublicGroup(group)
+	return
+}
+
+// GetUpcomingShows returns the show schedules that are not finished yet, from the earliest one.
+func (s *publicServiceImpl) GetUpcomingShows(ctx context.Context, p payload.GetUpcomingShows) (responses []response.PublicShowSchedule, err error) {
+	if validateErr := validator.Validate(p); validateErr != nil {
+		err = service.ErrInvalidPayload
+		return
+	}
+
+	if p.Limit == 0 {
+		p.Limit = defaultLimit
+	}
+
+	showSchedules, repoErr := s.showScheduleRepository.FindUpcoming(ctx, showschedule.UpcomingFilter{
+		From:       time.Now(),
+		GroupID:    p.GroupID,
+		DistrictID: p.DistrictID,
+		Limit:      p.Limit,
+	})
+	if repoErr != nil {
+		err = service.MapError(repoErr)
+		return
+	}
+
+	responses = make([]response.PublicShowSchedule, len(showSchedules))
+	for i, show := range showSchedules {
+		responses[i] = response.PublicShowSchedule{
+			ID:           show.ID,
+			GroupID:      *show.GroupID,
+			GroupName:    show.GroupName,
+			VillageName:  show.VillageName,
+			DistrictName: show.DistrictName,
+			Place:        show.Place,
+			StartOn:      show.StartOn.Format(time.RFC822),
+			FinishOn:     show.FinishOn.Format(time.RFC822),
+			Latitude:     show.Latitude,
+			Longitude:    show.Longitude,
+		}
+	}
+	return
+}
+
+// Scan tells a group from a property by the prefix of the ID. A property is only found while the group owning it
+// is active, as for the groups themselves. The bare IDs of the QR codes printed before the codes were signed are
+// still resolved, but not reported as verified.
+func (s *publicServiceImpl) Scan(ctx context.Context, code string) (result response.ScanResult, err error) {
+	id, groupID := code, ""
+	if qrsign.IsSigned(code) {
+		payload, verifyErr := s.codeSigner.VerifyCode(code)
+		if verifyErr != nil {
+			err = service.ErrCodeNotGenuine
+			return
+		}
+
+		id, groupID = payload.ID, payload.GroupID
+		result.Verified = true
+	}
+
+	switch {
+	case strings.HasPrefix(id, "g-"):
+		result.Type = scanTypeGroup
+		result.Group, err = s.GetGroupByID(ctx, id)
+	case strings.HasPrefix(id, "p-"):
+		property, repoErr := s.propertyRepository.FindByID(ctx, id)
+		if repoErr != nil {
+			err = service.MapError(repoErr)
+			return
+		}
+
+		if groupID != "" && property.GroupID != groupID {
+			err = service.ErrCodeNotGenuine
+			return
+		}
+
+		result.Type = scanTypeProperty
+		result.Property = &response.PublicProperty{
+			ID:          property.ID,
+			Name:        property.Name,
+			Description: property.Description,
+			Amount:      property.Amount,
+		}
+		result.Group, err = s.GetGroupByID(ctx, property.GroupID)
+	default:
+		err = service.ErrDataNotFound
+	}
+	return
+}
+
+// Verify checks the signature of the code, then that what it stands for still exists and still belongs to the
+// signed group. Unlike Scan, the groups that are not active are reported too, with their status, as the field
+// staff checks the codes of the suspended and dissolved groups as well.
+func (s *publicServiceImpl) Verify(ctx context.Context, code string) (result response.CodeVerification, err error) {
+	payload, verifyErr := s.codeSigner.VerifyCode(code)
+	if verifyErr != nil {
+		err = service.ErrCodeNotGenuine
+		return
+	}
+
+	if payload.Type == qrsign.TypeProperty {
+		property, repoErr := s.propertyRepository.FindByID(ctx, payload.ID)
+		if repoErr != nil {
+			err = service.MapError(repoErr)
+			return
+		}
+
+		if property.GroupID != payload.GroupID {
+			err = service.ErrCodeNotGenuine
+			return
+		}
+	}
+
+	group, repoErr := s.groupRepository.FindByID(ctx, payload.GroupID)
+	if repoErr != nil {
+		err = service.MapError(repoErr)
+		return
+	}
+
+	result = response.CodeVerification{
+		KeyID:       payload.KeyID,
+		Type:        payload.Type,
+		ID:          payload.ID,
+		GroupID:     group.ID,
+		GroupName:   group.Name,
+		GroupStatus: group.Status,
+		IssuedOn:    payload.IssuedOn.Format(dateLayout),
+	}
+	return
+}
+
+func mapToPublicGroup(e entity.Group) response.PublicGroup {
+	return response.PublicGroup{
+		ID:           e.ID,
+		Name:         e.Name,
+		VillageName:  e.Address.VillageName,
+		DistrictName: e.Address.DistrictName,
+		Website:      e.Contacts.Website,
+		Instagram:    e.Contacts.Instagram,
+		Facebook:     e.Contacts.Facebook,
+		YouTube:      e.Contacts.YouTube,
+		TikTok:       e.Contacts.TikTok,
+	}
+}
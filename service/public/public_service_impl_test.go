@@ -0,0 +1,667 @@
+package public
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/erikrios/reog-apps-apis/entity"
+	"github.com/erikrios/reog-apps-apis/model/payload"
+	"github.com/erikrios/reog-apps-apis/model/response"
+	"github.com/erikrios/reog-apps-apis/repository"
+	"github.com/erikrios/reog-apps-apis/repository/group"
+	mgr "github.com/erikrios/reog-apps-apis/repository/group/mocks"
+	mpr "github.com/erikrios/reog-apps-apis/repository/property/mocks"
+	"github.com/erikrios/reog-apps-apis/repository/showschedule"
+	mssr "github.com/erikrios/reog-apps-apis/repository/showschedule/mocks"
+	"github.com/erikrios/reog-apps-apis/service"
+	mqg "github.com/erikrios/reog-apps-apis/utils/generator/mocks"
+	"github.com/erikrios/reog-apps-apis/utils/qrsign"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var dummyGroup = entity.Group{
+	ID:     "g-xyz",
+	Name:   "Paguyuban Reog Singo Mudho",
+	Leader: "Erik Rio Setiawan",
+	Status: entity.GroupStatusActive,
+	Address: entity.Address{
+		Address:      "RT 01 RW 01 Dukuh Bibis",
+		VillageName:  "Bibis",
+		DistrictName: "Bungkal",
+	},
+	Contacts: entity.GroupContacts{
+		Phone:     "+62352481234",
+		WhatsApp:  "+6281234567890",
+		Email:     "erik@example.com",
+		Instagram: "reogsingomudho",
+	},
+}
+
+var dummyPublicGroup = response.PublicGroup{
+	ID:           "g-xyz",
+	Name:         "Paguyuban Reog Singo Mudho",
+	VillageName:  "Bibis",
+	DistrictName: "Bungkal",
+	Instagram:    "reogsingomudho",
+}
+
+func TestGetGroups(t *testing.T) {
+	mockGroupRepo := &mgr.GroupRepository{}
+	mockShowScheduleRepo := &mssr.ShowScheduleRepository{}
+	mockPropertyRepo := &mpr.PropertyRepository{}
+
+	mockCodeSigner := &mqg.CodeSigner{}
+
+	var publicService PublicService = NewPublicServiceImpl(mockGroupRepo, mockShowScheduleRepo, mockPropertyRepo, mockCodeSigner)
+
+	testCases := []struct {
+		name               string
+		inputPayload       payload.GetPublicGroups
+		expectedResponses  []response.PublicGroup
+		expectedPagination response.Pagination
+		expectedError      error
+		mockBehaviours     func()
+	}{
+		{
+			name:           "it should return service.ErrInvalidPayload error, when limit is too large",
+			inputPayload:   payload.GetPublicGroups{Limit: 101},
+			expectedError:  service.ErrInvalidPayload,
+			mockBehaviours: func() {},
+		},
+		{
+			name:           "it should return service.ErrInvalidPayload error, when page is too large",
+			inputPayload:   payload.GetPublicGroups{Page: math.MaxInt, Limit: 100},
+			expectedError:  service.ErrInvalidPayload,
+			mockBehaviours: func() {},
+		},
+		{
+			name:          "it should return service.ErrRepository error, when group repository return an error",
+			inputPayload:  payload.GetPublicGroups{},
+			expectedError: service.ErrRepository,
+			mockBehaviours: func() {
+				mockGroupRepo.On(
+					"FindAll",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", group.Filter{})),
+				).Return(
+					func(ctx context.Context, filter group.Filter) []entity.Group {
+						return nil
+					},
+					func(ctx context.Context, filter group.Filter) int64 {
+						return 0
+					},
+					func(ctx context.Context, filter group.Filter) error {
+						return repository.ErrDatabase
+					},
+				).Once()
+			},
+		},
+		{
+			name:               "it should return the active groups without private data, when no error is returned",
+			inputPayload:       payload.GetPublicGroups{Page: 2, Limit: 1, DistrictID: "3502030"},
+			expectedResponses:  []response.PublicGroup{dummyPublicGroup},
+			expectedPagination: response.Pagination{Page: 2, Limit: 1, TotalItems: 3, TotalPages: 3},
+			expectedError:      nil,
+			mockBehaviours: func() {
+				mockGroupRepo.On(
+					"FindAll",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					group.Filter{
+						DistrictID: "3502030",
+						Status:     entity.GroupStatusActive,
+						SortBy:     group.SortByName,
+						Offset:     1,
+						Limit:      1,
+					},
+				).Return(
+					func(ctx context.Context, filter group.Filter) []entity.Group {
+						return []entity.Group{dummyGroup}
+					},
+					func(ctx context.Context, filter group.Filter) int64 {
+						return 3
+					},
+					func(ctx context.Context, filter group.Filter) error {
+						return nil
+					},
+				).Once()
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.mockBehaviours()
+			gotResponses, gotPagination, gotErr := publicService.GetGroups(context.Background(), testCase.inputPayload)
+
+			if testCase.expectedError != nil {
+				assert.ErrorIs(t, gotErr, testCase.expectedError)
+			} else {
+				assert.NoError(t, gotErr)
+				assert.Equal(t, testCase.expectedResponses, gotResponses)
+				assert.Equal(t, testCase.expectedPagination, gotPagination)
+			}
+		})
+	}
+}
+
+func TestGetGroupByID(t *testing.T) {
+	mockGroupRepo := &mgr.GroupRepository{}
+	mockShowScheduleRepo := &mssr.ShowScheduleRepository{}
+	mockPropertyRepo := &mpr.PropertyRepository{}
+
+	mockCodeSigner := &mqg.CodeSigner{}
+
+	var publicService PublicService = NewPublicServiceImpl(mockGroupRepo, mockShowScheduleRepo, mockPropertyRepo, mockCodeSigner)
+
+	suspendedGroup := dummyGroup
+	suspendedGroup.Status = entity.GroupStatusSuspended
+
+	testCases := []struct {
+		name             string
+		inputID          string
+		expectedResponse response.PublicGroup
+		expectedError    error
+		mockBehaviours   func()
+	}{
+		{
+			name:          "it should return service.ErrDataNotFound error, when group repository return a not found error",
+			inputID:       "g-xyz",
+			expectedError: service.ErrDataNotFound,
+			mockBehaviours: func() {
+				mockGroupRepo.On(
+					"FindByID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					"g-xyz",
+				).Return(
+					func(ctx context.Context, id string) entity.Group {
+						return entity.Group{}
+					},
+					func(ctx context.Context, id string) error {
+						return repository.ErrRecordNotFound
+					},
+				).Once()
+			},
+		},
+		{
+			name:          "it should return service.ErrDataNotFound error, when the group is not active",
+			inputID:       "g-xyz",
+			expectedError: service.ErrDataNotFound,
+			mockBehaviours: func() {
+				mockGroupRepo.On(
+					"FindByID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					"g-xyz",
+				).Return(
+					func(ctx context.Context, id string) entity.Group {
+						return suspendedGroup
+					},
+					func(ctx context.Context, id string) error {
+						return nil
+					},
+				).Once()
+			},
+		},
+		{
+			name:             "it should return the group without private data, when no error is returned",
+			inputID:          "g-xyz",
+			expectedResponse: dummyPublicGroup,
+			expectedError:    nil,
+			mockBehaviours: func() {
+				mockGroupRepo.On(
+					"FindByID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					"g-xyz",
+				).Return(
+					func(ctx context.Context, id string) entity.Group {
+						return dummyGroup
+					},
+					func(ctx context.Context, id string) error {
+						return nil
+					},
+				).Once()
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.mockBehaviours()
+			gotResponse, gotErr := publicService.GetGroupByID(context.Background(), testCase.inputID)
+
+			if testCase.expectedError != nil {
+				assert.ErrorIs(t, gotErr, testCase.expectedError)
+			} else {
+				assert.NoError(t, gotErr)
+				assert.Equal(t, testCase.expectedResponse, gotResponse)
+			}
+		})
+	}
+}
+
+func TestGetUpcomingShows(t *testing.T) {
+	mockGroupRepo := &mgr.GroupRepository{}
+	mockShowScheduleRepo := &mssr.ShowScheduleRepository{}
+	mockPropertyRepo := &mpr.PropertyRepository{}
+
+	mockCodeSigner := &mqg.CodeSigner{}
+
+	var publicService PublicService = NewPublicServiceImpl(mockGroupRepo, mockShowScheduleRepo, mockPropertyRepo, mockCodeSigner)
+
+	startOn := time.Date(2022, 5, 14, 19, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name              string
+		inputPayload      payload.GetUpcomingShows
+		expectedResponses []response.PublicShowSchedule
+		expectedError     error
+		mockBehaviours    func()
+	}{
+		{
+			name:           "it should return service.ErrInvalidPayload error, when limit is too large",
+			inputPayload:   payload.GetUpcomingShows{Limit: 101},
+			expectedError:  service.ErrInvalidPayload,
+			mockBehaviours: func() {},
+		},
+		{
+			name:          "it should return service.ErrRepository error, when show schedule repository return an error",
+			inputPayload:  payload.GetUpcomingShows{},
+			expectedError: service.ErrRepository,
+			mockBehaviours: func() {
+				mockShowScheduleRepo.On(
+					"FindUpcoming",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", showschedule.UpcomingFilter{})),
+				).Return(
+					func(ctx context.Context, filter showschedule.UpcomingFilter) []showschedule.UpcomingShowSchedule {
+						return nil
+					},
+					func(ctx context.Context, filter showschedule.UpcomingFilter) error {
+						return repository.ErrDatabase
+					},
+				).Once()
+			},
+		},
+		{
+			name:         "it should return the upcoming shows, when no error is returned",
+			inputPayload: payload.GetUpcomingShows{DistrictID: "3502030"},
+			expectedResponses: []response.PublicShowSchedule{
+				{
+					ID:           "s-aBcdEfG",
+					GroupID:      "g-xyz",
+					GroupName:    "Paguyuban Reog Singo Mudho",
+					VillageName:  "Bibis",
+					DistrictName: "Bungkal",
+					Place:        "Alun-Alun Ponorogo",
+					StartOn:      startOn.Format(time.RFC822),
+					FinishOn:     startOn.Add(2 * time.Hour).Format(time.RFC822),
+				},
+			},
+			expectedError: nil,
+			mockBehaviours: func() {
+				mockShowScheduleRepo.On(
+					"FindUpcoming",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.MatchedBy(func(filter showschedule.UpcomingFilter) bool {
+						return filter.DistrictID == "3502030" &&
+							filter.GroupID == "" &&
+							filter.Limit == defaultLimit &&
+							time.Since(filter.From) < time.Minute
+					}),
+				).Return(
+					func(ctx context.Context, filter showschedule.UpcomingFilter) []showschedule.UpcomingShowSchedule {
+						return []showschedule.UpcomingShowSchedule{
+							{
+								ShowSchedule: entity.ShowSchedule{
+									ID:       "s-aBcdEfG",
+									GroupID:  stringPtr("g-xyz"),
+									Place:    "Alun-Alun Ponorogo",
+									StartOn:  startOn,
+									FinishOn: startOn.Add(2 * time.Hour),
+								},
+								GroupName:    "Paguyuban Reog Singo Mudho",
+								VillageName:  "Bibis",
+								DistrictName: "Bungkal",
+							},
+						}
+					},
+					func(ctx context.Context, filter showschedule.UpcomingFilter) error {
+						return nil
+					},
+				).Once()
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.mockBehaviours()
+			gotResponses, gotErr := publicService.GetUpcomingShows(context.Background(), testCase.inputPayload)
+
+			if testCase.expectedError != nil {
+				assert.ErrorIs(t, gotErr, testCase.expectedError)
+			} else {
+				assert.NoError(t, gotErr)
+				assert.Equal(t, testCase.expectedResponses, gotResponses)
+			}
+		})
+	}
+}
+
+func stringPtr(s string) *string {
+	return &s
+}
+
+func TestScan(t *testing.T) {
+	mockGroupRepo := &mgr.GroupRepository{}
+	mockShowScheduleRepo := &mssr.ShowScheduleRepository{}
+	mockPropertyRepo := &mpr.PropertyRepository{}
+
+	mockCodeSigner := &mqg.CodeSigner{}
+
+	var publicService PublicService = NewPublicServiceImpl(mockGroupRepo, mockShowScheduleRepo, mockPropertyRepo, mockCodeSigner)
+
+	suspendedGroup := dummyGroup
+	suspendedGroup.Status = entity.GroupStatusSuspended
+
+	onFindGroup := func(group entity.Group) {
+		mockGroupRepo.On(
+			"FindByID",
+			mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+			"g-xyz",
+		).Return(
+			func(ctx context.Context, id string) entity.Group {
+				return group
+			},
+			func(ctx context.Context, id string) error {
+				return nil
+			},
+		).Once()
+	}
+
+	onFindProperty := func(err error) {
+		mockPropertyRepo.On(
+			"FindByID",
+			mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+			"p-YIhpPgp",
+		).Return(
+			func(ctx context.Context, id string) entity.Property {
+				if err != nil {
+					return entity.Property{}
+				}
+				return entity.Property{ID: id, Name: "Dadak Merak", Description: "Dadak merak bulu asli", Amount: 2, GroupID: "g-xyz"}
+			},
+			func(ctx context.Context, id string) error {
+				return err
+			},
+		).Once()
+	}
+
+	onVerifyCode := func(code string, payload qrsign.Payload, err error) {
+		mockCodeSigner.On("VerifyCode", code).Return(
+			func(code string) qrsign.Payload {
+				return payload
+			},
+			func(code string) error {
+				return err
+			},
+		).Once()
+	}
+
+	testCases := []struct {
+		name             string
+		inputCode        string
+		expectedResponse response.ScanResult
+		expectedError    error
+		mockBehaviours   func()
+	}{
+		{
+			name:           "it should return service.ErrDataNotFound error, when the code is neither a group nor a property",
+			inputCode:      "s-YIhpPgp",
+			expectedError:  service.ErrDataNotFound,
+			mockBehaviours: func() {},
+		},
+		{
+			name:          "it should return service.ErrDataNotFound error, when the scanned group is not active",
+			inputCode:     "g-xyz",
+			expectedError: service.ErrDataNotFound,
+			mockBehaviours: func() {
+				onFindGroup(suspendedGroup)
+			},
+		},
+		{
+			name:          "it should return service.ErrDataNotFound error, when the property is not found",
+			inputCode:     "p-YIhpPgp",
+			expectedError: service.ErrDataNotFound,
+			mockBehaviours: func() {
+				onFindProperty(repository.ErrRecordNotFound)
+			},
+		},
+		{
+			name:          "it should return service.ErrDataNotFound error, when the group owning the property is not active",
+			inputCode:     "p-YIhpPgp",
+			expectedError: service.ErrDataNotFound,
+			mockBehaviours: func() {
+				onFindProperty(nil)
+				onFindGroup(suspendedGroup)
+			},
+		},
+		{
+			name:      "it should return the group, when a group is scanned",
+			inputCode: "g-xyz",
+			expectedResponse: response.ScanResult{
+				Type:  "group",
+				Group: dummyPublicGroup,
+			},
+			mockBehaviours: func() {
+				onFindGroup(dummyGroup)
+			},
+		},
+		{
+			name:      "it should return the property with its group, when a property is scanned",
+			inputCode: "p-YIhpPgp",
+			expectedResponse: response.ScanResult{
+				Type:  "property",
+				Group: dummyPublicGroup,
+				Property: &response.PublicProperty{
+					ID:          "p-YIhpPgp",
+					Name:        "Dadak Merak",
+					Description: "Dadak merak bulu asli",
+					Amount:      2,
+				},
+			},
+			mockBehaviours: func() {
+				onFindProperty(nil)
+				onFindGroup(dummyGroup)
+			},
+		},
+		{
+			name:          "it should return service.ErrCodeNotGenuine error, when the signature of the code does not match",
+			inputCode:     "k1.group.g-xyz.g-xyz.20221018.dGFtcGVyZWQ",
+			expectedError: service.ErrCodeNotGenuine,
+			mockBehaviours: func() {
+				onVerifyCode("k1.group.g-xyz.g-xyz.20221018.dGFtcGVyZWQ", qrsign.Payload{}, qrsign.ErrInvalidSignature)
+			},
+		},
+		{
+			name:          "it should return service.ErrCodeNotGenuine error, when the property no longer belongs to the signed group",
+			inputCode:     "k1.property.p-YIhpPgp.g-abc.20221018.c2lnbmF0dXJl",
+			expectedError: service.ErrCodeNotGenuine,
+			mockBehaviours: func() {
+				onVerifyCode(
+					"k1.property.p-YIhpPgp.g-abc.20221018.c2lnbmF0dXJl",
+					qrsign.Payload{KeyID: "k1", Type: qrsign.TypeProperty, ID: "p-YIhpPgp", GroupID: "g-abc"},
+					nil,
+				)
+				onFindProperty(nil)
+			},
+		},
+		{
+			name:      "it should return the verified group, when a signed group code is scanned",
+			inputCode: "k1.group.g-xyz.g-xyz.20221018.c2lnbmF0dXJl",
+			expectedResponse: response.ScanResult{
+				Type:     "group",
+				Group:    dummyPublicGroup,
+				Verified: true,
+			},
+			mockBehaviours: func() {
+				onVerifyCode(
+					"k1.group.g-xyz.g-xyz.20221018.c2lnbmF0dXJl",
+					qrsign.Payload{KeyID: "k1", Type: qrsign.TypeGroup, ID: "g-xyz", GroupID: "g-xyz"},
+					nil,
+				)
+				onFindGroup(dummyGroup)
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.mockBehaviours()
+			gotResponse, gotErr := publicService.Scan(context.Background(), testCase.inputCode)
+
+			if testCase.expectedError != nil {
+				assert.ErrorIs(t, gotErr, testCase.expectedError)
+			} else {
+				assert.NoError(t, gotErr)
+				assert.Equal(t, testCase.expectedResponse, gotResponse)
+			}
+		})
+	}
+}
+
+func TestVerify(t *testing.T) {
+	mockGroupRepo := &mgr.GroupRepository{}
+	mockShowScheduleRepo := &mssr.ShowScheduleRepository{}
+	mockPropertyRepo := &mpr.PropertyRepository{}
+	mockCodeSigner := &mqg.CodeSigner{}
+
+	var publicService PublicService = NewPublicServiceImpl(mockGroupRepo, mockShowScheduleRepo, mockPropertyRepo, mockCodeSigner)
+
+	suspendedGroup := dummyGroup
+	suspendedGroup.Status = entity.GroupStatusSuspended
+
+	propertyPayload := qrsign.Payload{
+		KeyID:    "k1",
+		Type:     qrsign.TypeProperty,
+		ID:       "p-YIhpPgp",
+		GroupID:  "g-xyz",
+		IssuedOn: time.Date(2022, 10, 18, 0, 0, 0, 0, time.UTC),
+	}
+
+	onVerifyCode := func(payload qrsign.Payload, err error) {
+		mockCodeSigner.On("VerifyCode", "k1.property.p-YIhpPgp.g-xyz.20221018.c2lnbmF0dXJl").Return(
+			func(code string) qrsign.Payload {
+				return payload
+			},
+			func(code string) error {
+				return err
+			},
+		).Once()
+	}
+
+	onFindProperty := func(groupID string, err error) {
+		mockPropertyRepo.On(
+			"FindByID",
+			mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+			"p-YIhpPgp",
+		).Return(
+			func(ctx context.Context, id string) entity.Property {
+				return entity.Property{ID: id, Name: "Dadak Merak", GroupID: groupID}
+			},
+			func(ctx context.Context, id string) error {
+				return err
+			},
+		).Once()
+	}
+
+	onFindGroup := func(group entity.Group, err error) {
+		mockGroupRepo.On(
+			"FindByID",
+			mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+			"g-xyz",
+		).Return(
+			func(ctx context.Context, id string) entity.Group {
+				return group
+			},
+			func(ctx context.Context, id string) error {
+				return err
+			},
+		).Once()
+	}
+
+	testCases := []struct {
+		name             string
+		expectedResponse response.CodeVerification
+		expectedError    error
+		mockBehaviours   func()
+	}{
+		{
+			name:          "it should return service.ErrCodeNotGenuine error, when the code is signed with an unknown key",
+			expectedError: service.ErrCodeNotGenuine,
+			mockBehaviours: func() {
+				onVerifyCode(qrsign.Payload{}, qrsign.ErrUnknownKey)
+			},
+		},
+		{
+			name:          "it should return service.ErrDataNotFound error, when the property is deleted",
+			expectedError: service.ErrDataNotFound,
+			mockBehaviours: func() {
+				onVerifyCode(propertyPayload, nil)
+				onFindProperty("", repository.ErrRecordNotFound)
+			},
+		},
+		{
+			name:          "it should return service.ErrCodeNotGenuine error, when the property belongs to another group",
+			expectedError: service.ErrCodeNotGenuine,
+			mockBehaviours: func() {
+				onVerifyCode(propertyPayload, nil)
+				onFindProperty("g-abc", nil)
+			},
+		},
+		{
+			name:          "it should return service.ErrRepository error, when group repository return an error",
+			expectedError: service.ErrRepository,
+			mockBehaviours: func() {
+				onVerifyCode(propertyPayload, nil)
+				onFindProperty("g-xyz", nil)
+				onFindGroup(entity.Group{}, errors.New("error find group"))
+			},
+		},
+		{
+			name: "it should report the group status, when the group of a genuine code is suspended",
+			expectedResponse: response.CodeVerification{
+				KeyID:       "k1",
+				Type:        "property",
+				ID:          "p-YIhpPgp",
+				GroupID:     "g-xyz",
+				GroupName:   "Paguyuban Reog Singo Mudho",
+				GroupStatus: entity.GroupStatusSuspended,
+				IssuedOn:    "2022-10-18",
+			},
+			mockBehaviours: func() {
+				onVerifyCode(propertyPayload, nil)
+				onFindProperty("g-xyz", nil)
+				onFindGroup(suspendedGroup, nil)
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.mockBehaviours()
+			gotResponse, gotErr := publicService.Verify(context.Background(), "k1.property.p-YIhpPgp.g-xyz.20221018.c2lnbmF0dXJl")
+
+			if testCase.expectedError != nil {
+				assert.ErrorIs(t, gotErr, testCase.expectedError)
+			} else {
+				assert.NoError(t, gotErr)
+				assert.Equal(t, testCase.expectedResponse, gotResponse)
+			}
+		})
+	}
+}
@@ -0,0 +1,39 @@
+// Code generated by mockery v2.10.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	payload "github.com/erikrios/reog-apps-apis/model/payload"
+	response "github.com/erikrios/reog-apps-apis/model/response"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// SearchService is an autogenerated mock type for the SearchService type
+type SearchService struct {
+	mock.Mock
+}
+
+// Search provides a mock function with given fields: ctx, p
+func (_m *SearchService) Search(ctx context.Context, p payload.Search) ([]response.SearchResult, error) {
+	ret := _m.Called(ctx, p)
+
+	var r0 []response.SearchResult
+	if rf, ok := ret.Get(0).(func(context.Context, payload.Search) []response.SearchResult); ok {
+		r0 = rf(ctx, p)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]response.SearchResult)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, payload.Search) error); ok {
+		r1 = rf(ctx, p)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
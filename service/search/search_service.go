@@ -0,0 +1,12 @@
+package search
+
+import (
+	"context"
+
+	"github.com/erikrios/reog-apps-apis/model/payload"
+	"github.com/erikrios/reog-apps-apis/model/response"
+)
+
+type SearchService interface {
+	Search(ctx context.Context, p payload.Search) (responses []response.SearchResult, err error)
+}
@@ -0,0 +1,55 @@
+package search
+
+import (
+	"context"
+	"strings"
+
+	"github.com/erikrios/reog-apps-apis/model/payload"
+	"github.com/erikrios/reog-apps-apis/model/response"
+	"github.com/erikrios/reog-apps-apis/repository/search"
+	"github.com/erikrios/reog-apps-apis/service"
+	"gopkg.in/validator.v2"
+)
+
+const defaultLimit = 20
+
+type searchServiceImpl struct {
+	searchRepository search.SearchRepository
+}
+
+func NewSearchServiceImpl(searchRepository search.SearchRepository) *searchServiceImpl {
+	return &searchServiceImpl{searchRepository: searchRepository}
+}
+
+func (s *searchServiceImpl) Search(ctx context.Context, p payload.Search) (responses []response.SearchResult, err error) {
+	p.Query = strings.TrimSpace(p.Query)
+	if validateErr := validator.Validate(p); validateErr != nil {
+		err = service.ErrInvalidPayload
+		return
+	}
+
+	limit := p.Limit
+	if limit == 0 {
+		limit = defaultLimit
+	}
+
+	results, repoErr := s.searchRepository.Search(ctx, p.Query, limit)
+	if repoErr != nil {
+		err = service.MapError(repoErr)
+		return
+	}
+
+	responses = make([]response.SearchResult, len(results))
+	for i, result := range results {
+		responses[i] = response.SearchResult{
+			Type:      result.Type,
+			ID:        result.ID,
+			GroupID:   result.GroupID,
+			GroupName: result.GroupName,
+			Title:     result.Title,
+			Snippet:   result.Snippet,
+			Rank:      result.Rank,
+		}
+	}
+	return
+}
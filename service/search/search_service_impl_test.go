@@ -0,0 +1,118 @@
+package search
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/erikrios/reog-apps-apis/model/payload"
+	"github.com/erikrios/reog-apps-apis/model/response"
+	"github.com/erikrios/reog-apps-apis/repository"
+	"github.com/erikrios/reog-apps-apis/repository/search"
+	msr "github.com/erikrios/reog-apps-apis/repository/search/mocks"
+	"github.com/erikrios/reog-apps-apis/service"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestSearch(t *testing.T) {
+	mockSearchRepo := &msr.SearchRepository{}
+
+	var searchService SearchService = NewSearchServiceImpl(mockSearchRepo)
+
+	testCases := []struct {
+		name            string
+		inputSearch     payload.Search
+		expectedResults []response.SearchResult
+		expectedError   error
+		mockBehaviours  func()
+	}{
+		{
+			name:           "it should return service.ErrInvalidPayload error, when query is blank",
+			inputSearch:    payload.Search{Query: "   "},
+			expectedError:  service.ErrInvalidPayload,
+			mockBehaviours: func() {},
+		},
+		{
+			name:           "it should return service.ErrInvalidPayload error, when limit is more than 50",
+			inputSearch:    payload.Search{Query: "barongan", Limit: 51},
+			expectedError:  service.ErrInvalidPayload,
+			mockBehaviours: func() {},
+		},
+		{
+			name:          "it should return service.ErrRepository error, when search repository return an error",
+			inputSearch:   payload.Search{Query: "barongan"},
+			expectedError: service.ErrRepository,
+			mockBehaviours: func() {
+				mockSearchRepo.On(
+					"Search",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					"barongan",
+					20,
+				).Return(
+					func(ctx context.Context, query string, limit int) []search.Result {
+						return nil
+					},
+					func(ctx context.Context, query string, limit int) error {
+						return repository.ErrDatabase
+					},
+				).Once()
+			},
+		},
+		{
+			name:        "it should return the ranked results of the trimmed query, when no error is returned",
+			inputSearch: payload.Search{Query: " sukorejo barongan ", Limit: 5},
+			expectedResults: []response.SearchResult{
+				{
+					Type:      search.ResultTypeProperty,
+					ID:        "p-YIhpPgp",
+					GroupID:   "g-Nzo",
+					GroupName: "Paguyuban Reog Sukorejo",
+					Title:     "Barongan",
+					Snippet:   "<mark>Barongan</mark> milik paguyuban <mark>Sukorejo</mark>",
+					Rank:      0.09,
+				},
+			},
+			expectedError: nil,
+			mockBehaviours: func() {
+				mockSearchRepo.On(
+					"Search",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					"sukorejo barongan",
+					5,
+				).Return(
+					func(ctx context.Context, query string, limit int) []search.Result {
+						return []search.Result{
+							{
+								Type:      search.ResultTypeProperty,
+								ID:        "p-YIhpPgp",
+								GroupID:   "g-Nzo",
+								GroupName: "Paguyuban Reog Sukorejo",
+								Title:     "Barongan",
+								Snippet:   "<mark>Barongan</mark> milik paguyuban <mark>Sukorejo</mark>",
+								Rank:      0.09,
+							},
+						}
+					},
+					func(ctx context.Context, query string, limit int) error {
+						return nil
+					},
+				).Once()
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.mockBehaviours()
+			gotResults, gotErr := searchService.Search(context.Background(), testCase.inputSearch)
+
+			if testCase.expectedError != nil {
+				assert.ErrorIs(t, gotErr, testCase.expectedError)
+			} else {
+				assert.NoError(t, gotErr)
+				assert.Equal(t, testCase.expectedResults, gotResults)
+			}
+		})
+	}
+}
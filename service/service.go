@@ -13,6 +13,22 @@ var (
 	ErrInvalidPayload     = errors.New("service: invalid payload")
 	ErrCredentialNotMatch = errors.New("service: credential not match")
 	ErrTimeParsing        = errors.New("service: time parsing error")
+	ErrDateParsing        = errors.New("service: date parsing error")
+	ErrFileTooLarge       = errors.New("service: file too large")
+	ErrUnsupportedFile    = errors.New("service: unsupported file type")
+	ErrStatusUnchanged    = errors.New("service: group already has the given status")
+	ErrGroupInactive      = errors.New("service: group is suspended or dissolved")
+	ErrParentDeleted      = errors.New("service: parent data is deleted")
+	ErrAlreadyReviewed    = errors.New("service: submission already reviewed")
+	ErrTooManyRequests    = errors.New("service: too many requests")
+	ErrGroupHasShows      = errors.New("service: group has show schedules blocking its deletion")
+	ErrVersionMismatch    = errors.New("service: data has been modified since it was read")
+	ErrVersionRequired    = errors.New("service: version of the data is required")
+	ErrNotEnoughAvailable = errors.New("service: not enough items available")
+	ErrLoanReturned       = errors.New("service: loan already returned")
+	ErrCodeNotGenuine     = errors.New("service: code is malformed, tampered with or signed with an unknown key")
+	ErrNotRegistered      = errors.New("service: group has no registration number yet")
+	ErrPropertyOnLoan     = errors.New("service: property has items out on loan")
 )
 
 func MapError(from error) error {
@@ -22,6 +38,12 @@ func MapError(from error) error {
 		return ErrRepository
 	} else if errors.Is(from, repository.ErrRecordAlreadyExists) {
 		return ErrDataAlreadyExists
+	} else if errors.Is(from, repository.ErrRecordModified) {
+		return ErrVersionMismatch
+	} else if errors.Is(from, repository.ErrNotEnoughQuantity) {
+		return ErrNotEnoughAvailable
+	} else if errors.Is(from, repository.ErrQuotaExceeded) {
+		return ErrTooManyRequests
 	} else {
 		return ErrRepository
 	}
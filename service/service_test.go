@@ -29,6 +29,16 @@ func TestMapError(t *testing.T) {
 			inputError:    repository.ErrRecordAlreadyExists,
 			expectedError: ErrDataAlreadyExists,
 		},
+		{
+			name:          "it should return service.ErrVersionMismatch, when input error is repository.ErrRecordModified",
+			inputError:    repository.ErrRecordModified,
+			expectedError: ErrVersionMismatch,
+		},
+		{
+			name:          "it should return service.ErrNotEnoughAvailable, when input error is repository.ErrNotEnoughQuantity",
+			inputError:    repository.ErrNotEnoughQuantity,
+			expectedError: ErrNotEnoughAvailable,
+		},
 		{
 			name:          "it should return service.ErrRepository, when input error is general error",
 			inputError:    errors.New("error general"),
@@ -6,9 +6,8 @@ import (
 	context "context"
 
 	payload "github.com/erikrios/reog-apps-apis/model/payload"
-	mock "github.com/stretchr/testify/mock"
-
 	response "github.com/erikrios/reog-apps-apis/model/response"
+	mock "github.com/stretchr/testify/mock"
 )
 
 // ShowScheduleService is an autogenerated mock type for the ShowScheduleService type
@@ -37,13 +36,13 @@ func (_m *ShowScheduleService) Create(ctx context.Context, p payload.CreateShowS
 	return r0, r1
 }
 
-// Delete provides a mock function with given fields: ctx, id
-func (_m *ShowScheduleService) Delete(ctx context.Context, id string) error {
-	ret := _m.Called(ctx, id)
+// Delete provides a mock function with given fields: ctx, id, version
+func (_m *ShowScheduleService) Delete(ctx context.Context, id string, version int) error {
+	ret := _m.Called(ctx, id, version)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
-		r0 = rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) error); ok {
+		r0 = rf(ctx, id, version)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -118,13 +117,27 @@ func (_m *ShowScheduleService) GetByID(ctx context.Context, id string) (response
 	return r0, r1
 }
 
-// Update provides a mock function with given fields: ctx, id, p
-func (_m *ShowScheduleService) Update(ctx context.Context, id string, p payload.UpdateShowSchedule) error {
-	ret := _m.Called(ctx, id, p)
+// Patch provides a mock function with given fields: ctx, id, version, p
+func (_m *ShowScheduleService) Patch(ctx context.Context, id string, version int, p payload.PatchShowSchedule) error {
+	ret := _m.Called(ctx, id, version, p)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, payload.PatchShowSchedule) error); ok {
+		r0 = rf(ctx, id, version, p)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Update provides a mock function with given fields: ctx, id, version, p
+func (_m *ShowScheduleService) Update(ctx context.Context, id string, version int, p payload.UpdateShowSchedule) error {
+	ret := _m.Called(ctx, id, version, p)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, payload.UpdateShowSchedule) error); ok {
-		r0 = rf(ctx, id, p)
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, payload.UpdateShowSchedule) error); ok {
+		r0 = rf(ctx, id, version, p)
 	} else {
 		r0 = ret.Error(0)
 	}
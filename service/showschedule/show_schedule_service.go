@@ -12,6 +12,7 @@ type ShowScheduleService interface {
 	GetAll(ctx context.Context) (responses []response.ShowSchedule, err error)
 	GetByID(ctx context.Context, id string) (response response.ShowScheduleDetails, err error)
 	GetByGroupID(ctx context.Context, groupID string) (responses []response.ShowSchedule, err error)
-	Update(ctx context.Context, id string, p payload.UpdateShowSchedule) (err error)
-	Delete(ctx context.Context, id string) (err error)
+	Update(ctx context.Context, id string, version int, p payload.UpdateShowSchedule) (err error)
+	Patch(ctx context.Context, id string, version int, p payload.PatchShowSchedule) (err error)
+	Delete(ctx context.Context, id string, version int) (err error)
 }
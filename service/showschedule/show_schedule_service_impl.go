@@ -33,7 +33,7 @@ func NewShowScheduleServiceImpl(
 }
 
 func (s *showScheduleServiceImpl) Create(ctx context.Context, p payload.CreateShowSchedule) (id string, err error) {
-	if validateErr := validator.Validate(p); validateErr != nil {
+	if validateErr := validator.Validate(p); validateErr != nil || (p.Latitude == nil) != (p.Longitude == nil) {
 		err = service.ErrInvalidPayload
 		return
 	}
@@ -50,11 +50,17 @@ func (s *showScheduleServiceImpl) Create(ctx context.Context, p payload.CreateSh
 		return
 	}
 
-	if _, repoErr := s.groupRepository.FindByID(ctx, p.GroupID); repoErr != nil {
+	group, repoErr := s.groupRepository.FindByID(ctx, p.GroupID)
+	if repoErr != nil {
 		err = service.MapError(repoErr)
 		return
 	}
 
+	if group.Status == entity.GroupStatusSuspended || group.Status == entity.GroupStatusDissolved {
+		err = service.ErrGroupInactive
+		return
+	}
+
 	id, genErr := s.idGenerator.GenerateShowScheduleID()
 	if genErr != nil {
 		err = service.MapError(genErr)
@@ -62,11 +68,13 @@ func (s *showScheduleServiceImpl) Create(ctx context.Context, p payload.CreateSh
 	}
 
 	showSchedule := entity.ShowSchedule{
-		ID:       id,
-		GroupID:  p.GroupID,
-		Place:    p.Place,
-		StartOn:  startOn,
-		FinishOn: finishOn,
+		ID:        id,
+		GroupID:   &p.GroupID,
+		Place:     p.Place,
+		StartOn:   startOn,
+		FinishOn:  finishOn,
+		Latitude:  p.Latitude,
+		Longitude: p.Longitude,
 	}
 
 	if repoErr := s.showScheduleRepository.Insert(ctx, showSchedule); repoErr != nil {
@@ -87,11 +95,16 @@ func (s *showScheduleServiceImpl) GetAll(ctx context.Context) (responses []respo
 
 	for _, entity := range entities {
 		response := response.ShowSchedule{
-			ID:       entity.ID,
-			GroupID:  entity.GroupID,
-			Place:    entity.Place,
-			StartOn:  entity.StartOn.Format(time.RFC822),
-			FinishOn: entity.FinishOn.Format(time.RFC822),
+			ID:        entity.ID,
+			Place:     entity.Place,
+			StartOn:   entity.StartOn.Format(time.RFC822),
+			FinishOn:  entity.FinishOn.Format(time.RFC822),
+			Latitude:  entity.Latitude,
+			Longitude: entity.Longitude,
+			Version:   entity.Version,
+		}
+		if entity.GroupID != nil {
+			response.GroupID = *entity.GroupID
 		}
 
 		responses = append(responses, response)
@@ -111,8 +124,16 @@ func (s *showScheduleServiceImpl) GetByID(ctx context.Context, id string) (respo
 	response.Place = entity.Place
 	response.StartOn = entity.StartOn.Format(time.RFC822)
 	response.FinishOn = entity.FinishOn.Format(time.RFC822)
+	response.Latitude = entity.Latitude
+	response.Longitude = entity.Longitude
+	response.Version = entity.Version
+
+	// A show schedule detached from its deleted group has no group to look up
+	if entity.GroupID == nil {
+		return
+	}
 
-	groupEntity, repoErr := s.groupRepository.FindByID(ctx, entity.GroupID)
+	groupEntity, repoErr := s.groupRepository.FindByID(ctx, *entity.GroupID)
 	if repoErr != nil {
 		err = service.MapError(repoErr)
 		return
@@ -141,11 +162,16 @@ func (s *showScheduleServiceImpl) GetByGroupID(ctx context.Context, groupID stri
 
 	for _, entity := range entities {
 		response := response.ShowSchedule{
-			ID:       entity.ID,
-			GroupID:  entity.GroupID,
-			Place:    entity.Place,
-			StartOn:  entity.StartOn.Format(time.RFC822),
-			FinishOn: entity.FinishOn.Format(time.RFC822),
+			ID:        entity.ID,
+			Place:     entity.Place,
+			StartOn:   entity.StartOn.Format(time.RFC822),
+			FinishOn:  entity.FinishOn.Format(time.RFC822),
+			Latitude:  entity.Latitude,
+			Longitude: entity.Longitude,
+			Version:   entity.Version,
+		}
+		if entity.GroupID != nil {
+			response.GroupID = *entity.GroupID
 		}
 
 		responses = append(responses, response)
@@ -154,8 +180,8 @@ func (s *showScheduleServiceImpl) GetByGroupID(ctx context.Context, groupID stri
 	return
 }
 
-func (s *showScheduleServiceImpl) Update(ctx context.Context, id string, p payload.UpdateShowSchedule) (err error) {
-	if validateErr := validator.Validate(p); validateErr != nil {
+func (s *showScheduleServiceImpl) Update(ctx context.Context, id string, version int, p payload.UpdateShowSchedule) (err error) {
+	if validateErr := validator.Validate(p); validateErr != nil || (p.Latitude == nil) != (p.Longitude == nil) {
 		err = service.ErrInvalidPayload
 		return
 	}
@@ -173,20 +199,64 @@ func (s *showScheduleServiceImpl) Update(ctx context.Context, id string, p paylo
 	}
 
 	showSchedule := entity.ShowSchedule{
-		Place:    p.Place,
-		StartOn:  startOn,
-		FinishOn: finishOn,
+		Place:     p.Place,
+		StartOn:   startOn,
+		FinishOn:  finishOn,
+		Latitude:  p.Latitude,
+		Longitude: p.Longitude,
+	}
+
+	if repoErr := s.showScheduleRepository.Update(ctx, id, version, showSchedule); repoErr != nil {
+		err = service.MapError(repoErr)
+	}
+
+	return
+}
+
+// Patch applies a merge patch to the show schedule if it still has the given version, so that one of the times can
+// be moved while the other is kept.
+func (s *showScheduleServiceImpl) Patch(ctx context.Context, id string, version int, p payload.PatchShowSchedule) (err error) {
+	if validateErr := validator.Validate(p); validateErr != nil || (p.Latitude == nil) != (p.Longitude == nil) {
+		err = service.ErrInvalidPayload
+		return
+	}
+
+	showSchedule := entity.ShowSchedule{
+		Latitude:  p.Latitude,
+		Longitude: p.Longitude,
+	}
+
+	if p.Place != nil {
+		showSchedule.Place = *p.Place
+	}
+
+	if p.StartOn != nil {
+		startOn, parseErr := time.Parse(time.RFC822, *p.StartOn)
+		if parseErr != nil {
+			err = service.ErrTimeParsing
+			return
+		}
+		showSchedule.StartOn = startOn
+	}
+
+	if p.FinishOn != nil {
+		finishOn, parseErr := time.Parse(time.RFC822, *p.FinishOn)
+		if parseErr != nil {
+			err = service.ErrTimeParsing
+			return
+		}
+		showSchedule.FinishOn = finishOn
 	}
 
-	if repoErr := s.showScheduleRepository.Update(ctx, id, showSchedule); repoErr != nil {
+	if repoErr := s.showScheduleRepository.Update(ctx, id, version, showSchedule); repoErr != nil {
 		err = service.MapError(repoErr)
 	}
 
 	return
 }
 
-func (s *showScheduleServiceImpl) Delete(ctx context.Context, id string) (err error) {
-	if repoErr := s.showScheduleRepository.Delete(ctx, id); repoErr != nil {
+func (s *showScheduleServiceImpl) Delete(ctx context.Context, id string, version int) (err error) {
+	if repoErr := s.showScheduleRepository.Delete(ctx, id, version); repoErr != nil {
 		err = service.MapError(repoErr)
 	}
 
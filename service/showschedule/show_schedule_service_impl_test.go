@@ -30,6 +30,8 @@ func TestCreate(t *testing.T) {
 		mockIDGen,
 	)
 
+	latitude, outOfRange := -7.95, 200.0
+
 	testCases := []struct {
 		name                    string
 		inputCreateShowSchedule payload.CreateShowSchedule
@@ -49,6 +51,33 @@ func TestCreate(t *testing.T) {
 			expectedError:  service.ErrInvalidPayload,
 			mockBehaviours: func() {},
 		},
+		{
+			name: "it should return service.ErrInvalidPayload error, when only latitude of the venue is given",
+			inputCreateShowSchedule: payload.CreateShowSchedule{
+				GroupID:  "g-xyz",
+				Place:    "Lapangan Bungkal",
+				StartOn:  "02 Feb 06 15:04 WIB",
+				FinishOn: "02 Feb 06 17:05 WIB",
+				Latitude: &latitude,
+			},
+			expectedID:     "",
+			expectedError:  service.ErrInvalidPayload,
+			mockBehaviours: func() {},
+		},
+		{
+			name: "it should return service.ErrInvalidPayload error, when longitude of the venue is out of range",
+			inputCreateShowSchedule: payload.CreateShowSchedule{
+				GroupID:   "g-xyz",
+				Place:     "Lapangan Bungkal",
+				StartOn:   "02 Feb 06 15:04 WIB",
+				FinishOn:  "02 Feb 06 17:05 WIB",
+				Latitude:  &latitude,
+				Longitude: &outOfRange,
+			},
+			expectedID:     "",
+			expectedError:  service.ErrInvalidPayload,
+			mockBehaviours: func() {},
+		},
 		{
 			name: "it should return service.ErrTimeParsing error, when StartOn payload is invalid",
 			inputCreateShowSchedule: payload.CreateShowSchedule{
@@ -98,6 +127,31 @@ func TestCreate(t *testing.T) {
 				).Once()
 			},
 		},
+		{
+			name: "it should return service.ErrGroupInactive error, when group is suspended or dissolved",
+			inputCreateShowSchedule: payload.CreateShowSchedule{
+				GroupID:  "g-xyz",
+				Place:    "Lapangan Bungkal",
+				StartOn:  "02 Feb 06 15:04 WIB",
+				FinishOn: "02 Feb 06 15:04 WIB",
+			},
+			expectedID:    "",
+			expectedError: service.ErrGroupInactive,
+			mockBehaviours: func() {
+				mockGroupRepo.On(
+					"FindByID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, id string) entity.Group {
+						return entity.Group{ID: "g-xyz", Status: entity.GroupStatusSuspended}
+					},
+					func(ctx context.Context, id string) error {
+						return nil
+					},
+				).Once()
+			},
+		},
 		{
 			name: "it should return service.ErrRepository error, when id generator return an error",
 			inputCreateShowSchedule: payload.CreateShowSchedule{
@@ -293,7 +347,7 @@ func TestGetAll(t *testing.T) {
 						return []entity.ShowSchedule{
 							{
 								ID:       "s-EuKgD1O",
-								GroupID:  "g-xyz",
+								GroupID:  stringPtr("g-xyz"),
 								Place:    "Lapangan Bungkal",
 								StartOn:  time.Now(),
 								FinishOn: time.Now().Add(3 * time.Hour),
@@ -389,7 +443,7 @@ func TestGetByID(t *testing.T) {
 					func(ctx context.Context, id string) entity.ShowSchedule {
 						return entity.ShowSchedule{
 							ID:       "s-EuKgD1O",
-							GroupID:  "g-xyz",
+							GroupID:  stringPtr("g-xyz"),
 							Place:    "Lapangan Bungkal",
 							StartOn:  time.Now(),
 							FinishOn: time.Now().Add(3 * time.Hour),
@@ -434,7 +488,7 @@ func TestGetByID(t *testing.T) {
 					func(ctx context.Context, id string) entity.ShowSchedule {
 						return entity.ShowSchedule{
 							ID:       "s-EuKgD1O",
-							GroupID:  "g-xyz",
+							GroupID:  stringPtr("g-xyz"),
 							Place:    "Lapangan Bungkal",
 							StartOn:  time.Now(),
 							FinishOn: time.Now().Add(3 * time.Hour),
@@ -462,6 +516,35 @@ func TestGetByID(t *testing.T) {
 				).Once()
 			},
 		},
+		{
+			name:          "it should return a show schedule without group, when it was detached from its deleted group",
+			expectedError: nil,
+			expectedShowSchedule: response.ShowScheduleDetails{
+				ID:       "s-EuKgD1O",
+				Place:    "Lapangan Bungkal",
+				StartOn:  time.Now().Format(time.RFC822),
+				FinishOn: time.Now().Add(3 * time.Hour).Format(time.RFC822),
+			},
+			mockBehaviours: func() {
+				mockShowScheduleRepo.On(
+					"FindByID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+				).Return(
+					func(ctx context.Context, id string) entity.ShowSchedule {
+						return entity.ShowSchedule{
+							ID:       "s-EuKgD1O",
+							Place:    "Lapangan Bungkal",
+							StartOn:  time.Now(),
+							FinishOn: time.Now().Add(3 * time.Hour),
+						}
+					},
+					func(ctx context.Context, id string) error {
+						return nil
+					},
+				).Once()
+			},
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -581,7 +664,7 @@ func TestGetByGroupID(t *testing.T) {
 						return []entity.ShowSchedule{
 							{
 								ID:       "s-EuKgD1O",
-								GroupID:  "g-xyz",
+								GroupID:  stringPtr("g-xyz"),
 								Place:    "Lapangan Bungkal",
 								StartOn:  time.Now(),
 								FinishOn: time.Now().Add(3 * time.Hour),
@@ -677,14 +760,38 @@ func TestUpdate(t *testing.T) {
 					"Update",
 					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
 					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					1,
 					mock.AnythingOfType(fmt.Sprintf("%T", entity.ShowSchedule{})),
 				).Return(
-					func(ctx context.Context, id string, e entity.ShowSchedule) error {
+					func(ctx context.Context, id string, version int, e entity.ShowSchedule) error {
 						return repository.ErrRecordNotFound
 					},
 				).Once()
 			},
 		},
+		{
+			name:    "it should return service.ErrVersionMismatch error, when the show schedule has been updated since the given version",
+			inputID: "s-EuKgD1O",
+			inputUpdateShowSchedule: payload.UpdateShowSchedule{
+				Place:    "Lapangan Bungkal",
+				StartOn:  "02 Feb 06 15:04 WIB",
+				FinishOn: "02 Feb 06 15:04 WIB",
+			},
+			expectedError: service.ErrVersionMismatch,
+			mockBehaviours: func() {
+				mockShowScheduleRepo.On(
+					"Update",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					1,
+					mock.AnythingOfType(fmt.Sprintf("%T", entity.ShowSchedule{})),
+				).Return(
+					func(ctx context.Context, id string, version int, e entity.ShowSchedule) error {
+						return repository.ErrRecordModified
+					},
+				).Once()
+			},
+		},
 		{
 			name:    "it should return service.ErrRepository error, when show schedule repository return an error",
 			inputID: "s-EuKgD1O",
@@ -699,9 +806,10 @@ func TestUpdate(t *testing.T) {
 					"Update",
 					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
 					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					1,
 					mock.AnythingOfType(fmt.Sprintf("%T", entity.ShowSchedule{})),
 				).Return(
-					func(ctx context.Context, id string, e entity.ShowSchedule) error {
+					func(ctx context.Context, id string, version int, e entity.ShowSchedule) error {
 						return repository.ErrDatabase
 					},
 				).Once()
@@ -721,9 +829,109 @@ func TestUpdate(t *testing.T) {
 					"Update",
 					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
 					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					1,
+					mock.AnythingOfType(fmt.Sprintf("%T", entity.ShowSchedule{})),
+				).Return(
+					func(ctx context.Context, id string, version int, e entity.ShowSchedule) error {
+						return nil
+					},
+				).Once()
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.mockBehaviours()
+
+			gotErr := showScheduleService.Update(context.Background(), testCase.inputID, 1, testCase.inputUpdateShowSchedule)
+
+			if testCase.expectedError != nil {
+				assert.ErrorIs(t, gotErr, testCase.expectedError)
+			} else {
+				assert.NoError(t, gotErr)
+			}
+		})
+	}
+}
+
+func TestPatch(t *testing.T) {
+	mockShowScheduleRepo := &mssr.ShowScheduleRepository{}
+	mockGroupRepo := &mgr.GroupRepository{}
+	mockIDGen := &mig.IDGenerator{}
+
+	var showScheduleService ShowScheduleService = NewShowScheduleServiceImpl(
+		mockShowScheduleRepo,
+		mockGroupRepo,
+		mockIDGen,
+	)
+
+	latitude := -7.87
+	finishOn, _ := time.Parse(time.RFC822, "05 May 22 19:00 WIB")
+
+	testCases := []struct {
+		name                   string
+		inputID                string
+		inputPatchShowSchedule payload.PatchShowSchedule
+		expectedError          error
+		mockBehaviours         func()
+	}{
+		{
+			name:                   "it should return service.ErrInvalidPayload error, when a present field is empty",
+			inputID:                "s-EuKgD1O",
+			inputPatchShowSchedule: payload.PatchShowSchedule{Place: stringPtr("")},
+			expectedError:          service.ErrInvalidPayload,
+			mockBehaviours:         func() {},
+		},
+		{
+			name:                   "it should return service.ErrInvalidPayload error, when only latitude is given",
+			inputID:                "s-EuKgD1O",
+			inputPatchShowSchedule: payload.PatchShowSchedule{Latitude: &latitude},
+			expectedError:          service.ErrInvalidPayload,
+			mockBehaviours:         func() {},
+		},
+		{
+			name:                   "it should return service.ErrTimeParsing error, when StartOn payload is invalid",
+			inputID:                "s-EuKgD1O",
+			inputPatchShowSchedule: payload.PatchShowSchedule{StartOn: stringPtr("Feb 02 06 15:04 WIB")},
+			expectedError:          service.ErrTimeParsing,
+			mockBehaviours:         func() {},
+		},
+		{
+			name:                   "it should return service.ErrDataNotFound error, when show schedule repository return an error",
+			inputID:                "s-EuKgD1O",
+			inputPatchShowSchedule: payload.PatchShowSchedule{Place: stringPtr("Lapangan Bungkal")},
+			expectedError:          service.ErrDataNotFound,
+			mockBehaviours: func() {
+				mockShowScheduleRepo.On(
+					"Update",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					1,
 					mock.AnythingOfType(fmt.Sprintf("%T", entity.ShowSchedule{})),
 				).Return(
-					func(ctx context.Context, id string, e entity.ShowSchedule) error {
+					func(ctx context.Context, id string, version int, e entity.ShowSchedule) error {
+						return repository.ErrRecordNotFound
+					},
+				).Once()
+			},
+		},
+		{
+			name:                   "it should only write the finish time, when it is the only field of the patch",
+			inputID:                "s-EuKgD1O",
+			inputPatchShowSchedule: payload.PatchShowSchedule{FinishOn: stringPtr("05 May 22 19:00 WIB")},
+			expectedError:          nil,
+			mockBehaviours: func() {
+				mockShowScheduleRepo.On(
+					"Update",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					"s-EuKgD1O",
+					1,
+					mock.MatchedBy(func(e entity.ShowSchedule) bool {
+						return e.Place == "" && e.StartOn.IsZero() && e.FinishOn.Equal(finishOn) && e.Latitude == nil
+					}),
+				).Return(
+					func(ctx context.Context, id string, version int, e entity.ShowSchedule) error {
 						return nil
 					},
 				).Once()
@@ -735,7 +943,7 @@ func TestUpdate(t *testing.T) {
 		t.Run(testCase.name, func(t *testing.T) {
 			testCase.mockBehaviours()
 
-			gotErr := showScheduleService.Update(context.Background(), testCase.inputID, testCase.inputUpdateShowSchedule)
+			gotErr := showScheduleService.Patch(context.Background(), testCase.inputID, 1, testCase.inputPatchShowSchedule)
 
 			if testCase.expectedError != nil {
 				assert.ErrorIs(t, gotErr, testCase.expectedError)
@@ -772,13 +980,31 @@ func TestDelete(t *testing.T) {
 					"Delete",
 					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
 					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					1,
 				).Return(
-					func(ctx context.Context, id string) error {
+					func(ctx context.Context, id string, version int) error {
 						return repository.ErrRecordNotFound
 					},
 				).Once()
 			},
 		},
+		{
+			name:          "it should return service.ErrVersionMismatch error, when the show schedule has been updated since the given version",
+			inputID:       "s-EuKgD1O",
+			expectedError: service.ErrVersionMismatch,
+			mockBehaviours: func() {
+				mockShowScheduleRepo.On(
+					"Delete",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					1,
+				).Return(
+					func(ctx context.Context, id string, version int) error {
+						return repository.ErrRecordModified
+					},
+				).Once()
+			},
+		},
 		{
 			name:          "it should return service.ErrRepository error, when show schedule repository return an error",
 			inputID:       "s-EuKgD1O",
@@ -788,8 +1014,9 @@ func TestDelete(t *testing.T) {
 					"Delete",
 					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
 					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					1,
 				).Return(
-					func(ctx context.Context, id string) error {
+					func(ctx context.Context, id string, version int) error {
 						return repository.ErrDatabase
 					},
 				).Once()
@@ -804,8 +1031,9 @@ func TestDelete(t *testing.T) {
 					"Delete",
 					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
 					mock.AnythingOfType(fmt.Sprintf("%T", "")),
+					1,
 				).Return(
-					func(ctx context.Context, id string) error {
+					func(ctx context.Context, id string, version int) error {
 						return nil
 					},
 				).Once()
@@ -817,7 +1045,7 @@ func TestDelete(t *testing.T) {
 		t.Run(testCase.name, func(t *testing.T) {
 			testCase.mockBehaviours()
 
-			gotErr := showScheduleService.Delete(context.Background(), testCase.inputID)
+			gotErr := showScheduleService.Delete(context.Background(), testCase.inputID, 1)
 
 			if testCase.expectedError != nil {
 				assert.ErrorIs(t, gotErr, testCase.expectedError)
@@ -827,3 +1055,7 @@ func TestDelete(t *testing.T) {
 		})
 	}
 }
+
+func stringPtr(s string) *string {
+	return &s
+}
@@ -0,0 +1,37 @@
+// Code generated by mockery v2.10.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	payload "github.com/erikrios/reog-apps-apis/model/payload"
+	response "github.com/erikrios/reog-apps-apis/model/response"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// StatsService is an autogenerated mock type for the StatsService type
+type StatsService struct {
+	mock.Mock
+}
+
+// GetDistricts provides a mock function with given fields: ctx, p
+func (_m *StatsService) GetDistricts(ctx context.Context, p payload.GetDistrictStats) (response.DistrictStatsReport, error) {
+	ret := _m.Called(ctx, p)
+
+	var r0 response.DistrictStatsReport
+	if rf, ok := ret.Get(0).(func(context.Context, payload.GetDistrictStats) response.DistrictStatsReport); ok {
+		r0 = rf(ctx, p)
+	} else {
+		r0 = ret.Get(0).(response.DistrictStatsReport)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, payload.GetDistrictStats) error); ok {
+		r1 = rf(ctx, p)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
@@ -0,0 +1,12 @@
+package stats
+
+import (
+	"context"
+
+	"github.com/erikrios/reog-apps-apis/model/payload"
+	"github.com/erikrios/reog-apps-apis/model/response"
+)
+
+type StatsService interface {
+	GetDistricts(ctx context.Context, p payload.GetDistrictStats) (response response.DistrictStatsReport, err error)
+}
@@ -0,0 +1,117 @@
+package stats
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/erikrios/reog-apps-apis/model/payload"
+	"github.com/erikrios/reog-apps-apis/model/response"
+	"github.com/erikrios/reog-apps-apis/repository/stats"
+	"github.com/erikrios/reog-apps-apis/service"
+	"gopkg.in/validator.v2"
+)
+
+const dateLayout = "2006-01-02"
+
+type statsServiceImpl struct {
+	statsRepository stats.StatsRepository
+}
+
+func NewStatsServiceImpl(statsRepository stats.StatsRepository) *statsServiceImpl {
+	return &statsServiceImpl{statsRepository: statsRepository}
+}
+
+// GetDistricts reports the districts having at least one group, property or show schedule counted, by name.
+func (s *statsServiceImpl) GetDistricts(ctx context.Context, p payload.GetDistrictStats) (report response.DistrictStatsReport, err error) {
+	if validateErr := validator.Validate(p); validateErr != nil {
+		err = service.ErrInvalidPayload
+		return
+	}
+
+	from, to, err := dateRange(p)
+	if err != nil {
+		return
+	}
+
+	groupCounts, repoErr := s.statsRepository.CountActiveGroupsByDistrict(ctx)
+	if repoErr != nil {
+		err = service.MapError(repoErr)
+		return
+	}
+
+	propertyAmounts, repoErr := s.statsRepository.SumPropertyAmountsByDistrict(ctx)
+	if repoErr != nil {
+		err = service.MapError(repoErr)
+		return
+	}
+
+	// The shows of the last day are counted too
+	showCounts, repoErr := s.statsRepository.CountShowSchedulesByDistrict(ctx, from, to.AddDate(0, 0, 1))
+	if repoErr != nil {
+		err = service.MapError(repoErr)
+		return
+	}
+
+	districts := make(map[string]*response.DistrictStats)
+	district := func(id, name string) *response.DistrictStats {
+		if _, ok := districts[id]; !ok {
+			districts[id] = &response.DistrictStats{DistrictID: id, DistrictName: name, Properties: []response.PropertyTotal{}}
+		}
+		return districts[id]
+	}
+
+	for _, count := range groupCounts {
+		district(count.DistrictID, count.DistrictName).ActiveGroups = count.Count
+	}
+	for _, amount := range propertyAmounts {
+		d := district(amount.DistrictID, amount.DistrictName)
+		d.Properties = append(d.Properties, response.PropertyTotal{Name: amount.Name, Amount: amount.Amount})
+	}
+	for _, count := range showCounts {
+		district(count.DistrictID, count.DistrictName).ShowSchedules = count.Count
+	}
+
+	report = response.DistrictStatsReport{
+		From:      from.Format(dateLayout),
+		To:        to.Format(dateLayout),
+		Districts: make([]response.DistrictStats, 0, len(districts)),
+	}
+	for _, d := range districts {
+		report.Districts = append(report.Districts, *d)
+	}
+	sort.Slice(report.Districts, func(i, j int) bool {
+		a, b := report.Districts[i], report.Districts[j]
+		if a.DistrictName != b.DistrictName {
+			return a.DistrictName < b.DistrictName
+		}
+		return a.DistrictID < b.DistrictID
+	})
+	return
+}
+
+// dateRange parses the days of the range, which defaults to the current year.
+func dateRange(p payload.GetDistrictStats) (from, to time.Time, err error) {
+	year := time.Now().Year()
+	from = time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC)
+	to = time.Date(year, time.December, 31, 0, 0, 0, 0, time.UTC)
+
+	if p.From != "" {
+		if from, err = time.Parse(dateLayout, p.From); err != nil {
+			err = service.ErrDateParsing
+			return
+		}
+	}
+
+	if p.To != "" {
+		if to, err = time.Parse(dateLayout, p.To); err != nil {
+			err = service.ErrDateParsing
+			return
+		}
+	}
+
+	if to.Before(from) {
+		err = service.ErrInvalidPayload
+	}
+	return
+}
@@ -0,0 +1,215 @@
+package stats
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/erikrios/reog-apps-apis/model/payload"
+	"github.com/erikrios/reog-apps-apis/model/response"
+	"github.com/erikrios/reog-apps-apis/repository"
+	"github.com/erikrios/reog-apps-apis/repository/stats"
+	msr "github.com/erikrios/reog-apps-apis/repository/stats/mocks"
+	"github.com/erikrios/reog-apps-apis/service"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestGetDistricts(t *testing.T) {
+	mockStatsRepo := &msr.StatsRepository{}
+
+	var statsService StatsService = NewStatsServiceImpl(mockStatsRepo)
+
+	year := time.Now().Year()
+
+	testCases := []struct {
+		name           string
+		inputPayload   payload.GetDistrictStats
+		expectedReport response.DistrictStatsReport
+		expectedError  error
+		mockBehaviours func()
+	}{
+		{
+			name:           "it should return service.ErrDateParsing error, when from is not a date",
+			inputPayload:   payload.GetDistrictStats{From: "01-01-2022"},
+			expectedError:  service.ErrDateParsing,
+			mockBehaviours: func() {},
+		},
+		{
+			name:           "it should return service.ErrInvalidPayload error, when to is before from",
+			inputPayload:   payload.GetDistrictStats{From: "2022-06-01", To: "2022-05-31"},
+			expectedError:  service.ErrInvalidPayload,
+			mockBehaviours: func() {},
+		},
+		{
+			name:          "it should return service.ErrRepository error, when stats repository return an error",
+			inputPayload:  payload.GetDistrictStats{},
+			expectedError: service.ErrRepository,
+			mockBehaviours: func() {
+				mockStatsRepo.On(
+					"CountActiveGroupsByDistrict",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+				).Return(
+					func(ctx context.Context) []stats.DistrictCount {
+						return nil
+					},
+					func(ctx context.Context) error {
+						return repository.ErrDatabase
+					},
+				).Once()
+			},
+		},
+		{
+			name:         "it should return the stats of the current year, when the range is not given",
+			inputPayload: payload.GetDistrictStats{},
+			expectedReport: response.DistrictStatsReport{
+				From:      fmt.Sprintf("%d-01-01", year),
+				To:        fmt.Sprintf("%d-12-31", year),
+				Districts: []response.DistrictStats{},
+			},
+			expectedError: nil,
+			mockBehaviours: func() {
+				mockStatsRepo.On(
+					"CountActiveGroupsByDistrict",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+				).Return(
+					func(ctx context.Context) []stats.DistrictCount {
+						return []stats.DistrictCount{}
+					},
+					func(ctx context.Context) error {
+						return nil
+					},
+				).Once()
+
+				mockStatsRepo.On(
+					"SumPropertyAmountsByDistrict",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+				).Return(
+					func(ctx context.Context) []stats.DistrictPropertyAmount {
+						return []stats.DistrictPropertyAmount{}
+					},
+					func(ctx context.Context) error {
+						return nil
+					},
+				).Once()
+
+				mockStatsRepo.On(
+					"CountShowSchedulesByDistrict",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC),
+					time.Date(year+1, time.January, 1, 0, 0, 0, 0, time.UTC),
+				).Return(
+					func(ctx context.Context, from, to time.Time) []stats.DistrictCount {
+						return []stats.DistrictCount{}
+					},
+					func(ctx context.Context, from, to time.Time) error {
+						return nil
+					},
+				).Once()
+			},
+		},
+		{
+			name:         "it should merge the stats by district, when no error is returned",
+			inputPayload: payload.GetDistrictStats{From: "2022-05-01", To: "2022-05-31"},
+			expectedReport: response.DistrictStatsReport{
+				From: "2022-05-01",
+				To:   "2022-05-31",
+				Districts: []response.DistrictStats{
+					{
+						DistrictID:    "3502030",
+						DistrictName:  "Bungkal",
+						ActiveGroups:  0,
+						Properties:    []response.PropertyTotal{},
+						ShowSchedules: 2,
+					},
+					{
+						DistrictID:   "3502010",
+						DistrictName: "Ngrayun",
+						ActiveGroups: 3,
+						Properties: []response.PropertyTotal{
+							{Name: "Dadak Merak", Amount: 4},
+							{Name: "Kendang", Amount: 7},
+						},
+						ShowSchedules: 5,
+					},
+					{
+						DistrictID:   "3502020",
+						DistrictName: "Slahung",
+						ActiveGroups: 1,
+						Properties: []response.PropertyTotal{
+							{Name: "Dadak Merak", Amount: 1},
+						},
+						ShowSchedules: 0,
+					},
+				},
+			},
+			expectedError: nil,
+			mockBehaviours: func() {
+				mockStatsRepo.On(
+					"CountActiveGroupsByDistrict",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+				).Return(
+					func(ctx context.Context) []stats.DistrictCount {
+						return []stats.DistrictCount{
+							{DistrictID: "3502020", DistrictName: "Slahung", Count: 1},
+							{DistrictID: "3502010", DistrictName: "Ngrayun", Count: 3},
+						}
+					},
+					func(ctx context.Context) error {
+						return nil
+					},
+				).Once()
+
+				mockStatsRepo.On(
+					"SumPropertyAmountsByDistrict",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+				).Return(
+					func(ctx context.Context) []stats.DistrictPropertyAmount {
+						return []stats.DistrictPropertyAmount{
+							{DistrictID: "3502010", DistrictName: "Ngrayun", Name: "Dadak Merak", Amount: 4},
+							{DistrictID: "3502020", DistrictName: "Slahung", Name: "Dadak Merak", Amount: 1},
+							{DistrictID: "3502010", DistrictName: "Ngrayun", Name: "Kendang", Amount: 7},
+						}
+					},
+					func(ctx context.Context) error {
+						return nil
+					},
+				).Once()
+
+				mockStatsRepo.On(
+					"CountShowSchedulesByDistrict",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					time.Date(2022, time.May, 1, 0, 0, 0, 0, time.UTC),
+					time.Date(2022, time.June, 1, 0, 0, 0, 0, time.UTC),
+				).Return(
+					func(ctx context.Context, from, to time.Time) []stats.DistrictCount {
+						return []stats.DistrictCount{
+							{DistrictID: "3502010", DistrictName: "Ngrayun", Count: 5},
+							{DistrictID: "3502030", DistrictName: "Bungkal", Count: 2},
+						}
+					},
+					func(ctx context.Context, from, to time.Time) error {
+						return nil
+					},
+				).Once()
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.mockBehaviours()
+			gotReport, gotErr := statsService.GetDistricts(context.Background(), testCase.inputPayload)
+
+			if testCase.expectedError != nil {
+				assert.ErrorIs(t, gotErr, testCase.expectedError)
+			} else {
+				assert.NoError(t, gotErr)
+				assert.Equal(t, testCase.expectedReport, gotReport)
+			}
+		})
+	}
+
+	mockStatsRepo.AssertExpectations(t)
+}
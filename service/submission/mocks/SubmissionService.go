@@ -0,0 +1,116 @@
+// Code generated by mockery v2.10.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	payload "github.com/erikrios/reog-apps-apis/model/payload"
+	response "github.com/erikrios/reog-apps-apis/model/response"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// SubmissionService is an autogenerated mock type for the SubmissionService type
+type SubmissionService struct {
+	mock.Mock
+}
+
+// Approve provides a mock function with given fields: ctx, id, adminUsername
+func (_m *SubmissionService) Approve(ctx context.Context, id string, adminUsername string) (string, error) {
+	ret := _m.Called(ctx, id, adminUsername)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) string); ok {
+		r0 = rf(ctx, id, adminUsername)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, id, adminUsername)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAll provides a mock function with given fields: ctx, p
+func (_m *SubmissionService) GetAll(ctx context.Context, p payload.GetSubmissions) ([]response.Submission, error) {
+	ret := _m.Called(ctx, p)
+
+	var r0 []response.Submission
+	if rf, ok := ret.Get(0).(func(context.Context, payload.GetSubmissions) []response.Submission); ok {
+		r0 = rf(ctx, p)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]response.Submission)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, payload.GetSubmissions) error); ok {
+		r1 = rf(ctx, p)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetByID provides a mock function with given fields: ctx, id
+func (_m *SubmissionService) GetByID(ctx context.Context, id string) (response.Submission, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 response.Submission
+	if rf, ok := ret.Get(0).(func(context.Context, string) response.Submission); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(response.Submission)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Reject provides a mock function with given fields: ctx, id, adminUsername, p
+func (_m *SubmissionService) Reject(ctx context.Context, id string, adminUsername string, p payload.RejectSubmission) error {
+	ret := _m.Called(ctx, id, adminUsername, p)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, payload.RejectSubmission) error); ok {
+		r0 = rf(ctx, id, adminUsername, p)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Submit provides a mock function with given fields: ctx, ip, p
+func (_m *SubmissionService) Submit(ctx context.Context, ip string, p payload.SubmitGroup) (string, error) {
+	ret := _m.Called(ctx, ip, p)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(context.Context, string, payload.SubmitGroup) string); ok {
+		r0 = rf(ctx, ip, p)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, payload.SubmitGroup) error); ok {
+		r1 = rf(ctx, ip, p)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
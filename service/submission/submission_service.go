@@ -0,0 +1,16 @@
+package submission
+
+import (
+	"context"
+
+	"github.com/erikrios/reog-apps-apis/model/payload"
+	"github.com/erikrios/reog-apps-apis/model/response"
+)
+
+type SubmissionService interface {
+	Submit(ctx context.Context, ip string, p payload.SubmitGroup) (id string, err error)
+	GetAll(ctx context.Context, p payload.GetSubmissions) (responses []response.Submission, err error)
+	GetByID(ctx context.Context, id string) (response response.Submission, err error)
+	Approve(ctx context.Context, id, adminUsername string) (groupID string, err error)
+	Reject(ctx context.Context, id, adminUsername string, p payload.RejectSubmission) (err error)
+}
@@ -0,0 +1,260 @@
+package submission
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/erikrios/reog-apps-apis/entity"
+	"github.com/erikrios/reog-apps-apis/model/payload"
+	"github.com/erikrios/reog-apps-apis/model/response"
+	"github.com/erikrios/reog-apps-apis/repository"
+	"github.com/erikrios/reog-apps-apis/repository/submission"
+	"github.com/erikrios/reog-apps-apis/repository/village"
+	"github.com/erikrios/reog-apps-apis/service"
+	"github.com/erikrios/reog-apps-apis/service/group"
+	"github.com/erikrios/reog-apps-apis/utils/contact"
+	"github.com/erikrios/reog-apps-apis/utils/generator"
+	"github.com/erikrios/reog-apps-apis/utils/geo"
+	"gopkg.in/validator.v2"
+)
+
+const (
+	// quota is the number of submissions accepted from an IP address within quotaWindow
+	quota       = 3
+	quotaWindow = 24 * time.Hour
+)
+
+type submissionServiceImpl struct {
+	submissionRepository submission.SubmissionRepository
+	villageRepository    village.VillageRepository
+	groupService         group.GroupService
+	idGenerator          generator.IDGenerator
+}
+
+func NewSubmissionServiceImpl(
+	submissionRepository submission.SubmissionRepository,
+	villageRepository village.VillageRepository,
+	groupService group.GroupService,
+	idGenerator generator.IDGenerator,
+) *submissionServiceImpl {
+	return &submissionServiceImpl{
+		submissionRepository: submissionRepository,
+		villageRepository:    villageRepository,
+		groupService:         groupService,
+		idGenerator:          idGenerator,
+	}
+}
+
+// Submit stores the proposed group as pending. A submission with the honeypot filled in is answered
+// as if it was stored, so that spam bots can't tell it was discarded.
+func (s *submissionServiceImpl) Submit(ctx context.Context, ip string, p payload.SubmitGroup) (id string, err error) {
+	phone, validPhone := contact.NormalizePhone(p.ContactPhone)
+	validEmail := p.ContactEmail == "" || contact.ValidEmail(p.ContactEmail)
+	if validateErr := validator.Validate(p); validateErr != nil || !geo.ValidLocation(p.Latitude, p.Longitude) || !validPhone || !validEmail {
+		err = service.ErrInvalidPayload
+		return
+	}
+
+	if p.Website != "" {
+		id, err = s.generateID()
+		return
+	}
+
+	if _, villageErr := s.villageRepository.FindByID(p.VillageID); villageErr != nil {
+		err = service.MapError(villageErr)
+		return
+	}
+
+	id, err = s.generateID()
+	if err != nil {
+		return
+	}
+
+	submission := entity.GroupSubmission{
+		ID:           id,
+		Name:         p.Name,
+		Leader:       p.Leader,
+		Address:      p.Address,
+		VillageID:    p.VillageID,
+		Latitude:     p.Latitude,
+		Longitude:    p.Longitude,
+		ContactPhone: phone,
+		ContactEmail: p.ContactEmail,
+		SubmitterIP:  ip,
+		Status:       entity.SubmissionStatusPending,
+	}
+
+	if repoErr := s.submissionRepository.Insert(ctx, submission, quota, time.Now().Add(-quotaWindow)); repoErr != nil {
+		err = service.MapError(repoErr)
+		return
+	}
+
+	return
+}
+
+func (s *submissionServiceImpl) GetAll(ctx context.Context, p payload.GetSubmissions) (responses []response.Submission, err error) {
+	if validateErr := validator.Validate(p); validateErr != nil {
+		err = service.ErrInvalidPayload
+		return
+	}
+
+	submissions, repoErr := s.submissionRepository.FindAll(ctx, p.Status)
+	if repoErr != nil {
+		err = service.MapError(repoErr)
+		return
+	}
+
+	responses = make([]response.Submission, len(submissions))
+	for i, submission := range submissions {
+		responses[i] = mapToResponse(submission)
+	}
+	return
+}
+
+func (s *submissionServiceImpl) GetByID(ctx context.Context, id string) (response response.Submission, err error) {
+	submission, repoErr := s.submissionRepository.FindByID(ctx, id)
+	if repoErr != nil {
+		err = service.MapError(repoErr)
+		return
+	}
+
+	response = mapToResponse(submission)
+	return
+}
+
+// Approve claims the submission first, so that it is approved once even by concurrent admins, then creates the
+// proposed group with the submitted contacts through the group service and records the approval. When either step
+// fails, the created group is deleted again and the claim is released, for the submission to be approved again or
+// rejected.
+func (s *submissionServiceImpl) Approve(ctx context.Context, id, adminUsername string) (groupID string, err error) {
+	submission, err := s.findPending(ctx, id)
+	if err != nil {
+		return
+	}
+
+	if repoErr := s.submissionRepository.Claim(ctx, id); repoErr != nil {
+		if errors.Is(repoErr, repository.ErrRecordNotFound) {
+			err = service.ErrAlreadyReviewed
+			return
+		}
+
+		err = service.MapError(repoErr)
+		return
+	}
+
+	groupID, err = s.groupService.CreateWithContacts(ctx, payload.CreateGroup{
+		Name:      submission.Name,
+		Leader:    submission.Leader,
+		Address:   submission.Address,
+		VillageID: submission.VillageID,
+		Latitude:  submission.Latitude,
+		Longitude: submission.Longitude,
+	}, payload.UpdateGroupContacts{
+		Phone: submission.ContactPhone,
+		Email: submission.ContactEmail,
+	})
+	if err != nil {
+		s.release(ctx, id)
+		return
+	}
+
+	now := time.Now()
+	submission.Status = entity.SubmissionStatusApproved
+	submission.GroupID = &groupID
+	submission.ReviewedBy = adminUsername
+	submission.ReviewedAt = &now
+
+	if repoErr := s.submissionRepository.Review(ctx, submission, entity.SubmissionStatusApproving); repoErr != nil {
+		err = service.MapError(repoErr)
+
+		// The group is deleted at the version it was created with, so approving again does not create it twice
+		_ = s.groupService.Delete(ctx, groupID, 1, payload.DeleteGroup{})
+		s.release(ctx, id)
+		groupID = ""
+		return
+	}
+
+	return
+}
+
+// release hands a claimed submission back for review after its approval failed. A failure is already logged by the
+// repository, the error of the approval is the one to report.
+func (s *submissionServiceImpl) release(ctx context.Context, id string) {
+	pending := entity.GroupSubmission{ID: id, Status: entity.SubmissionStatusPending}
+	_ = s.submissionRepository.Review(ctx, pending, entity.SubmissionStatusApproving)
+}
+
+func (s *submissionServiceImpl) Reject(ctx context.Context, id, adminUsername string, p payload.RejectSubmission) (err error) {
+	if validateErr := validator.Validate(p); validateErr != nil {
+		err = service.ErrInvalidPayload
+		return
+	}
+
+	submission, err := s.findPending(ctx, id)
+	if err != nil {
+		return
+	}
+
+	now := time.Now()
+	submission.Status = entity.SubmissionStatusRejected
+	submission.RejectionReason = p.Reason
+	submission.ReviewedBy = adminUsername
+	submission.ReviewedAt = &now
+
+	if repoErr := s.submissionRepository.Review(ctx, submission, entity.SubmissionStatusPending); repoErr != nil {
+		err = service.MapError(repoErr)
+		return
+	}
+
+	return
+}
+
+func (s *submissionServiceImpl) findPending(ctx context.Context, id string) (submission entity.GroupSubmission, err error) {
+	submission, repoErr := s.submissionRepository.FindByID(ctx, id)
+	if repoErr != nil {
+		err = service.MapError(repoErr)
+		return
+	}
+
+	if submission.Status != entity.SubmissionStatusPending {
+		err = service.ErrAlreadyReviewed
+	}
+	return
+}
+
+func (s *submissionServiceImpl) generateID() (id string, err error) {
+	id, genErr := s.idGenerator.GenerateSubmissionID()
+	if genErr != nil {
+		err = service.MapError(genErr)
+	}
+	return
+}
+
+func mapToResponse(submission entity.GroupSubmission) response.Submission {
+	response := response.Submission{
+		ID:              submission.ID,
+		Name:            submission.Name,
+		Leader:          submission.Leader,
+		Address:         submission.Address,
+		VillageID:       submission.VillageID,
+		Latitude:        submission.Latitude,
+		Longitude:       submission.Longitude,
+		ContactPhone:    submission.ContactPhone,
+		ContactEmail:    submission.ContactEmail,
+		SubmitterIP:     submission.SubmitterIP,
+		Status:          submission.Status,
+		RejectionReason: submission.RejectionReason,
+		ReviewedBy:      submission.ReviewedBy,
+		SubmittedAt:     submission.CreatedAt.Format(time.RFC822),
+	}
+
+	if submission.GroupID != nil {
+		response.GroupID = *submission.GroupID
+	}
+
+	if submission.ReviewedAt != nil {
+		response.ReviewedAt = submission.ReviewedAt.Format(time.RFC822)
+	}
+	return response
+}
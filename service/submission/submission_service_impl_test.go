@@ -0,0 +1,647 @@
+package submission
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/erikrios/reog-apps-apis/entity"
+	"github.com/erikrios/reog-apps-apis/model/payload"
+	"github.com/erikrios/reog-apps-apis/model/response"
+	"github.com/erikrios/reog-apps-apis/repository"
+	mrr "github.com/erikrios/reog-apps-apis/repository/submission/mocks"
+	mvr "github.com/erikrios/reog-apps-apis/repository/village/mocks"
+	"github.com/erikrios/reog-apps-apis/service"
+	mgs "github.com/erikrios/reog-apps-apis/service/group/mocks"
+	mig "github.com/erikrios/reog-apps-apis/utils/generator/mocks"
+	_ "github.com/erikrios/reog-apps-apis/validation"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestSubmit(t *testing.T) {
+	mockSubmissionRepo := &mrr.SubmissionRepository{}
+	mockVillageRepo := &mvr.VillageRepository{}
+	mockGroupService := &mgs.GroupService{}
+	mockIDGen := &mig.IDGenerator{}
+
+	var submissionService SubmissionService = NewSubmissionServiceImpl(
+		mockSubmissionRepo,
+		mockVillageRepo,
+		mockGroupService,
+		mockIDGen,
+	)
+
+	validPayload := payload.SubmitGroup{
+		CreateGroup: payload.CreateGroup{
+			Name:      "Paguyuban Reog Singo Mudho",
+			Leader:    "Erik Rio Setiawan",
+			Address:   "RT 01 RW 01 Dukuh Bibis",
+			VillageID: "3502030007",
+		},
+		ContactPhone: "+62 812-3456-7890",
+		ContactEmail: "erik@example.com",
+	}
+
+	honeypotPayload := validPayload
+	honeypotPayload.Website = "http://spam.example.com"
+
+	onValidSubmission := func() {
+		mockVillageRepo.On("FindByID", "3502030007").Return(
+			func(id string) entity.Village {
+				return entity.Village{ID: id}
+			},
+			func(id string) error {
+				return nil
+			},
+		).Once()
+
+		mockIDGen.On("GenerateSubmissionID").Return(
+			func() string {
+				return "r-aBcdEfG"
+			},
+			func() error {
+				return nil
+			},
+		).Once()
+	}
+
+	onInsert := func(err error) {
+		mockSubmissionRepo.On(
+			"Insert",
+			mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+			mock.MatchedBy(func(submission entity.GroupSubmission) bool {
+				return submission.ID == "r-aBcdEfG" &&
+					submission.SubmitterIP == "192.0.2.1" &&
+					submission.Status == entity.SubmissionStatusPending &&
+					submission.ContactPhone == "+6281234567890"
+			}),
+			int64(quota),
+			mock.MatchedBy(func(since time.Time) bool {
+				return time.Since(since) >= quotaWindow && time.Since(since) < quotaWindow+time.Minute
+			}),
+		).Return(
+			func(ctx context.Context, submission entity.GroupSubmission, quota int64, since time.Time) error {
+				return err
+			},
+		).Once()
+	}
+
+	testCases := []struct {
+		name             string
+		inputIP          string
+		inputSubmitGroup payload.SubmitGroup
+		expectedID       string
+		expectedError    error
+		mockBehaviours   func()
+	}{
+		{
+			name:    "it should return service.ErrInvalidPayload error, when the group name is empty",
+			inputIP: "192.0.2.1",
+			inputSubmitGroup: payload.SubmitGroup{
+				CreateGroup:  payload.CreateGroup{Leader: "Erik Rio Setiawan", Address: "RT 01 RW 01 Dukuh Bibis", VillageID: "3502030007"},
+				ContactPhone: "+62 812-3456-7890",
+			},
+			expectedError:  service.ErrInvalidPayload,
+			mockBehaviours: func() {},
+		},
+		{
+			name:    "it should return service.ErrInvalidPayload error, when the contact phone is invalid",
+			inputIP: "192.0.2.1",
+			inputSubmitGroup: payload.SubmitGroup{
+				CreateGroup:  validPayload.CreateGroup,
+				ContactPhone: "call me maybe",
+			},
+			expectedError:  service.ErrInvalidPayload,
+			mockBehaviours: func() {},
+		},
+		{
+			name:    "it should return service.ErrInvalidPayload error, when the contact email is invalid",
+			inputIP: "192.0.2.1",
+			inputSubmitGroup: payload.SubmitGroup{
+				CreateGroup:  validPayload.CreateGroup,
+				ContactPhone: "081234567890",
+				ContactEmail: "erik",
+			},
+			expectedError:  service.ErrInvalidPayload,
+			mockBehaviours: func() {},
+		},
+		{
+			name:             "it should return a valid ID without storing the submission, when the honeypot is filled in",
+			inputIP:          "192.0.2.1",
+			inputSubmitGroup: honeypotPayload,
+			expectedID:       "r-aBcdEfG",
+			expectedError:    nil,
+			mockBehaviours: func() {
+				mockIDGen.On("GenerateSubmissionID").Return(
+					func() string {
+						return "r-aBcdEfG"
+					},
+					func() error {
+						return nil
+					},
+				).Once()
+			},
+		},
+		{
+			name:             "it should return service.ErrDataNotFound error, when the village does not exist",
+			inputIP:          "192.0.2.1",
+			inputSubmitGroup: validPayload,
+			expectedError:    service.ErrDataNotFound,
+			mockBehaviours: func() {
+				mockVillageRepo.On("FindByID", "3502030007").Return(
+					func(id string) entity.Village {
+						return entity.Village{}
+					},
+					func(id string) error {
+						return repository.ErrRecordNotFound
+					},
+				).Once()
+			},
+		},
+		{
+			name:             "it should return service.ErrTooManyRequests error, when the IP address used up its quota",
+			inputIP:          "192.0.2.1",
+			inputSubmitGroup: validPayload,
+			expectedError:    service.ErrTooManyRequests,
+			mockBehaviours: func() {
+				onValidSubmission()
+				onInsert(repository.ErrQuotaExceeded)
+			},
+		},
+		{
+			name:             "it should return a valid ID, when no error is returned",
+			inputIP:          "192.0.2.1",
+			inputSubmitGroup: validPayload,
+			expectedID:       "r-aBcdEfG",
+			expectedError:    nil,
+			mockBehaviours: func() {
+				onValidSubmission()
+				onInsert(nil)
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.mockBehaviours()
+			gotID, gotErr := submissionService.Submit(context.Background(), testCase.inputIP, testCase.inputSubmitGroup)
+
+			if testCase.expectedError != nil {
+				assert.ErrorIs(t, gotErr, testCase.expectedError)
+			} else {
+				assert.NoError(t, gotErr)
+				assert.Equal(t, testCase.expectedID, gotID)
+			}
+		})
+	}
+
+	mockSubmissionRepo.AssertExpectations(t)
+}
+
+func TestGetAll(t *testing.T) {
+	mockSubmissionRepo := &mrr.SubmissionRepository{}
+	mockVillageRepo := &mvr.VillageRepository{}
+	mockGroupService := &mgs.GroupService{}
+	mockIDGen := &mig.IDGenerator{}
+
+	var submissionService SubmissionService = NewSubmissionServiceImpl(
+		mockSubmissionRepo,
+		mockVillageRepo,
+		mockGroupService,
+		mockIDGen,
+	)
+
+	groupID := "g-abc"
+	createdAt := time.Date(2022, 5, 1, 8, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name              string
+		inputPayload      payload.GetSubmissions
+		expectedResponses []response.Submission
+		expectedError     error
+		mockBehaviours    func()
+	}{
+		{
+			name:           "it should return service.ErrInvalidPayload error, when status is invalid",
+			inputPayload:   payload.GetSubmissions{Status: "deleted"},
+			expectedError:  service.ErrInvalidPayload,
+			mockBehaviours: func() {},
+		},
+		{
+			name:          "it should return service.ErrRepository error, when submission repository return an error",
+			inputPayload:  payload.GetSubmissions{},
+			expectedError: service.ErrRepository,
+			mockBehaviours: func() {
+				mockSubmissionRepo.On(
+					"FindAll",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					"",
+				).Return(
+					func(ctx context.Context, status string) []entity.GroupSubmission {
+						return nil
+					},
+					func(ctx context.Context, status string) error {
+						return repository.ErrDatabase
+					},
+				).Once()
+			},
+		},
+		{
+			name:         "it should return submissions, when no error is returned",
+			inputPayload: payload.GetSubmissions{Status: "approved"},
+			expectedResponses: []response.Submission{
+				{
+					ID:           "r-aBcdEfG",
+					Name:         "Paguyuban Reog Singo Mudho",
+					Leader:       "Erik Rio Setiawan",
+					Address:      "RT 01 RW 01 Dukuh Bibis",
+					VillageID:    "3502030007",
+					ContactPhone: "081234567890",
+					SubmitterIP:  "192.0.2.1",
+					Status:       entity.SubmissionStatusApproved,
+					GroupID:      "g-abc",
+					ReviewedBy:   "erikrios",
+					ReviewedAt:   createdAt.Add(time.Hour).Format(time.RFC822),
+					SubmittedAt:  createdAt.Format(time.RFC822),
+				},
+			},
+			expectedError: nil,
+			mockBehaviours: func() {
+				mockSubmissionRepo.On(
+					"FindAll",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					"approved",
+				).Return(
+					func(ctx context.Context, status string) []entity.GroupSubmission {
+						reviewedAt := createdAt.Add(time.Hour)
+						return []entity.GroupSubmission{
+							{
+								ID:           "r-aBcdEfG",
+								Name:         "Paguyuban Reog Singo Mudho",
+								Leader:       "Erik Rio Setiawan",
+								Address:      "RT 01 RW 01 Dukuh Bibis",
+								VillageID:    "3502030007",
+								ContactPhone: "081234567890",
+								SubmitterIP:  "192.0.2.1",
+								Status:       entity.SubmissionStatusApproved,
+								GroupID:      &groupID,
+								ReviewedBy:   "erikrios",
+								ReviewedAt:   &reviewedAt,
+								CreatedAt:    createdAt,
+							},
+						}
+					},
+					func(ctx context.Context, status string) error {
+						return nil
+					},
+				).Once()
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.mockBehaviours()
+			gotResponses, gotErr := submissionService.GetAll(context.Background(), testCase.inputPayload)
+
+			if testCase.expectedError != nil {
+				assert.ErrorIs(t, gotErr, testCase.expectedError)
+			} else {
+				assert.NoError(t, gotErr)
+				assert.Equal(t, testCase.expectedResponses, gotResponses)
+			}
+		})
+	}
+}
+
+func TestApprove(t *testing.T) {
+	mockSubmissionRepo := &mrr.SubmissionRepository{}
+	mockVillageRepo := &mvr.VillageRepository{}
+	mockGroupService := &mgs.GroupService{}
+	mockIDGen := &mig.IDGenerator{}
+
+	var submissionService SubmissionService = NewSubmissionServiceImpl(
+		mockSubmissionRepo,
+		mockVillageRepo,
+		mockGroupService,
+		mockIDGen,
+	)
+
+	pending := entity.GroupSubmission{
+		ID:           "r-aBcdEfG",
+		Name:         "Paguyuban Reog Singo Mudho",
+		Leader:       "Erik Rio Setiawan",
+		Address:      "RT 01 RW 01 Dukuh Bibis",
+		VillageID:    "3502030007",
+		ContactPhone: "+6281234567890",
+		ContactEmail: "erik@example.com",
+		Status:       entity.SubmissionStatusPending,
+	}
+
+	onFindByID := func(submission entity.GroupSubmission, err error) {
+		mockSubmissionRepo.On(
+			"FindByID",
+			mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+			"r-aBcdEfG",
+		).Return(
+			func(ctx context.Context, id string) entity.GroupSubmission {
+				return submission
+			},
+			func(ctx context.Context, id string) error {
+				return err
+			},
+		).Once()
+	}
+
+	onClaim := func(err error) {
+		mockSubmissionRepo.On(
+			"Claim",
+			mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+			"r-aBcdEfG",
+		).Return(
+			func(ctx context.Context, id string) error {
+				return err
+			},
+		).Once()
+	}
+
+	onCreateWithContacts := func(groupID string, err error) {
+		mockGroupService.On(
+			"CreateWithContacts",
+			mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+			mock.MatchedBy(func(p payload.CreateGroup) bool {
+				return p.Name == pending.Name && p.Leader == pending.Leader && p.VillageID == pending.VillageID
+			}),
+			payload.UpdateGroupContacts{Phone: "+6281234567890", Email: "erik@example.com"},
+		).Return(
+			func(ctx context.Context, p payload.CreateGroup, c payload.UpdateGroupContacts) string {
+				return groupID
+			},
+			func(ctx context.Context, p payload.CreateGroup, c payload.UpdateGroupContacts) error {
+				return err
+			},
+		).Once()
+	}
+
+	onReview := func(status string, err error) {
+		mockSubmissionRepo.On(
+			"Review",
+			mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+			mock.MatchedBy(func(submission entity.GroupSubmission) bool {
+				return submission.ID == "r-aBcdEfG" && submission.Status == status
+			}),
+			entity.SubmissionStatusApproving,
+		).Return(
+			func(ctx context.Context, submission entity.GroupSubmission, from string) error {
+				return err
+			},
+		).Once()
+	}
+
+	testCases := []struct {
+		name            string
+		inputID         string
+		expectedGroupID string
+		expectedError   error
+		mockBehaviours  func()
+	}{
+		{
+			name:          "it should return service.ErrDataNotFound error, when the submission does not exist",
+			inputID:       "r-aBcdEfG",
+			expectedError: service.ErrDataNotFound,
+			mockBehaviours: func() {
+				onFindByID(entity.GroupSubmission{}, repository.ErrRecordNotFound)
+			},
+		},
+		{
+			name:          "it should return service.ErrAlreadyReviewed error, when the submission was rejected",
+			inputID:       "r-aBcdEfG",
+			expectedError: service.ErrAlreadyReviewed,
+			mockBehaviours: func() {
+				rejected := pending
+				rejected.Status = entity.SubmissionStatusRejected
+				onFindByID(rejected, nil)
+			},
+		},
+		{
+			name:          "it should return service.ErrAlreadyReviewed error without creating a group, when another admin claimed the submission first",
+			inputID:       "r-aBcdEfG",
+			expectedError: service.ErrAlreadyReviewed,
+			mockBehaviours: func() {
+				onFindByID(pending, nil)
+				onClaim(repository.ErrRecordNotFound)
+			},
+		},
+		{
+			name:          "it should release the claim, when group service fails to create the group",
+			inputID:       "r-aBcdEfG",
+			expectedError: service.ErrDataNotFound,
+			mockBehaviours: func() {
+				onFindByID(pending, nil)
+				onClaim(nil)
+				onCreateWithContacts("", service.ErrDataNotFound)
+				onReview(entity.SubmissionStatusPending, nil)
+			},
+		},
+		{
+			name:          "it should delete the created group and release the claim, when the approval cannot be recorded",
+			inputID:       "r-aBcdEfG",
+			expectedError: service.ErrRepository,
+			mockBehaviours: func() {
+				onFindByID(pending, nil)
+				onClaim(nil)
+				onCreateWithContacts("g-abc", nil)
+				onReview(entity.SubmissionStatusApproved, repository.ErrDatabase)
+
+				mockGroupService.On(
+					"Delete",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					"g-abc",
+					1,
+					payload.DeleteGroup{},
+				).Return(
+					func(ctx context.Context, id string, version int, p payload.DeleteGroup) error {
+						return nil
+					},
+				).Once()
+
+				onReview(entity.SubmissionStatusPending, nil)
+			},
+		},
+		{
+			name:            "it should return the created group ID, when no error is returned",
+			inputID:         "r-aBcdEfG",
+			expectedGroupID: "g-abc",
+			expectedError:   nil,
+			mockBehaviours: func() {
+				onFindByID(pending, nil)
+				onClaim(nil)
+				onCreateWithContacts("g-abc", nil)
+
+				mockSubmissionRepo.On(
+					"Review",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.MatchedBy(func(submission entity.GroupSubmission) bool {
+						return submission.Status == entity.SubmissionStatusApproved &&
+							submission.GroupID != nil && *submission.GroupID == "g-abc" &&
+							submission.ReviewedBy == "erikrios" &&
+							submission.ReviewedAt != nil
+					}),
+					entity.SubmissionStatusApproving,
+				).Return(
+					func(ctx context.Context, submission entity.GroupSubmission, from string) error {
+						return nil
+					},
+				).Once()
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.mockBehaviours()
+			gotGroupID, gotErr := submissionService.Approve(context.Background(), testCase.inputID, "erikrios")
+
+			if testCase.expectedError != nil {
+				assert.ErrorIs(t, gotErr, testCase.expectedError)
+			} else {
+				assert.NoError(t, gotErr)
+				assert.Equal(t, testCase.expectedGroupID, gotGroupID)
+			}
+		})
+	}
+
+	mockGroupService.AssertExpectations(t)
+	mockSubmissionRepo.AssertExpectations(t)
+}
+
+func TestReject(t *testing.T) {
+	mockSubmissionRepo := &mrr.SubmissionRepository{}
+	mockVillageRepo := &mvr.VillageRepository{}
+	mockGroupService := &mgs.GroupService{}
+	mockIDGen := &mig.IDGenerator{}
+
+	var submissionService SubmissionService = NewSubmissionServiceImpl(
+		mockSubmissionRepo,
+		mockVillageRepo,
+		mockGroupService,
+		mockIDGen,
+	)
+
+	testCases := []struct {
+		name           string
+		inputID        string
+		inputPayload   payload.RejectSubmission
+		expectedError  error
+		mockBehaviours func()
+	}{
+		{
+			name:           "it should return service.ErrInvalidPayload error, when the reason is empty",
+			inputID:        "r-aBcdEfG",
+			inputPayload:   payload.RejectSubmission{},
+			expectedError:  service.ErrInvalidPayload,
+			mockBehaviours: func() {},
+		},
+		{
+			name:          "it should return service.ErrAlreadyReviewed error, when the submission was approved",
+			inputID:       "r-aBcdEfG",
+			inputPayload:  payload.RejectSubmission{Reason: "The group is already registered"},
+			expectedError: service.ErrAlreadyReviewed,
+			mockBehaviours: func() {
+				mockSubmissionRepo.On(
+					"FindByID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					"r-aBcdEfG",
+				).Return(
+					func(ctx context.Context, id string) entity.GroupSubmission {
+						return entity.GroupSubmission{ID: id, Status: entity.SubmissionStatusApproved}
+					},
+					func(ctx context.Context, id string) error {
+						return nil
+					},
+				).Once()
+			},
+		},
+		{
+			name:          "it should return service.ErrDataNotFound error, when the submission was reviewed concurrently",
+			inputID:       "r-aBcdEfG",
+			inputPayload:  payload.RejectSubmission{Reason: "The group is already registered"},
+			expectedError: service.ErrDataNotFound,
+			mockBehaviours: func() {
+				mockSubmissionRepo.On(
+					"FindByID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					"r-aBcdEfG",
+				).Return(
+					func(ctx context.Context, id string) entity.GroupSubmission {
+						return entity.GroupSubmission{ID: id, Status: entity.SubmissionStatusPending}
+					},
+					func(ctx context.Context, id string) error {
+						return nil
+					},
+				).Once()
+
+				mockSubmissionRepo.On(
+					"Review",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.AnythingOfType(fmt.Sprintf("%T", entity.GroupSubmission{})),
+					entity.SubmissionStatusPending,
+				).Return(
+					func(ctx context.Context, submission entity.GroupSubmission, from string) error {
+						return repository.ErrRecordNotFound
+					},
+				).Once()
+			},
+		},
+		{
+			name:          "it should return nil error, when no error is returned",
+			inputID:       "r-aBcdEfG",
+			inputPayload:  payload.RejectSubmission{Reason: "The group is already registered"},
+			expectedError: nil,
+			mockBehaviours: func() {
+				mockSubmissionRepo.On(
+					"FindByID",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					"r-aBcdEfG",
+				).Return(
+					func(ctx context.Context, id string) entity.GroupSubmission {
+						return entity.GroupSubmission{ID: id, Status: entity.SubmissionStatusPending}
+					},
+					func(ctx context.Context, id string) error {
+						return nil
+					},
+				).Once()
+
+				mockSubmissionRepo.On(
+					"Review",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					mock.MatchedBy(func(submission entity.GroupSubmission) bool {
+						return submission.Status == entity.SubmissionStatusRejected &&
+							submission.RejectionReason == "The group is already registered" &&
+							submission.GroupID == nil
+					}),
+					entity.SubmissionStatusPending,
+				).Return(
+					func(ctx context.Context, submission entity.GroupSubmission, from string) error {
+						return nil
+					},
+				).Once()
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.mockBehaviours()
+			gotErr := submissionService.Reject(context.Background(), testCase.inputID, "erikrios", testCase.inputPayload)
+
+			if testCase.expectedError != nil {
+				assert.ErrorIs(t, gotErr, testCase.expectedError)
+			} else {
+				assert.NoError(t, gotErr)
+			}
+		})
+	}
+}
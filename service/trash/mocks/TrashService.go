@@ -0,0 +1,182 @@
+// Code generated by mockery v2.10.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	response "github.com/erikrios/reog-apps-apis/model/response"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// TrashService is an autogenerated mock type for the TrashService type
+type TrashService struct {
+	mock.Mock
+}
+
+// GetGroups provides a mock function with given fields: ctx
+func (_m *TrashService) GetGroups(ctx context.Context) ([]response.DeletedGroup, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []response.DeletedGroup
+	if rf, ok := ret.Get(0).(func(context.Context) []response.DeletedGroup); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]response.DeletedGroup)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetProperties provides a mock function with given fields: ctx
+func (_m *TrashService) GetProperties(ctx context.Context) ([]response.DeletedProperty, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []response.DeletedProperty
+	if rf, ok := ret.Get(0).(func(context.Context) []response.DeletedProperty); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]response.DeletedProperty)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetShowSchedules provides a mock function with given fields: ctx
+func (_m *TrashService) GetShowSchedules(ctx context.Context) ([]response.DeletedShowSchedule, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []response.DeletedShowSchedule
+	if rf, ok := ret.Get(0).(func(context.Context) []response.DeletedShowSchedule); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]response.DeletedShowSchedule)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PurgeExpired provides a mock function with given fields: ctx, retentionDays
+func (_m *TrashService) PurgeExpired(ctx context.Context, retentionDays int) error {
+	ret := _m.Called(ctx, retentionDays)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) error); ok {
+		r0 = rf(ctx, retentionDays)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PurgeGroup provides a mock function with given fields: ctx, id
+func (_m *TrashService) PurgeGroup(ctx context.Context, id string) error {
+	ret := _m.Called(ctx, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PurgeProperty provides a mock function with given fields: ctx, id
+func (_m *TrashService) PurgeProperty(ctx context.Context, id string) error {
+	ret := _m.Called(ctx, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PurgeShowSchedule provides a mock function with given fields: ctx, id
+func (_m *TrashService) PurgeShowSchedule(ctx context.Context, id string) error {
+	ret := _m.Called(ctx, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RestoreGroup provides a mock function with given fields: ctx, id
+func (_m *TrashService) RestoreGroup(ctx context.Context, id string) error {
+	ret := _m.Called(ctx, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RestoreProperty provides a mock function with given fields: ctx, id
+func (_m *TrashService) RestoreProperty(ctx context.Context, id string) error {
+	ret := _m.Called(ctx, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RestoreShowSchedule provides a mock function with given fields: ctx, id
+func (_m *TrashService) RestoreShowSchedule(ctx context.Context, id string) error {
+	ret := _m.Called(ctx, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
@@ -0,0 +1,20 @@
+package trash
+
+import (
+	"context"
+
+	"github.com/erikrios/reog-apps-apis/model/response"
+)
+
+type TrashService interface {
+	GetGroups(ctx context.Context) (responses []response.DeletedGroup, err error)
+	GetProperties(ctx context.Context) (responses []response.DeletedProperty, err error)
+	GetShowSchedules(ctx context.Context) (responses []response.DeletedShowSchedule, err error)
+	RestoreGroup(ctx context.Context, id string) (err error)
+	RestoreProperty(ctx context.Context, id string) (err error)
+	RestoreShowSchedule(ctx context.Context, id string) (err error)
+	PurgeGroup(ctx context.Context, id string) (err error)
+	PurgeProperty(ctx context.Context, id string) (err error)
+	PurgeShowSchedule(ctx context.Context, id string) (err error)
+	PurgeExpired(ctx context.Context, retentionDays int) (err error)
+}
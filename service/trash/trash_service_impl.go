@@ -0,0 +1,302 @@
+package trash
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/erikrios/reog-apps-apis/entity"
+	"github.com/erikrios/reog-apps-apis/model/response"
+	"github.com/erikrios/reog-apps-apis/repository"
+	"github.com/erikrios/reog-apps-apis/repository/group"
+	"github.com/erikrios/reog-apps-apis/repository/trash"
+	"github.com/erikrios/reog-apps-apis/service"
+	"github.com/erikrios/reog-apps-apis/utils/storage"
+)
+
+type trashServiceImpl struct {
+	trashRepository trash.TrashRepository
+	groupRepository group.GroupRepository
+	storage         storage.Storage
+}
+
+func NewTrashServiceImpl(
+	trashRepository trash.TrashRepository,
+	groupRepository group.GroupRepository,
+	storage storage.Storage,
+) *trashServiceImpl {
+	return &trashServiceImpl{
+		trashRepository: trashRepository,
+		groupRepository: groupRepository,
+		storage:         storage,
+	}
+}
+
+func (t *trashServiceImpl) GetGroups(ctx context.Context) (responses []response.DeletedGroup, err error) {
+	groups, repoErr := t.trashRepository.FindDeletedGroups(ctx)
+	if repoErr != nil {
+		err = service.MapError(repoErr)
+		return
+	}
+
+	responses = make([]response.DeletedGroup, len(groups))
+
+	for i, group := range groups {
+		responses[i] = response.DeletedGroup{
+			ID:        group.ID,
+			Name:      group.Name,
+			Leader:    group.Leader,
+			DeletedAt: group.DeletedAt.Time.Format(time.RFC822),
+		}
+		if group.MergedIntoID != nil {
+			responses[i].MergedIntoID = *group.MergedIntoID
+		}
+	}
+	return
+}
+
+func (t *trashServiceImpl) GetProperties(ctx context.Context) (responses []response.DeletedProperty, err error) {
+	properties, repoErr := t.trashRepository.FindDeletedProperties(ctx)
+	if repoErr != nil {
+		err = service.MapError(repoErr)
+		return
+	}
+
+	responses = make([]response.DeletedProperty, len(properties))
+
+	for i, property := range properties {
+		responses[i] = response.DeletedProperty{
+			ID:          property.ID,
+			GroupID:     property.GroupID,
+			Name:        property.Name,
+			Description: property.Description,
+			Amount:      property.Amount,
+			DeletedAt:   property.DeletedAt.Time.Format(time.RFC822),
+		}
+	}
+	return
+}
+
+func (t *trashServiceImpl) GetShowSchedules(ctx context.Context) (responses []response.DeletedShowSchedule, err error) {
+	showSchedules, repoErr := t.trashRepository.FindDeletedShowSchedules(ctx)
+	if repoErr != nil {
+		err = service.MapError(repoErr)
+		return
+	}
+
+	responses = make([]response.DeletedShowSchedule, len(showSchedules))
+
+	for i, showSchedule := range showSchedules {
+		responses[i] = response.DeletedShowSchedule{
+			ID:        showSchedule.ID,
+			Place:     showSchedule.Place,
+			StartOn:   showSchedule.StartOn.Format(time.RFC822),
+			FinishOn:  showSchedule.FinishOn.Format(time.RFC822),
+			DeletedAt: showSchedule.DeletedAt.Time.Format(time.RFC822),
+		}
+		if showSchedule.GroupID != nil {
+			responses[i].GroupID = *showSchedule.GroupID
+		}
+	}
+	return
+}
+
+func (t *trashServiceImpl) RestoreGroup(ctx context.Context, id string) (err error) {
+	if repoErr := t.trashRepository.RestoreGroup(ctx, id); repoErr != nil {
+		err = service.MapError(repoErr)
+	}
+	return
+}
+
+func (t *trashServiceImpl) RestoreProperty(ctx context.Context, id string) (err error) {
+	property, repoErr := t.trashRepository.FindDeletedPropertyByID(ctx, id)
+	if repoErr != nil {
+		err = service.MapError(repoErr)
+		return
+	}
+
+	if err = t.checkGroup(ctx, property.GroupID); err != nil {
+		return
+	}
+
+	if repoErr := t.trashRepository.RestoreProperty(ctx, id); repoErr != nil {
+		err = service.MapError(repoErr)
+	}
+	return
+}
+
+func (t *trashServiceImpl) RestoreShowSchedule(ctx context.Context, id string) (err error) {
+	showSchedule, repoErr := t.trashRepository.FindDeletedShowScheduleByID(ctx, id)
+	if repoErr != nil {
+		err = service.MapError(repoErr)
+		return
+	}
+
+	if showSchedule.GroupID != nil {
+		if err = t.checkGroup(ctx, *showSchedule.GroupID); err != nil {
+			return
+		}
+	}
+
+	if repoErr := t.trashRepository.RestoreShowSchedule(ctx, id); repoErr != nil {
+		err = service.MapError(repoErr)
+	}
+	return
+}
+
+func (t *trashServiceImpl) PurgeGroup(ctx context.Context, id string) (err error) {
+	group, repoErr := t.trashRepository.FindDeletedGroupByID(ctx, id)
+	if repoErr != nil {
+		err = service.MapError(repoErr)
+		return
+	}
+
+	err = t.purgeGroup(ctx, group)
+	return
+}
+
+func (t *trashServiceImpl) PurgeProperty(ctx context.Context, id string) (err error) {
+	property, repoErr := t.trashRepository.FindDeletedPropertyByID(ctx, id)
+	if repoErr != nil {
+		err = service.MapError(repoErr)
+		return
+	}
+
+	err = t.purgeProperty(ctx, property)
+	return
+}
+
+func (t *trashServiceImpl) PurgeShowSchedule(ctx context.Context, id string) (err error) {
+	if repoErr := t.trashRepository.PurgeShowSchedule(ctx, id); repoErr != nil {
+		err = service.MapError(repoErr)
+	}
+	return
+}
+
+// PurgeExpired permanently deletes everything that has been in the trash for more than retentionDays days.
+// Groups go first, so the properties and show schedules deleted with them are purged along. The properties with items
+// still out on loan are kept until the items are returned.
+func (t *trashServiceImpl) PurgeExpired(ctx context.Context, retentionDays int) (err error) {
+	expiredBefore := time.Now().AddDate(0, 0, -retentionDays)
+
+	groups, repoErr := t.trashRepository.FindDeletedGroups(ctx)
+	if repoErr != nil {
+		err = service.MapError(repoErr)
+		return
+	}
+
+	for _, group := range groups {
+		if group.DeletedAt.Time.Before(expiredBefore) {
+			if err = t.purgeGroup(ctx, group); err != nil {
+				return
+			}
+		}
+	}
+
+	properties, repoErr := t.trashRepository.FindDeletedProperties(ctx)
+	if repoErr != nil {
+		err = service.MapError(repoErr)
+		return
+	}
+
+	for _, property := range properties {
+		if property.DeletedAt.Time.Before(expiredBefore) && len(property.Loans) == 0 {
+			if err = t.purgeProperty(ctx, property); err != nil {
+				return
+			}
+		}
+	}
+
+	showSchedules, repoErr := t.trashRepository.FindDeletedShowSchedules(ctx)
+	if repoErr != nil {
+		err = service.MapError(repoErr)
+		return
+	}
+
+	for _, showSchedule := range showSchedules {
+		if showSchedule.DeletedAt.Time.Before(expiredBefore) {
+			if err = t.PurgeShowSchedule(ctx, showSchedule.ID); err != nil {
+				return
+			}
+		}
+	}
+	return
+}
+
+// checkGroup makes sure the group a property or show schedule belongs to is not in the trash itself.
+func (t *trashServiceImpl) checkGroup(ctx context.Context, groupID string) (err error) {
+	if _, repoErr := t.groupRepository.FindByID(ctx, groupID); repoErr != nil {
+		if errors.Is(repoErr, repository.ErrRecordNotFound) {
+			err = service.ErrParentDeleted
+			return
+		}
+
+		err = service.MapError(repoErr)
+	}
+	return
+}
+
+func (t *trashServiceImpl) purgeGroup(ctx context.Context, group entity.Group) (err error) {
+	attachments := group.Attachments
+	for _, property := range group.Properties {
+		attachments = append(attachments, propertyAttachments(property)...)
+	}
+	for _, achievement := range group.Achievements {
+		if achievement.Certificate != nil {
+			attachments = append(attachments, *achievement.Certificate)
+		}
+	}
+
+	if err = t.removeFiles(ctx, attachments); err != nil {
+		return
+	}
+
+	if repoErr := t.trashRepository.PurgeGroup(ctx, group.ID); repoErr != nil {
+		err = service.MapError(repoErr)
+	}
+	return
+}
+
+// purgeProperty refuses to purge a property while some of its items are out on loan, before any file is removed.
+func (t *trashServiceImpl) purgeProperty(ctx context.Context, property entity.Property) (err error) {
+	if len(property.Loans) > 0 {
+		err = service.ErrPropertyOnLoan
+		return
+	}
+
+	if err = t.removeFiles(ctx, propertyAttachments(property)); err != nil {
+		return
+	}
+
+	if repoErr := t.trashRepository.PurgeProperty(ctx, property.ID); repoErr != nil {
+		err = service.MapError(repoErr)
+	}
+	return
+}
+
+// propertyAttachments collects the attachments of the property along with the photos of its maintenance records.
+func propertyAttachments(property entity.Property) []entity.Attachment {
+	attachments := property.Attachments
+	for _, maintenance := range property.Maintenances {
+		attachments = append(attachments, maintenance.Photos...)
+	}
+	return attachments
+}
+
+// removeFiles deletes the files of the attachments before their records, so a failure leaves the records in
+// place and the purge can be retried.
+func (t *trashServiceImpl) removeFiles(ctx context.Context, attachments []entity.Attachment) (err error) {
+	for _, attachment := range attachments {
+		for _, key := range []string{attachment.Key, attachment.ThumbnailKey} {
+			if key == "" {
+				continue
+			}
+
+			if storageErr := t.storage.Delete(ctx, key); storageErr != nil {
+				err = service.MapError(storageErr)
+				return
+			}
+		}
+	}
+	return
+}
@@ -0,0 +1,515 @@
+package trash
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/erikrios/reog-apps-apis/entity"
+	"github.com/erikrios/reog-apps-apis/model/response"
+	"github.com/erikrios/reog-apps-apis/repository"
+	mgr "github.com/erikrios/reog-apps-apis/repository/group/mocks"
+	mtr "github.com/erikrios/reog-apps-apis/repository/trash/mocks"
+	"github.com/erikrios/reog-apps-apis/service"
+	mst "github.com/erikrios/reog-apps-apis/utils/storage/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+func deletedAt(t time.Time) gorm.DeletedAt {
+	return gorm.DeletedAt(sql.NullTime{Time: t, Valid: true})
+}
+
+func TestGetGroups(t *testing.T) {
+	mockTrashRepo := &mtr.TrashRepository{}
+	mockGroupRepo := &mgr.GroupRepository{}
+	mockStorage := &mst.Storage{}
+
+	var trashService TrashService = NewTrashServiceImpl(mockTrashRepo, mockGroupRepo, mockStorage)
+
+	deletedOn := time.Date(2022, time.June, 1, 9, 30, 0, 0, time.UTC)
+	mergedIntoID := "g-xyz"
+
+	testCases := []struct {
+		name              string
+		expectedResponses []response.DeletedGroup
+		expectedError     error
+		mockBehaviours    func()
+	}{
+		{
+			name:          "it should return service.ErrRepository error, when trash repository return an error",
+			expectedError: service.ErrRepository,
+			mockBehaviours: func() {
+				mockTrashRepo.On(
+					"FindDeletedGroups",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+				).Return(
+					func(ctx context.Context) []entity.Group {
+						return nil
+					},
+					func(ctx context.Context) error {
+						return repository.ErrDatabase
+					},
+				).Once()
+			},
+		},
+		{
+			name: "it should return the deleted groups, when no error is returned",
+			expectedResponses: []response.DeletedGroup{
+				{
+					ID:        "g-xyz",
+					Name:      "Paguyuban Reog",
+					Leader:    "Erik Rio S",
+					DeletedAt: deletedOn.Format(time.RFC822),
+				},
+				{
+					ID:           "g-abc",
+					Name:         "Paguyuban Reog.",
+					Leader:       "Erik Rio",
+					DeletedAt:    deletedOn.Format(time.RFC822),
+					MergedIntoID: "g-xyz",
+				},
+			},
+			expectedError: nil,
+			mockBehaviours: func() {
+				mockTrashRepo.On(
+					"FindDeletedGroups",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+				).Return(
+					func(ctx context.Context) []entity.Group {
+						return []entity.Group{
+							{
+								ID:        "g-xyz",
+								Name:      "Paguyuban Reog",
+								Leader:    "Erik Rio S",
+								DeletedAt: deletedAt(deletedOn),
+							},
+							{
+								ID:           "g-abc",
+								Name:         "Paguyuban Reog.",
+								Leader:       "Erik Rio",
+								DeletedAt:    deletedAt(deletedOn),
+								MergedIntoID: &mergedIntoID,
+							},
+						}
+					},
+					func(ctx context.Context) error {
+						return nil
+					},
+				).Once()
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.mockBehaviours()
+
+			gotResponses, gotErr := trashService.GetGroups(context.Background())
+
+			if testCase.expectedError != nil {
+				assert.ErrorIs(t, gotErr, testCase.expectedError)
+			} else {
+				assert.NoError(t, gotErr)
+				assert.Equal(t, testCase.expectedResponses, gotResponses)
+			}
+		})
+	}
+}
+
+func TestRestoreProperty(t *testing.T) {
+	mockTrashRepo := &mtr.TrashRepository{}
+	mockGroupRepo := &mgr.GroupRepository{}
+	mockStorage := &mst.Storage{}
+
+	var trashService TrashService = NewTrashServiceImpl(mockTrashRepo, mockGroupRepo, mockStorage)
+
+	onFindDeletedProperty := func(err error) {
+		mockTrashRepo.On(
+			"FindDeletedPropertyByID",
+			mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+			"p-Ay8LmNI",
+		).Return(
+			func(ctx context.Context, id string) entity.Property {
+				return entity.Property{ID: "p-Ay8LmNI", GroupID: "g-xyz"}
+			},
+			func(ctx context.Context, id string) error {
+				return err
+			},
+		).Once()
+	}
+
+	onFindGroup := func(err error) {
+		mockGroupRepo.On(
+			"FindByID",
+			mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+			"g-xyz",
+		).Return(
+			func(ctx context.Context, id string) entity.Group {
+				return entity.Group{ID: "g-xyz"}
+			},
+			func(ctx context.Context, id string) error {
+				return err
+			},
+		).Once()
+	}
+
+	testCases := []struct {
+		name           string
+		expectedError  error
+		mockBehaviours func()
+	}{
+		{
+			name:          "it should return service.ErrDataNotFound error, when property is not in the trash",
+			expectedError: service.ErrDataNotFound,
+			mockBehaviours: func() {
+				onFindDeletedProperty(repository.ErrRecordNotFound)
+			},
+		},
+		{
+			name:          "it should return service.ErrParentDeleted error, when the group of the property is deleted",
+			expectedError: service.ErrParentDeleted,
+			mockBehaviours: func() {
+				onFindDeletedProperty(nil)
+				onFindGroup(repository.ErrRecordNotFound)
+			},
+		},
+		{
+			name:          "it should return service.ErrRepository error, when group repository return an error",
+			expectedError: service.ErrRepository,
+			mockBehaviours: func() {
+				onFindDeletedProperty(nil)
+				onFindGroup(repository.ErrDatabase)
+			},
+		},
+		{
+			name:          "it should return nil error, when the property is restored",
+			expectedError: nil,
+			mockBehaviours: func() {
+				onFindDeletedProperty(nil)
+				onFindGroup(nil)
+
+				mockTrashRepo.On(
+					"RestoreProperty",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					"p-Ay8LmNI",
+				).Return(
+					func(ctx context.Context, id string) error {
+						return nil
+					},
+				).Once()
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.mockBehaviours()
+
+			gotErr := trashService.RestoreProperty(context.Background(), "p-Ay8LmNI")
+
+			if testCase.expectedError != nil {
+				assert.ErrorIs(t, gotErr, testCase.expectedError)
+			} else {
+				assert.NoError(t, gotErr)
+			}
+		})
+	}
+
+	mockTrashRepo.AssertExpectations(t)
+}
+
+func TestPurgeGroup(t *testing.T) {
+	mockTrashRepo := &mtr.TrashRepository{}
+	mockGroupRepo := &mgr.GroupRepository{}
+	mockStorage := &mst.Storage{}
+
+	var trashService TrashService = NewTrashServiceImpl(mockTrashRepo, mockGroupRepo, mockStorage)
+
+	dummyGroup := entity.Group{
+		ID: "g-xyz",
+		Attachments: []entity.Attachment{
+			{ID: "f-Ay8LmNI", Key: "groups/g-xyz/f-Ay8LmNI.pdf"},
+		},
+		Properties: []entity.Property{
+			{
+				ID: "p-Ay8LmNI",
+				Attachments: []entity.Attachment{
+					{
+						ID:           "f-Xu8LmNI",
+						Key:          "properties/p-Ay8LmNI/f-Xu8LmNI.png",
+						ThumbnailKey: "properties/p-Ay8LmNI/f-Xu8LmNI_thumbnail.jpg",
+					},
+				},
+				Maintenances: []entity.Maintenance{
+					{
+						ID:     "i-Ay8LmNI",
+						Photos: []entity.Attachment{{ID: "f-Wu8LmNI", Key: "maintenances/i-Ay8LmNI/f-Wu8LmNI.jpg"}},
+					},
+				},
+			},
+		},
+		Achievements: []entity.Achievement{
+			{
+				ID:          "c-Ay8LmNI",
+				Certificate: &entity.Attachment{ID: "f-Zu8LmNI", Key: "achievements/c-Ay8LmNI/f-Zu8LmNI.pdf"},
+			},
+			{ID: "c-Bq8LmNI"},
+		},
+	}
+
+	onFindDeletedGroup := func(err error) {
+		mockTrashRepo.On(
+			"FindDeletedGroupByID",
+			mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+			"g-xyz",
+		).Return(
+			func(ctx context.Context, id string) entity.Group {
+				return dummyGroup
+			},
+			func(ctx context.Context, id string) error {
+				return err
+			},
+		).Once()
+	}
+
+	onDeleteFile := func(key string, err error) {
+		mockStorage.On(
+			"Delete",
+			mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+			key,
+		).Return(
+			func(ctx context.Context, key string) error {
+				return err
+			},
+		).Once()
+	}
+
+	testCases := []struct {
+		name           string
+		expectedError  error
+		mockBehaviours func()
+	}{
+		{
+			name:          "it should return service.ErrDataNotFound error, when group is not in the trash",
+			expectedError: service.ErrDataNotFound,
+			mockBehaviours: func() {
+				onFindDeletedGroup(repository.ErrRecordNotFound)
+			},
+		},
+		{
+			name:          "it should return service.ErrRepository error and keep the records, when a file cannot be deleted",
+			expectedError: service.ErrRepository,
+			mockBehaviours: func() {
+				onFindDeletedGroup(nil)
+				onDeleteFile("groups/g-xyz/f-Ay8LmNI.pdf", errors.New("storage unavailable"))
+			},
+		},
+		{
+			name:          "it should delete the attachment files of the group, its properties and its achievements, when no error is returned",
+			expectedError: nil,
+			mockBehaviours: func() {
+				onFindDeletedGroup(nil)
+				onDeleteFile("groups/g-xyz/f-Ay8LmNI.pdf", nil)
+				onDeleteFile("properties/p-Ay8LmNI/f-Xu8LmNI.png", nil)
+				onDeleteFile("properties/p-Ay8LmNI/f-Xu8LmNI_thumbnail.jpg", nil)
+				onDeleteFile("maintenances/i-Ay8LmNI/f-Wu8LmNI.jpg", nil)
+				onDeleteFile("achievements/c-Ay8LmNI/f-Zu8LmNI.pdf", nil)
+
+				mockTrashRepo.On(
+					"PurgeGroup",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					"g-xyz",
+				).Return(
+					func(ctx context.Context, id string) error {
+						return nil
+					},
+				).Once()
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.mockBehaviours()
+
+			gotErr := trashService.PurgeGroup(context.Background(), "g-xyz")
+
+			if testCase.expectedError != nil {
+				assert.ErrorIs(t, gotErr, testCase.expectedError)
+			} else {
+				assert.NoError(t, gotErr)
+			}
+		})
+	}
+
+	mockTrashRepo.AssertExpectations(t)
+	mockStorage.AssertExpectations(t)
+}
+
+func TestPurgeProperty(t *testing.T) {
+	mockTrashRepo := &mtr.TrashRepository{}
+	mockGroupRepo := &mgr.GroupRepository{}
+	mockStorage := &mst.Storage{}
+
+	var trashService TrashService = NewTrashServiceImpl(mockTrashRepo, mockGroupRepo, mockStorage)
+
+	dummyProperty := entity.Property{
+		ID:          "p-Ay8LmNI",
+		Attachments: []entity.Attachment{{ID: "f-Xu8LmNI", Key: "properties/p-Ay8LmNI/f-Xu8LmNI.png"}},
+	}
+
+	onFindDeletedProperty := func(property entity.Property) {
+		mockTrashRepo.On(
+			"FindDeletedPropertyByID",
+			mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+			"p-Ay8LmNI",
+		).Return(
+			func(ctx context.Context, id string) entity.Property {
+				return property
+			},
+			func(ctx context.Context, id string) error {
+				return nil
+			},
+		).Once()
+	}
+
+	testCases := []struct {
+		name           string
+		expectedError  error
+		mockBehaviours func()
+	}{
+		{
+			name:          "it should return service.ErrPropertyOnLoan error and keep the files, when some of the items are out on loan",
+			expectedError: service.ErrPropertyOnLoan,
+			mockBehaviours: func() {
+				onLoan := dummyProperty
+				onLoan.Loans = []entity.Loan{{ID: "l-Ay8LmNI", PropertyID: "p-Ay8LmNI", Quantity: 1}}
+				onFindDeletedProperty(onLoan)
+			},
+		},
+		{
+			name:          "it should delete the attachment files of the property, when no error is returned",
+			expectedError: nil,
+			mockBehaviours: func() {
+				onFindDeletedProperty(dummyProperty)
+
+				mockStorage.On(
+					"Delete",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					"properties/p-Ay8LmNI/f-Xu8LmNI.png",
+				).Return(
+					func(ctx context.Context, key string) error {
+						return nil
+					},
+				).Once()
+
+				mockTrashRepo.On(
+					"PurgeProperty",
+					mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+					"p-Ay8LmNI",
+				).Return(
+					func(ctx context.Context, id string) error {
+						return nil
+					},
+				).Once()
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.mockBehaviours()
+
+			gotErr := trashService.PurgeProperty(context.Background(), "p-Ay8LmNI")
+
+			if testCase.expectedError != nil {
+				assert.ErrorIs(t, gotErr, testCase.expectedError)
+			} else {
+				assert.NoError(t, gotErr)
+			}
+		})
+	}
+
+	mockTrashRepo.AssertExpectations(t)
+	mockStorage.AssertExpectations(t)
+}
+
+func TestPurgeExpired(t *testing.T) {
+	mockTrashRepo := &mtr.TrashRepository{}
+	mockGroupRepo := &mgr.GroupRepository{}
+	mockStorage := &mst.Storage{}
+
+	var trashService TrashService = NewTrashServiceImpl(mockTrashRepo, mockGroupRepo, mockStorage)
+
+	expired := deletedAt(time.Now().AddDate(0, 0, -31))
+	recent := deletedAt(time.Now().AddDate(0, 0, -29))
+
+	mockTrashRepo.On(
+		"FindDeletedGroups",
+		mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+	).Return(
+		func(ctx context.Context) []entity.Group {
+			return []entity.Group{
+				{ID: "g-old", DeletedAt: expired},
+				{ID: "g-new", DeletedAt: recent},
+			}
+		},
+		func(ctx context.Context) error {
+			return nil
+		},
+	).Once()
+
+	mockTrashRepo.On(
+		"FindDeletedProperties",
+		mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+	).Return(
+		func(ctx context.Context) []entity.Property {
+			return []entity.Property{
+				{ID: "p-Ay8LmNI", DeletedAt: expired},
+				{ID: "p-Lo4nNIx", DeletedAt: expired, Loans: []entity.Loan{{ID: "l-Ay8LmNI", Quantity: 1}}},
+			}
+		},
+		func(ctx context.Context) error {
+			return nil
+		},
+	).Once()
+
+	mockTrashRepo.On(
+		"FindDeletedShowSchedules",
+		mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+	).Return(
+		func(ctx context.Context) []entity.ShowSchedule {
+			return []entity.ShowSchedule{
+				{ID: "s-Ay8LmNI", DeletedAt: recent},
+			}
+		},
+		func(ctx context.Context) error {
+			return nil
+		},
+	).Once()
+
+	for method, id := range map[string]string{"PurgeGroup": "g-old", "PurgeProperty": "p-Ay8LmNI"} {
+		mockTrashRepo.On(
+			method,
+			mock.AnythingOfType(fmt.Sprintf("%T", context.Background())),
+			id,
+		).Return(
+			func(ctx context.Context, id string) error {
+				return nil
+			},
+		).Once()
+	}
+
+	t.Run("it should only purge what has been in the trash longer than the retention period, but the properties on loan", func(t *testing.T) {
+		assert.NoError(t, trashService.PurgeExpired(context.Background(), 30))
+		mockTrashRepo.AssertExpectations(t)
+		mockTrashRepo.AssertNotCalled(t, "PurgeGroup", mock.Anything, "g-new")
+		mockTrashRepo.AssertNotCalled(t, "PurgeProperty", mock.Anything, "p-Lo4nNIx")
+		mockTrashRepo.AssertNotCalled(t, "PurgeShowSchedule", mock.Anything, mock.Anything)
+	})
+}
@@ -0,0 +1,54 @@
+// Package contact normalizes and validates the contact details of the groups, and encodes them as vCards.
+package contact
+
+import (
+	"regexp"
+	"strings"
+)
+
+const countryCode = "+62"
+
+var (
+	// nationalNumber matches the Indonesian phone numbers without their trunk prefix 0, such as 352481234 or 81234567890
+	nationalNumber = regexp.MustCompile(`^[1-9][0-9]{7,11}$`)
+	mobileNumber   = regexp.MustCompile(`^8[0-9]{8,11}$`)
+)
+
+// NormalizePhone turns an Indonesian phone number written with +62, 62 or the trunk prefix 0 into the
+// E.164 format, such as +6281234567890. Spaces, dashes, dots and parentheses are ignored.
+func NormalizePhone(s string) (phone string, ok bool) {
+	number, ok := nationalPart(s)
+	if !ok || !nationalNumber.MatchString(number) {
+		return "", false
+	}
+	return countryCode + number, true
+}
+
+// NormalizeMobilePhone is like NormalizePhone, but only accepts mobile numbers, as WhatsApp requires one.
+func NormalizeMobilePhone(s string) (phone string, ok bool) {
+	number, ok := nationalPart(s)
+	if !ok || !mobileNumber.MatchString(number) {
+		return "", false
+	}
+	return countryCode + number, true
+}
+
+func nationalPart(s string) (number string, ok bool) {
+	number = strings.Map(func(r rune) rune {
+		switch r {
+		case ' ', '-', '.', '(', ')':
+			return -1
+		}
+		return r
+	}, s)
+
+	switch {
+	case strings.HasPrefix(number, countryCode):
+		return number[len(countryCode):], true
+	case strings.HasPrefix(number, countryCode[1:]):
+		return number[len(countryCode)-1:], true
+	case strings.HasPrefix(number, "0"):
+		return number[1:], true
+	}
+	return "", false
+}
@@ -0,0 +1,56 @@
+package contact
+
+import (
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// Network is a social media network the groups promote their shows on.
+type Network struct {
+	Name string
+	// Hosts are the hosts of the profile URLs, the first one is used to build them
+	Hosts  []string
+	handle *regexp.Regexp
+	// handlePrefix is prepended to the handle in the profile URLs
+	handlePrefix string
+}
+
+var (
+	Instagram = Network{Name: "instagram", Hosts: []string{"www.instagram.com", "instagram.com"}, handle: regexp.MustCompile(`^[A-Za-z0-9._]{1,30}$`)}
+	Facebook  = Network{Name: "facebook", Hosts: []string{"www.facebook.com", "facebook.com", "m.facebook.com"}, handle: regexp.MustCompile(`^[A-Za-z0-9.]{5,50}$`)}
+	YouTube   = Network{Name: "youtube", Hosts: []string{"www.youtube.com", "youtube.com", "m.youtube.com"}, handle: regexp.MustCompile(`^[A-Za-z0-9._-]{3,30}$`), handlePrefix: "@"}
+	TikTok    = Network{Name: "tiktok", Hosts: []string{"www.tiktok.com", "tiktok.com"}, handle: regexp.MustCompile(`^[A-Za-z0-9._]{2,24}$`), handlePrefix: "@"}
+)
+
+// NormalizeHandle accepts either a handle, with or without its leading @, or a profile URL on the network,
+// and returns the handle without the @.
+func (n Network) NormalizeHandle(s string) (handle string, ok bool) {
+	handle = s
+	if u, err := url.ParseRequestURI(s); err == nil && u.Host != "" {
+		if !n.hasHost(strings.ToLower(u.Host)) {
+			return "", false
+		}
+		handle = strings.Trim(u.Path, "/")
+	}
+
+	handle = strings.TrimPrefix(handle, "@")
+	if !n.handle.MatchString(handle) {
+		return "", false
+	}
+	return handle, true
+}
+
+// ProfileURL returns the URL of the profile with the given handle.
+func (n Network) ProfileURL(handle string) string {
+	return "https://" + n.Hosts[0] + "/" + n.handlePrefix + handle
+}
+
+func (n Network) hasHost(host string) bool {
+	for _, h := range n.Hosts {
+		if h == host {
+			return true
+		}
+	}
+	return false
+}
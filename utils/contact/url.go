@@ -0,0 +1,22 @@
+package contact
+
+import (
+	"net/url"
+	"regexp"
+)
+
+var email = regexp.MustCompile(`^[^@\s]+@[^@\s]+\.[^@\s]+$`)
+
+// ValidURL reports whether s is an absolute http or https URL.
+func ValidURL(s string) bool {
+	u, err := url.ParseRequestURI(s)
+	if err != nil {
+		return false
+	}
+	return (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+}
+
+// ValidEmail reports whether s looks like an email address. Whether it exists can only be known by writing to it.
+func ValidEmail(s string) bool {
+	return email.MatchString(s)
+}
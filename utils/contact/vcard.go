@@ -0,0 +1,97 @@
+package contact
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// maxLineLength is the length in octets after which vCard lines are folded, as required by RFC 2425
+const maxLineLength = 75
+
+// VCard is a business card in the vCard 3.0 format of RFC 2426, which the phone address books import.
+type VCard struct {
+	FormattedName  string
+	Organization   string
+	Title          string
+	Phone          string
+	Mobile         string
+	Email          string
+	URL            string
+	Address        VCardAddress
+	SocialProfiles []SocialProfile
+}
+
+type VCardAddress struct {
+	Street   string
+	Locality string
+	Region   string
+	Country  string
+}
+
+type SocialProfile struct {
+	Network string
+	URL     string
+}
+
+// Encode returns the vCard with CRLF line endings. Empty properties are left out.
+func (v VCard) Encode() []byte {
+	var b strings.Builder
+	writeLine(&b, "BEGIN:VCARD")
+	writeLine(&b, "VERSION:3.0")
+	writeLine(&b, "FN:"+escape(v.FormattedName))
+	// The name can't be reliably split into family and given names, so all of it goes in the family name
+	writeLine(&b, "N:"+escape(v.FormattedName)+";;;;")
+	writeProperty(&b, "ORG", v.Organization)
+	writeProperty(&b, "TITLE", v.Title)
+	writeProperty(&b, "TEL;TYPE=WORK,VOICE", v.Phone)
+	writeProperty(&b, "TEL;TYPE=CELL", v.Mobile)
+	writeProperty(&b, "EMAIL;TYPE=INTERNET", v.Email)
+	if v.Address != (VCardAddress{}) {
+		writeLine(&b, "ADR;TYPE=WORK:;;"+strings.Join([]string{
+			escape(v.Address.Street),
+			escape(v.Address.Locality),
+			escape(v.Address.Region),
+			"",
+			escape(v.Address.Country),
+		}, ";"))
+	}
+	if v.URL != "" {
+		writeLine(&b, "URL:"+v.URL)
+	}
+	for _, profile := range v.SocialProfiles {
+		writeLine(&b, "X-SOCIALPROFILE;TYPE="+profile.Network+":"+profile.URL)
+	}
+	writeLine(&b, "END:VCARD")
+	return []byte(b.String())
+}
+
+func writeProperty(b *strings.Builder, name, value string) {
+	if value != "" {
+		writeLine(b, name+":"+escape(value))
+	}
+}
+
+// writeLine folds the line into lines of at most maxLineLength octets, continued by a leading space,
+// without splitting UTF-8 characters.
+func writeLine(b *strings.Builder, line string) {
+	limit := maxLineLength
+	for len(line) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(line[cut]) {
+			cut--
+		}
+		b.WriteString(line[:cut])
+		b.WriteString("\r\n ")
+		line = line[cut:]
+		// The leading space of the continuation lines counts towards their length
+		limit = maxLineLength - 1
+	}
+	b.WriteString(line)
+	b.WriteString("\r\n")
+}
+
+var escaper = strings.NewReplacer(`\`, `\\`, ",", `\,`, ";", `\;`, "\r\n", `\n`, "\n", `\n`)
+
+func escape(s string) string {
+	return escaper.Replace(s)
+}
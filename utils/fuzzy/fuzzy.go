@@ -0,0 +1,71 @@
+package fuzzy
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Normalize lowercases the text, drops punctuation and collapses whitespaces, so spelling variants such as
+// "Singo  Barong." and "singo barong" compare equal.
+func Normalize(text string) string {
+	var builder strings.Builder
+	for _, r := range strings.ToLower(text) {
+		switch {
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			builder.WriteRune(r)
+		case unicode.IsSpace(r):
+			builder.WriteRune(' ')
+		}
+	}
+	return strings.Join(strings.Fields(builder.String()), " ")
+}
+
+// Similarity returns how close the normalized texts are, from 0 for completely different texts to 1 for equal ones.
+// It is the Levenshtein distance relative to the length of the longer text.
+func Similarity(a, b string) float64 {
+	runesA := []rune(Normalize(a))
+	runesB := []rune(Normalize(b))
+
+	longest := len(runesA)
+	if len(runesB) > longest {
+		longest = len(runesB)
+	}
+	if longest == 0 {
+		return 1
+	}
+
+	return 1 - float64(distance(runesA, runesB))/float64(longest)
+}
+
+// distance returns the Levenshtein distance between a and b, keeping only two rows of the matrix.
+func distance(a, b []rune) int {
+	previous := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+
+	return previous[len(b)]
+}
+
+func min(values ...int) int {
+	result := values[0]
+	for _, value := range values[1:] {
+		if value < result {
+			result = value
+		}
+	}
+	return result
+}
@@ -0,0 +1,78 @@
+package generator
+
+import (
+	"bytes"
+
+	"github.com/erikrios/reog-apps-apis/entity"
+	"github.com/jung-kurt/gofpdf"
+)
+
+type CertificateGenerator interface {
+	GenerateCertificate(group entity.Group, qrCode []byte) ([]byte, error)
+}
+
+type pdfCertificateGenerator struct{}
+
+func NewPDFCertificateGenerator() *pdfCertificateGenerator {
+	return &pdfCertificateGenerator{}
+}
+
+// GenerateCertificate renders a landscape A4 registration certificate with the group data on the left and
+// the PNG qrCode on the right.
+func (p *pdfCertificateGenerator) GenerateCertificate(group entity.Group, qrCode []byte) ([]byte, error) {
+	pdf := gofpdf.New("L", "mm", "A4", "")
+	tr := pdf.UnicodeTranslatorFromDescriptor("")
+
+	pdf.SetTitle("Certificate of Registration "+group.RegistrationNumber, true)
+	pdf.SetAutoPageBreak(false, 0)
+	pdf.AddPage()
+
+	pdf.SetLineWidth(1.2)
+	pdf.Rect(10, 10, 277, 190, "D")
+	pdf.SetLineWidth(0.3)
+	pdf.Rect(14, 14, 269, 182, "D")
+
+	pdf.SetY(28)
+	pdf.SetFont("Helvetica", "B", 26)
+	pdf.CellFormat(0, 12, "CERTIFICATE OF REGISTRATION", "", 1, "C", false, 0, "")
+	pdf.SetFont("Helvetica", "", 14)
+	pdf.CellFormat(0, 8, "Reog Ponorogo Art Group", "", 1, "C", false, 0, "")
+	pdf.Ln(4)
+	pdf.SetFont("Helvetica", "B", 16)
+	pdf.CellFormat(0, 10, tr("No. "+group.RegistrationNumber), "", 1, "C", false, 0, "")
+
+	rows := [][2]string{
+		{"Group ID", group.ID},
+		{"Group Name", group.Name},
+		{"Leader", group.Leader},
+		{"Address", group.Address.Address},
+		{"Village", group.Address.VillageName},
+		{"District", group.Address.DistrictName},
+		{"Regency", group.Address.RegencyName},
+		{"Province", group.Address.ProvinceName},
+		{"Registered On", group.CreatedAt.Format("02 January 2006")},
+	}
+
+	pdf.SetY(80)
+	for _, row := range rows {
+		pdf.SetX(30)
+		pdf.SetFont("Helvetica", "", 12)
+		pdf.CellFormat(40, 9, row[0], "", 0, "L", false, 0, "")
+		pdf.CellFormat(5, 9, ":", "", 0, "L", false, 0, "")
+		pdf.SetFont("Helvetica", "B", 12)
+		pdf.CellFormat(135, 9, tr(row[1]), "", 1, "L", false, 0, "")
+	}
+
+	options := gofpdf.ImageOptions{ImageType: "PNG"}
+	pdf.RegisterImageOptionsReader("qrcode", options, bytes.NewReader(qrCode))
+	pdf.ImageOptions("qrcode", 215, 85, 55, 55, false, options, 0, "")
+	pdf.SetXY(215, 142)
+	pdf.SetFont("Helvetica", "", 9)
+	pdf.CellFormat(55, 5, "Scan to verify", "", 0, "C", false, 0, "")
+
+	var buf bytes.Buffer
+	if err := pdf.Output(&buf); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,47 @@
+package generator
+
+import (
+	"errors"
+	"time"
+
+	"github.com/erikrios/reog-apps-apis/utils/qrsign"
+)
+
+var ErrUnknownSigningKey = errors.New("generator: signing key ID must be one of the signing keys")
+
+type CodeSigner interface {
+	// SignCode returns the signed code encoded in the QR code of a group or a property, issued today.
+	SignCode(codeType string, id string, groupID string) (code string, err error)
+	// VerifyCode returns the payload of a code signed with any of the keys, the rotated ones included.
+	VerifyCode(code string) (payload qrsign.Payload, err error)
+}
+
+type hmacCodeSigner struct {
+	keyID string
+	keys  qrsign.Keys
+}
+
+// NewHMACCodeSigner signs the new codes with the key of keyID, and verifies the codes signed with any of the keys.
+func NewHMACCodeSigner(keyID string, keys qrsign.Keys) (*hmacCodeSigner, error) {
+	if _, ok := keys[keyID]; !ok {
+		return nil, ErrUnknownSigningKey
+	}
+
+	return &hmacCodeSigner{keyID: keyID, keys: keys}, nil
+}
+
+func (h *hmacCodeSigner) SignCode(codeType string, id string, groupID string) (code string, err error) {
+	code, err = qrsign.Sign(qrsign.Payload{
+		KeyID:    h.keyID,
+		Type:     codeType,
+		ID:       id,
+		GroupID:  groupID,
+		IssuedOn: time.Now(),
+	}, h.keys)
+	return
+}
+
+func (h *hmacCodeSigner) VerifyCode(code string) (payload qrsign.Payload, err error) {
+	payload, err = qrsign.Verify(code, h.keys)
+	return
+}
@@ -11,6 +11,14 @@ type IDGenerator interface {
 	GenerateAdminID() (id string, err error)
 	GeneratePropertyID() (id string, err error)
 	GenerateShowScheduleID() (id string, err error)
+	GenerateMemberID() (id string, err error)
+	GenerateAttachmentID() (id string, err error)
+	GenerateStatusTransitionID() (id string, err error)
+	GenerateAchievementID() (id string, err error)
+	GenerateLeadershipChangeID() (id string, err error)
+	GenerateSubmissionID() (id string, err error)
+	GenerateLoanID() (id string, err error)
+	GenerateMaintenanceID() (id string, err error)
 }
 
 type nanoidIDGenerator struct{}
@@ -43,6 +51,54 @@ func (n *nanoidIDGenerator) GenerateShowScheduleID() (id string, err error) {
 	return
 }
 
+func (n *nanoidIDGenerator) GenerateMemberID() (id string, err error) {
+	id, err = n.generate(7)
+	id = fmt.Sprintf("m-%s", id)
+	return
+}
+
+func (n *nanoidIDGenerator) GenerateAttachmentID() (id string, err error) {
+	id, err = n.generate(7)
+	id = fmt.Sprintf("f-%s", id)
+	return
+}
+
+func (n *nanoidIDGenerator) GenerateStatusTransitionID() (id string, err error) {
+	id, err = n.generate(7)
+	id = fmt.Sprintf("t-%s", id)
+	return
+}
+
+func (n *nanoidIDGenerator) GenerateAchievementID() (id string, err error) {
+	id, err = n.generate(7)
+	id = fmt.Sprintf("c-%s", id)
+	return
+}
+
+func (n *nanoidIDGenerator) GenerateLeadershipChangeID() (id string, err error) {
+	id, err = n.generate(7)
+	id = fmt.Sprintf("l-%s", id)
+	return
+}
+
+func (n *nanoidIDGenerator) GenerateSubmissionID() (id string, err error) {
+	id, err = n.generate(7)
+	id = fmt.Sprintf("r-%s", id)
+	return
+}
+
+func (n *nanoidIDGenerator) GenerateLoanID() (id string, err error) {
+	id, err = n.generate(7)
+	id = fmt.Sprintf("o-%s", id)
+	return
+}
+
+func (n *nanoidIDGenerator) GenerateMaintenanceID() (id string, err error) {
+	id, err = n.generate(7)
+	id = fmt.Sprintf("i-%s", id)
+	return
+}
+
 func (n *nanoidIDGenerator) generate(size int) (id string, err error) {
 	id, err = nanoid.GenerateString(nanoid.DefaultAlphabet, size)
 	return
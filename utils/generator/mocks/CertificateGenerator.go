@@ -0,0 +1,37 @@
+// Code generated by mockery v2.10.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	entity "github.com/erikrios/reog-apps-apis/entity"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// CertificateGenerator is an autogenerated mock type for the CertificateGenerator type
+type CertificateGenerator struct {
+	mock.Mock
+}
+
+// GenerateCertificate provides a mock function with given fields: group, qrCode
+func (_m *CertificateGenerator) GenerateCertificate(group entity.Group, qrCode []byte) ([]byte, error) {
+	ret := _m.Called(group, qrCode)
+
+	var r0 []byte
+	if rf, ok := ret.Get(0).(func(entity.Group, []byte) []byte); ok {
+		r0 = rf(group, qrCode)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(entity.Group, []byte) error); ok {
+		r1 = rf(group, qrCode)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
@@ -0,0 +1,55 @@
+// Code generated by mockery v2.10.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	qrsign "github.com/erikrios/reog-apps-apis/utils/qrsign"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// CodeSigner is an autogenerated mock type for the CodeSigner type
+type CodeSigner struct {
+	mock.Mock
+}
+
+// SignCode provides a mock function with given fields: codeType, id, groupID
+func (_m *CodeSigner) SignCode(codeType string, id string, groupID string) (string, error) {
+	ret := _m.Called(codeType, id, groupID)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(string, string, string) string); ok {
+		r0 = rf(codeType, id, groupID)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string, string) error); ok {
+		r1 = rf(codeType, id, groupID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// VerifyCode provides a mock function with given fields: code
+func (_m *CodeSigner) VerifyCode(code string) (qrsign.Payload, error) {
+	ret := _m.Called(code)
+
+	var r0 qrsign.Payload
+	if rf, ok := ret.Get(0).(func(string) qrsign.Payload); ok {
+		r0 = rf(code)
+	} else {
+		r0 = ret.Get(0).(qrsign.Payload)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(code)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
@@ -9,6 +9,27 @@ type IDGenerator struct {
 	mock.Mock
 }
 
+// GenerateAchievementID provides a mock function with given fields:
+func (_m *IDGenerator) GenerateAchievementID() (string, error) {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GenerateAdminID provides a mock function with given fields:
 func (_m *IDGenerator) GenerateAdminID() (string, error) {
 	ret := _m.Called()
@@ -30,6 +51,27 @@ func (_m *IDGenerator) GenerateAdminID() (string, error) {
 	return r0, r1
 }
 
+// GenerateAttachmentID provides a mock function with given fields:
+func (_m *IDGenerator) GenerateAttachmentID() (string, error) {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GenerateGroupID provides a mock function with given fields:
 func (_m *IDGenerator) GenerateGroupID() (string, error) {
 	ret := _m.Called()
@@ -51,6 +93,90 @@ func (_m *IDGenerator) GenerateGroupID() (string, error) {
 	return r0, r1
 }
 
+// GenerateLeadershipChangeID provides a mock function with given fields:
+func (_m *IDGenerator) GenerateLeadershipChangeID() (string, error) {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GenerateLoanID provides a mock function with given fields:
+func (_m *IDGenerator) GenerateLoanID() (string, error) {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GenerateMaintenanceID provides a mock function with given fields:
+func (_m *IDGenerator) GenerateMaintenanceID() (string, error) {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GenerateMemberID provides a mock function with given fields:
+func (_m *IDGenerator) GenerateMemberID() (string, error) {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GeneratePropertyID provides a mock function with given fields:
 func (_m *IDGenerator) GeneratePropertyID() (string, error) {
 	ret := _m.Called()
@@ -92,3 +218,45 @@ func (_m *IDGenerator) GenerateShowScheduleID() (string, error) {
 
 	return r0, r1
 }
+
+// GenerateStatusTransitionID provides a mock function with given fields:
+func (_m *IDGenerator) GenerateStatusTransitionID() (string, error) {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GenerateSubmissionID provides a mock function with given fields:
+func (_m *IDGenerator) GenerateSubmissionID() (string, error) {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
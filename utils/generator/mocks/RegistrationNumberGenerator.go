@@ -0,0 +1,42 @@
+// Code generated by mockery v2.10.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	entity "github.com/erikrios/reog-apps-apis/entity"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// RegistrationNumberGenerator is an autogenerated mock type for the RegistrationNumberGenerator type
+type RegistrationNumberGenerator struct {
+	mock.Mock
+}
+
+// GenerateRegistrationNumber provides a mock function with given fields: address, year, number
+func (_m *RegistrationNumberGenerator) GenerateRegistrationNumber(address entity.Address, year int, number int) string {
+	ret := _m.Called(address, year, number)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(entity.Address, int, int) string); ok {
+		r0 = rf(address, year, number)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// GenerateScope provides a mock function with given fields: address, year
+func (_m *RegistrationNumberGenerator) GenerateScope(address entity.Address, year int) string {
+	ret := _m.Called(address, year)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(entity.Address, int) string); ok {
+		r0 = rf(address, year)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
@@ -0,0 +1,24 @@
+// Code generated by mockery v2.10.4. DO NOT EDIT.
+
+package mocks
+
+import mock "github.com/stretchr/testify/mock"
+
+// ScanLinkGenerator is an autogenerated mock type for the ScanLinkGenerator type
+type ScanLinkGenerator struct {
+	mock.Mock
+}
+
+// GenerateScanLink provides a mock function with given fields: code
+func (_m *ScanLinkGenerator) GenerateScanLink(code string) string {
+	ret := _m.Called(code)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(string) string); ok {
+		r0 = rf(code)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
@@ -0,0 +1,33 @@
+// Code generated by mockery v2.10.4. DO NOT EDIT.
+
+package mocks
+
+import mock "github.com/stretchr/testify/mock"
+
+// ThumbnailGenerator is an autogenerated mock type for the ThumbnailGenerator type
+type ThumbnailGenerator struct {
+	mock.Mock
+}
+
+// GenerateThumbnail provides a mock function with given fields: content, size
+func (_m *ThumbnailGenerator) GenerateThumbnail(content []byte, size int) ([]byte, error) {
+	ret := _m.Called(content, size)
+
+	var r0 []byte
+	if rf, ok := ret.Get(0).(func([]byte, int) []byte); ok {
+		r0 = rf(content, size)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func([]byte, int) error); ok {
+		r1 = rf(content, size)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
@@ -0,0 +1,98 @@
+package generator
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/erikrios/reog-apps-apis/entity"
+)
+
+// DefaultRegistrationNumberFormat numbers the groups of each district per year, e.g. 3502/3502030/0001/2022.
+const DefaultRegistrationNumberFormat = "{regency}/{district}/{number:4}/{year}"
+
+var (
+	ErrInvalidRegistrationNumberFormat = errors.New("generator: registration number format must contain exactly one {number} placeholder")
+	ErrUnknownRegistrationNumberField  = errors.New("generator: unknown registration number placeholder")
+)
+
+var registrationNumberPlaceholder = regexp.MustCompile(`\{([a-z]+)(?::([0-9]+))?\}`)
+
+type RegistrationNumberGenerator interface {
+	// GenerateScope returns the registration number with the running number left out.
+	// Running numbers count up separately within each scope.
+	GenerateScope(address entity.Address, year int) (scope string)
+	GenerateRegistrationNumber(address entity.Address, year int, number int) (registrationNumber string)
+}
+
+type templateRegistrationNumberGenerator struct {
+	format string
+}
+
+// NewTemplateRegistrationNumberGenerator builds registration numbers from a format with the placeholders
+// {province}, {regency}, {district} and {village} for the address IDs, {year}, and {number} for the
+// running number. {year} and {number} can be zero-padded to a width, e.g. {number:4}.
+func NewTemplateRegistrationNumberGenerator(format string) (*templateRegistrationNumberGenerator, error) {
+	numbers := 0
+	for _, match := range registrationNumberPlaceholder.FindAllStringSubmatch(format, -1) {
+		switch match[1] {
+		case "number":
+			numbers++
+		case "province", "regency", "district", "village", "year":
+		default:
+			return nil, fmt.Errorf("%w: %s", ErrUnknownRegistrationNumberField, match[0])
+		}
+	}
+
+	if numbers != 1 {
+		return nil, ErrInvalidRegistrationNumberFormat
+	}
+
+	return &templateRegistrationNumberGenerator{format: format}, nil
+}
+
+func (t *templateRegistrationNumberGenerator) GenerateScope(address entity.Address, year int) (scope string) {
+	scope = t.generate(address, year, func(width int) string {
+		return "{number}"
+	})
+	return
+}
+
+func (t *templateRegistrationNumberGenerator) GenerateRegistrationNumber(address entity.Address, year int, number int) (registrationNumber string) {
+	registrationNumber = t.generate(address, year, func(width int) string {
+		return pad(number, width)
+	})
+	return
+}
+
+func (t *templateRegistrationNumberGenerator) generate(address entity.Address, year int, number func(width int) string) string {
+	return registrationNumberPlaceholder.ReplaceAllStringFunc(t.format, func(placeholder string) string {
+		match := registrationNumberPlaceholder.FindStringSubmatch(placeholder)
+		width, _ := strconv.Atoi(match[2])
+
+		switch match[1] {
+		case "province":
+			return address.ProvinceID
+		case "regency":
+			return address.RegencyID
+		case "district":
+			return address.DistrictID
+		case "village":
+			return address.VillageID
+		case "year":
+			return pad(year, width)
+		default:
+			return number(width)
+		}
+	})
+}
+
+func pad(value, width int) string {
+	s := strconv.Itoa(value)
+	if len(s) < width {
+		s = strings.Repeat("0", width-len(s)) + s
+	}
+	return s
+}
@@ -0,0 +1,41 @@
+package generator
+
+import (
+	"errors"
+	"net/url"
+	"strings"
+)
+
+const scanLinkPlaceholder = "{code}"
+
+var ErrInvalidScanLinkFormat = errors.New("generator: scan link format must be an absolute URL with exactly one {code} placeholder")
+
+type ScanLinkGenerator interface {
+	// GenerateScanLink returns the URL encoded in the QR code of a group or a property, which opens the scan
+	// resolution endpoint, or an app handling the link, when scanned with a phone camera.
+	GenerateScanLink(code string) (link string)
+}
+
+type templateScanLinkGenerator struct {
+	format string
+}
+
+// NewTemplateScanLinkGenerator builds scan links from a URL with a {code} placeholder for the ID of the group or
+// the property, e.g. https://reog.example.com/api/v1/scan/{code}.
+func NewTemplateScanLinkGenerator(format string) (*templateScanLinkGenerator, error) {
+	if strings.Count(format, scanLinkPlaceholder) != 1 {
+		return nil, ErrInvalidScanLinkFormat
+	}
+
+	link, err := url.Parse(strings.Replace(format, scanLinkPlaceholder, "code", 1))
+	if err != nil || link.Scheme == "" || (link.Host == "" && link.Opaque == "") {
+		return nil, ErrInvalidScanLinkFormat
+	}
+
+	return &templateScanLinkGenerator{format: format}, nil
+}
+
+func (t *templateScanLinkGenerator) GenerateScanLink(code string) (link string) {
+	link = strings.Replace(t.format, scanLinkPlaceholder, url.PathEscape(code), 1)
+	return
+}
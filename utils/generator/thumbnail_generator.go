@@ -0,0 +1,75 @@
+package generator
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/jpeg"
+
+	_ "image/gif"
+	_ "image/png"
+
+	"golang.org/x/image/draw"
+	_ "golang.org/x/image/webp"
+)
+
+// MaxImagePixels caps the size of the images GenerateThumbnail decodes to 40 megapixels, as a small upload can
+// decode into gigabytes of pixels.
+const MaxImagePixels = 40000000
+
+var ErrImageTooLarge = errors.New("generator: image has more pixels than MaxImagePixels")
+
+type ThumbnailGenerator interface {
+	GenerateThumbnail(content []byte, size int) ([]byte, error)
+}
+
+type thumbnailGeneratorImpl struct{}
+
+func NewThumbnailGeneratorImpl() *thumbnailGeneratorImpl {
+	return &thumbnailGeneratorImpl{}
+}
+
+// GenerateThumbnail scales the image down so its longest side is at most size pixels and encodes it as JPEG. Only the
+// header is read from an image larger than MaxImagePixels, which is rejected with ErrImageTooLarge.
+func (t *thumbnailGeneratorImpl) GenerateThumbnail(content []byte, size int) ([]byte, error) {
+	config, _, err := image.DecodeConfig(bytes.NewReader(content))
+	if err != nil {
+		return nil, err
+	}
+	if int64(config.Width)*int64(config.Height) > MaxImagePixels {
+		return nil, ErrImageTooLarge
+	}
+
+	src, _, err := image.Decode(bytes.NewReader(content))
+	if err != nil {
+		return nil, err
+	}
+
+	bounds := src.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+	if width > size || height > size {
+		if width > height {
+			height = height * size / width
+			width = size
+		} else {
+			width = width * size / height
+			height = size
+		}
+	}
+	if width < 1 {
+		width = 1
+	}
+	if height < 1 {
+		height = 1
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, width, height))
+	draw.Draw(dst, dst.Bounds(), image.White, image.Point{}, draw.Src)
+	draw.CatmullRom.Scale(dst, dst.Bounds(), src, bounds, draw.Over, nil)
+
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, dst, &jpeg.Options{Quality: 80}); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
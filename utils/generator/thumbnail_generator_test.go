@@ -0,0 +1,83 @@
+package generator
+
+import (
+	"bytes"
+	"encoding/binary"
+	"hash/crc32"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGenerateThumbnail(t *testing.T) {
+	var thumbnailGenerator ThumbnailGenerator = NewThumbnailGeneratorImpl()
+
+	testCases := []struct {
+		name           string
+		inputContent   []byte
+		expectedWidth  int
+		expectedHeight int
+		expectedError  error
+	}{
+		{
+			name:           "it should scale the longest side down to the size, when the image is larger",
+			inputContent:   encodePNG(t, 400, 200),
+			expectedWidth:  100,
+			expectedHeight: 50,
+		},
+		{
+			name:           "it should keep the size of the image, when the image is smaller",
+			inputContent:   encodePNG(t, 40, 20),
+			expectedWidth:  40,
+			expectedHeight: 20,
+		},
+		{
+			name:          "it should return ErrImageTooLarge without decoding the pixels, when the image has too many pixels",
+			inputContent:  resizePNG(encodePNG(t, 1, 1), 50000, 50000),
+			expectedError: ErrImageTooLarge,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			gotThumbnail, gotError := thumbnailGenerator.GenerateThumbnail(testCase.inputContent, 100)
+
+			if testCase.expectedError != nil {
+				assert.ErrorIs(t, gotError, testCase.expectedError)
+				assert.Nil(t, gotThumbnail)
+			} else if assert.NoError(t, gotError) {
+				config, err := jpeg.DecodeConfig(bytes.NewReader(gotThumbnail))
+				if assert.NoError(t, err) {
+					assert.Equal(t, testCase.expectedWidth, config.Width)
+					assert.Equal(t, testCase.expectedHeight, config.Height)
+				}
+			}
+		})
+	}
+}
+
+func encodePNG(t *testing.T, width, height int) []byte {
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	img.Set(0, 0, color.Black)
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+// resizePNG rewrites the dimensions in the IHDR chunk of the PNG, which directly follows the 8 byte signature, as a
+// decompression bomb claims a size its few bytes do not hold.
+func resizePNG(content []byte, width, height uint32) []byte {
+	resized := append([]byte{}, content...)
+	ihdr := resized[8:]
+	binary.BigEndian.PutUint32(ihdr[8:12], width)
+	binary.BigEndian.PutUint32(ihdr[12:16], height)
+	binary.BigEndian.PutUint32(ihdr[21:25], crc32.ChecksumIEEE(ihdr[4:21]))
+	return resized
+}
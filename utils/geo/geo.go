@@ -0,0 +1,61 @@
+package geo
+
+import "math"
+
+// earthRadius is the mean radius of the earth, in meters.
+const earthRadius = 6371008.8
+
+// Point is a position on the earth, in degrees.
+type Point struct {
+	Latitude  float64
+	Longitude float64
+}
+
+// Bounds is a rectangle between two corners, the south west one being Min.
+type Bounds struct {
+	Min Point
+	Max Point
+}
+
+// PonorogoBounds covers Kabupaten Ponorogo, with a small margin so places on the border are accepted.
+var PonorogoBounds = Bounds{
+	Min: Point{Latitude: -8.35, Longitude: 111.25},
+	Max: Point{Latitude: -7.78, Longitude: 111.90},
+}
+
+// ValidLocation reports whether the optional coordinates are either both missing, or both given and inside Ponorogo.
+func ValidLocation(latitude, longitude *float64) bool {
+	if latitude == nil || longitude == nil {
+		return latitude == nil && longitude == nil
+	}
+
+	return PonorogoBounds.Contains(Point{Latitude: *latitude, Longitude: *longitude})
+}
+
+func (b Bounds) Contains(p Point) bool {
+	return p.Latitude >= b.Min.Latitude && p.Latitude <= b.Max.Latitude &&
+		p.Longitude >= b.Min.Longitude && p.Longitude <= b.Max.Longitude
+}
+
+// Around returns the bounds of the circle of the radius, in meters, around the center. It is meant to narrow down
+// the candidates before their Distance is calculated.
+func Around(center Point, radius float64) Bounds {
+	latitudeDelta := radius / earthRadius * 180 / math.Pi
+	longitudeDelta := latitudeDelta / math.Cos(center.Latitude*math.Pi/180)
+
+	return Bounds{
+		Min: Point{Latitude: center.Latitude - latitudeDelta, Longitude: center.Longitude - longitudeDelta},
+		Max: Point{Latitude: center.Latitude + latitudeDelta, Longitude: center.Longitude + longitudeDelta},
+	}
+}
+
+// Distance returns the great-circle distance between two points, in meters, using the haversine formula.
+func Distance(a, b Point) float64 {
+	latitudeA := a.Latitude * math.Pi / 180
+	latitudeB := b.Latitude * math.Pi / 180
+	latitudeDelta := latitudeB - latitudeA
+	longitudeDelta := (b.Longitude - a.Longitude) * math.Pi / 180
+
+	h := math.Pow(math.Sin(latitudeDelta/2), 2) + math.Cos(latitudeA)*math.Cos(latitudeB)*math.Pow(math.Sin(longitudeDelta/2), 2)
+	return 2 * earthRadius * math.Asin(math.Sqrt(h))
+}
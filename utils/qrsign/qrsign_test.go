@@ -0,0 +1,256 @@
+package qrsign
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseKeys(t *testing.T) {
+	testCases := []struct {
+		name          string
+		inputKeys     string
+		expectedKeys  Keys
+		expectedError error
+	}{
+		{
+			name:      "it should return the keys, when the pairs are well formed",
+			inputKeys: "k2:new-secret, k1:old-secret",
+			expectedKeys: Keys{
+				"k2": []byte("new-secret"),
+				"k1": []byte("old-secret"),
+			},
+		},
+		{
+			name:          "it should return ErrInvalidKeys, when no key is given",
+			inputKeys:     "",
+			expectedError: ErrInvalidKeys,
+		},
+		{
+			name:          "it should return ErrInvalidKeys, when a pair has no colon",
+			inputKeys:     "k2:new-secret,k1",
+			expectedError: ErrInvalidKeys,
+		},
+		{
+			name:          "it should return ErrInvalidKeys, when a secret is empty",
+			inputKeys:     "k2:new-secret,k1:",
+			expectedError: ErrInvalidKeys,
+		},
+		{
+			name:          "it should return ErrInvalidKeys, when a key ID is empty",
+			inputKeys:     ":new-secret",
+			expectedError: ErrInvalidKeys,
+		},
+		{
+			name:          "it should return ErrInvalidKeys, when a key ID contains the separator",
+			inputKeys:     "k.2:new-secret",
+			expectedError: ErrInvalidKeys,
+		},
+		{
+			name:          "it should return ErrInvalidKeys, when a key ID is duplicated",
+			inputKeys:     "k1:new-secret,k1:old-secret",
+			expectedError: ErrInvalidKeys,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			gotKeys, gotError := ParseKeys(testCase.inputKeys)
+
+			if testCase.expectedError != nil {
+				assert.ErrorIs(t, gotError, testCase.expectedError)
+				assert.Nil(t, gotKeys)
+			} else {
+				assert.NoError(t, gotError)
+				assert.Equal(t, testCase.expectedKeys, gotKeys)
+			}
+		})
+	}
+}
+
+func TestSign(t *testing.T) {
+	keys := Keys{"k1": []byte("old-secret")}
+	issuedOn := time.Date(2022, time.October, 18, 0, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name          string
+		inputPayload  Payload
+		expectedError error
+	}{
+		{
+			name:         "it should return a code, when the payload is valid",
+			inputPayload: Payload{KeyID: "k1", Type: TypeProperty, ID: "p-Xq3vT", GroupID: "g-Nzo", IssuedOn: issuedOn},
+		},
+		{
+			name:          "it should return ErrUnknownKey, when the key ID is not among the keys",
+			inputPayload:  Payload{KeyID: "k2", Type: TypeProperty, ID: "p-Xq3vT", GroupID: "g-Nzo", IssuedOn: issuedOn},
+			expectedError: ErrUnknownKey,
+		},
+		{
+			name:          "it should return ErrMalformedCode, when the type is unknown",
+			inputPayload:  Payload{KeyID: "k1", Type: "member", ID: "m-Xq3vT", GroupID: "g-Nzo", IssuedOn: issuedOn},
+			expectedError: ErrMalformedCode,
+		},
+		{
+			name:          "it should return ErrMalformedCode, when the ID contains the separator",
+			inputPayload:  Payload{KeyID: "k1", Type: TypeProperty, ID: "p.Xq3vT", GroupID: "g-Nzo", IssuedOn: issuedOn},
+			expectedError: ErrMalformedCode,
+		},
+		{
+			name:          "it should return ErrMalformedCode, when the group ID is empty",
+			inputPayload:  Payload{KeyID: "k1", Type: TypeProperty, ID: "p-Xq3vT", IssuedOn: issuedOn},
+			expectedError: ErrMalformedCode,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			gotCode, gotError := Sign(testCase.inputPayload, keys)
+
+			if testCase.expectedError != nil {
+				assert.ErrorIs(t, gotError, testCase.expectedError)
+				assert.Empty(t, gotCode)
+			} else {
+				assert.NoError(t, gotError)
+				assert.True(t, strings.HasPrefix(gotCode, "k1.property.p-Xq3vT.g-Nzo.20221018."))
+			}
+		})
+	}
+}
+
+func TestVerify(t *testing.T) {
+	oldKeys := Keys{"k1": []byte("old-secret")}
+	rotatedKeys := Keys{"k2": []byte("new-secret"), "k1": []byte("old-secret")}
+	payload := Payload{
+		KeyID:    "k1",
+		Type:     TypeGroup,
+		ID:       "g-Nzo",
+		GroupID:  "g-Nzo",
+		IssuedOn: time.Date(2022, time.October, 18, 0, 0, 0, 0, time.UTC),
+	}
+
+	code, err := Sign(payload, oldKeys)
+	if err != nil {
+		t.Fatal(err)
+	}
+	signature := code[strings.LastIndex(code, ".")+1:]
+
+	newPayload := payload
+	newPayload.KeyID = "k2"
+	newCode, err := Sign(newPayload, rotatedKeys)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		name            string
+		inputCode       string
+		inputKeys       Keys
+		expectedPayload Payload
+		expectedError   error
+	}{
+		{
+			name:            "it should return the payload, when the code is signed with the given key",
+			inputCode:       code,
+			inputKeys:       oldKeys,
+			expectedPayload: payload,
+		},
+		{
+			name:            "it should return the payload, when the code is signed with a rotated key kept alongside the current one",
+			inputCode:       code,
+			inputKeys:       rotatedKeys,
+			expectedPayload: payload,
+		},
+		{
+			name:            "it should return the payload, when the code is signed with the current key after the rotation",
+			inputCode:       newCode,
+			inputKeys:       rotatedKeys,
+			expectedPayload: newPayload,
+		},
+		{
+			name:          "it should return ErrUnknownKey, when the code is signed with a key no longer kept",
+			inputCode:     code,
+			inputKeys:     Keys{"k2": []byte("new-secret")},
+			expectedError: ErrUnknownKey,
+		},
+		{
+			name:          "it should return ErrInvalidSignature, when the payload is tampered with",
+			inputCode:     "k1.group.g-Abc.g-Abc.20221018." + signature,
+			inputKeys:     oldKeys,
+			expectedError: ErrInvalidSignature,
+		},
+		{
+			name:          "it should return ErrInvalidSignature, when the signature is tampered with",
+			inputCode:     code[:len(code)-1] + tamper(code[len(code)-1]),
+			inputKeys:     oldKeys,
+			expectedError: ErrInvalidSignature,
+		},
+		{
+			name:          "it should return ErrInvalidSignature, when the key ID is swapped for another kept key",
+			inputCode:     "k2" + code[len("k1"):],
+			inputKeys:     rotatedKeys,
+			expectedError: ErrInvalidSignature,
+		},
+		{
+			name:          "it should return ErrMalformedCode, when the code has too few fields",
+			inputCode:     "k1.group.g-Nzo." + signature,
+			inputKeys:     oldKeys,
+			expectedError: ErrMalformedCode,
+		},
+		{
+			name:          "it should return ErrMalformedCode, when the code is a bare ID",
+			inputCode:     "g-Nzo",
+			inputKeys:     oldKeys,
+			expectedError: ErrMalformedCode,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			gotPayload, gotError := Verify(testCase.inputCode, testCase.inputKeys)
+
+			if testCase.expectedError != nil {
+				assert.ErrorIs(t, gotError, testCase.expectedError)
+				assert.Equal(t, Payload{}, gotPayload)
+			} else {
+				assert.NoError(t, gotError)
+				assert.Equal(t, testCase.expectedPayload, gotPayload)
+			}
+		})
+	}
+}
+
+func TestIsSigned(t *testing.T) {
+	testCases := []struct {
+		name           string
+		inputCode      string
+		expectedSigned bool
+	}{
+		{
+			name:           "it should return true, when the code is signed",
+			inputCode:      "k1.property.p-Xq3vT.g-Nzo.20221018.c2lnbmF0dXJl",
+			expectedSigned: true,
+		},
+		{
+			name:           "it should return false, when the code is a bare ID",
+			inputCode:      "p-Xq3vT",
+			expectedSigned: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.Equal(t, testCase.expectedSigned, IsSigned(testCase.inputCode))
+		})
+	}
+}
+
+// tamper returns another character of the base64 alphabet.
+func tamper(c byte) string {
+	if c == 'A' {
+		return "B"
+	}
+	return "A"
+}
@@ -0,0 +1,56 @@
+package storage
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+type localStorage struct {
+	dir     string
+	baseURL string
+}
+
+// NewLocalStorage stores files under dir and builds their URLs from baseURL,
+// which should point at wherever dir is served from.
+func NewLocalStorage(dir, baseURL string) *localStorage {
+	return &localStorage{dir: dir, baseURL: strings.TrimSuffix(baseURL, "/")}
+}
+
+func (l *localStorage) Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) (err error) {
+	path := l.path(key)
+	if err = os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return
+	}
+
+	file, err := os.Create(path)
+	if err != nil {
+		return
+	}
+
+	if _, err = io.Copy(file, r); err != nil {
+		file.Close()
+		os.Remove(path)
+		return
+	}
+	err = file.Close()
+	return
+}
+
+func (l *localStorage) Delete(ctx context.Context, key string) (err error) {
+	if err = os.Remove(l.path(key)); os.IsNotExist(err) {
+		err = nil
+	}
+	return
+}
+
+func (l *localStorage) URL(key string) (url string) {
+	url = l.baseURL + "/" + key
+	return
+}
+
+func (l *localStorage) path(key string) string {
+	return filepath.Join(l.dir, filepath.FromSlash(filepath.Clean("/"+key)))
+}
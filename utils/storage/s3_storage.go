@@ -0,0 +1,36 @@
+package storage
+
+import (
+	"context"
+	"io"
+	"strings"
+
+	"github.com/minio/minio-go/v7"
+)
+
+type s3Storage struct {
+	client  *minio.Client
+	bucket  string
+	baseURL string
+}
+
+// NewS3Storage stores files in an S3-compatible bucket, such as AWS S3 or MinIO.
+// The bucket is expected to allow public reads under baseURL.
+func NewS3Storage(client *minio.Client, bucket, baseURL string) *s3Storage {
+	return &s3Storage{client: client, bucket: bucket, baseURL: strings.TrimSuffix(baseURL, "/")}
+}
+
+func (s *s3Storage) Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) (err error) {
+	_, err = s.client.PutObject(ctx, s.bucket, key, r, size, minio.PutObjectOptions{ContentType: contentType})
+	return
+}
+
+func (s *s3Storage) Delete(ctx context.Context, key string) (err error) {
+	err = s.client.RemoveObject(ctx, s.bucket, key, minio.RemoveObjectOptions{})
+	return
+}
+
+func (s *s3Storage) URL(key string) (url string) {
+	url = s.baseURL + "/" + key
+	return
+}
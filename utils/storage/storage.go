@@ -0,0 +1,12 @@
+package storage
+
+import (
+	"context"
+	"io"
+)
+
+type Storage interface {
+	Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) (err error)
+	Delete(ctx context.Context, key string) (err error)
+	URL(key string) (url string)
+}